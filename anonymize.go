@@ -5,6 +5,7 @@ import (
 
 	"github.com/way-platform/tachograph-go/internal/card"
 	"github.com/way-platform/tachograph-go/internal/vu"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
 )
 
@@ -36,6 +37,38 @@ type AnonymizeOptions struct {
 	// If false (default), timestamps are shifted to a fixed epoch (2020-01-01 00:00:00 UTC)
 	// to obscure the exact time of events while maintaining relative ordering.
 	PreserveTimestamps bool
+
+	// BaseLatitude and BaseLongitude are the coordinates replacing anonymized
+	// GNSS positions, encoded as GeoCoordinates (±DDMM.M * 10, e.g. 48125 and
+	// 11345 for Munich at 48°12.5'N 11°34.5'E).
+	// If both are zero (default), Helsinki (60°10.0'N 24°56.0'E) is used.
+	BaseLatitude, BaseLongitude int32
+
+	// HomeNation replaces anonymized nations, such as the countries of places.
+	// If unspecified (default), Finland is used.
+	HomeNation ddv1.NationNumeric
+
+	// NeighborNation is the country entered by anonymized border crossings,
+	// which leave HomeNation. If unspecified (default), Sweden is used.
+	NeighborNation ddv1.NationNumeric
+
+	// PreserveNations controls whether nations and regions are preserved.
+	//
+	// If true, the countries and regions of places and border crossings, and
+	// the issuing member states of card numbers, keep their original values,
+	// which are not personal data on their own. This keeps them consistent
+	// with the vehicle registration nations, which are always preserved.
+	// If false (default), they are replaced with HomeNation and NeighborNation.
+	PreserveNations bool
+
+	// Key is the secret key of the HMAC-SHA256 deriving anonymized card
+	// numbers from the original ones.
+	//
+	// Files anonymized with the same key anonymize a card to the same number,
+	// also across runs. Without the key, the original numbers cannot be
+	// recovered by anonymizing candidates, so set a secret key when sharing
+	// anonymized files. If empty (default), a fixed, public key is used.
+	Key []byte
 }
 
 // Anonymize creates an anonymized copy of a parsed tachograph file.
@@ -57,6 +90,12 @@ func (o AnonymizeOptions) Anonymize(file *tachographv1.File) (*tachographv1.File
 		cardOpts := card.AnonymizeOptions{
 			PreserveDistanceAndTrips: o.PreserveDistanceAndTrips,
 			PreserveTimestamps:       o.PreserveTimestamps,
+			BaseLatitude:             o.BaseLatitude,
+			BaseLongitude:            o.BaseLongitude,
+			HomeNation:               o.HomeNation,
+			NeighborNation:           o.NeighborNation,
+			PreserveNations:          o.PreserveNations,
+			Key:                      o.Key,
 		}
 		anonymizedCard, err := cardOpts.AnonymizeDriverCardFile(file.GetDriverCard())
 		if err != nil {
@@ -68,6 +107,12 @@ func (o AnonymizeOptions) Anonymize(file *tachographv1.File) (*tachographv1.File
 		vuOpts := vu.AnonymizeOptions{
 			PreserveDistanceAndTrips: o.PreserveDistanceAndTrips,
 			PreserveTimestamps:       o.PreserveTimestamps,
+			BaseLatitude:             o.BaseLatitude,
+			BaseLongitude:            o.BaseLongitude,
+			HomeNation:               o.HomeNation,
+			NeighborNation:           o.NeighborNation,
+			PreserveNations:          o.PreserveNations,
+			Key:                      o.Key,
 		}
 		anonymizedVU, err := vuOpts.AnonymizeVehicleUnitFile(file.GetVehicleUnit())
 		if err != nil {
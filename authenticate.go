@@ -52,8 +52,23 @@ type AuthenticateOptions struct {
 // The zero value of AuthenticateOptions uses the default certificate resolver
 // and does not mutate the input.
 func (o AuthenticateOptions) Authenticate(ctx context.Context, rawFile *tachographv1.RawFile) (*tachographv1.RawFile, error) {
+	target, _, err := o.AuthenticateWithReport(ctx, rawFile)
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// AuthenticateWithReport is like Authenticate, but also returns a report of
+// the authentication status of each record of the file.
+//
+// Unlike Authenticate, the authenticated RawFile and the report are also
+// returned when some records fail authentication, together with the error, so
+// that callers can tell which parts of a partly authenticated file to trust.
+// Only invalid input yields a nil RawFile and report.
+func (o AuthenticateOptions) AuthenticateWithReport(ctx context.Context, rawFile *tachographv1.RawFile) (*tachographv1.RawFile, *AuthenticationReport, error) {
 	if rawFile == nil {
-		return nil, fmt.Errorf("rawFile cannot be nil")
+		return nil, nil, fmt.Errorf("rawFile cannot be nil")
 	}
 
 	// Clone the input unless mutate is explicitly requested
@@ -72,18 +87,15 @@ func (o AuthenticateOptions) Authenticate(ctx context.Context, rawFile *tachogra
 		CertificateResolver: o.CertificateResolver,
 	}
 
+	var err error
 	switch target.GetType() {
 	case tachographv1.RawFile_CARD:
-		if err := cardOpts.AuthenticateRawCardFile(ctx, target.GetCard()); err != nil {
-			return nil, err
-		}
+		err = cardOpts.AuthenticateRawCardFile(ctx, target.GetCard())
 	case tachographv1.RawFile_VEHICLE_UNIT:
-		if err := vuOpts.AuthenticateRawVehicleUnitFile(ctx, target.GetVehicleUnit()); err != nil {
-			return nil, err
-		}
+		err = vuOpts.AuthenticateRawVehicleUnitFile(ctx, target.GetVehicleUnit())
 	default:
-		return nil, fmt.Errorf("unsupported file type: %v", target.GetType())
+		return nil, nil, fmt.Errorf("unsupported file type: %v", target.GetType())
 	}
 
-	return target, nil
+	return target, newAuthenticationReport(target), err
 }
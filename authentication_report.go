@@ -0,0 +1,143 @@
+package tachograph
+
+import (
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+)
+
+// AuthenticationStatus is the outcome of authenticating a single record.
+type AuthenticationStatus int
+
+const (
+	// AuthenticationUnsigned is a record that carries no signature, such as
+	// an EF outside the signed set of the card, or a transfer without a
+	// signature.
+	AuthenticationUnsigned AuthenticationStatus = iota + 1
+
+	// AuthenticationVerified is a signed record whose signature was verified
+	// against a trusted certificate chain.
+	AuthenticationVerified
+
+	// AuthenticationInvalid is a signed record whose signature does not
+	// match its data.
+	AuthenticationInvalid
+
+	// AuthenticationUnverifiable is a signed record whose signature could not
+	// be checked, for example because a certificate of the chain was missing
+	// or could not be verified.
+	AuthenticationUnverifiable
+)
+
+// String returns the name of the authentication status.
+func (s AuthenticationStatus) String() string {
+	switch s {
+	case AuthenticationUnsigned:
+		return "UNSIGNED"
+	case AuthenticationVerified:
+		return "VERIFIED"
+	case AuthenticationInvalid:
+		return "INVALID"
+	case AuthenticationUnverifiable:
+		return "UNVERIFIABLE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// RecordAuthentication is the authentication status of a single record of a
+// raw file.
+type RecordAuthentication struct {
+	// Name is the elementary file type of a card record (e.g. "EF_IDENTIFICATION"),
+	// or the transfer type of a vehicle unit record (e.g. "ACTIVITIES_GEN1").
+	Name string
+
+	// Generation is the generation of the record.
+	Generation ddv1.Generation
+
+	// FileOffset is the byte offset of the record in the file.
+	FileOffset int
+
+	// Status is the authentication status of the record.
+	Status AuthenticationStatus
+}
+
+// AuthenticationReport summarizes the authentication status of the records of
+// a raw file, in file order.
+//
+// Card records are reported per data record; the signature records they are
+// signed by are not reported separately.
+type AuthenticationReport struct {
+	Records []RecordAuthentication
+}
+
+// Authenticated reports whether every signed record of the file was verified.
+func (r *AuthenticationReport) Authenticated() bool {
+	for _, record := range r.Records {
+		if record.Status != AuthenticationUnsigned && record.Status != AuthenticationVerified {
+			return false
+		}
+	}
+	return true
+}
+
+// newAuthenticationReport builds the report of an authenticated raw file.
+func newAuthenticationReport(rawFile *tachographv1.RawFile) *AuthenticationReport {
+	report := &AuthenticationReport{}
+	switch rawFile.GetType() {
+	case tachographv1.RawFile_CARD:
+		records := rawFile.GetCard().GetRecords()
+		for i, record := range records {
+			if record.GetContentType() != cardv1.ContentType_DATA {
+				continue
+			}
+			var status AuthenticationStatus
+			switch {
+			case record.HasAuthentication():
+				status = authenticationStatus(record.GetAuthentication())
+			case i+1 < len(records) &&
+				records[i+1].GetFile() == record.GetFile() &&
+				records[i+1].GetGeneration() == record.GetGeneration() &&
+				records[i+1].GetContentType() == cardv1.ContentType_SIGNATURE:
+				// Signed, but not authenticated, e.g. because the certificate
+				// chain of the card could not be verified.
+				status = AuthenticationUnverifiable
+			default:
+				status = AuthenticationUnsigned
+			}
+			report.Records = append(report.Records, RecordAuthentication{
+				Name:       record.GetFile().String(),
+				Generation: record.GetGeneration(),
+				FileOffset: int(record.GetFileOffset()),
+				Status:     status,
+			})
+		}
+	case tachographv1.RawFile_VEHICLE_UNIT:
+		for _, record := range rawFile.GetVehicleUnit().GetRecords() {
+			status := AuthenticationUnsigned
+			if record.GetSignatureSize() > 0 {
+				status = authenticationStatus(record.GetAuthentication())
+			}
+			report.Records = append(report.Records, RecordAuthentication{
+				Name:       record.GetType().String(),
+				Generation: record.GetGeneration(),
+				FileOffset: int(record.GetFileOffset()),
+				Status:     status,
+			})
+		}
+	}
+	return report
+}
+
+// authenticationStatus maps the authentication result of a signed record.
+func authenticationStatus(auth *securityv1.Authentication) AuthenticationStatus {
+	switch auth.GetStatus() {
+	case securityv1.Authentication_VERIFIED:
+		return AuthenticationVerified
+	case securityv1.Authentication_DATA_SIGNATURE_INVALID:
+		return AuthenticationInvalid
+	default:
+		return AuthenticationUnverifiable
+	}
+}
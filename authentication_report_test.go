@@ -0,0 +1,102 @@
+package tachograph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestNewAuthenticationReport_card(t *testing.T) {
+	record := func(file cardv1.ElementaryFileType, gen ddv1.Generation, contentType cardv1.ContentType, offset int32, status securityv1.Authentication_Status) *cardv1.RawCardFile_Record {
+		r := &cardv1.RawCardFile_Record{}
+		r.SetFile(file)
+		r.SetGeneration(gen)
+		r.SetContentType(contentType)
+		r.SetFileOffset(offset)
+		if status != securityv1.Authentication_STATUS_UNSPECIFIED {
+			auth := &securityv1.Authentication{}
+			auth.SetStatus(status)
+			r.SetAuthentication(auth)
+		}
+		return r
+	}
+	g1, g2 := ddv1.Generation_GENERATION_1, ddv1.Generation_GENERATION_2
+	data, signature := cardv1.ContentType_DATA, cardv1.ContentType_SIGNATURE
+	rawCard := &cardv1.RawCardFile{}
+	rawCard.SetRecords([]*cardv1.RawCardFile_Record{
+		record(cardv1.ElementaryFileType_EF_ICC, g1, data, 0, 0),
+		record(cardv1.ElementaryFileType_EF_IDENTIFICATION, g1, data, 30, securityv1.Authentication_VERIFIED),
+		record(cardv1.ElementaryFileType_EF_IDENTIFICATION, g1, signature, 178, 0),
+		record(cardv1.ElementaryFileType_EF_EVENTS_DATA, g1, data, 311, securityv1.Authentication_DATA_SIGNATURE_INVALID),
+		record(cardv1.ElementaryFileType_EF_EVENTS_DATA, g1, signature, 1180, 0),
+		record(cardv1.ElementaryFileType_EF_IDENTIFICATION, g2, data, 1313, 0),
+		record(cardv1.ElementaryFileType_EF_IDENTIFICATION, g2, signature, 1461, 0),
+	})
+	rawFile := &tachographv1.RawFile{}
+	rawFile.SetType(tachographv1.RawFile_CARD)
+	rawFile.SetCard(rawCard)
+
+	report := newAuthenticationReport(rawFile)
+	want := []RecordAuthentication{
+		{Name: "EF_ICC", Generation: g1, FileOffset: 0, Status: AuthenticationUnsigned},
+		{Name: "EF_IDENTIFICATION", Generation: g1, FileOffset: 30, Status: AuthenticationVerified},
+		{Name: "EF_EVENTS_DATA", Generation: g1, FileOffset: 311, Status: AuthenticationInvalid},
+		{Name: "EF_IDENTIFICATION", Generation: g2, FileOffset: 1313, Status: AuthenticationUnverifiable},
+	}
+	if diff := cmp.Diff(want, report.Records); diff != "" {
+		t.Errorf("report mismatch (-want +got):\n%s", diff)
+	}
+	if report.Authenticated() {
+		t.Error("Authenticated() = true, want false")
+	}
+}
+
+func TestNewAuthenticationReport_vehicleUnit(t *testing.T) {
+	record := func(transferType vuv1.TransferType, signatureSize int32, status securityv1.Authentication_Status) *vuv1.RawVehicleUnitFile_Record {
+		r := &vuv1.RawVehicleUnitFile_Record{}
+		r.SetType(transferType)
+		r.SetGeneration(ddv1.Generation_GENERATION_2)
+		r.SetSignatureSize(signatureSize)
+		auth := &securityv1.Authentication{}
+		auth.SetStatus(status)
+		r.SetAuthentication(auth)
+		return r
+	}
+	rawVU := &vuv1.RawVehicleUnitFile{}
+	rawVU.SetRecords([]*vuv1.RawVehicleUnitFile_Record{
+		record(vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION, 0, securityv1.Authentication_CERTIFICATE_VERIFICATION_FAILED),
+		record(vuv1.TransferType_OVERVIEW_GEN2_V2, 64, securityv1.Authentication_VERIFIED),
+	})
+	rawFile := &tachographv1.RawFile{}
+	rawFile.SetType(tachographv1.RawFile_VEHICLE_UNIT)
+	rawFile.SetVehicleUnit(rawVU)
+
+	report := newAuthenticationReport(rawFile)
+	var got []AuthenticationStatus
+	for _, record := range report.Records {
+		got = append(got, record.Status)
+	}
+	if diff := cmp.Diff([]AuthenticationStatus{AuthenticationUnsigned, AuthenticationVerified}, got); diff != "" {
+		t.Errorf("statuses mismatch (-want +got):\n%s", diff)
+	}
+	if !report.Authenticated() {
+		t.Error("Authenticated() = false, want true")
+	}
+}
+
+func TestAuthenticateOptions_AuthenticateWithReport_invalidInput(t *testing.T) {
+	rawFile, report, err := AuthenticateOptions{}.AuthenticateWithReport(context.Background(), &tachographv1.RawFile{})
+	if err == nil {
+		t.Fatal("AuthenticateWithReport succeeded for a file without type, want error")
+	}
+	if rawFile != nil || report != nil {
+		t.Errorf("AuthenticateWithReport = %v, %v, want nil results on invalid input", rawFile, report)
+	}
+}
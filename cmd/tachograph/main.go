@@ -5,11 +5,17 @@ import (
 	"fmt"
 	"image/color"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/fang"
 	"github.com/charmbracelet/lipgloss/v2"
 	"github.com/spf13/cobra"
 	"github.com/way-platform/tachograph-go"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -48,6 +54,8 @@ func newRootCommand() *cobra.Command {
 	}
 	cmd.AddGroup(&cobra.Group{ID: "ddd", Title: ".DDD Files"})
 	cmd.AddCommand(newParseCommand())
+	cmd.AddCommand(newAnonymizeCommand())
+	cmd.AddCommand(newValidateCommand())
 	cmd.AddGroup(&cobra.Group{ID: "utils", Title: "Utils"})
 	cmd.SetHelpCommandGroupID("utils")
 	cmd.SetCompletionCommandGroupID("utils")
@@ -66,6 +74,9 @@ func newParseCommand() *cobra.Command {
 	authenticate := cmd.Flags().Bool("authenticate", false, "Authenticate signatures and certificates")
 	strict := cmd.Flags().Bool("strict", true, "Error on unrecognized tags (default true)")
 	preserveRawData := cmd.Flags().Bool("preserve-raw-data", true, "Store raw bytes for round-trip fidelity (default true)")
+	strictEnums := cmd.Flags().Bool("strict-enums", false, "Error on enum values outside the Data Dictionary")
+	transfers := cmd.Flags().StringSlice("transfer", nil, "Only output VU transfers of these types, by name (e.g. ACTIVITIES_GEN2_V1) or hex TREP (e.g. 22)")
+	efs := cmd.Flags().StringSlice("ef", nil, "Only output card elementary files of these types (e.g. EF_IDENTIFICATION)")
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -77,7 +88,8 @@ func newParseCommand() *cobra.Command {
 
 			// Step 1: Unmarshal to raw format
 			unmarshalOpts := tachograph.UnmarshalOptions{
-				Strict: *strict,
+				Strict:     *strict,
+				Decompress: true,
 			}
 			rawFile, err := unmarshalOpts.Unmarshal(data)
 			if err != nil {
@@ -95,6 +107,13 @@ func newParseCommand() *cobra.Command {
 				}
 			}
 
+			// Optionally select transfers or elementary files
+			if len(*transfers) > 0 || len(*efs) > 0 {
+				if err := filterRawFile(rawFile, *transfers, *efs); err != nil {
+					return err
+				}
+			}
+
 			// Step 3: Output raw or parse to semantic format
 			if *raw {
 				// Output raw format (with or without authentication)
@@ -103,6 +122,7 @@ func newParseCommand() *cobra.Command {
 				// Parse to semantic format (authentication results are propagated)
 				parseOpts := tachograph.ParseOptions{
 					PreserveRawData: *preserveRawData,
+					StrictEnums:     *strictEnums,
 				}
 				file, err := parseOpts.Parse(rawFile)
 				if err != nil {
@@ -115,3 +135,177 @@ func newParseCommand() *cobra.Command {
 	}
 	return cmd
 }
+
+// filterRawFile removes the records of a raw file that do not match the
+// selected VU transfers or card elementary files.
+//
+// Transfers are selected by TransferType name or by TREP value in hex, and
+// elementary files by ElementaryFileType name.
+func filterRawFile(rawFile *tachographv1.RawFile, transfers, efs []string) error {
+	switch rawFile.GetType() {
+	case tachographv1.RawFile_VEHICLE_UNIT:
+		if len(transfers) == 0 {
+			return nil
+		}
+		selected := make(map[vuv1.TransferType]bool)
+		treps := make(map[uint32]bool)
+		for _, transfer := range transfers {
+			if value, ok := vuv1.TransferType_value[strings.ToUpper(transfer)]; ok {
+				selected[vuv1.TransferType(value)] = true
+				continue
+			}
+			trep, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(transfer), "0x"), 16, 8)
+			if err != nil {
+				return fmt.Errorf("unknown transfer %q: want a transfer type name or a hex TREP", transfer)
+			}
+			treps[uint32(trep)] = true
+		}
+		var records []*vuv1.RawVehicleUnitFile_Record
+		for _, record := range rawFile.GetVehicleUnit().GetRecords() {
+			if selected[record.GetType()] || treps[record.GetTag()&0xFF] {
+				records = append(records, record)
+			}
+		}
+		if len(records) == 0 {
+			return fmt.Errorf("no transfers match %v", transfers)
+		}
+		rawFile.GetVehicleUnit().SetRecords(records)
+	case tachographv1.RawFile_CARD:
+		if len(efs) == 0 {
+			return nil
+		}
+		selected := make(map[cardv1.ElementaryFileType]bool)
+		for _, ef := range efs {
+			value, ok := cardv1.ElementaryFileType_value[strings.ToUpper(ef)]
+			if !ok {
+				return fmt.Errorf("unknown elementary file %q: want an elementary file type name", ef)
+			}
+			selected[cardv1.ElementaryFileType(value)] = true
+		}
+		var records []*cardv1.RawCardFile_Record
+		for _, record := range rawFile.GetCard().GetRecords() {
+			if selected[record.GetFile()] {
+				records = append(records, record)
+			}
+		}
+		if len(records) == 0 {
+			return fmt.Errorf("no elementary files match %v", efs)
+		}
+		rawFile.GetCard().SetRecords(records)
+	}
+	return nil
+}
+
+func newAnonymizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "anonymize <file>",
+		Short:   "Anonymize a .DDD file",
+		Long:    "Replace personal data in a driver card or Gen1 vehicle unit .DDD file with test values, and write the result as a valid .DDD file.",
+		GroupID: "ddd",
+		Args:    cobra.ExactArgs(1),
+	}
+
+	output := cmd.Flags().StringP("output", "o", "", "Output .DDD file (required)")
+	_ = cmd.MarkFlagRequired("output")
+	strict := cmd.Flags().Bool("strict", true, "Error on unrecognized tags (default true)")
+	preserveTimestamps := cmd.Flags().Bool("preserve-timestamps", false, "Keep original timestamps")
+	preserveDistances := cmd.Flags().Bool("preserve-distances", false, "Keep original odometer readings and distances")
+	preserveNations := cmd.Flags().Bool("preserve-nations", false, "Keep original countries and regions")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		filename := args[0]
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", filename, err)
+		}
+
+		// Step 1: Unmarshal to raw format
+		unmarshalOpts := tachograph.UnmarshalOptions{
+			Strict:     *strict,
+			Decompress: true,
+		}
+		rawFile, err := unmarshalOpts.Unmarshal(data)
+		if err != nil {
+			return fmt.Errorf("error parsing raw %s: %w", filename, err)
+		}
+
+		// Step 2: Parse to semantic format
+		parseOpts := tachograph.ParseOptions{
+			PreserveRawData: true,
+		}
+		file, err := parseOpts.Parse(rawFile)
+		if err != nil {
+			return fmt.Errorf("error parsing %s: %w", filename, err)
+		}
+		// Gen2 VU transfers are partly re-encoded from raw_data, which would
+		// carry the original personal data into the output.
+		if file.GetType() == tachographv1.File_VEHICLE_UNIT && file.GetVehicleUnit().GetGeneration() != ddv1.Generation_GENERATION_1 {
+			return fmt.Errorf("error anonymizing %s: %v vehicle unit files are not supported", filename, file.GetVehicleUnit().GetGeneration())
+		}
+
+		// Step 3: Anonymize
+		anonymizeOpts := tachograph.AnonymizeOptions{
+			PreserveTimestamps:       *preserveTimestamps,
+			PreserveDistanceAndTrips: *preserveDistances,
+			PreserveNations:          *preserveNations,
+		}
+		anonymized, err := anonymizeOpts.Anonymize(file)
+		if err != nil {
+			return fmt.Errorf("error anonymizing %s: %w", filename, err)
+		}
+
+		// Step 4: Unparse and marshal back to .DDD bytes
+		anonymizedRawFile, err := tachograph.Unparse(anonymized)
+		if err != nil {
+			return fmt.Errorf("error unparsing %s: %w", filename, err)
+		}
+		anonymizedData, err := tachograph.MarshalFile(anonymizedRawFile)
+		if err != nil {
+			return fmt.Errorf("error marshaling %s: %w", filename, err)
+		}
+		if err := os.WriteFile(*output, anonymizedData, 0o644); err != nil {
+			return fmt.Errorf("error writing %s: %w", *output, err)
+		}
+		return nil
+	}
+	return cmd
+}
+
+func newValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validate [file ...]",
+		Short:   "Validate .DDD files",
+		Long:    "Check .DDD files for structural errors, missing or invalid signatures, parse errors and inconsistent timestamps. Exits with status 1 if any error is found.",
+		GroupID: "ddd",
+		Args:    cobra.MinimumNArgs(1),
+	}
+
+	authenticate := cmd.Flags().Bool("authenticate", false, "Verify signatures and certificates")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		opts := tachograph.ValidateOptions{
+			Authenticate: *authenticate,
+		}
+		var invalid int
+		for _, filename := range args {
+			data, err := os.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("error reading %s: %w", filename, err)
+			}
+			report := opts.Validate(ctx, data)
+			for _, issue := range report.Issues {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s: %v\n", filename, issue)
+			}
+			if report.HasErrors() {
+				invalid++
+			}
+		}
+		if invalid > 0 {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%d of %d files are invalid", invalid, len(args))
+		}
+		return nil
+	}
+	return cmd
+}
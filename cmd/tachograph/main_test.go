@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/way-platform/tachograph-go"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	cardRecordsDir = "../../internal/card/testdata/records/000-anonymized"
+	vuRecordsDir   = "../../internal/vu/testdata/records/000-anonymized"
+)
+
+// Identifiers set on the test files before anonymization.
+var personalData = []string{"DF000012345678", "Virtanen", "Matti"}
+
+func TestAnonymizeCommand(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		file func(t *testing.T) *tachographv1.File
+	}{
+		{name: "driver card", file: readCardFile},
+		{name: "Gen1 vehicle unit", file: readVehicleUnitFile},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tachograph.MarshalOptions{}.MarshalFile(tt.file(t))
+			if err != nil {
+				t.Fatalf("MarshalFile failed: %v", err)
+			}
+			for _, s := range personalData {
+				if !bytes.Contains(data, []byte(s)) {
+					t.Fatalf("input does not contain %q", s)
+				}
+			}
+			dir := t.TempDir()
+			input, output := filepath.Join(dir, "input.DDD"), filepath.Join(dir, "output.DDD")
+			if err := os.WriteFile(input, data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			runCommand(t, "anonymize", input, "-o", output)
+
+			got, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range personalData {
+				if bytes.Contains(got, []byte(s)) {
+					t.Errorf("anonymized file contains %q", s)
+				}
+			}
+			rawFile, err := tachograph.Unmarshal(got)
+			if err != nil {
+				t.Fatalf("Unmarshal of the anonymized file failed: %v", err)
+			}
+			if _, err := tachograph.Parse(rawFile); err != nil {
+				t.Fatalf("Parse of the anonymized file failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestAnonymizeCommand_gen2VehicleUnit(t *testing.T) {
+	// A Gen2 V2 download, which starts with the download interface version.
+	data := []byte{0x76, 0x00, 0x01, 0x01}
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.DDD")
+	if err := os.WriteFile(input, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	err := executeCommand("anonymize", input, "-o", filepath.Join(dir, "output.DDD"))
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("anonymize of a Gen2 vehicle unit file: got error %v, want not supported", err)
+	}
+}
+
+func TestValidateCommand(t *testing.T) {
+	// A Gen1 download of the overview transfer only.
+	overview := readHexdump(t, filepath.Join(vuRecordsDir, "000-OVERVIEW_GEN1.hexdump"))
+	data := append([]byte{0x76, 0x01}, overview...)
+	dir := t.TempDir()
+	valid, truncated := filepath.Join(dir, "valid.DDD"), filepath.Join(dir, "truncated.DDD")
+	if err := os.WriteFile(valid, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(truncated, data[:len(data)-10], 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	runCommand(t, "validate", valid)
+
+	err := executeCommand("validate", valid, truncated)
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 files are invalid") {
+		t.Errorf("validate of a truncated file: got error %v, want 1 of 2 files are invalid", err)
+	}
+}
+
+func runCommand(t *testing.T, args ...string) {
+	t.Helper()
+	if err := executeCommand(args...); err != nil {
+		t.Fatalf("tachograph %s failed: %v", strings.Join(args, " "), err)
+	}
+}
+
+func executeCommand(args ...string) error {
+	cmd := newRootCommand()
+	cmd.SetArgs(args)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	return cmd.Execute()
+}
+
+// readCardFile assembles the driver card test records into a file, and sets
+// the card number and holder name of its identification.
+func readCardFile(t *testing.T) *tachographv1.File {
+	t.Helper()
+	var data []byte
+	for _, path := range globRecords(t, cardRecordsDir) {
+		// Example: "003-EF_IDENTIFICATION-GENERATION_1-DATA.hexdump"
+		parts := strings.Split(strings.TrimSuffix(filepath.Base(path), ".hexdump"), "-")
+		ef := cardv1.ElementaryFileType(cardv1.ElementaryFileType_value[parts[1]])
+		fid := proto.GetExtension(ef.Descriptor().Values().ByNumber(ef.Number()).Options(), cardv1.E_FileId).(int32)
+		var appendix byte
+		if parts[2] == ddv1.Generation_GENERATION_2.String() {
+			appendix |= 0x02
+		}
+		if parts[3] == cardv1.ContentType_SIGNATURE.String() {
+			appendix |= 0x01
+		}
+		value := readHexdump(t, path)
+		data = binary.BigEndian.AppendUint16(data, uint16(fid))
+		data = append(data, appendix)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(value)))
+		data = append(data, value...)
+	}
+	file := parse(t, data)
+	id := file.GetDriverCard().GetTachograph().GetIdentification()
+	id.GetDriverIdentification().SetDriverIdentificationNumber(newIa5StringValue("DF000012345678"))
+	id.SetCardHolderSurname(newStringValue("Virtanen"))
+	id.SetCardHolderFirstNames(newStringValue("Matti"))
+	return file
+}
+
+// readVehicleUnitFile assembles the Gen1 vehicle unit test transfers into a
+// file, and sets the card number and holder name of its card insertions.
+func readVehicleUnitFile(t *testing.T) *tachographv1.File {
+	t.Helper()
+	var data []byte
+	for _, path := range globRecords(t, vuRecordsDir) {
+		// Example: "001-ACTIVITIES_GEN1.hexdump"
+		_, name, _ := strings.Cut(strings.TrimSuffix(filepath.Base(path), ".hexdump"), "-")
+		tag, ok := tachograph.TagForTransferType(vuv1.TransferType(vuv1.TransferType_value[name]))
+		if !ok {
+			t.Fatalf("no tag for transfer %s", name)
+		}
+		data = binary.BigEndian.AppendUint16(data, tag)
+		data = append(data, readHexdump(t, path)...)
+	}
+	file := parse(t, data)
+	var n int
+	for _, activities := range file.GetVehicleUnit().GetGen1().GetActivities() {
+		for _, record := range activities.GetCardIwData() {
+			record.GetFullCardNumber().GetDriverIdentification().SetDriverIdentificationNumber(newIa5StringValue("DF000012345678"))
+			record.GetCardHolderName().SetHolderSurname(newStringValue("Virtanen"))
+			record.GetCardHolderName().SetHolderFirstNames(newStringValue("Matti"))
+			n++
+		}
+	}
+	if n == 0 {
+		t.Fatal("no card insertions in the vehicle unit test transfers")
+	}
+	return file
+}
+
+func globRecords(t *testing.T, dir string) []string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.hexdump"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Skipf("no records in %s", dir)
+	}
+	return paths
+}
+
+func readHexdump(t *testing.T, path string) []byte {
+	t.Helper()
+	dump, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := tachograph.UnmarshalHexdump(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode %s: %v", path, err)
+	}
+	return value
+}
+
+func parse(t *testing.T, data []byte) *tachographv1.File {
+	t.Helper()
+	rawFile, err := tachograph.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	file, err := tachograph.Parse(rawFile)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	return file
+}
+
+func newIa5StringValue(value string) *ddv1.Ia5StringValue {
+	v := &ddv1.Ia5StringValue{}
+	v.SetLength(int32(len(value)))
+	v.SetValue(value)
+	return v
+}
+
+func newStringValue(value string) *ddv1.StringValue {
+	v := &ddv1.StringValue{}
+	v.SetEncoding(ddv1.Encoding_ISO_8859_1)
+	v.SetLength(35)
+	v.SetValue(value)
+	return v
+}
@@ -0,0 +1,210 @@
+package tachograph
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EventCorrelation is an event recorded on a driver card, in a vehicle unit,
+// or both.
+type EventCorrelation struct {
+	// EventType is the type of the event.
+	EventType ddv1.EventFaultType
+
+	// BeginTime and EndTime bound the event. EndTime is taken from the card
+	// record when the event was recorded on both sides.
+	BeginTime, EndTime time.Time
+
+	// OnCard reports whether the event was recorded on the driver card.
+	OnCard bool
+
+	// OnVehicleUnit reports whether the event was recorded in the vehicle unit.
+	OnVehicleUnit bool
+}
+
+// Matched reports whether the event was recorded both on the card and in the
+// vehicle unit.
+func (c EventCorrelation) Matched() bool {
+	return c.OnCard && c.OnVehicleUnit
+}
+
+// CorrelateEvents matches the events recorded on a driver card with the
+// events recorded by a vehicle unit, to find events present on only one side.
+//
+// Both the card (EF_Events_Data, Data Dictionary, Section 2.20) and the
+// vehicle unit (VuEventData, Section 2.198) record an event occurring while
+// the card is inserted, with the same type and begin time. Events are matched
+// on these two values. Only events the other side is expected to hold are
+// considered:
+//
+//   - vehicle unit events with the driver card in the driver or co-driver slot
+//     at the begin or end of the event, and
+//   - card events recorded in the vehicle, identified by its registration
+//     number, within the downloadable period of the vehicle unit.
+//
+// The registration and downloadable period are taken from the overview of the
+// vehicle unit file; card events are not filtered on values it lacks. Events
+// recorded by both applications of the card are reported once, as are events
+// repeated in several vehicle unit transfers. Invalid card records and records
+// without a begin time are skipped.
+//
+// The correlations are ordered by begin time and event type.
+func CorrelateEvents(card *cardv1.DriverCardFile, vu *vuv1.VehicleUnitFile) []EventCorrelation {
+	correlations := map[eventKey]*EventCorrelation{}
+	get := func(eventType ddv1.EventFaultType, begin *timestamppb.Timestamp) *EventCorrelation {
+		key := eventKey{eventType: eventType, begin: begin.GetSeconds()}
+		correlation, ok := correlations[key]
+		if !ok {
+			correlation = &EventCorrelation{EventType: eventType, BeginTime: begin.AsTime().UTC()}
+			correlations[key] = correlation
+		}
+		return correlation
+	}
+
+	window := vehicleUnitEventWindow(vu)
+	for _, data := range []*cardv1.EventsData{
+		card.GetTachograph().GetEventsData(),
+		card.GetTachographG2().GetEventsData(),
+	} {
+		for _, record := range data.GetEvents() {
+			if !record.GetValid() || dd.IsUnsetTime(record.GetEventBeginTime()) || !window.contains(record) {
+				continue
+			}
+			correlation := get(record.GetEventType(), record.GetEventBeginTime())
+			correlation.OnCard = true
+			if !dd.IsUnsetTime(record.GetEventEndTime()) {
+				correlation.EndTime = record.GetEventEndTime().AsTime().UTC()
+			}
+		}
+	}
+
+	identification := card.GetTachographG2().GetIdentification()
+	if identification == nil {
+		identification = card.GetTachograph().GetIdentification()
+	}
+	inserted := func(cardNumbers ...*ddv1.FullCardNumber) bool {
+		driverID := identification.GetDriverIdentification().GetDriverIdentificationNumber().GetValue()
+		if driverID == "" {
+			return false
+		}
+		for _, cardNumber := range cardNumbers {
+			if cardNumber.GetCardIssuingMemberState() == identification.GetCardIssuingMemberState() &&
+				cardNumber.GetDriverIdentification().GetDriverIdentificationNumber().GetValue() == driverID {
+				return true
+			}
+		}
+		return false
+	}
+	addVehicleUnitEvent := func(eventType ddv1.EventFaultType, begin, end *timestamppb.Timestamp) {
+		if dd.IsUnsetTime(begin) {
+			return
+		}
+		correlation := get(eventType, begin)
+		if !correlation.OnCard && !dd.IsUnsetTime(end) {
+			correlation.EndTime = end.AsTime().UTC()
+		}
+		correlation.OnVehicleUnit = true
+	}
+	for _, transfer := range vu.GetGen1().GetEventsAndFaults() {
+		for _, event := range transfer.GetEvents() {
+			if inserted(event.GetCardNumberDriverSlotBegin(), event.GetCardNumberCodriverSlotBegin(), event.GetCardNumberDriverSlotEnd(), event.GetCardNumberCodriverSlotEnd()) {
+				addVehicleUnitEvent(event.GetEventType(), event.GetBeginTime(), event.GetEndTime())
+			}
+		}
+	}
+	for _, transfer := range vu.GetGen2V1().GetEventsAndFaults() {
+		for _, event := range transfer.GetEvents() {
+			if inserted(
+				event.GetCardNumberAndGenDriverSlotBegin().GetFullCardNumber(),
+				event.GetCardNumberAndGenCodriverSlotBegin().GetFullCardNumber(),
+				event.GetCardNumberAndGenDriverSlotEnd().GetFullCardNumber(),
+				event.GetCardNumberAndGenCodriverSlotEnd().GetFullCardNumber(),
+			) {
+				addVehicleUnitEvent(event.GetEventType(), event.GetBeginTime(), event.GetEndTime())
+			}
+		}
+	}
+	for _, transfer := range vu.GetGen2V2().GetEventsAndFaults() {
+		for _, event := range transfer.GetEvents() {
+			if inserted(
+				event.GetCardNumberAndGenDriverSlotBegin().GetFullCardNumber(),
+				event.GetCardNumberAndGenCodriverSlotBegin().GetFullCardNumber(),
+				event.GetCardNumberAndGenDriverSlotEnd().GetFullCardNumber(),
+				event.GetCardNumberAndGenCodriverSlotEnd().GetFullCardNumber(),
+			) {
+				addVehicleUnitEvent(event.GetEventType(), event.GetBeginTime(), event.GetEndTime())
+			}
+		}
+	}
+
+	result := make([]EventCorrelation, 0, len(correlations))
+	for _, correlation := range correlations {
+		result = append(result, *correlation)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].BeginTime.Equal(result[j].BeginTime) {
+			return result[i].BeginTime.Before(result[j].BeginTime)
+		}
+		return result[i].EventType < result[j].EventType
+	})
+	return result
+}
+
+// eventKey identifies an event by its type and begin time.
+type eventKey struct {
+	eventType ddv1.EventFaultType
+	begin     int64
+}
+
+// eventWindow selects the card events expected in a vehicle unit.
+type eventWindow struct {
+	// registration is the registration number of the vehicle, if known.
+	registration string
+
+	// period is the downloadable period of the vehicle unit, if known.
+	period *ddv1.DownloadablePeriod
+}
+
+// vehicleUnitEventWindow returns the event window of a vehicle unit file,
+// from its overview.
+func vehicleUnitEventWindow(vu *vuv1.VehicleUnitFile) eventWindow {
+	var window eventWindow
+	switch {
+	case vu.GetGen1().HasOverview():
+		overview := vu.GetGen1().GetOverview()
+		window.registration = overview.GetVehicleRegistrationWithNation().GetNumber().GetValue()
+		window.period = overview.GetDownloadablePeriod()
+	case vu.GetGen2V1().HasOverview():
+		overview := vu.GetGen2V1().GetOverview()
+		window.registration = overview.GetVehicleRegistrationWithNation().GetNumber().GetValue()
+		window.period = overview.GetDownloadablePeriod()
+	case vu.GetGen2V2().HasOverview():
+		overview := vu.GetGen2V2().GetOverview()
+		window.registration = overview.GetVehicleRegistrationNumber().GetValue()
+		window.period = overview.GetDownloadablePeriod()
+	}
+	window.registration = strings.TrimSpace(window.registration)
+	return window
+}
+
+// contains reports whether a card event falls within the window.
+func (w eventWindow) contains(record *cardv1.EventsData_Record) bool {
+	if w.registration != "" && strings.TrimSpace(record.GetEventVehicleRegistration().GetNumber().GetValue()) != w.registration {
+		return false
+	}
+	begin := record.GetEventBeginTime().GetSeconds()
+	if minTime := w.period.GetMinTime(); minTime != nil && begin < minTime.GetSeconds() {
+		return false
+	}
+	if maxTime := w.period.GetMaxTime(); maxTime != nil && begin > maxTime.GetSeconds() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package tachograph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestCorrelateEvents(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newRegistration := func(number string) *ddv1.VehicleRegistrationIdentification {
+		value := &ddv1.StringValue{}
+		value.SetValue(number)
+		registration := &ddv1.VehicleRegistrationIdentification{}
+		registration.SetNation(ddv1.NationNumeric_GERMANY)
+		registration.SetNumber(value)
+		return registration
+	}
+	newDriverIdentification := func(number string) *ddv1.DriverIdentification {
+		value := &ddv1.Ia5StringValue{}
+		value.SetValue(number)
+		driverID := &ddv1.DriverIdentification{}
+		driverID.SetDriverIdentificationNumber(value)
+		return driverID
+	}
+	newCardNumber := func(number string) *ddv1.FullCardNumber {
+		cardNumber := &ddv1.FullCardNumber{}
+		cardNumber.SetCardType(ddv1.EquipmentType_DRIVER_CARD)
+		cardNumber.SetCardIssuingMemberState(ddv1.NationNumeric_GERMANY)
+		cardNumber.SetDriverIdentification(newDriverIdentification(number))
+		return cardNumber
+	}
+	newCardEvent := func(eventType ddv1.EventFaultType, begin, end time.Duration, registration string) *cardv1.EventsData_Record {
+		record := &cardv1.EventsData_Record{}
+		record.SetValid(true)
+		record.SetEventType(eventType)
+		record.SetEventBeginTime(timestamppb.New(day.Add(begin)))
+		record.SetEventEndTime(timestamppb.New(day.Add(end)))
+		record.SetEventVehicleRegistration(newRegistration(registration))
+		return record
+	}
+	newVuEvent := func(eventType ddv1.EventFaultType, begin, end time.Duration, driverSlot, codriverSlot *ddv1.FullCardNumber) *ddv1.VuEventRecord {
+		record := &ddv1.VuEventRecord{}
+		record.SetEventType(eventType)
+		record.SetBeginTime(timestamppb.New(day.Add(begin)))
+		record.SetEndTime(timestamppb.New(day.Add(end)))
+		record.SetCardNumberDriverSlotBegin(driverSlot)
+		record.SetCardNumberCodriverSlotEnd(codriverSlot)
+		return record
+	}
+
+	identification := &cardv1.DriverCardIdentification{}
+	identification.SetCardIssuingMemberState(ddv1.NationNumeric_GERMANY)
+	identification.SetDriverIdentification(newDriverIdentification("D123456789012"))
+	gen1Events := &cardv1.EventsData{}
+	gen1Events.SetEvents([]*cardv1.EventsData_Record{
+		newCardEvent(ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING, 8*time.Hour, 8*time.Hour+time.Minute, "AB123"),
+		newCardEvent(ddv1.EventFaultType_GENERAL_OVER_SPEEDING, 9*time.Hour, 9*time.Hour+2*time.Minute, "AB123"),
+		// Recorded in another vehicle.
+		newCardEvent(ddv1.EventFaultType_GENERAL_TIME_OVERLAP, 10*time.Hour, 10*time.Hour, "XY987"),
+		// Recorded before the downloadable period of the vehicle unit.
+		newCardEvent(ddv1.EventFaultType_GENERAL_OVER_SPEEDING, -48*time.Hour, -47*time.Hour, "AB123"),
+		// Unused record.
+		{},
+	})
+	gen2Events := &cardv1.EventsData{}
+	gen2Events.SetEvents([]*cardv1.EventsData_Record{
+		newCardEvent(ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING, 8*time.Hour, 8*time.Hour+time.Minute, "AB123"),
+	})
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetIdentification(identification)
+	tachograph.SetEventsData(gen1Events)
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetEventsData(gen2Events)
+	cardFile := &cardv1.DriverCardFile{}
+	cardFile.SetTachograph(tachograph)
+	cardFile.SetTachographG2(tachographG2)
+
+	period := &ddv1.DownloadablePeriod{}
+	period.SetMinTime(timestamppb.New(day.Add(-24 * time.Hour)))
+	period.SetMaxTime(timestamppb.New(day.Add(24 * time.Hour)))
+	overview := &vuv1.OverviewGen1{}
+	overview.SetVehicleRegistrationWithNation(newRegistration("AB123"))
+	overview.SetDownloadablePeriod(period)
+	eventsAndFaults := &vuv1.EventsAndFaultsGen1{}
+	eventsAndFaults.SetEvents([]*ddv1.VuEventRecord{
+		newVuEvent(ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING, 8*time.Hour, 8*time.Hour+time.Minute, newCardNumber("D123456789012"), nil),
+		newVuEvent(ddv1.EventFaultType_GENERAL_POWER_SUPPLY_INTERRUPTION, 11*time.Hour, 12*time.Hour, nil, newCardNumber("D123456789012")),
+		// Recorded while another driver's card was inserted.
+		newVuEvent(ddv1.EventFaultType_GENERAL_POWER_SUPPLY_INTERRUPTION, 13*time.Hour, 14*time.Hour, newCardNumber("D999999999999"), nil),
+	})
+	gen1 := &vuv1.VehicleUnitFileGen1{}
+	gen1.SetOverview(overview)
+	gen1.SetEventsAndFaults([]*vuv1.EventsAndFaultsGen1{eventsAndFaults, eventsAndFaults})
+	vuFile := &vuv1.VehicleUnitFile{}
+	vuFile.SetGeneration(ddv1.Generation_GENERATION_1)
+	vuFile.SetGen1(gen1)
+
+	got := CorrelateEvents(cardFile, vuFile)
+	want := []EventCorrelation{
+		{
+			EventType:     ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING,
+			BeginTime:     day.Add(8 * time.Hour),
+			EndTime:       day.Add(8*time.Hour + time.Minute),
+			OnCard:        true,
+			OnVehicleUnit: true,
+		},
+		{
+			EventType: ddv1.EventFaultType_GENERAL_OVER_SPEEDING,
+			BeginTime: day.Add(9 * time.Hour),
+			EndTime:   day.Add(9*time.Hour + 2*time.Minute),
+			OnCard:    true,
+		},
+		{
+			EventType:     ddv1.EventFaultType_GENERAL_POWER_SUPPLY_INTERRUPTION,
+			BeginTime:     day.Add(11 * time.Hour),
+			EndTime:       day.Add(12 * time.Hour),
+			OnVehicleUnit: true,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CorrelateEvents() mismatch (-want +got):\n%s", diff)
+	}
+	if !got[0].Matched() || got[1].Matched() {
+		t.Errorf("Matched() = %v, %v; want true, false", got[0].Matched(), got[1].Matched())
+	}
+}
@@ -0,0 +1,157 @@
+package tachograph
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Leading bytes of the compressed formats detected by UnmarshalOptions.Decompress.
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+// maxFileSize is the maximum size of a file read from a stream or
+// decompressed. Tachograph files are at most a few megabytes, and the limit
+// keeps endless streams and small compressed input that expands to gigabytes
+// from exhausting memory.
+const maxFileSize = 64 << 20
+
+// maxSizeHint caps the uncompressed size announced by compressed input that
+// is allocated up front, so that a forged size cannot exhaust memory.
+const maxSizeHint = 16 << 20
+
+// decompress returns the decompressed content of data if it is a gzip stream
+// or a zip archive, detected from its leading bytes, and data otherwise.
+//
+// Concatenated gzip members are decompressed as one stream. A zip archive
+// must hold exactly one file; directory entries are ignored.
+func decompress(data []byte) ([]byte, error) {
+	var d decompressor
+	return d.decompress(data)
+}
+
+// decompressor decompresses gzip and zip input like decompress, reusing the
+// gzip and flate readers of previous calls.
+//
+// The decompressed content is always freshly allocated, since unmarshaled
+// records refer to it. A decompressor is safe for concurrent use.
+type decompressor struct {
+	gzipReaders  sync.Pool // *gzip.Reader
+	flateReaders sync.Pool // io.ReadCloser implementing flate.Resetter
+}
+
+// decompress returns the decompressed content of data, see decompress.
+func (d *decompressor) decompress(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		r, err := d.gzipReader(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip header: %w", err)
+		}
+		defer d.gzipReaders.Put(r)
+		// The trailer of the last member holds the uncompressed size modulo
+		// 2^32, which is exact for single-member streams.
+		var sizeHint uint32
+		if len(data) >= 4 {
+			sizeHint = binary.LittleEndian.Uint32(data[len(data)-4:])
+		}
+		decompressed, err := readAll(r, uint64(sizeHint))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress gzip data: %w", err)
+		}
+		return decompressed, nil
+
+	case bytes.HasPrefix(data, zipMagic):
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read zip archive: %w", err)
+		}
+		archive.RegisterDecompressor(zip.Deflate, d.flateReader)
+		var files []*zip.File
+		for _, file := range archive.File {
+			if !file.FileInfo().IsDir() {
+				files = append(files, file)
+			}
+		}
+		if len(files) != 1 {
+			return nil, fmt.Errorf("zip archive holds %d files, want 1", len(files))
+		}
+		r, err := files[0].Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s in zip archive: %w", files[0].Name, err)
+		}
+		defer r.Close()
+		decompressed, err := readAll(r, files[0].UncompressedSize64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress %s in zip archive: %w", files[0].Name, err)
+		}
+		return decompressed, nil
+
+	default:
+		return data, nil
+	}
+}
+
+// gzipReader returns a pooled gzip reader reset to read data.
+func (d *decompressor) gzipReader(data []byte) (*gzip.Reader, error) {
+	if r, ok := d.gzipReaders.Get().(*gzip.Reader); ok {
+		if err := r.Reset(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	return gzip.NewReader(bytes.NewReader(data))
+}
+
+// flateReader returns a pooled flate reader reset to read r, which returns
+// to the pool when closed. It implements zip.Decompressor.
+func (d *decompressor) flateReader(r io.Reader) io.ReadCloser {
+	fr, ok := d.flateReaders.Get().(io.ReadCloser)
+	if ok {
+		if err := fr.(flate.Resetter).Reset(r, nil); err != nil {
+			ok = false
+		}
+	}
+	if !ok {
+		fr = flate.NewReader(r)
+	}
+	return &pooledFlateReader{ReadCloser: fr, pool: &d.flateReaders}
+}
+
+// pooledFlateReader is a flate reader that returns to its pool when closed.
+type pooledFlateReader struct {
+	io.ReadCloser
+	pool *sync.Pool
+}
+
+// Close closes the reader and returns it to its pool.
+func (r *pooledFlateReader) Close() error {
+	if r.ReadCloser == nil {
+		return nil
+	}
+	err := r.ReadCloser.Close()
+	r.pool.Put(r.ReadCloser)
+	r.ReadCloser = nil
+	return err
+}
+
+// readAll reads r until EOF into a buffer allocated for sizeHint bytes,
+// capped at maxSizeHint. It fails if r holds more than maxFileSize
+// bytes.
+func readAll(r io.Reader, sizeHint uint64) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, min(sizeHint, maxSizeHint)+bytes.MinRead))
+	if _, err := buf.ReadFrom(io.LimitReader(r, maxFileSize+1)); err != nil {
+		return nil, err
+	}
+	if buf.Len() > maxFileSize {
+		return nil, fmt.Errorf("decompressed data exceeds %d bytes", maxFileSize)
+	}
+	return buf.Bytes(), nil
+}
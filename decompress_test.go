@@ -0,0 +1,111 @@
+package tachograph
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+)
+
+func TestUnmarshalOptions_Decompress(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	data := append([]byte{0x76, 0x01}, value...)
+
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	if _, err := gw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	newZip := func(names ...string) []byte {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for _, name := range names {
+			w, err := zw.Create(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.HasSuffix(name, "/") {
+				continue
+			}
+			if _, err := w.Write(data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	opts := UnmarshalOptions{Strict: true, Decompress: true}
+	want, err := opts.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	for name, compressed := range map[string][]byte{
+		"gzip":             gzipped.Bytes(),
+		"zip":              newZip("download.DDD"),
+		"zip in directory": newZip("downloads/", "downloads/download.DDD"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := opts.Unmarshal(compressed)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("Unmarshal mismatch (-want +got):\n%s", diff)
+			}
+			got, err = opts.UnmarshalFrom(bytes.NewReader(compressed))
+			if err != nil {
+				t.Fatalf("UnmarshalFrom failed: %v", err)
+			}
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("UnmarshalFrom mismatch (-want +got):\n%s", diff)
+			}
+			if _, err := (UnmarshalOptions{Strict: true}).Unmarshal(compressed); err == nil {
+				t.Error("Unmarshal without Decompress succeeded, want error")
+			}
+		})
+	}
+
+	if _, err := opts.Unmarshal(newZip("a.DDD", "b.DDD")); err == nil {
+		t.Error("Unmarshal of a zip archive with two files succeeded, want error")
+	}
+}
+
+func TestUnmarshalOptions_Decompress_tooLarge(t *testing.T) {
+	// A run of zeros compresses about a thousandfold, so a small input
+	// decompresses to more than the limit.
+	var compressed bytes.Buffer
+	gw, err := gzip.NewWriterLevel(&compressed, gzip.BestSpeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gw.Write(make([]byte, maxFileSize+1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	opts := UnmarshalOptions{Decompress: true}
+	if _, err := opts.Unmarshal(compressed.Bytes()); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("Unmarshal of a decompression bomb: got error %v, want size limit error", err)
+	}
+}
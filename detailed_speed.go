@@ -0,0 +1,20 @@
+package tachograph
+
+import (
+	"github.com/way-platform/tachograph-go/internal/vu"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// SpeedSample is the speed of a vehicle during one second.
+type SpeedSample = vu.SpeedSample
+
+// ExpandDetailedSpeed expands the detailed speed transfers of a parsed VU
+// download into one sample per second, ordered chronologically.
+//
+// Each VuDetailedSpeedBlock (Data Dictionary, Section 2.190) holds the speeds
+// of the 60 seconds of the minute beginning at speedBlockBeginDate. Blocks
+// only cover the minutes during which the vehicle was moving, so the timeline
+// has gaps while it was stationary.
+func ExpandDetailedSpeed(file *vuv1.VehicleUnitFile) []SpeedSample {
+	return vu.ExpandDetailedSpeed(file)
+}
@@ -0,0 +1,30 @@
+package tachograph
+
+import (
+	"github.com/way-platform/tachograph-go/internal/card"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// ElementaryFile identifies an EF of a driver card within the application of
+// a generation.
+type ElementaryFile = card.ElementaryFile
+
+// PresentElementaryFiles returns the EFs present in a parsed driver card
+// file, with the generation of the application they are present in.
+//
+// EFs present in both the Tachograph and Tachograph_G2 applications, such as
+// EF_Events_Data, are reported as GENERATION_2.
+func PresentElementaryFiles(file *cardv1.DriverCardFile) map[cardv1.ElementaryFileType]ddv1.Generation {
+	return card.PresentElementaryFiles(file)
+}
+
+// MissingRequiredEFs returns the EFs that the regulation mandates in the
+// download of a driver card, but that are absent from a parsed driver card
+// file, in download order.
+//
+// The common EFs and the EFs of the Tachograph DF are mandatory for all
+// driver cards, and the EFs of the Tachograph_G2 DF once it is present.
+func MissingRequiredEFs(file *cardv1.DriverCardFile) []ElementaryFile {
+	return card.MissingRequiredEFs(file)
+}
@@ -0,0 +1,35 @@
+package tachograph
+
+import (
+	"github.com/way-platform/tachograph-go/internal/dd"
+)
+
+// Errors wrapped by the errors of Unmarshal, Parse and Authenticate, for use
+// with errors.Is.
+var (
+	// ErrTruncated reports data that ends before the structure being decoded.
+	// It is io.ErrUnexpectedEOF.
+	ErrTruncated = dd.ErrTruncated
+
+	// ErrUnknownTag reports an unrecognized card file ID or vehicle unit
+	// transfer type, when unmarshaling in strict mode.
+	ErrUnknownTag = dd.ErrUnknownTag
+
+	// ErrEmptyTransfer reports a vehicle unit transfer whose tag is followed
+	// by no value, when unmarshaling in strict mode. Otherwise the transfer is
+	// kept as a record with an empty value.
+	ErrEmptyTransfer = dd.ErrEmptyTransfer
+
+	// ErrUnsupportedGeneration reports a record or file of a generation that
+	// is not supported where it occurs.
+	ErrUnsupportedGeneration = dd.ErrUnsupportedGeneration
+
+	// ErrInvalidSignature reports a data signature that is missing or does
+	// not match its data.
+	ErrInvalidSignature = dd.ErrInvalidSignature
+)
+
+// ParseError is an error decoding a single record of a file: an elementary
+// file (EF) of a card, or a transfer of a vehicle unit. Parse reports errors
+// decoding a record as a *ParseError, for use with errors.As.
+type ParseError = dd.ParseError
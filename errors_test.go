@@ -0,0 +1,58 @@
+package tachograph
+
+import (
+	"errors"
+	"testing"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestErrors(t *testing.T) {
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := Unmarshal([]byte{0x76, 0x7F, 0x00, 0x00})
+		if !errors.Is(err, ErrUnknownTag) {
+			t.Errorf("Unmarshal error = %v, want ErrUnknownTag", err)
+		}
+	})
+
+	t.Run("truncated transfer", func(t *testing.T) {
+		record := &vuv1.RawVehicleUnitFile_Record{}
+		record.SetType(vuv1.TransferType_OVERVIEW_GEN1)
+		record.SetGeneration(ddv1.Generation_GENERATION_1)
+		record.SetFileOffset(2)
+		record.SetValue([]byte{0x01, 0x02, 0x03})
+		rawVU := &vuv1.RawVehicleUnitFile{}
+		rawVU.SetRecords([]*vuv1.RawVehicleUnitFile_Record{record})
+		rawFile := &tachographv1.RawFile{}
+		rawFile.SetType(tachographv1.RawFile_VEHICLE_UNIT)
+		rawFile.SetVehicleUnit(rawVU)
+
+		_, err := Parse(rawFile)
+		if !errors.Is(err, ErrTruncated) {
+			t.Errorf("Parse error = %v, want ErrTruncated", err)
+		}
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("Parse error = %v, want a *ParseError", err)
+		}
+		if parseErr.Name != "OVERVIEW_GEN1" || parseErr.Generation != ddv1.Generation_GENERATION_1 || parseErr.FileOffset != 2 {
+			t.Errorf("ParseError = {%s, %v, %d}, want {OVERVIEW_GEN1, GENERATION_1, 2}", parseErr.Name, parseErr.Generation, parseErr.FileOffset)
+		}
+	})
+
+	t.Run("unsupported generation", func(t *testing.T) {
+		record := &vuv1.RawVehicleUnitFile_Record{}
+		record.SetType(vuv1.TransferType_OVERVIEW_GEN1)
+		rawVU := &vuv1.RawVehicleUnitFile{}
+		rawVU.SetRecords([]*vuv1.RawVehicleUnitFile_Record{record})
+		rawFile := &tachographv1.RawFile{}
+		rawFile.SetType(tachographv1.RawFile_VEHICLE_UNIT)
+		rawFile.SetVehicleUnit(rawVU)
+
+		if _, err := Parse(rawFile); !errors.Is(err, ErrUnsupportedGeneration) {
+			t.Errorf("Parse error = %v, want ErrUnsupportedGeneration", err)
+		}
+	})
+}
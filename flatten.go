@@ -0,0 +1,80 @@
+package tachograph
+
+import (
+	"strings"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/card"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+)
+
+// ActivityRow is a single driver activity period, flattened into plain
+// columns for export to tabular formats such as CSV or Parquet.
+type ActivityRow struct {
+	// DriverID is the driver identification number of the card, without
+	// trailing padding.
+	DriverID string
+
+	// Date is midnight UTC at the start of the day the activity was recorded.
+	Date time.Time
+
+	// Slot is the slot the card was in during the activity.
+	Slot ddv1.CardSlotNumber
+
+	// Status is the activity of the driver.
+	Status ddv1.DriverActivityValue
+
+	// Start and End bound the activity period, in UTC.
+	Start, End time.Time
+
+	// Duration is the length of the activity period.
+	Duration time.Duration
+}
+
+// FlattenActivities returns the driver activities of a file as flat rows, one
+// per activity period, ordered chronologically.
+//
+// Activities are taken from the CardActivityDailyRecords of a driver card
+// (Data Dictionary, Section 2.9), merged across the Tachograph and
+// Tachograph_G2 applications as in card.UnifiedDriverActivity. An activity
+// lasts until the next activity change of the day, or until midnight UTC;
+// activities spanning midnight are split at the day boundary. Other file
+// types have no rows.
+func FlattenActivities(file *tachographv1.File) []ActivityRow {
+	driverCard := file.GetDriverCard()
+	if driverCard == nil {
+		return nil
+	}
+	identification := driverCard.GetTachographG2().GetIdentification()
+	if identification == nil {
+		identification = driverCard.GetTachograph().GetIdentification()
+	}
+	driverID := strings.TrimSpace(identification.GetDriverIdentification().GetDriverIdentificationNumber().GetValue())
+
+	const day = 24 * time.Hour
+	var rows []ActivityRow
+	for _, activityDay := range card.UnifiedDriverActivity(driverCard) {
+		changes := activityDay.ActivityChanges
+		for i, change := range changes {
+			start := activityDay.Date.Add(time.Duration(change.GetTimeOfChangeMinutes()) * time.Minute)
+			end := activityDay.Date.Add(day)
+			if i+1 < len(changes) {
+				end = activityDay.Date.Add(time.Duration(changes[i+1].GetTimeOfChangeMinutes()) * time.Minute)
+			}
+			if !start.Before(end) {
+				continue
+			}
+			rows = append(rows, ActivityRow{
+				DriverID: driverID,
+				Date:     activityDay.Date,
+				Slot:     change.GetSlot(),
+				Status:   change.GetActivity(),
+				Start:    start,
+				End:      end,
+				Duration: end.Sub(start),
+			})
+		}
+	}
+	return rows
+}
@@ -0,0 +1,74 @@
+package tachograph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+)
+
+func TestFlattenActivities(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newChange := func(minutes int32, slot ddv1.CardSlotNumber, activity ddv1.DriverActivityValue) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetTimeOfChangeMinutes(minutes)
+		change.SetSlot(slot)
+		change.SetActivity(activity)
+		return change
+	}
+	record := &cardv1.DriverActivityData_DailyRecord{}
+	record.SetValid(true)
+	record.SetActivityRecordDate(timestamppb.New(day))
+	record.SetActivityChangeInfo([]*ddv1.ActivityChangeInfo{
+		newChange(0, ddv1.CardSlotNumber_DRIVER_SLOT, ddv1.DriverActivityValue_BREAK_REST),
+		newChange(480, ddv1.CardSlotNumber_DRIVER_SLOT, ddv1.DriverActivityValue_DRIVING),
+		newChange(480, ddv1.CardSlotNumber_DRIVER_SLOT, ddv1.DriverActivityValue_WORK),
+		newChange(750, ddv1.CardSlotNumber_CO_DRIVER_SLOT, ddv1.DriverActivityValue_AVAILABILITY),
+	})
+	activityData := &cardv1.DriverActivityData{}
+	activityData.SetDailyRecords([]*cardv1.DriverActivityData_DailyRecord{record})
+
+	driverIDValue := &ddv1.Ia5StringValue{}
+	driverIDValue.SetValue("DRIVER0000001 ")
+	driverID := &ddv1.DriverIdentification{}
+	driverID.SetDriverIdentificationNumber(driverIDValue)
+	identification := &cardv1.DriverCardIdentification{}
+	identification.SetDriverIdentification(driverID)
+
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetIdentification(identification)
+	tachograph.SetDriverActivityData(activityData)
+	driverCard := &cardv1.DriverCardFile{}
+	driverCard.SetTachograph(tachograph)
+	file := &tachographv1.File{}
+	file.SetType(tachographv1.File_DRIVER_CARD)
+	file.SetDriverCard(driverCard)
+
+	row := func(slot ddv1.CardSlotNumber, status ddv1.DriverActivityValue, start, end time.Duration) ActivityRow {
+		return ActivityRow{
+			DriverID: "DRIVER0000001",
+			Date:     day,
+			Slot:     slot,
+			Status:   status,
+			Start:    day.Add(start),
+			End:      day.Add(end),
+			Duration: end - start,
+		}
+	}
+	want := []ActivityRow{
+		row(ddv1.CardSlotNumber_DRIVER_SLOT, ddv1.DriverActivityValue_BREAK_REST, 0, 8*time.Hour),
+		row(ddv1.CardSlotNumber_DRIVER_SLOT, ddv1.DriverActivityValue_WORK, 8*time.Hour, 12*time.Hour+30*time.Minute),
+		row(ddv1.CardSlotNumber_CO_DRIVER_SLOT, ddv1.DriverActivityValue_AVAILABILITY, 12*time.Hour+30*time.Minute, 24*time.Hour),
+	}
+	if diff := cmp.Diff(want, FlattenActivities(file)); diff != "" {
+		t.Errorf("FlattenActivities() mismatch (-want +got):\n%s", diff)
+	}
+	if rows := FlattenActivities(&tachographv1.File{}); rows != nil {
+		t.Errorf("FlattenActivities(empty file) = %v, want nil", rows)
+	}
+}
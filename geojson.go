@@ -0,0 +1,113 @@
+package tachograph
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/card"
+	"github.com/way-platform/tachograph-go/internal/vu"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+)
+
+// ExportGeoJSON returns the GNSS positions recorded in a file as a GeoJSON
+// FeatureCollection (RFC 7946), with one Point feature per position, ordered
+// chronologically.
+//
+// Positions are taken from EF_GNSS_Places of a driver card (see card.Route),
+// and from the GNSS accumulated driving, border crossing and load/unload
+// records of a vehicle unit (see vu.Route). Records with the unknown position
+// marker are skipped. Each feature has the properties:
+//
+//   - time: the time of the record, in RFC 3339 format and UTC
+//   - recordType: GNSS_ACCUMULATED_DRIVING, BORDER_CROSSING or LOAD_UNLOAD
+//   - odometerKm: the vehicle odometer value
+//   - countryLeft and countryEntered: for border crossings
+//   - operationType: for load/unload operations
+//
+// Files without positions, such as Gen1 files, yield an empty collection.
+func ExportGeoJSON(file *tachographv1.File) ([]byte, error) {
+	collection := geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: []geoJSONFeature{},
+	}
+	switch file.GetType() {
+	case tachographv1.File_DRIVER_CARD:
+		for _, point := range card.Route(file.GetDriverCard()) {
+			if !point.HasPosition {
+				continue
+			}
+			collection.Features = append(collection.Features, newGeoJSONFeature(point.Latitude, point.Longitude, geoJSONProperties{
+				Time:       point.Time.Format(time.RFC3339),
+				RecordType: vu.RoutePointGNSSAccumulatedDriving.String(),
+				OdometerKm: point.OdometerKm,
+			}))
+		}
+	case tachographv1.File_VEHICLE_UNIT:
+		for _, point := range vu.Route(file.GetVehicleUnit()) {
+			if !point.HasPosition {
+				continue
+			}
+			properties := geoJSONProperties{
+				Time:       point.Time.Format(time.RFC3339),
+				RecordType: point.Kind.String(),
+				OdometerKm: point.OdometerKm,
+			}
+			switch point.Kind {
+			case vu.RoutePointBorderCrossing:
+				properties.CountryLeft = point.CountryLeft.String()
+				properties.CountryEntered = point.CountryEntered.String()
+			case vu.RoutePointLoadUnload:
+				properties.OperationType = point.OperationType.String()
+			}
+			collection.Features = append(collection.Features, newGeoJSONFeature(point.Latitude, point.Longitude, properties))
+		}
+	}
+	data, err := json.Marshal(collection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GeoJSON: %w", err)
+	}
+	return data, nil
+}
+
+// geoJSONFeatureCollection is a GeoJSON FeatureCollection object.
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// geoJSONFeature is a GeoJSON Feature object with a Point geometry.
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	Geometry   geoJSONPoint      `json:"geometry"`
+	Properties geoJSONProperties `json:"properties"`
+}
+
+// geoJSONPoint is a GeoJSON Point geometry.
+type geoJSONPoint struct {
+	Type string `json:"type"`
+	// Coordinates are the longitude and latitude, in that order.
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// geoJSONProperties are the properties of a position feature.
+type geoJSONProperties struct {
+	Time           string `json:"time"`
+	RecordType     string `json:"recordType"`
+	OdometerKm     int32  `json:"odometerKm"`
+	CountryLeft    string `json:"countryLeft,omitempty"`
+	CountryEntered string `json:"countryEntered,omitempty"`
+	OperationType  string `json:"operationType,omitempty"`
+}
+
+// newGeoJSONFeature returns a Point feature at a position in decimal degrees.
+func newGeoJSONFeature(latitude, longitude float64, properties geoJSONProperties) geoJSONFeature {
+	return geoJSONFeature{
+		Type: "Feature",
+		Geometry: geoJSONPoint{
+			Type:        "Point",
+			Coordinates: [2]float64{longitude, latitude},
+		},
+		Properties: properties,
+	}
+}
@@ -0,0 +1,81 @@
+package tachograph
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestExportGeoJSON(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newPlace := func(at time.Time, latitude, longitude int32) *ddv1.GNSSPlaceAuthRecord {
+		coords := &ddv1.GeoCoordinates{}
+		coords.SetLatitude(latitude)
+		coords.SetLongitude(longitude)
+		place := &ddv1.GNSSPlaceAuthRecord{}
+		place.SetTimestamp(timestamppb.New(at))
+		place.SetGeoCoordinates(coords)
+		return place
+	}
+	gnss := &ddv1.VuGNSSADRecordG2{}
+	gnss.SetTimeStamp(timestamppb.New(day.Add(9 * time.Hour)))
+	gnss.SetGnssPlaceAuthRecord(newPlace(day.Add(9*time.Hour), 55300, 9300))
+	gnss.SetVehicleOdometerKm(1250)
+	crossing := &ddv1.VuBorderCrossingRecord{}
+	crossing.SetCountryLeft(ddv1.NationNumeric_DENMARK)
+	crossing.SetCountryEntered(ddv1.NationNumeric_GERMANY)
+	crossing.SetGnssPlaceAuthRecord(newPlace(day.Add(11*time.Hour), 54300, 10300))
+	crossing.SetVehicleOdometerKm(1400)
+	unknown := &ddv1.VuLoadUnloadRecord{}
+	unknown.SetTimeStamp(timestamppb.New(day.Add(12 * time.Hour)))
+	unknown.SetOperationType(ddv1.OperationType_LOAD_OPERATION)
+	unknown.SetGnssPlaceAuthRecord(newPlace(day.Add(12*time.Hour), 0x7FFFFF, 0x7FFFFF))
+
+	activities := &vuv1.ActivitiesGen2V2{}
+	activities.SetDateOfDay(timestamppb.New(day))
+	activities.SetGnssAccumulatedDriving([]*ddv1.VuGNSSADRecordG2{gnss})
+	activities.SetBorderCrossings([]*ddv1.VuBorderCrossingRecord{crossing})
+	activities.SetLoadUnloadOperations([]*ddv1.VuLoadUnloadRecord{unknown})
+	gen2v2 := &vuv1.VehicleUnitFileGen2V2{}
+	gen2v2.SetActivities([]*vuv1.ActivitiesGen2V2{activities})
+	vehicleUnit := &vuv1.VehicleUnitFile{}
+	vehicleUnit.SetGeneration(ddv1.Generation_GENERATION_2)
+	vehicleUnit.SetVersion(ddv1.Version_VERSION_2)
+	vehicleUnit.SetGen2V2(gen2v2)
+	file := &tachographv1.File{}
+	file.SetType(tachographv1.File_VEHICLE_UNIT)
+	file.SetVehicleUnit(vehicleUnit)
+
+	got, err := ExportGeoJSON(file)
+	if err != nil {
+		t.Fatalf("ExportGeoJSON failed: %v", err)
+	}
+	want := `{"type":"FeatureCollection","features":[
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[9.5,55.5]},
+		 "properties":{"time":"2024-05-06T09:00:00Z","recordType":"GNSS_ACCUMULATED_DRIVING","odometerKm":1250}},
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[10.5,54.5]},
+		 "properties":{"time":"2024-05-06T11:00:00Z","recordType":"BORDER_CROSSING","odometerKm":1400,"countryLeft":"DENMARK","countryEntered":"GERMANY"}}
+	]}`
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, []byte(want)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, compacted.Bytes()) {
+		t.Errorf("ExportGeoJSON() =\n%s\nwant\n%s", got, compacted.Bytes())
+	}
+
+	empty, err := ExportGeoJSON(&tachographv1.File{})
+	if err != nil {
+		t.Fatalf("ExportGeoJSON(empty file) failed: %v", err)
+	}
+	if got, want := string(empty), `{"type":"FeatureCollection","features":[]}`; got != want {
+		t.Errorf("ExportGeoJSON(empty file) = %s, want %s", got, want)
+	}
+}
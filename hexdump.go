@@ -0,0 +1,14 @@
+package tachograph
+
+import "github.com/way-platform/tachograph-go/internal/hexdump"
+
+// UnmarshalHexdump converts a hexdump, such as the output of `hexdump -C` or
+// a dump pasted into a bug report, back to binary data.
+//
+// Offsets, ASCII columns and the spacing between hex bytes are ignored, and a
+// line consisting of a single '*' repeats the previous line up to the offset
+// of the next one, as in `hexdump -C`. An error is returned for lines with
+// malformed hex data.
+func UnmarshalHexdump(data []byte) ([]byte, error) {
+	return hexdump.Unmarshal(data)
+}
@@ -4,8 +4,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"sort"
 
-	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -39,7 +40,7 @@ func (opts UnmarshalOptions) unmarshalDriverActivityData(data []byte) (*cardv1.D
 	)
 
 	if len(data) < lenCardDriverActivityHeader {
-		return nil, fmt.Errorf("insufficient data for activity data header")
+		return nil, fmt.Errorf("insufficient data for activity data header: %w", io.ErrUnexpectedEOF)
 	}
 
 	target := &cardv1.DriverActivityData{}
@@ -68,7 +69,7 @@ func (opts UnmarshalOptions) unmarshalDriverActivityData(data []byte) (*cardv1.D
 	target.SetRawData(activityData)
 
 	// Parse records using the iterator
-	dailyRecords, err := opts.parseActivityRecordsWithIterator(activityData, int(newestDayRecordPointer))
+	dailyRecords, err := opts.parseActivityRecordsWithIterator(activityData, int(newestDayRecordPointer), int(oldestDayRecordPointer))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse cyclic activity daily records: %w", err)
 	}
@@ -80,10 +81,13 @@ func (opts UnmarshalOptions) unmarshalDriverActivityData(data []byte) (*cardv1.D
 // parseActivityRecordsWithIterator parses activity records using the CyclicRecordIterator.
 // This separates the complex traversal logic from the parsing logic, improving maintainability
 // and enabling the buffer painting strategy for perfect round-trip fidelity.
-func (opts UnmarshalOptions) parseActivityRecordsWithIterator(buffer []byte, startPos int) ([]*cardv1.DriverActivityData_DailyRecord, error) {
+//
+// The records are returned in chronological order, sorted by record date. Records
+// that could not be parsed keep their position in the buffer order.
+func (opts UnmarshalOptions) parseActivityRecordsWithIterator(buffer []byte, newestPos, oldestPos int) ([]*cardv1.DriverActivityData_DailyRecord, error) {
 	var records []*cardv1.DriverActivityData_DailyRecord
 
-	iterator := NewCyclicRecordIterator(buffer, startPos)
+	iterator := NewCyclicRecordIterator(buffer, newestPos, oldestPos)
 	for iterator.Next() {
 		recordBytes, _, _ := iterator.Record()
 
@@ -119,10 +123,30 @@ func (opts UnmarshalOptions) parseActivityRecordsWithIterator(buffer []byte, sta
 	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
 		records[i], records[j] = records[j], records[i]
 	}
+	sortDailyRecordsByDate(records)
 
 	return records, nil
 }
 
+// sortDailyRecordsByDate sorts the valid records by record date, in place.
+// Invalid records have no date and stay at their position.
+func sortDailyRecordsByDate(records []*cardv1.DriverActivityData_DailyRecord) {
+	var positions []int
+	var valid []*cardv1.DriverActivityData_DailyRecord
+	for i, record := range records {
+		if record.GetValid() {
+			positions = append(positions, i)
+			valid = append(valid, record)
+		}
+	}
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].GetActivityRecordDate().AsTime().Before(valid[j].GetActivityRecordDate().AsTime())
+	})
+	for i, position := range positions {
+		records[position] = valid[i]
+	}
+}
+
 // parseSingleActivityDailyRecord parses a single daily record byte slice.
 func (opts UnmarshalOptions) parseSingleActivityDailyRecord(data []byte) (*cardv1.DriverActivityData_DailyRecord, error) {
 	const (
@@ -130,7 +154,7 @@ func (opts UnmarshalOptions) parseSingleActivityDailyRecord(data []byte) (*cardv
 	)
 
 	if len(data) < lenMinDailyRecord {
-		return nil, fmt.Errorf("insufficient data for daily record, got %d bytes", len(data))
+		return nil, fmt.Errorf("insufficient data for daily record, got %d bytes: %w", len(data), io.ErrUnexpectedEOF)
 	}
 
 	record := &cardv1.DriverActivityData_DailyRecord{}
@@ -146,7 +170,7 @@ func (opts UnmarshalOptions) parseSingleActivityDailyRecord(data []byte) (*cardv
 
 	// Read activity record date (4 bytes TimeReal)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for activity record date")
+		return nil, fmt.Errorf("insufficient data for activity record date: %w", io.ErrUnexpectedEOF)
 	}
 	date, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -157,7 +181,7 @@ func (opts UnmarshalOptions) parseSingleActivityDailyRecord(data []byte) (*cardv
 
 	// Read activity daily presence counter (2 bytes BCD)
 	if offset+2 > len(data) {
-		return nil, fmt.Errorf("insufficient data for presence counter")
+		return nil, fmt.Errorf("insufficient data for presence counter: %w", io.ErrUnexpectedEOF)
 	}
 	bcdCounter, err := opts.UnmarshalBcdString(data[offset : offset+2])
 	if err != nil {
@@ -168,7 +192,7 @@ func (opts UnmarshalOptions) parseSingleActivityDailyRecord(data []byte) (*cardv
 
 	// Read activity day distance (2 bytes)
 	if offset+2 > len(data) {
-		return nil, fmt.Errorf("insufficient data for day distance")
+		return nil, fmt.Errorf("insufficient data for day distance: %w", io.ErrUnexpectedEOF)
 	}
 	dayDistance := binary.BigEndian.Uint16(data[offset : offset+2])
 	record.SetActivityDayDistance(int32(dayDistance))
@@ -259,10 +283,16 @@ func (opts MarshalOptions) MarshalDriverActivity(activity *cardv1.DriverActivity
 //
 // The iterator follows the linked list structure where each record contains a
 // pointer to the previous record's length, allowing backward traversal through
-// the cyclic buffer while handling wrap-around conditions.
+// the cyclic buffer while handling wrap-around conditions. Records, including
+// their headers, may wrap around the end of the buffer.
+//
+// Traversal ends at the record at the oldest day record pointer. Once the buffer
+// is full, the oldest record still links back to the record it overwrote, so the
+// previous record length alone does not mark the end of the chain.
 type cyclicRecordIterator struct {
 	buffer      []byte
 	currentPos  int
+	oldestPos   int
 	recordCount int
 	err         error
 
@@ -273,11 +303,13 @@ type cyclicRecordIterator struct {
 }
 
 // NewCyclicRecordIterator creates a new iterator for traversing activity records
-// in the cyclic buffer, starting from the newest record position.
-func NewCyclicRecordIterator(buffer []byte, startPos int) *cyclicRecordIterator {
+// in the cyclic buffer, starting from the newest record position and ending at
+// the oldest record position.
+func NewCyclicRecordIterator(buffer []byte, newestPos, oldestPos int) *cyclicRecordIterator {
 	return &cyclicRecordIterator{
 		buffer:     buffer,
-		currentPos: startPos,
+		currentPos: newestPos,
+		oldestPos:  oldestPos,
 	}
 }
 
@@ -297,17 +329,22 @@ func (it *cyclicRecordIterator) Next() bool {
 		return false // No data to parse
 	}
 	// Validate current position for reading header
-	if it.currentPos < 0 || it.currentPos+4 > len(it.buffer) {
+	if it.currentPos < 0 || it.currentPos >= len(it.buffer) || len(it.buffer) < 4 {
 		return false // Invalid position for header
 	}
-	// Read record header (4 bytes: prevRecordLength + currentRecordLength)
-	prevRecordLength := int(binary.BigEndian.Uint16(it.buffer[it.currentPos : it.currentPos+2]))
-	currentRecordLength := int(binary.BigEndian.Uint16(it.buffer[it.currentPos+2 : it.currentPos+4]))
+	// Read record header (4 bytes: prevRecordLength + currentRecordLength),
+	// handling buffer wrap-around
+	var header [4]byte
+	for i := range header {
+		header[i] = it.buffer[(it.currentPos+i)%len(it.buffer)]
+	}
+	prevRecordLength := int(binary.BigEndian.Uint16(header[0:2]))
+	currentRecordLength := int(binary.BigEndian.Uint16(header[2:4]))
 	if currentRecordLength == 0 {
 		return false // Zero-length record signifies end of chain
 	}
 	// Validate record length
-	if currentRecordLength < 4 {
+	if currentRecordLength < 4 || currentRecordLength > len(it.buffer) {
 		it.err = fmt.Errorf("invalid record length %d at position %d", currentRecordLength, it.currentPos)
 		return false
 	}
@@ -321,8 +358,8 @@ func (it *cyclicRecordIterator) Next() bool {
 	}
 	it.recordCount++
 	// Move to previous record for next iteration
-	if prevRecordLength == 0 {
-		// End of chain marker - no more records
+	if prevRecordLength == 0 || it.currentPos == it.oldestPos {
+		// End of chain marker or oldest record - no more records
 		it.currentPos = -1 // Mark as finished
 	} else {
 		// Move backwards by prevRecordLength, handling wrap-around
@@ -517,10 +554,7 @@ func (opts AnonymizeOptions) anonymizeDriverActivityData(activity *cardv1.Driver
 	anonymized := &cardv1.DriverActivityData{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Note: We do NOT preserve raw_data or the cyclic buffer pointers here, as we're modifying
 	// the semantic fields (dates and activity change times), which means the buffer must be
@@ -0,0 +1,64 @@
+package card
+
+import (
+	"sort"
+	"time"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// ActivityDay is the activity of a driver on one calendar day, as recorded by
+// a CardActivityDailyRecord (Data Dictionary, Section 2.9).
+type ActivityDay struct {
+	// Date is midnight UTC at the start of the day.
+	Date time.Time
+
+	// DailyPresenceCounter is the number of days the card has been inserted
+	// in a vehicle unit, as of this day.
+	DailyPresenceCounter *ddv1.BcdString
+
+	// DistanceKm is the total distance travelled during the day.
+	DistanceKm int32
+
+	// ActivityChanges are the activity changes of the driver during the day,
+	// in the order they were recorded.
+	ActivityChanges []*ddv1.ActivityChangeInfo
+}
+
+// UnifiedDriverActivity returns the activity days recorded on a driver card,
+// merged across the Tachograph and Tachograph_G2 applications and ordered
+// chronologically.
+//
+// Cards with both applications record the same days in both EF_Driver_Activity_Data
+// files. A day recorded by both is taken from the Generation 2 application, and
+// each day is returned once. Records that could not be parsed (valid = false)
+// are skipped.
+func UnifiedDriverActivity(file *cardv1.DriverCardFile) []*ActivityDay {
+	const day = 24 * time.Hour
+	days := map[time.Time]*ActivityDay{}
+	add := func(data *cardv1.DriverActivityData) {
+		for _, record := range data.GetDailyRecords() {
+			if !record.GetValid() || !record.HasActivityRecordDate() {
+				continue
+			}
+			date := record.GetActivityRecordDate().AsTime().UTC().Truncate(day)
+			days[date] = &ActivityDay{
+				Date:                 date,
+				DailyPresenceCounter: record.GetActivityDailyPresenceCounter(),
+				DistanceKm:           record.GetActivityDayDistance(),
+				ActivityChanges:      record.GetActivityChangeInfo(),
+			}
+		}
+	}
+	add(file.GetTachograph().GetDriverActivityData())
+	add(file.GetTachographG2().GetDriverActivityData())
+	result := make([]*ActivityDay, 0, len(days))
+	for _, activityDay := range days {
+		result = append(result, activityDay)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date.Before(result[j].Date)
+	})
+	return result
+}
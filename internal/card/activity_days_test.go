@@ -0,0 +1,58 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+)
+
+func TestUnifiedDriverActivity(t *testing.T) {
+	newRecord := func(date time.Time, distanceKm int32) *cardv1.DriverActivityData_DailyRecord {
+		record := &cardv1.DriverActivityData_DailyRecord{}
+		record.SetValid(true)
+		record.SetActivityRecordDate(timestamppb.New(date))
+		record.SetActivityDayDistance(distanceKm)
+		return record
+	}
+	newData := func(records ...*cardv1.DriverActivityData_DailyRecord) *cardv1.DriverActivityData {
+		data := &cardv1.DriverActivityData{}
+		data.SetDailyRecords(records)
+		return data
+	}
+	day1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	day3 := day1.AddDate(0, 0, 2)
+	invalid := &cardv1.DriverActivityData_DailyRecord{}
+	invalid.SetRawData([]byte{0xFF})
+
+	// The Gen1 application records days 1 and 2, and the Gen2 application
+	// records days 2 and 3, in the order of the cyclic buffer.
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetDriverActivityData(newData(newRecord(day2, 120), newRecord(day1, 100), invalid))
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetDriverActivityData(newData(newRecord(day3, 300), newRecord(day2, 200)))
+	file := &cardv1.DriverCardFile{}
+	file.SetTachograph(tachograph)
+	file.SetTachographG2(tachographG2)
+
+	type activityDay struct {
+		Date       time.Time
+		DistanceKm int32
+	}
+	var got []activityDay
+	for _, day := range UnifiedDriverActivity(file) {
+		got = append(got, activityDay{Date: day.Date, DistanceKm: day.DistanceKm})
+	}
+	want := []activityDay{
+		{Date: day1, DistanceKm: 100},
+		{Date: day2, DistanceKm: 200},
+		{Date: day3, DistanceKm: 300},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UnifiedDriverActivity() mismatch (-want +got):\n%s", diff)
+	}
+}
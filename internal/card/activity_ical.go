@@ -0,0 +1,74 @@
+package card
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// icalActivitySummaries maps driver activities to VEVENT summaries.
+var icalActivitySummaries = map[ddv1.DriverActivityValue]string{
+	ddv1.DriverActivityValue_DRIVING:      "Driving",
+	ddv1.DriverActivityValue_WORK:         "Work",
+	ddv1.DriverActivityValue_AVAILABILITY: "Availability",
+	ddv1.DriverActivityValue_BREAK_REST:   "Break/rest",
+}
+
+// ExportActivitiesICal writes the driver activities of a driver card as an
+// iCalendar object (RFC 5545), with one VEVENT per continuous activity period.
+//
+// Activity periods are taken from EF_Driver_Activity_Data, preferring the
+// Generation 2 application when present. Periods of the same activity that
+// continue across midnight are merged into a single event. Events are written
+// in UTC, and their DTSTAMP is their start time, so the output only depends on
+// the card contents.
+func ExportActivitiesICal(file *cardv1.DriverCardFile, w io.Writer) error {
+	data := file.GetTachographG2().GetDriverActivityData()
+	if data == nil {
+		data = file.GetTachograph().GetDriverActivityData()
+	}
+	var periods []activitySegment
+	for _, segment := range activitySegments(data) {
+		if _, ok := icalActivitySummaries[segment.activity]; !ok {
+			continue
+		}
+		if n := len(periods); n > 0 && periods[n-1].activity == segment.activity && periods[n-1].end.Equal(segment.start) {
+			periods[n-1].end = segment.end
+			continue
+		}
+		periods = append(periods, segment)
+	}
+
+	var buf bytes.Buffer
+	line := func(format string, args ...any) {
+		fmt.Fprintf(&buf, format, args...)
+		buf.WriteString("\r\n")
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//way-platform//tachograph-go//EN")
+	line("CALSCALE:GREGORIAN")
+	for _, period := range periods {
+		start := icalTime(period.start)
+		line("BEGIN:VEVENT")
+		line("UID:%s-%s@tachograph-go", start, strings.ToLower(period.activity.String()))
+		line("DTSTAMP:%s", start)
+		line("DTSTART:%s", start)
+		line("DTEND:%s", icalTime(period.end))
+		line("SUMMARY:%s", icalActivitySummaries[period.activity])
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// icalTime formats t as an iCalendar DATE-TIME in UTC.
+func icalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
@@ -0,0 +1,103 @@
+package card
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestExportActivitiesICal(t *testing.T) {
+	newChange := func(minutes int32, activity ddv1.DriverActivityValue) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetTimeOfChangeMinutes(minutes)
+		change.SetActivity(activity)
+		return change
+	}
+	record := &cardv1.DriverActivityData_DailyRecord{}
+	record.SetValid(true)
+	record.SetActivityRecordDate(timestamppb.New(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+	record.SetActivityChangeInfo([]*ddv1.ActivityChangeInfo{
+		newChange(0, ddv1.DriverActivityValue_BREAK_REST),
+		newChange(8*60, ddv1.DriverActivityValue_WORK),
+		newChange(8*60+30, ddv1.DriverActivityValue_DRIVING),
+		newChange(12*60+30, ddv1.DriverActivityValue_BREAK_REST),
+		newChange(13*60+15, ddv1.DriverActivityValue_DRIVING),
+		newChange(17*60, ddv1.DriverActivityValue_BREAK_REST),
+	})
+	data := &cardv1.DriverActivityData{}
+	data.SetDailyRecords([]*cardv1.DriverActivityData_DailyRecord{record})
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetDriverActivityData(data)
+	file := &cardv1.DriverCardFile{}
+	file.SetTachograph(tachograph)
+
+	var buf bytes.Buffer
+	if err := ExportActivitiesICal(file, &buf); err != nil {
+		t.Fatalf("ExportActivitiesICal failed: %v", err)
+	}
+	got := buf.String()
+
+	// Every content line must be terminated by CRLF.
+	if !strings.HasSuffix(got, "\r\n") || strings.Count(got, "\n") != strings.Count(got, "\r\n") {
+		t.Error("content lines are not CRLF-terminated")
+	}
+	want := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//way-platform//tachograph-go//EN",
+		"CALSCALE:GREGORIAN",
+		"BEGIN:VEVENT",
+		"UID:20240301T000000Z-break_rest@tachograph-go",
+		"DTSTAMP:20240301T000000Z",
+		"DTSTART:20240301T000000Z",
+		"DTEND:20240301T080000Z",
+		"SUMMARY:Break/rest",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20240301T080000Z-work@tachograph-go",
+		"DTSTAMP:20240301T080000Z",
+		"DTSTART:20240301T080000Z",
+		"DTEND:20240301T083000Z",
+		"SUMMARY:Work",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20240301T083000Z-driving@tachograph-go",
+		"DTSTAMP:20240301T083000Z",
+		"DTSTART:20240301T083000Z",
+		"DTEND:20240301T123000Z",
+		"SUMMARY:Driving",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20240301T123000Z-break_rest@tachograph-go",
+		"DTSTAMP:20240301T123000Z",
+		"DTSTART:20240301T123000Z",
+		"DTEND:20240301T131500Z",
+		"SUMMARY:Break/rest",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20240301T131500Z-driving@tachograph-go",
+		"DTSTAMP:20240301T131500Z",
+		"DTSTART:20240301T131500Z",
+		"DTEND:20240301T170000Z",
+		"SUMMARY:Driving",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20240301T170000Z-break_rest@tachograph-go",
+		"DTSTAMP:20240301T170000Z",
+		"DTSTART:20240301T170000Z",
+		"DTEND:20240302T000000Z",
+		"SUMMARY:Break/rest",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+	if diff := cmp.Diff(want, strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n")); diff != "" {
+		t.Errorf("ExportActivitiesICal mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,139 @@
+package card
+
+import (
+	"sort"
+	"time"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// SummarizeOptions configures how driver activity is bucketed into days.
+type SummarizeOptions struct {
+	// DayBoundary is the offset from midnight UTC at which a driving day starts.
+	//
+	// The zero value buckets activity by calendar day in UTC, matching the
+	// layout of CardActivityDailyRecord. A value of 4*time.Hour makes each
+	// day run from 04:00 UTC to 04:00 UTC the following day.
+	//
+	// Values outside [0, 24h) are normalized into that range.
+	DayBoundary time.Duration
+}
+
+// DailyActivitySummary holds the accumulated activity durations for one day.
+type DailyActivitySummary struct {
+	// Start is the start of the day bucket, i.e. midnight UTC plus the
+	// configured day boundary.
+	Start time.Time
+
+	// Driving is the total time spent driving.
+	Driving time.Duration
+
+	// Work is the total time spent on other work.
+	Work time.Duration
+
+	// Availability is the total time spent available.
+	Availability time.Duration
+
+	// BreakRest is the total time spent on break or rest.
+	BreakRest time.Duration
+}
+
+// SummarizeDriverActivity accumulates the activity changes of a driver card
+// into per-day totals.
+//
+// Each CardActivityDailyRecord covers one calendar day in UTC, from 00:00 to
+// 24:00. An activity lasts until the next change in the same record, or until
+// the end of the record's day. The resulting periods are then split at the
+// configured day boundary, so a period that crosses the boundary contributes
+// to both adjacent days.
+//
+// Records that could not be parsed (valid = false) are skipped. The returned
+// summaries are ordered chronologically and only include days with activity.
+func (o SummarizeOptions) SummarizeDriverActivity(data *cardv1.DriverActivityData) []*DailyActivitySummary {
+	const day = 24 * time.Hour
+	boundary := o.DayBoundary % day
+	if boundary < 0 {
+		boundary += day
+	}
+	summaries := map[time.Time]*DailyActivitySummary{}
+	add := func(activity ddv1.DriverActivityValue, start, end time.Time) {
+		for start.Before(end) {
+			bucket := start.Add(-boundary).Truncate(day).Add(boundary)
+			next := bucket.Add(day)
+			if next.After(end) {
+				next = end
+			}
+			summary, ok := summaries[bucket]
+			if !ok {
+				summary = &DailyActivitySummary{Start: bucket}
+				summaries[bucket] = summary
+			}
+			d := next.Sub(start)
+			switch activity {
+			case ddv1.DriverActivityValue_DRIVING:
+				summary.Driving += d
+			case ddv1.DriverActivityValue_WORK:
+				summary.Work += d
+			case ddv1.DriverActivityValue_AVAILABILITY:
+				summary.Availability += d
+			case ddv1.DriverActivityValue_BREAK_REST:
+				summary.BreakRest += d
+			}
+			start = next
+		}
+	}
+	for _, segment := range activitySegments(data) {
+		add(segment.activity, segment.start, segment.end)
+	}
+	result := make([]*DailyActivitySummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+	return result
+}
+
+// activitySegment is a period of a single driver activity.
+type activitySegment struct {
+	activity   ddv1.DriverActivityValue
+	start, end time.Time
+}
+
+// activitySegments flattens the daily records of a driver card into
+// chronologically ordered activity periods.
+//
+// An activity lasts until the next change in the same record, or until the
+// end of the record's day. Records that could not be parsed are skipped.
+func activitySegments(data *cardv1.DriverActivityData) []activitySegment {
+	const day = 24 * time.Hour
+	var segments []activitySegment
+	for _, record := range data.GetDailyRecords() {
+		if !record.GetValid() || !record.HasActivityRecordDate() {
+			continue
+		}
+		date := record.GetActivityRecordDate().AsTime().UTC().Truncate(day)
+		changes := record.GetActivityChangeInfo()
+		for i, change := range changes {
+			start := date.Add(time.Duration(change.GetTimeOfChangeMinutes()) * time.Minute)
+			end := date.Add(day)
+			if i+1 < len(changes) {
+				end = date.Add(time.Duration(changes[i+1].GetTimeOfChangeMinutes()) * time.Minute)
+			}
+			if !start.Before(end) {
+				continue
+			}
+			segments = append(segments, activitySegment{
+				activity: change.GetActivity(),
+				start:    start,
+				end:      end,
+			})
+		}
+	}
+	sort.SliceStable(segments, func(i, j int) bool {
+		return segments[i].start.Before(segments[j].start)
+	})
+	return segments
+}
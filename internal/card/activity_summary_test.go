@@ -0,0 +1,84 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestSummarizeDriverActivity(t *testing.T) {
+	newChange := func(minutes int32, activity ddv1.DriverActivityValue) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetTimeOfChangeMinutes(minutes)
+		change.SetActivity(activity)
+		return change
+	}
+	newRecord := func(date time.Time, changes ...*ddv1.ActivityChangeInfo) *cardv1.DriverActivityData_DailyRecord {
+		record := &cardv1.DriverActivityData_DailyRecord{}
+		record.SetValid(true)
+		record.SetActivityRecordDate(timestamppb.New(date))
+		record.SetActivityChangeInfo(changes)
+		return record
+	}
+	day1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	// A night shift: driving from 22:00 on day 1 until 02:00 on day 2.
+	data := &cardv1.DriverActivityData{}
+	data.SetDailyRecords([]*cardv1.DriverActivityData_DailyRecord{
+		newRecord(day1,
+			newChange(0, ddv1.DriverActivityValue_BREAK_REST),
+			newChange(22*60, ddv1.DriverActivityValue_DRIVING),
+		),
+		newRecord(day2,
+			newChange(0, ddv1.DriverActivityValue_DRIVING),
+			newChange(2*60, ddv1.DriverActivityValue_BREAK_REST),
+		),
+	})
+
+	tests := []struct {
+		name string
+		opts SummarizeOptions
+		want []*DailyActivitySummary
+	}{
+		{
+			name: "midnight boundary",
+			opts: SummarizeOptions{},
+			want: []*DailyActivitySummary{
+				{Start: day1, Driving: 2 * time.Hour, BreakRest: 22 * time.Hour},
+				{Start: day2, Driving: 2 * time.Hour, BreakRest: 22 * time.Hour},
+			},
+		},
+		{
+			name: "boundary at 12:00",
+			opts: SummarizeOptions{DayBoundary: 12 * time.Hour},
+			want: []*DailyActivitySummary{
+				{Start: day1.Add(-12 * time.Hour), BreakRest: 12 * time.Hour},
+				{Start: day1.Add(12 * time.Hour), Driving: 4 * time.Hour, BreakRest: 20 * time.Hour},
+				{Start: day2.Add(12 * time.Hour), BreakRest: 12 * time.Hour},
+			},
+		},
+		{
+			name: "negative boundary is normalized",
+			opts: SummarizeOptions{DayBoundary: -12 * time.Hour},
+			want: []*DailyActivitySummary{
+				{Start: day1.Add(-12 * time.Hour), BreakRest: 12 * time.Hour},
+				{Start: day1.Add(12 * time.Hour), Driving: 4 * time.Hour, BreakRest: 20 * time.Hour},
+				{Start: day2.Add(12 * time.Hour), BreakRest: 12 * time.Hour},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.opts.SummarizeDriverActivity(data)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("SummarizeDriverActivity() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
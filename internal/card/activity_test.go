@@ -1,8 +1,10 @@
 package card
 
 import (
+	"encoding/binary"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -111,3 +113,59 @@ func TestActivity_Generation2(t *testing.T) {
 		})
 	}
 }
+
+func TestActivity_wrapAround(t *testing.T) {
+	const lenRecord = 14 // header, date, presence counter, distance and one change
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	newRecord := func(prevRecordLength int, date time.Time) []byte {
+		record := binary.BigEndian.AppendUint16(nil, uint16(prevRecordLength))
+		record = binary.BigEndian.AppendUint16(record, lenRecord)
+		record = binary.BigEndian.AppendUint32(record, uint32(date.Unix()))
+		record = append(record, 0x00, 0x01)                 // presence counter
+		record = binary.BigEndian.AppendUint16(record, 100) // distance
+		return append(record, 0x18, 0x00)                   // driving from 00:00
+	}
+
+	// A full buffer of three records, where the middle record and its header
+	// wrap around the end of the buffer. The oldest record still links back to
+	// the overwritten record at the position of the newest record.
+	const (
+		oldestPos = 25
+		middlePos = 39
+		newestPos = 11
+	)
+	buffer := make([]byte, 3*lenRecord)
+	copy(buffer[oldestPos:], newRecord(lenRecord, day))
+	middle := newRecord(lenRecord, day.AddDate(0, 0, 1))
+	n := copy(buffer[middlePos:], middle)
+	copy(buffer, middle[n:])
+	copy(buffer[newestPos:], newRecord(lenRecord, day.AddDate(0, 0, 2)))
+
+	data := binary.BigEndian.AppendUint16(nil, oldestPos)
+	data = binary.BigEndian.AppendUint16(data, newestPos)
+	data = append(data, buffer...)
+
+	activity, err := UnmarshalOptions{}.unmarshalDriverActivityData(data)
+	if err != nil {
+		t.Fatalf("unmarshalDriverActivityData failed: %v", err)
+	}
+	var got []time.Time
+	for _, record := range activity.GetDailyRecords() {
+		if !record.GetValid() {
+			t.Fatalf("invalid record: %x", record.GetRawData())
+		}
+		got = append(got, record.GetActivityRecordDate().AsTime())
+	}
+	want := []time.Time{day, day.AddDate(0, 0, 1), day.AddDate(0, 0, 2)}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("record dates mismatch (-want +got):\n%s", diff)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalDriverActivity(activity)
+	if err != nil {
+		t.Fatalf("MarshalDriverActivity failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -1,8 +1,11 @@
 package card
 
 import (
+	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AnonymizeOptions configures the anonymization of card files.
@@ -12,6 +15,25 @@ type AnonymizeOptions struct {
 
 	// PreserveTimestamps controls whether timestamps are preserved.
 	PreserveTimestamps bool
+
+	// BaseLatitude and BaseLongitude are the coordinates replacing anonymized
+	// positions, encoded as GeoCoordinates (±DDMM.M * 10).
+	// If both are zero, Helsinki (60°10.0'N 24°56.0'E) is used.
+	BaseLatitude, BaseLongitude int32
+
+	// HomeNation replaces anonymized nations. If unspecified, Finland is used.
+	HomeNation ddv1.NationNumeric
+
+	// NeighborNation is the country entered by anonymized border crossings.
+	// If unspecified, Sweden is used.
+	NeighborNation ddv1.NationNumeric
+
+	// PreserveNations keeps the original nations and regions.
+	PreserveNations bool
+
+	// Key is the secret key deriving anonymized card numbers.
+	// If empty, dd.DefaultAnonymizationKey is used.
+	Key []byte
 }
 
 // AnonymizeDriverCardFile creates an anonymized copy of a driver card file.
@@ -126,3 +148,174 @@ func (opts AnonymizeOptions) AnonymizeDriverCardFile(file *cardv1.DriverCardFile
 
 	return result, nil
 }
+
+// The card file messages for workshop, control and company cards do not exist
+// yet, so their EFs are anonymized individually. The string fields keep their
+// original lengths, so that the anonymized EFs have the same byte sizes as the
+// originals.
+
+// AnonymizeWorkshopCardIdentification creates an anonymized copy of the
+// identification of a workshop card (EF_Identification).
+//
+// The workshop name and address, and the names of the card holder, who is the
+// technician performing calibrations, are replaced with test values.
+func (opts AnonymizeOptions) AnonymizeWorkshopCardIdentification(id *cardv1.WorkshopCardIdentification) *cardv1.WorkshopCardIdentification {
+	if id == nil {
+		return nil
+	}
+	ddOpts := opts.ddAnonymizeOptions()
+	result := &cardv1.WorkshopCardIdentification{}
+	result.SetCardIssuingMemberState(id.GetCardIssuingMemberState())
+	result.SetOwnerIdentification(ddOpts.AnonymizeOwnerIdentification(id.GetOwnerIdentification()))
+	result.SetCardIssuingAuthorityName(anonymizedName("Transport and Communications Agency", id.GetCardIssuingAuthorityName()))
+	result.SetCardIssueDate(&timestamppb.Timestamp{Seconds: 1577836800})
+	result.SetCardValidityBegin(&timestamppb.Timestamp{Seconds: 1577836800})
+	result.SetCardExpiryDate(&timestamppb.Timestamp{Seconds: 1735689599})
+	result.SetWorkshopName(anonymizedName("Test Workshop", id.GetWorkshopName()))
+	result.SetWorkshopAddress(anonymizedName("Test Street 1", id.GetWorkshopAddress()))
+	result.SetCardHolderSurname(anonymizedName("Doe", id.GetCardHolderSurname()))
+	result.SetCardHolderFirstNames(anonymizedName("John", id.GetCardHolderFirstNames()))
+	result.SetCardHolderPreferredLanguage(id.GetCardHolderPreferredLanguage())
+	return result
+}
+
+// AnonymizeControlCardIdentification creates an anonymized copy of the
+// identification of a control card (EF_Identification).
+//
+// The control body name and address, and the names of the controller holding
+// the card, are replaced with test values.
+func (opts AnonymizeOptions) AnonymizeControlCardIdentification(id *cardv1.ControlCardIdentification) *cardv1.ControlCardIdentification {
+	if id == nil {
+		return nil
+	}
+	ddOpts := opts.ddAnonymizeOptions()
+	result := &cardv1.ControlCardIdentification{}
+	result.SetCardIssuingMemberState(id.GetCardIssuingMemberState())
+	result.SetOwnerIdentification(ddOpts.AnonymizeOwnerIdentification(id.GetOwnerIdentification()))
+	result.SetCardIssuingAuthorityName(anonymizedName("Transport and Communications Agency", id.GetCardIssuingAuthorityName()))
+	result.SetCardIssueDate(&timestamppb.Timestamp{Seconds: 1577836800})
+	result.SetCardValidityBegin(&timestamppb.Timestamp{Seconds: 1577836800})
+	result.SetCardExpiryDate(&timestamppb.Timestamp{Seconds: 1735689599})
+	result.SetControlBodyName(anonymizedName("Test Control Body", id.GetControlBodyName()))
+	result.SetControlBodyAddress(anonymizedName("Test Street 1", id.GetControlBodyAddress()))
+	result.SetCardHolderSurname(anonymizedName("Doe", id.GetCardHolderSurname()))
+	result.SetCardHolderFirstNames(anonymizedName("John", id.GetCardHolderFirstNames()))
+	result.SetCardHolderPreferredLanguage(id.GetCardHolderPreferredLanguage())
+	return result
+}
+
+// AnonymizeCompanyCardIdentification creates an anonymized copy of the
+// identification of a company card (EF_Identification).
+//
+// The company name and address are replaced with test values.
+func (opts AnonymizeOptions) AnonymizeCompanyCardIdentification(id *cardv1.CompanyCardIdentification) *cardv1.CompanyCardIdentification {
+	if id == nil {
+		return nil
+	}
+	ddOpts := opts.ddAnonymizeOptions()
+	result := &cardv1.CompanyCardIdentification{}
+	result.SetCardIssuingMemberState(id.GetCardIssuingMemberState())
+	result.SetOwnerIdentification(ddOpts.AnonymizeOwnerIdentification(id.GetOwnerIdentification()))
+	result.SetCardIssuingAuthorityName(anonymizedName("Transport and Communications Agency", id.GetCardIssuingAuthorityName()))
+	result.SetCardIssueDate(&timestamppb.Timestamp{Seconds: 1577836800})
+	result.SetCardValidityBegin(&timestamppb.Timestamp{Seconds: 1577836800})
+	result.SetCardExpiryDate(&timestamppb.Timestamp{Seconds: 1735689599})
+	result.SetCompanyName(anonymizedName("Test Company", id.GetCompanyName()))
+	result.SetCompanyAddress(anonymizedName("Test Street 1", id.GetCompanyAddress()))
+	result.SetCardHolderPreferredLanguage(id.GetCardHolderPreferredLanguage())
+	return result
+}
+
+// AnonymizeCalibration creates an anonymized copy of the calibration records
+// of a workshop card (EF_Calibration).
+//
+// The calibrated vehicles are anonymized, along with the odometer values and
+// times. Technical values, such as the vehicle characteristic constant and the
+// equipment serial numbers, are preserved.
+func (opts AnonymizeOptions) AnonymizeCalibration(calibration *cardv1.Calibration) *cardv1.Calibration {
+	if calibration == nil {
+		return nil
+	}
+	ddOpts := opts.ddAnonymizeOptions()
+	result := proto.Clone(calibration).(*cardv1.Calibration)
+	result.ClearRawData()
+	for _, record := range result.GetRecords() {
+		record.SetVehicleIdentificationNumber(ddOpts.AnonymizeIa5StringValue(record.GetVehicleIdentificationNumber()))
+		record.SetVehicleRegistration(ddOpts.AnonymizeVehicleRegistrationIdentification(record.GetVehicleRegistration()))
+		record.SetOldOdometerKm(ddOpts.AnonymizeOdometerValue(record.GetOldOdometerKm()))
+		record.SetNewOdometerKm(ddOpts.AnonymizeOdometerValue(record.GetNewOdometerKm()))
+		record.SetOldTime(ddOpts.AnonymizeTimestamp(record.GetOldTime()))
+		record.SetNewTime(ddOpts.AnonymizeTimestamp(record.GetNewTime()))
+		record.SetNextCalibrationDate(ddOpts.AnonymizeTimestamp(record.GetNextCalibrationDate()))
+	}
+	return result
+}
+
+// AnonymizeControllerActivityData creates an anonymized copy of the control
+// activities of a control card (EF_Controller_Activity_Data).
+//
+// The controlled cards and vehicles are anonymized, along with the control
+// times and download periods.
+func (opts AnonymizeOptions) AnonymizeControllerActivityData(data *cardv1.ControllerActivityData) *cardv1.ControllerActivityData {
+	if data == nil {
+		return nil
+	}
+	ddOpts := opts.ddAnonymizeOptions()
+	result := proto.Clone(data).(*cardv1.ControllerActivityData)
+	for _, record := range result.GetRecords() {
+		record.SetControlledCardNumber(ddOpts.AnonymizeFullCardNumberAndGeneration(record.GetControlledCardNumber()))
+		record.SetControlledVehicleRegistration(ddOpts.AnonymizeVehicleRegistrationIdentification(record.GetControlledVehicleRegistration()))
+		record.SetControlTime(ddOpts.AnonymizeTimestamp(record.GetControlTime()))
+		record.SetControlDownloadPeriodBegin(ddOpts.AnonymizeTimestamp(record.GetControlDownloadPeriodBegin()))
+		record.SetControlDownloadPeriodEnd(ddOpts.AnonymizeTimestamp(record.GetControlDownloadPeriodEnd()))
+	}
+	return result
+}
+
+// AnonymizeCompanyActivityData creates an anonymized copy of the company
+// activities of a company card (EF_Company_Activity_Data).
+//
+// The cards and vehicles the company activities relate to are anonymized,
+// along with the activity times and download periods.
+func (opts AnonymizeOptions) AnonymizeCompanyActivityData(data *cardv1.CompanyActivityData) *cardv1.CompanyActivityData {
+	if data == nil {
+		return nil
+	}
+	ddOpts := opts.ddAnonymizeOptions()
+	result := proto.Clone(data).(*cardv1.CompanyActivityData)
+	for _, record := range result.GetRecords() {
+		record.SetCardNumberInformation(ddOpts.AnonymizeFullCardNumberAndGeneration(record.GetCardNumberInformation()))
+		record.SetVehicleRegistrationInformation(ddOpts.AnonymizeVehicleRegistrationIdentification(record.GetVehicleRegistrationInformation()))
+		record.SetCompanyActivityTime(ddOpts.AnonymizeTimestamp(record.GetCompanyActivityTime()))
+		record.SetDownloadPeriodBegin(ddOpts.AnonymizeTimestamp(record.GetDownloadPeriodBegin()))
+		record.SetDownloadPeriodEnd(ddOpts.AnonymizeTimestamp(record.GetDownloadPeriodEnd()))
+	}
+	return result
+}
+
+// ddAnonymizeOptions returns the data dictionary anonymize options matching opts.
+func (opts AnonymizeOptions) ddAnonymizeOptions() dd.AnonymizeOptions {
+	return dd.AnonymizeOptions{
+		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
+		PreserveTimestamps:       opts.PreserveTimestamps,
+		BaseLatitude:             opts.BaseLatitude,
+		BaseLongitude:            opts.BaseLongitude,
+		HomeNation:               opts.HomeNation,
+		NeighborNation:           opts.NeighborNation,
+		PreserveNations:          opts.PreserveNations,
+		Key:                      opts.Key,
+	}
+}
+
+// anonymizedName returns a Latin-1 test value replacing a name, keeping the
+// data length of the original so that the anonymized EF keeps its size.
+func anonymizedName(value string, original *ddv1.StringValue) *ddv1.StringValue {
+	length := int32(35)
+	if original.HasLength() {
+		length = original.GetLength()
+	}
+	if len(value) > int(length) {
+		value = value[:length]
+	}
+	return dd.NewStringValue(ddv1.Encoding_ISO_8859_1, length, value)
+}
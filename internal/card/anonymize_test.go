@@ -0,0 +1,56 @@
+package card
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestAnonymizeWorkshopCardIdentification(t *testing.T) {
+	ownerID := &ddv1.OwnerIdentification{}
+	ownerID.SetOwnerIdentification(dd.NewIa5StringValue(13, "FI12345678901"))
+	ownerID.SetConsecutiveIndex(dd.NewIa5StringValue(1, "0"))
+	ownerID.SetReplacementIndex(dd.NewIa5StringValue(1, "0"))
+	ownerID.SetRenewalIndex(dd.NewIa5StringValue(1, "1"))
+
+	id := &cardv1.WorkshopCardIdentification{}
+	id.SetCardIssuingMemberState(ddv1.NationNumeric_FINLAND)
+	id.SetOwnerIdentification(ownerID)
+	id.SetWorkshopName(dd.NewStringValue(ddv1.Encoding_ISO_8859_1, 35, "Korjaamo Virtanen Oy"))
+	id.SetWorkshopAddress(dd.NewStringValue(ddv1.Encoding_ISO_8859_1, 35, "Teollisuuskatu 7, Tampere"))
+	id.SetCardHolderSurname(dd.NewStringValue(ddv1.Encoding_ISO_8859_1, 35, "Virtanen"))
+	id.SetCardHolderFirstNames(dd.NewStringValue(ddv1.Encoding_ISO_8859_1, 35, "Matti"))
+
+	got := AnonymizeOptions{Key: []byte("test key")}.AnonymizeWorkshopCardIdentification(id)
+
+	wantOwnerID := &ddv1.OwnerIdentification{}
+	wantOwnerID.SetOwnerIdentification(dd.NewIa5StringValue(13, "1771158617222"))
+	wantOwnerID.SetConsecutiveIndex(dd.NewIa5StringValue(1, "0"))
+	wantOwnerID.SetReplacementIndex(dd.NewIa5StringValue(1, "0"))
+	wantOwnerID.SetRenewalIndex(dd.NewIa5StringValue(1, "1"))
+	if diff := cmp.Diff(wantOwnerID, got.GetOwnerIdentification(), protocmp.Transform()); diff != "" {
+		t.Errorf("OwnerIdentification mismatch (-want +got):\n%s", diff)
+	}
+	if got.GetCardIssuingMemberState() != ddv1.NationNumeric_FINLAND {
+		t.Errorf("CardIssuingMemberState = %v, want FINLAND", got.GetCardIssuingMemberState())
+	}
+	for _, tt := range []struct {
+		name string
+		got  *ddv1.StringValue
+		want string
+	}{
+		{name: "workshop name", got: got.GetWorkshopName(), want: "Test Workshop"},
+		{name: "workshop address", got: got.GetWorkshopAddress(), want: "Test Street 1"},
+		{name: "surname", got: got.GetCardHolderSurname(), want: "Doe"},
+		{name: "first names", got: got.GetCardHolderFirstNames(), want: "John"},
+	} {
+		if tt.got.GetValue() != tt.want || tt.got.GetLength() != 35 {
+			t.Errorf("%s = (%q, length %d), want (%q, length 35)", tt.name, tt.got.GetValue(), tt.got.GetLength(), tt.want)
+		}
+	}
+}
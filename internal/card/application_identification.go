@@ -44,7 +44,7 @@ func (opts UnmarshalOptions) unmarshalApplicationIdentification(data []byte) (*c
 		return nil, fmt.Errorf("failed to read card type: %w", err)
 	}
 	// Convert raw card type to enum using protocol annotations
-	if equipmentType, err := dd.UnmarshalEnum[ddv1.EquipmentType](cardType); err == nil {
+	if equipmentType, err := dd.UnmarshalEnumOrReport[ddv1.EquipmentType](opts.UnmarshalOptions, "DriverCardApplicationIdentification.typeOfTachographCardId", cardType); err == nil {
 		target.SetTypeOfTachographCardId(equipmentType)
 	} else {
 		return nil, fmt.Errorf("invalid equipment type: %w", err)
@@ -240,3 +240,16 @@ func (opts AnonymizeOptions) anonymizeApplicationIdentification(appId *cardv1.Ap
 
 	return anonymized
 }
+
+// StructureVersion returns the card structure version of a driver card, or
+// nil if the card has no application identification.
+//
+// The value is taken from the cardStructureVersion field of the application
+// identification (Data Dictionary, Sections 2.2 and 2.36), preferring the
+// Generation 2 application when present.
+func StructureVersion(file *cardv1.DriverCardFile) *ddv1.CardStructureVersion {
+	if appID := file.GetTachographG2().GetApplicationIdentification(); appID != nil {
+		return appID.GetCardStructureVersion()
+	}
+	return file.GetTachograph().GetApplicationIdentification().GetCardStructureVersion()
+}
@@ -48,7 +48,7 @@ func (opts UnmarshalOptions) unmarshalApplicationIdentificationG2(data []byte) (
 		return nil, fmt.Errorf("failed to read card type: %w", err)
 	}
 	// Convert raw card type to enum using protocol annotations
-	if equipmentType, err := dd.UnmarshalEnum[ddv1.EquipmentType](cardType); err == nil {
+	if equipmentType, err := dd.UnmarshalEnumOrReport[ddv1.EquipmentType](opts.UnmarshalOptions, "DriverCardApplicationIdentification.typeOfTachographCardId", cardType); err == nil {
 		target.SetTypeOfTachographCardId(equipmentType)
 	} else {
 		return nil, fmt.Errorf("invalid equipment type: %w", err)
@@ -60,3 +60,36 @@ func TestApplicationIdentification_Generation1(t *testing.T) {
 		})
 	}
 }
+
+func TestStructureVersion(t *testing.T) {
+	data, err := readHexdump("testdata/records/000-anonymized/002-EF_APPLICATION_IDENTIFICATION-GENERATION_1-DATA.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	// cardStructureVersion follows typeOfTachographCardId.
+	data[1], data[2] = 0x01, 0x02
+
+	appID, err := UnmarshalOptions{}.unmarshalApplicationIdentification(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetApplicationIdentification(appID)
+	file := &cardv1.DriverCardFile{}
+	file.SetTachograph(tachograph)
+
+	version := StructureVersion(file)
+	if version.GetMajor() != 1 || version.GetMinor() != 2 {
+		t.Errorf("StructureVersion() = %d.%d, want 1.2", version.GetMajor(), version.GetMinor())
+	}
+	marshaled, err := MarshalOptions{}.MarshalCardApplicationIdentification(appID)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+	if got := StructureVersion(&cardv1.DriverCardFile{}); got != nil {
+		t.Errorf("StructureVersion() of an empty file = %v, want nil", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 )
@@ -26,7 +27,7 @@ func (opts UnmarshalOptions) unmarshalApplicationIdentificationV2(data []byte) (
 	)
 
 	if len(data) < lenEfApplicationIdentificationV2 {
-		return nil, fmt.Errorf("insufficient data for application identification V2: got %d bytes, need %d", len(data), lenEfApplicationIdentificationV2)
+		return nil, fmt.Errorf("insufficient data for application identification V2: got %d bytes, need %d: %w", len(data), lenEfApplicationIdentificationV2, io.ErrUnexpectedEOF)
 	}
 	var target cardv1.ApplicationIdentificationV2
 	r := bytes.NewReader(data)
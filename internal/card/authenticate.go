@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/way-platform/tachograph-go/internal/cert"
+	"github.com/way-platform/tachograph-go/internal/dd"
 	"github.com/way-platform/tachograph-go/internal/security"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -289,24 +290,13 @@ func (opts AuthenticateOptions) verifyGen1CertificateChain(ctx context.Context,
 	return nil
 }
 
-// verifyGen2CertificateChain verifies the Gen2 certificate chain: EUR Root -> MSCA -> Card
+// verifyGen2CertificateChain verifies the Gen2 certificate chain: EUR Root -> MSCA -> Card.
+// Link certificates between the MSCA and the EUR root are fetched from the resolver.
 func (opts AuthenticateOptions) verifyGen2CertificateChain(ctx context.Context, cardCert *securityv1.EccCertificate, mscaCert *securityv1.EccCertificate) error {
-	// Get Gen2 ECC root certificate
-	rootCert, err := opts.CertificateResolver.GetEccRootCertificate(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get Gen2 root certificate: %w", err)
-	}
-
-	// Verify MSCA certificate against EUR Gen2 root (both ECC)
-	if err := security.VerifyEccCertificateWithEccRoot(mscaCert, rootCert); err != nil {
-		return fmt.Errorf("MSCA certificate verification failed: %w", err)
-	}
-
-	// Verify card certificate against MSCA
-	if err := security.VerifyEccCertificateWithCA(cardCert, mscaCert); err != nil {
+	intermediates := []*securityv1.EccCertificate{mscaCert}
+	if _, err := security.VerifyEccCertificateChain(ctx, cardCert, intermediates, opts.CertificateResolver); err != nil {
 		return fmt.Errorf("card certificate verification failed: %w", err)
 	}
-
 	return nil
 }
 
@@ -317,16 +307,20 @@ func (opts AuthenticateOptions) authenticateGen1EF(dataRecord *cardv1.RawCardFil
 
 	if signatureRecord == nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("signature record not found for EF %v", dataRecord.GetFile())
+		return fmt.Errorf("%w: signature record not found for EF %v", dd.ErrInvalidSignature, dataRecord.GetFile())
 	}
 
-	// Verify the signature using PKCS#1 v1.5
-	data := dataRecord.GetValue()
+	data, err := SignedBytes(dataRecord)
+	if err != nil {
+		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
+		return err
+	}
 	signature := signatureRecord.GetValue()
 
+	// Verify the signature using PKCS#1 v1.5
 	if err := security.VerifyRsaDataSignature(data, signature, cardCert); err != nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("signature verification failed for EF %v: %w", dataRecord.GetFile(), err)
+		return fmt.Errorf("signature verification failed for EF %v: %w: %w", dataRecord.GetFile(), dd.ErrInvalidSignature, err)
 	}
 
 	// Authentication succeeded
@@ -343,16 +337,20 @@ func (opts AuthenticateOptions) authenticateGen2EF(dataRecord *cardv1.RawCardFil
 
 	if signatureRecord == nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("signature record not found for EF %v", dataRecord.GetFile())
+		return fmt.Errorf("%w: signature record not found for EF %v", dd.ErrInvalidSignature, dataRecord.GetFile())
 	}
 
-	// Verify the signature using ECDSA
-	data := dataRecord.GetValue()
+	data, err := SignedBytes(dataRecord)
+	if err != nil {
+		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
+		return err
+	}
 	signature := signatureRecord.GetValue()
 
+	// Verify the signature using ECDSA
 	if err := security.VerifyEccDataSignature(data, signature, cardCert); err != nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("signature verification failed for EF %v: %w", dataRecord.GetFile(), err)
+		return fmt.Errorf("signature verification failed for EF %v: %w: %w", dataRecord.GetFile(), dd.ErrInvalidSignature, err)
 	}
 
 	// Determine signature algorithm based on curve
@@ -0,0 +1,338 @@
+package card
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// lenWorkshopCardCalibrationRecord is the size of a Gen1 WorkshopCardCalibrationRecord.
+const lenWorkshopCardCalibrationRecord = 105
+
+// UnmarshalCalibration unmarshals the calibration records of a Generation 1
+// workshop card (EF_Calibration).
+//
+// The data type `WorkshopCardCalibrationData` is specified in the Data Dictionary, Section 2.235.
+//
+// ASN.1 Definition:
+//
+//	WorkshopCardCalibrationData ::= SEQUENCE {
+//	    calibrationTotalNumber INTEGER(0..MaxNoOfCalibrationRecords),  -- 1 byte
+//	    calibrationPointerNewestRecord INTEGER(0..NoOfCalibrationRecords-1), -- 1 byte
+//	    calibrationRecords SET SIZE(NoOfCalibrationRecords) OF WorkshopCardCalibrationRecord
+//	}
+//
+// The number of record slots, NoOfCalibrationRecords, is given by the size
+// of the EF. Unused slots are kept as invalid records.
+//
+// Workshop card files are not parsed as a whole, so this is exported for
+// parsing the EF directly.
+func (opts UnmarshalOptions) UnmarshalCalibration(data []byte) (*cardv1.Calibration, error) {
+	const (
+		idxCalibrationTotalNumber         = 0
+		idxCalibrationPointerNewestRecord = 1
+		lenHeader                         = 2
+	)
+	if len(data) < lenHeader {
+		return nil, fmt.Errorf("insufficient data for calibration: got %d bytes, need at least %d: %w", len(data), lenHeader, io.ErrUnexpectedEOF)
+	}
+	if (len(data)-lenHeader)%lenWorkshopCardCalibrationRecord != 0 {
+		return nil, fmt.Errorf("invalid data length for calibration records: got %d bytes, want a multiple of %d", len(data)-lenHeader, lenWorkshopCardCalibrationRecord)
+	}
+
+	var target cardv1.Calibration
+	// Save complete raw data for painting
+	target.SetRawData(data)
+	target.SetCalibrationTotalCount(int32(data[idxCalibrationTotalNumber]))
+	target.SetNewestRecordIndex(int32(data[idxCalibrationPointerNewestRecord]))
+
+	var records []*cardv1.Calibration_Record
+	for offset := lenHeader; offset < len(data); offset += lenWorkshopCardCalibrationRecord {
+		recordData := data[offset : offset+lenWorkshopCardCalibrationRecord]
+		if isEmptyRecord(recordData) {
+			// Unused slot: preserve original bytes
+			record := &cardv1.Calibration_Record{}
+			record.SetValid(false)
+			record.SetRawData(recordData)
+			records = append(records, record)
+			continue
+		}
+		record, err := opts.unmarshalCalibrationRecord(recordData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse calibration record at offset %d: %w", offset, err)
+		}
+		record.SetValid(true)
+		records = append(records, record)
+	}
+	target.SetRecords(records)
+
+	return &target, nil
+}
+
+// unmarshalCalibrationRecord unmarshals a Gen1 calibration record.
+//
+// The data type `WorkshopCardCalibrationRecord` is specified in the Data Dictionary, Section 2.236.
+//
+// ASN.1 Definition (Gen1):
+//
+//	WorkshopCardCalibrationRecord ::= SEQUENCE {
+//	    calibrationPurpose CalibrationPurpose,                       -- 1 byte
+//	    vehicleIdentificationNumber VehicleIdentificationNumber,     -- 17 bytes
+//	    vehicleRegistration VehicleRegistrationIdentification,       -- 15 bytes
+//	    wVehicleCharacteristicConstant W-VehicleCharacteristicConstant, -- 2 bytes
+//	    kConstantOfRecordingEquipment K-ConstantOfRecordingEquipment,   -- 2 bytes
+//	    lTyreCircumference L-TyreCircumference,                      -- 2 bytes
+//	    tyreSize TyreSize,                                           -- 15 bytes
+//	    authorisedSpeed SpeedAuthorised,                             -- 1 byte
+//	    oldOdometerValue OdometerShort,                              -- 3 bytes
+//	    newOdometerValue OdometerShort,                              -- 3 bytes
+//	    oldTimeValue TimeReal,                                       -- 4 bytes
+//	    newTimeValue TimeReal,                                       -- 4 bytes
+//	    nextCalibrationDate TimeReal,                                -- 4 bytes
+//	    vuPartNumber VuPartNumber,                                   -- 16 bytes
+//	    vuSerialNumber VuSerialNumber,                               -- 8 bytes
+//	    sensorSerialNumber SensorSerialNumber                        -- 8 bytes
+//	}
+func (opts UnmarshalOptions) unmarshalCalibrationRecord(data []byte) (*cardv1.Calibration_Record, error) {
+	const (
+		idxCalibrationPurpose      = 0
+		idxVIN                     = 1
+		lenVIN                     = 17
+		idxVehicleRegistration     = 18
+		lenVehicleRegistration     = 15
+		idxWVehicleCharConstant    = 33
+		idxKConstantRecordingEquip = 35
+		idxLTyreCircumference      = 37
+		idxTyreSize                = 39
+		lenTyreSize                = 15
+		idxAuthorisedSpeed         = 54
+		idxOldOdometerValue        = 55
+		idxNewOdometerValue        = 58
+		lenOdometerShort           = 3
+		idxOldTimeValue            = 61
+		idxNewTimeValue            = 65
+		idxNextCalibrationDate     = 69
+		lenTimeReal                = 4
+		idxVuPartNumber            = 73
+		lenVuPartNumber            = 16
+		idxVuSerialNumber          = 89
+		idxSensorSerialNumber      = 97
+		lenExtendedSerialNumber    = 8
+	)
+	if len(data) != lenWorkshopCardCalibrationRecord {
+		return nil, fmt.Errorf("invalid data length for WorkshopCardCalibrationRecord: got %d, want %d", len(data), lenWorkshopCardCalibrationRecord)
+	}
+
+	record := &cardv1.Calibration_Record{}
+
+	if purpose, err := dd.UnmarshalEnumOrReport[ddv1.CalibrationPurpose](opts.UnmarshalOptions, "WorkshopCardCalibrationRecord.calibrationPurpose", data[idxCalibrationPurpose]); err == nil {
+		record.SetCalibrationPurpose(purpose)
+	} else {
+		record.SetCalibrationPurpose(ddv1.CalibrationPurpose_CALIBRATION_PURPOSE_UNRECOGNIZED)
+		record.SetUnrecognizedCalibrationPurpose(int32(data[idxCalibrationPurpose]))
+	}
+
+	vin, err := opts.UnmarshalIa5StringValue(data[idxVIN : idxVIN+lenVIN])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse VIN: %w", err)
+	}
+	record.SetVehicleIdentificationNumber(vin)
+
+	vehicleRegistration, err := opts.UnmarshalVehicleRegistrationIdentification(data[idxVehicleRegistration : idxVehicleRegistration+lenVehicleRegistration])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vehicle registration: %w", err)
+	}
+	record.SetVehicleRegistration(vehicleRegistration)
+
+	record.SetWVehicleCharacteristicConstant(int32(binary.BigEndian.Uint16(data[idxWVehicleCharConstant:])))
+	record.SetKConstantOfRecordingEquipment(int32(binary.BigEndian.Uint16(data[idxKConstantRecordingEquip:])))
+	record.SetLTyreCircumferenceEighthsMm(int32(binary.BigEndian.Uint16(data[idxLTyreCircumference:])))
+
+	tyreSize, err := opts.UnmarshalIa5StringValue(data[idxTyreSize : idxTyreSize+lenTyreSize])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tyre size: %w", err)
+	}
+	record.SetTyreSize(tyreSize)
+
+	record.SetAuthorisedSpeedKmh(int32(data[idxAuthorisedSpeed]))
+
+	oldOdometer, err := opts.UnmarshalOdometer(data[idxOldOdometerValue : idxOldOdometerValue+lenOdometerShort])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse old odometer value: %w", err)
+	}
+	record.SetOldOdometerKm(int32(oldOdometer))
+	newOdometer, err := opts.UnmarshalOdometer(data[idxNewOdometerValue : idxNewOdometerValue+lenOdometerShort])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new odometer value: %w", err)
+	}
+	record.SetNewOdometerKm(int32(newOdometer))
+
+	oldTime, err := opts.UnmarshalTimeReal(data[idxOldTimeValue : idxOldTimeValue+lenTimeReal])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse old time value: %w", err)
+	}
+	record.SetOldTime(oldTime)
+	newTime, err := opts.UnmarshalTimeReal(data[idxNewTimeValue : idxNewTimeValue+lenTimeReal])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new time value: %w", err)
+	}
+	record.SetNewTime(newTime)
+	nextCalibrationDate, err := opts.UnmarshalTimeReal(data[idxNextCalibrationDate : idxNextCalibrationDate+lenTimeReal])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse next calibration date: %w", err)
+	}
+	record.SetNextCalibrationDate(nextCalibrationDate)
+
+	vuPartNumber, err := opts.UnmarshalIa5StringValue(data[idxVuPartNumber : idxVuPartNumber+lenVuPartNumber])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse VU part number: %w", err)
+	}
+	record.SetVuPartNumber(vuPartNumber)
+
+	vuSerialNumber, err := opts.UnmarshalExtendedSerialNumber(data[idxVuSerialNumber : idxVuSerialNumber+lenExtendedSerialNumber])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse VU serial number: %w", err)
+	}
+	record.SetVuSerialNumber(vuSerialNumber)
+	sensorSerialNumber, err := opts.UnmarshalExtendedSerialNumber(data[idxSensorSerialNumber : idxSensorSerialNumber+lenExtendedSerialNumber])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sensor serial number: %w", err)
+	}
+	record.SetSensorSerialNumber(sensorSerialNumber)
+
+	return record, nil
+}
+
+// MarshalCalibration marshals the calibration records of a Generation 1
+// workshop card (EF_Calibration).
+//
+// The data type `WorkshopCardCalibrationData` is specified in the Data Dictionary, Section 2.235.
+func (opts MarshalOptions) MarshalCalibration(calibration *cardv1.Calibration) ([]byte, error) {
+	if calibration == nil {
+		return nil, nil
+	}
+
+	const lenHeader = 2
+
+	// Use raw_data as canvas if available and correct size
+	expectedSize := lenHeader + len(calibration.GetRecords())*lenWorkshopCardCalibrationRecord
+	if rawData := calibration.GetRawData(); len(rawData) == expectedSize {
+		canvas := make([]byte, expectedSize)
+		copy(canvas, rawData)
+		canvas[0] = byte(calibration.GetCalibrationTotalCount())
+		canvas[1] = byte(calibration.GetNewestRecordIndex())
+
+		offset := lenHeader
+		for _, record := range calibration.GetRecords() {
+			recordBytes, err := opts.marshalCalibrationRecord(record)
+			if err != nil {
+				return nil, err
+			}
+			copy(canvas[offset:], recordBytes)
+			offset += lenWorkshopCardCalibrationRecord
+		}
+		return canvas, nil
+	}
+
+	// Fall back to building from scratch
+	dst := []byte{
+		byte(calibration.GetCalibrationTotalCount()),
+		byte(calibration.GetNewestRecordIndex()),
+	}
+	for _, record := range calibration.GetRecords() {
+		recordBytes, err := opts.marshalCalibrationRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, recordBytes...)
+	}
+	return dst, nil
+}
+
+// marshalCalibrationRecord marshals a Gen1 calibration record, returning the
+// original bytes of invalid records (e.g. unused slots) verbatim.
+//
+// The data type `WorkshopCardCalibrationRecord` is specified in the Data Dictionary, Section 2.236.
+func (opts MarshalOptions) marshalCalibrationRecord(record *cardv1.Calibration_Record) ([]byte, error) {
+	if !record.GetValid() && len(record.GetRawData()) == lenWorkshopCardCalibrationRecord {
+		return record.GetRawData(), nil
+	}
+	dst := make([]byte, 0, lenWorkshopCardCalibrationRecord)
+
+	if record.GetCalibrationPurpose() == ddv1.CalibrationPurpose_CALIBRATION_PURPOSE_UNRECOGNIZED {
+		dst = append(dst, byte(record.GetUnrecognizedCalibrationPurpose()))
+	} else {
+		purpose, err := dd.MarshalEnum(record.GetCalibrationPurpose())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal calibration purpose: %w", err)
+		}
+		dst = append(dst, purpose)
+	}
+
+	vin, err := opts.MarshalIa5StringValue(record.GetVehicleIdentificationNumber())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VIN: %w", err)
+	}
+	dst = append(dst, vin...)
+
+	vehicleRegistration, err := opts.MarshalVehicleRegistrationIdentification(record.GetVehicleRegistration())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal vehicle registration: %w", err)
+	}
+	dst = append(dst, vehicleRegistration...)
+
+	dst = binary.BigEndian.AppendUint16(dst, uint16(record.GetWVehicleCharacteristicConstant()))
+	dst = binary.BigEndian.AppendUint16(dst, uint16(record.GetKConstantOfRecordingEquipment()))
+	dst = binary.BigEndian.AppendUint16(dst, uint16(record.GetLTyreCircumferenceEighthsMm()))
+
+	tyreSize, err := opts.MarshalIa5StringValue(record.GetTyreSize())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tyre size: %w", err)
+	}
+	dst = append(dst, tyreSize...)
+
+	dst = append(dst, byte(record.GetAuthorisedSpeedKmh()))
+
+	for _, odometer := range []int32{record.GetOldOdometerKm(), record.GetNewOdometerKm()} {
+		odometerBytes, err := opts.MarshalOdometer(odometer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal odometer value: %w", err)
+		}
+		dst = append(dst, odometerBytes...)
+	}
+
+	for _, ts := range []*timestamppb.Timestamp{record.GetOldTime(), record.GetNewTime(), record.GetNextCalibrationDate()} {
+		timeBytes, err := opts.MarshalTimeReal(ts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal time value: %w", err)
+		}
+		dst = append(dst, timeBytes...)
+	}
+
+	vuPartNumber, err := opts.MarshalIa5StringValue(record.GetVuPartNumber())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VU part number: %w", err)
+	}
+	dst = append(dst, vuPartNumber...)
+
+	vuSerialNumber, err := opts.MarshalExtendedSerialNumber(record.GetVuSerialNumber())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal VU serial number: %w", err)
+	}
+	dst = append(dst, vuSerialNumber...)
+	sensorSerialNumber, err := opts.MarshalExtendedSerialNumber(record.GetSensorSerialNumber())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sensor serial number: %w", err)
+	}
+	dst = append(dst, sensorSerialNumber...)
+
+	if len(dst) != lenWorkshopCardCalibrationRecord {
+		return nil, fmt.Errorf("invalid WorkshopCardCalibrationRecord size: got %d, want %d", len(dst), lenWorkshopCardCalibrationRecord)
+	}
+	return dst, nil
+}
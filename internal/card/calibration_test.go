@@ -0,0 +1,103 @@
+package card
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// calibrationTestRecord returns a Gen1 WorkshopCardCalibrationRecord with the
+// given calibration purpose.
+func calibrationTestRecord(purpose byte) []byte {
+	record := make([]byte, 0, lenWorkshopCardCalibrationRecord)
+	record = append(record, purpose)
+	record = append(record, "WDB9634031L123456"...)                         // vehicleIdentificationNumber
+	record = append(record, 0x11, 0x01)                                     // vehicleRegistrationNation, codePage
+	record = append(record, "B-AB 1234    "...)                             // vehicleRegistrationNumber
+	record = append(record, 0x1f, 0x40, 0x1f, 0x40, 0x0c, 0x80)             // w, k, l
+	record = append(record, "315/70 R22.5   "...)                           // tyreSize
+	record = append(record, 90)                                             // authorisedSpeed
+	record = append(record, 0x00, 0x30, 0x39, 0x00, 0x30, 0x3a)             // old and new odometer
+	record = append(record, 0x5f, 0x5e, 0x10, 0x00, 0x5f, 0x5e, 0x10, 0x3c) // old and new time
+	record = append(record, 0x61, 0x3f, 0x43, 0x80)                         // nextCalibrationDate
+	record = append(record, "1381.1234567890 "...)                          // vuPartNumber
+	record = append(record, 0x00, 0x01, 0xe2, 0x40, 0x03, 0x19, 0x01, 0x21) // vuSerialNumber
+	record = append(record, 0x00, 0x03, 0x0d, 0x40, 0x05, 0x19, 0x06, 0x21) // sensorSerialNumber
+	return record
+}
+
+func TestCalibration_RoundTrip(t *testing.T) {
+	data := []byte{2, 0} // calibrationTotalNumber, calibrationPointerNewestRecord
+	data = append(data, calibrationTestRecord(0x03)...)
+	data = append(data, make([]byte, lenWorkshopCardCalibrationRecord)...) // unused slot
+	data = append(data, calibrationTestRecord(0x42)...)
+
+	var reports []uint64
+	opts := UnmarshalOptions{}
+	opts.OnUnrecognized = func(context string, rawValue uint64) {
+		if context != "WorkshopCardCalibrationRecord.calibrationPurpose" {
+			t.Errorf("OnUnrecognized context = %q", context)
+		}
+		reports = append(reports, rawValue)
+	}
+	calibration, err := opts.UnmarshalCalibration(data)
+	if err != nil {
+		t.Fatalf("UnmarshalCalibration failed: %v", err)
+	}
+	if diff := cmp.Diff([]uint64{0x42}, reports); diff != "" {
+		t.Errorf("OnUnrecognized reports mismatch (-want +got):\n%s", diff)
+	}
+
+	records := calibration.GetRecords()
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+	if records[1].GetValid() {
+		t.Error("unused slot is valid, want invalid")
+	}
+	record := records[0]
+	if got, want := record.GetCalibrationPurpose(), ddv1.CalibrationPurpose_INSTALLATION; got != want {
+		t.Errorf("calibration purpose = %v, want %v", got, want)
+	}
+	if got, want := record.GetVehicleIdentificationNumber().GetValue(), "WDB9634031L123456"; got != want {
+		t.Errorf("VIN = %q, want %q", got, want)
+	}
+	if got, want := record.GetTyreSize().GetValue(), "315/70 R22.5"; got != want {
+		t.Errorf("tyre size = %q, want %q", got, want)
+	}
+	if got, want := record.GetWVehicleCharacteristicConstant(), int32(8000); got != want {
+		t.Errorf("w = %d, want %d", got, want)
+	}
+	if got, want := record.GetAuthorisedSpeedKmh(), int32(90); got != want {
+		t.Errorf("authorised speed = %d, want %d", got, want)
+	}
+	if got, want := record.GetNewOdometerKm(), int32(12346); got != want {
+		t.Errorf("new odometer = %d, want %d", got, want)
+	}
+	if got, want := records[2].GetCalibrationPurpose(), ddv1.CalibrationPurpose_CALIBRATION_PURPOSE_UNRECOGNIZED; got != want {
+		t.Errorf("unrecognized calibration purpose = %v, want %v", got, want)
+	}
+	if got, want := records[2].GetUnrecognizedCalibrationPurpose(), int32(0x42); got != want {
+		t.Errorf("unrecognized calibration purpose value = %#x, want %#x", got, want)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalCalibration(calibration)
+	if err != nil {
+		t.Fatalf("MarshalCalibration failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+
+	// Without raw_data, the unused slot is restored from its record.
+	calibration.ClearRawData()
+	marshaled, err = MarshalOptions{}.MarshalCalibration(calibration)
+	if err != nil {
+		t.Fatalf("MarshalCalibration without raw data failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("binary round-trip without raw data mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -2,8 +2,11 @@ package card
 
 import (
 	"fmt"
+	"io"
+	"time"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // unmarshalCardDownload unmarshals card download data from a card EF.
@@ -19,7 +22,7 @@ func (opts UnmarshalOptions) unmarshalCardDownload(data []byte) (*cardv1.CardDow
 	)
 
 	if len(data) < lenCardDownloadDriver {
-		return nil, fmt.Errorf("insufficient data for card download")
+		return nil, fmt.Errorf("insufficient data for card download: %w", io.ErrUnexpectedEOF)
 	}
 
 	var target cardv1.CardDownloadDriver
@@ -55,3 +58,29 @@ func (opts MarshalOptions) MarshalCardDownload(lastDownload *cardv1.CardDownload
 
 	return timestampBytes, nil
 }
+
+// LastDownloadTime returns the time of the last download of a driver card.
+//
+// The value is taken from LastCardDownload (Data Dictionary, Section 2.89) in
+// EF_Card_Download. Both the Generation 1 and the Generation 2 application
+// hold the file; the latest of the two timestamps is returned. The returned ok
+// value is false if the card has never been downloaded.
+//
+// Only driver cards record a download time: the EF_Card_Download file of a
+// workshop card holds NoOfCalibrationsSinceDownload (Section 2.103), and
+// company and control cards have no such file.
+func LastDownloadTime(file *cardv1.DriverCardFile) (_ time.Time, ok bool) {
+	var last *timestamppb.Timestamp
+	for _, download := range []*cardv1.CardDownloadDriver{
+		file.GetTachograph().GetCardDownload(),
+		file.GetTachographG2().GetCardDownload(),
+	} {
+		if timestamp := download.GetTimestamp(); timestamp.GetSeconds() > last.GetSeconds() {
+			last = timestamp
+		}
+	}
+	if last == nil {
+		return time.Time{}, false
+	}
+	return last.AsTime().UTC(), true
+}
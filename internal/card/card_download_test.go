@@ -0,0 +1,55 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCardDownload_roundTrip(t *testing.T) {
+	data := []byte{0x5E, 0x0C, 0x5A, 0x80}
+	download, err := UnmarshalOptions{}.unmarshalCardDownload(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got, want := download.GetTimestamp().AsTime(), time.Unix(0x5E0C5A80, 0).UTC(); !got.Equal(want) {
+		t.Errorf("timestamp = %v, want %v", got, want)
+	}
+	marshaled, err := MarshalOptions{}.MarshalCardDownload(download)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(marshaled) != string(data) {
+		t.Errorf("Binary round-trip mismatch: got %x, want %x", marshaled, data)
+	}
+}
+
+func TestLastDownloadTime(t *testing.T) {
+	gen1 := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	gen2 := time.Date(2024, 3, 29, 8, 0, 0, 0, time.UTC)
+	download := func(t time.Time) *cardv1.CardDownloadDriver {
+		download := &cardv1.CardDownloadDriver{}
+		download.SetTimestamp(timestamppb.New(t))
+		return download
+	}
+	file := &cardv1.DriverCardFile{}
+	if _, ok := LastDownloadTime(file); ok {
+		t.Error("LastDownloadTime of a card without downloads reported ok")
+	}
+
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetCardDownload(download(gen1))
+	file.SetTachograph(tachograph)
+	if got, ok := LastDownloadTime(file); !ok || !got.Equal(gen1) {
+		t.Errorf("LastDownloadTime() = %v, %v; want %v, true", got, ok, gen1)
+	}
+
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetCardDownload(download(gen2))
+	file.SetTachographG2(tachographG2)
+	if got, ok := LastDownloadTime(file); !ok || !got.Equal(gen2) {
+		t.Errorf("LastDownloadTime() = %v, %v; want %v, true", got, ok, gen2)
+	}
+}
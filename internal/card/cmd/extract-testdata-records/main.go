@@ -85,7 +85,7 @@ func processCardFile(filePath string, fileIndex int) error {
 	originalDir := filepath.Join(*outputDir, fmt.Sprintf("%03d-%s", fileIndex, baseNameWithoutExt))
 
 	// Infer card type to determine if we should anonymize
-	cardType := card.InferFileType(rawFile)
+	cardType, _ := card.InferFileType(rawFile)
 	isDriverCard := cardType == cardv1.CardType_DRIVER_CARD
 
 	// Write original hexdumps
@@ -3,8 +3,8 @@ package card
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
-	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -30,7 +30,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 	)
 
 	if len(data) < lenCardControlActivityDataRecord {
-		return nil, fmt.Errorf("insufficient data for control activity data")
+		return nil, fmt.Errorf("insufficient data for control activity data: %w", io.ErrUnexpectedEOF)
 	}
 	var target cardv1.ControlActivityData
 	controlTime := binary.BigEndian.Uint32(data[1:5])
@@ -45,7 +45,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 
 	// Read control type (1 byte)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for control type")
+		return nil, fmt.Errorf("insufficient data for control type: %w", io.ErrUnexpectedEOF)
 	}
 	controlType, err := opts.UnmarshalControlType(data[offset : offset+1])
 	if err != nil {
@@ -56,7 +56,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 
 	// Read control time (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for control time")
+		return nil, fmt.Errorf("insufficient data for control time: %w", io.ErrUnexpectedEOF)
 	}
 	controlTimestamp, err2 := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err2 != nil {
@@ -74,7 +74,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 
 	// Read the card number as IA5 string
 	if offset+18 > len(data) {
-		return nil, fmt.Errorf("insufficient data for control card number")
+		return nil, fmt.Errorf("insufficient data for control card number: %w", io.ErrUnexpectedEOF)
 	}
 	cardNumberStr, err := opts.UnmarshalIa5StringValue(data[offset : offset+18])
 	if err != nil {
@@ -96,7 +96,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 
 	// Read vehicle registration (15 bytes: 1 byte nation + 14 bytes number)
 	if offset+15 > len(data) {
-		return nil, fmt.Errorf("insufficient data for vehicle registration")
+		return nil, fmt.Errorf("insufficient data for vehicle registration: %w", io.ErrUnexpectedEOF)
 	}
 	vehicleReg, err := opts.UnmarshalVehicleRegistration(data[offset : offset+15])
 	if err != nil {
@@ -107,7 +107,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 
 	// Read control download period begin (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for control download period begin")
+		return nil, fmt.Errorf("insufficient data for control download period begin: %w", io.ErrUnexpectedEOF)
 	}
 	controlDownloadPeriodBegin, err3 := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err3 != nil {
@@ -118,7 +118,7 @@ func (opts UnmarshalOptions) unmarshalControlActivityData(data []byte) (*cardv1.
 
 	// Read control download period end (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for control download period end")
+		return nil, fmt.Errorf("insufficient data for control download period end: %w", io.ErrUnexpectedEOF)
 	}
 	controlDownloadPeriodEnd, err4 := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err4 != nil {
@@ -246,10 +246,7 @@ func (opts AnonymizeOptions) anonymizeControlActivityData(ca *cardv1.ControlActi
 	}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Preserve control type (categorical)
 	anonymized.SetControlType(ca.GetControlType())
@@ -259,73 +256,7 @@ func (opts AnonymizeOptions) anonymizeControlActivityData(ca *cardv1.ControlActi
 
 	// Anonymize control card number
 	if cardNum := ca.GetControlCardNumber(); cardNum != nil {
-		anonymizedCardNum := &ddv1.FullCardNumberAndGeneration{}
-		if fcn := cardNum.GetFullCardNumber(); fcn != nil {
-			anonymizedFCN := &ddv1.FullCardNumber{}
-			anonymizedFCN.SetCardType(fcn.GetCardType())
-			anonymizedFCN.SetCardIssuingMemberState(ddv1.NationNumeric_FINLAND)
-
-			// Anonymize driver or owner identification
-			if driverID := fcn.GetDriverIdentification(); driverID != nil {
-				anonymizedDriverID := &ddv1.DriverIdentification{}
-				if driverID.GetDriverIdentificationNumber() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("CTRL-DRV-001")
-
-					sv.SetLength(14)
-					anonymizedDriverID.SetDriverIdentificationNumber(sv)
-				}
-				if driverID.GetCardReplacementIndex() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("0")
-
-					sv.SetLength(1)
-					anonymizedDriverID.SetCardReplacementIndex(sv)
-				}
-				if driverID.GetCardRenewalIndex() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("0")
-
-					sv.SetLength(1)
-					anonymizedDriverID.SetCardRenewalIndex(sv)
-				}
-				anonymizedFCN.SetDriverIdentification(anonymizedDriverID)
-			} else if ownerID := fcn.GetOwnerIdentification(); ownerID != nil {
-				anonymizedOwnerID := &ddv1.OwnerIdentification{}
-				if ownerID.GetOwnerIdentification() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("CTRL-OWN-001")
-
-					sv.SetLength(13)
-					anonymizedOwnerID.SetOwnerIdentification(sv)
-				}
-				if ownerID.GetConsecutiveIndex() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("0")
-
-					sv.SetLength(1)
-					anonymizedOwnerID.SetConsecutiveIndex(sv)
-				}
-				if ownerID.GetReplacementIndex() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("0")
-
-					sv.SetLength(1)
-					anonymizedOwnerID.SetReplacementIndex(sv)
-				}
-				if ownerID.GetRenewalIndex() != nil {
-					sv := &ddv1.Ia5StringValue{}
-					sv.SetValue("0")
-
-					sv.SetLength(1)
-					anonymizedOwnerID.SetRenewalIndex(sv)
-				}
-				anonymizedFCN.SetOwnerIdentification(anonymizedOwnerID)
-			}
-
-			anonymizedCardNum.SetFullCardNumber(anonymizedFCN)
-		}
-		anonymized.SetControlCardNumber(anonymizedCardNum)
+		anonymized.SetControlCardNumber(ddOpts.AnonymizeFullCardNumberAndGeneration(cardNum))
 	}
 
 	// Anonymize vehicle registration
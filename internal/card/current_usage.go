@@ -2,8 +2,8 @@ package card
 
 import (
 	"fmt"
+	"io"
 
-	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,14 +24,14 @@ func (opts UnmarshalOptions) unmarshalCurrentUsage(data []byte) (*cardv1.Current
 	)
 
 	if len(data) < lenCardCurrentUse {
-		return nil, fmt.Errorf("insufficient data for current usage")
+		return nil, fmt.Errorf("insufficient data for current usage: %w", io.ErrUnexpectedEOF)
 	}
 	var target cardv1.CurrentUsage
 	offset := 0
 
 	// Read session open time (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for session open time")
+		return nil, fmt.Errorf("insufficient data for session open time: %w", io.ErrUnexpectedEOF)
 	}
 	sessionOpenTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -42,7 +42,7 @@ func (opts UnmarshalOptions) unmarshalCurrentUsage(data []byte) (*cardv1.Current
 
 	// Read session open vehicle registration (15 bytes: 1 byte nation + 14 bytes number)
 	if offset+15 > len(data) {
-		return nil, fmt.Errorf("insufficient data for vehicle registration")
+		return nil, fmt.Errorf("insufficient data for vehicle registration: %w", io.ErrUnexpectedEOF)
 	}
 	vehicleReg, err := opts.UnmarshalVehicleRegistration(data[offset : offset+15])
 	if err != nil {
@@ -103,10 +103,7 @@ func (opts AnonymizeOptions) anonymizeCurrentUsage(cu *cardv1.CurrentUsage) *car
 	anonymized := &cardv1.CurrentUsage{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Use static test timestamp: 2020-01-01 00:00:00 UTC (epoch: 1577836800)
 	anonymized.SetSessionOpenTime(&timestamppb.Timestamp{Seconds: 1577836800})
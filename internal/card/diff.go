@@ -0,0 +1,235 @@
+package card
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DriverCardDiff describes the differences between two reads of a driver card.
+//
+// The diff is a plain Go value that can be serialized with encoding/json.
+// Records are embedded in their protojson representation.
+type DriverCardDiff struct {
+	// ActivityDays holds the added, removed and changed daily activity records,
+	// keyed by activityRecordDate.
+	ActivityDays RecordSetDiff `json:"activityDays"`
+
+	// Events holds the added, removed and changed event records, keyed by
+	// eventBeginTime and eventType.
+	Events RecordSetDiff `json:"events"`
+
+	// Faults holds the added, removed and changed fault records, keyed by
+	// faultBeginTime and faultType.
+	Faults RecordSetDiff `json:"faults"`
+
+	// VehiclesUsed holds the added, removed and changed vehicle records,
+	// keyed by vehicleFirstUse.
+	VehiclesUsed RecordSetDiff `json:"vehiclesUsed"`
+}
+
+// IsEmpty reports whether the diff contains no differences.
+func (d *DriverCardDiff) IsEmpty() bool {
+	return d.ActivityDays.IsEmpty() && d.Events.IsEmpty() && d.Faults.IsEmpty() && d.VehiclesUsed.IsEmpty()
+}
+
+// RecordSetDiff describes the differences between two sets of records from a
+// repeated field of an elementary file.
+type RecordSetDiff struct {
+	// Added holds records that are only present in the newer file.
+	Added []*RecordDiff `json:"added,omitempty"`
+
+	// Removed holds records that are only present in the older file, typically
+	// because they were overwritten in the card's cyclic buffer.
+	Removed []*RecordDiff `json:"removed,omitempty"`
+
+	// Changed holds records that are present in both files with different content.
+	Changed []*RecordDiff `json:"changed,omitempty"`
+}
+
+// IsEmpty reports whether the record set diff contains no differences.
+func (d *RecordSetDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// RecordDiff describes a single record difference.
+type RecordDiff struct {
+	// Key is the natural key of the record, e.g. "2024-03-01T00:00:00Z".
+	// Composite keys are joined with "/".
+	Key string `json:"key"`
+
+	// Before is the protojson representation of the record in the older file.
+	Before json.RawMessage `json:"before,omitempty"`
+
+	// After is the protojson representation of the record in the newer file.
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+// DiffDriverCardFile compares two driver card files and reports the records
+// that were added, removed or changed between a and b.
+//
+// Repeated EF records are matched by their natural key (timestamps and, where
+// needed, types) rather than by position, since the card stores them in cyclic
+// buffers where positions shift as new data is recorded. Records that could
+// not be parsed (valid = false) or that have no key are ignored.
+//
+// When a file contains Gen2 application data, the Gen2 EFs are compared;
+// otherwise the Gen1 EFs are used.
+func DiffDriverCardFile(a, b *cardv1.DriverCardFile) *DriverCardDiff {
+	var diff DriverCardDiff
+	diff.ActivityDays = diffRecords(
+		driverActivityData(a), driverActivityData(b),
+		"daily_records", "activity_record_date",
+	)
+	diff.Events = diffRecords(
+		eventsData(a), eventsData(b),
+		"events", "event_begin_time", "event_type",
+	)
+	diff.Faults = diffRecords(
+		faultsData(a), faultsData(b),
+		"faults", "fault_begin_time", "fault_type",
+	)
+	diff.VehiclesUsed = diffRecords(
+		vehiclesUsed(a), vehiclesUsed(b),
+		"records", "vehicle_first_use",
+	)
+	return &diff
+}
+
+func driverActivityData(file *cardv1.DriverCardFile) proto.Message {
+	if file.HasTachographG2() {
+		return file.GetTachographG2().GetDriverActivityData()
+	}
+	return file.GetTachograph().GetDriverActivityData()
+}
+
+func eventsData(file *cardv1.DriverCardFile) proto.Message {
+	if file.HasTachographG2() {
+		return file.GetTachographG2().GetEventsData()
+	}
+	return file.GetTachograph().GetEventsData()
+}
+
+func faultsData(file *cardv1.DriverCardFile) proto.Message {
+	if file.HasTachographG2() {
+		return file.GetTachographG2().GetFaultsData()
+	}
+	return file.GetTachograph().GetFaultsData()
+}
+
+func vehiclesUsed(file *cardv1.DriverCardFile) proto.Message {
+	if file.HasTachographG2() {
+		return file.GetTachographG2().GetVehiclesUsed()
+	}
+	return file.GetTachograph().GetVehiclesUsed()
+}
+
+// diffRecords diffs the repeated message field listField of two EF messages,
+// matching records by the values of keyFields.
+func diffRecords(a, b proto.Message, listField protoreflect.Name, keyFields ...protoreflect.Name) RecordSetDiff {
+	before := keyedRecords(a, listField, keyFields)
+	after := keyedRecords(b, listField, keyFields)
+	var diff RecordSetDiff
+	for key, record := range after {
+		previous, ok := before[key]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, &RecordDiff{Key: key, After: marshalRecord(record)})
+		case !proto.Equal(previous, record):
+			diff.Changed = append(diff.Changed, &RecordDiff{
+				Key:    key,
+				Before: marshalRecord(previous),
+				After:  marshalRecord(record),
+			})
+		}
+	}
+	for key, record := range before {
+		if _, ok := after[key]; !ok {
+			diff.Removed = append(diff.Removed, &RecordDiff{Key: key, Before: marshalRecord(record)})
+		}
+	}
+	for _, records := range [][]*RecordDiff{diff.Added, diff.Removed, diff.Changed} {
+		sort.Slice(records, func(i, j int) bool {
+			return records[i].Key < records[j].Key
+		})
+	}
+	return diff
+}
+
+// keyedRecords indexes the records of a repeated message field by natural key.
+func keyedRecords(ef proto.Message, listField protoreflect.Name, keyFields []protoreflect.Name) map[string]proto.Message {
+	result := map[string]proto.Message{}
+	if ef == nil {
+		return result
+	}
+	m := ef.ProtoReflect()
+	if !m.IsValid() {
+		return result
+	}
+	fd := m.Descriptor().Fields().ByName(listField)
+	if fd == nil || !fd.IsList() || fd.Message() == nil {
+		return result
+	}
+	list := m.Get(fd).List()
+	for i := 0; i < list.Len(); i++ {
+		record := list.Get(i).Message()
+		if valid := record.Descriptor().Fields().ByName("valid"); valid != nil && !record.Get(valid).Bool() {
+			continue
+		}
+		key, ok := recordKey(record, keyFields)
+		if !ok {
+			continue
+		}
+		result[key] = record.Interface()
+	}
+	return result
+}
+
+// recordKey builds the natural key of a record from the given fields.
+// The first key field must be set for the record to have a key.
+func recordKey(record protoreflect.Message, keyFields []protoreflect.Name) (string, bool) {
+	parts := make([]string, 0, len(keyFields))
+	for i, name := range keyFields {
+		fd := record.Descriptor().Fields().ByName(name)
+		if fd == nil {
+			return "", false
+		}
+		if i == 0 && !record.Has(fd) {
+			return "", false
+		}
+		value := record.Get(fd)
+		switch {
+		case fd.Message() != nil && fd.Message().FullName() == "google.protobuf.Timestamp":
+			ts, ok := value.Message().Interface().(*timestamppb.Timestamp)
+			if !ok {
+				return "", false
+			}
+			parts = append(parts, ts.AsTime().UTC().Format(time.RFC3339))
+		case fd.Enum() != nil:
+			if ev := fd.Enum().Values().ByNumber(value.Enum()); ev != nil {
+				parts = append(parts, string(ev.Name()))
+			} else {
+				parts = append(parts, fmt.Sprint(value.Enum()))
+			}
+		default:
+			parts = append(parts, value.String())
+		}
+	}
+	return strings.Join(parts, "/"), true
+}
+
+func marshalRecord(record proto.Message) json.RawMessage {
+	data, err := protojson.Marshal(record)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,130 @@
+package card
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestDiffDriverCardFile(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+	newDay := func(d int, distance int32) *cardv1.DriverActivityData_DailyRecord {
+		record := &cardv1.DriverActivityData_DailyRecord{}
+		record.SetValid(true)
+		record.SetActivityRecordDate(timestamppb.New(day(d)))
+		record.SetActivityDayDistance(distance)
+		return record
+	}
+	newEvent := func(d int, eventType ddv1.EventFaultType) *cardv1.EventsData_Record {
+		record := &cardv1.EventsData_Record{}
+		record.SetValid(true)
+		record.SetEventType(eventType)
+		record.SetEventBeginTime(timestamppb.New(day(d).Add(8 * time.Hour)))
+		return record
+	}
+	newVehicle := func(d int) *ddv1.CardVehicleRecord {
+		record := &ddv1.CardVehicleRecord{}
+		record.SetValid(true)
+		record.SetVehicleFirstUse(timestamppb.New(day(d).Add(6 * time.Hour)))
+		return record
+	}
+	newFile := func(days []*cardv1.DriverActivityData_DailyRecord, events []*cardv1.EventsData_Record, vehicles []*ddv1.CardVehicleRecord) *cardv1.DriverCardFile {
+		activity := &cardv1.DriverActivityData{}
+		activity.SetDailyRecords(days)
+		eventsData := &cardv1.EventsData{}
+		eventsData.SetEvents(events)
+		vehiclesUsed := &cardv1.VehiclesUsed{}
+		vehiclesUsed.SetRecords(vehicles)
+		tachograph := &cardv1.DriverCardFile_Tachograph{}
+		tachograph.SetDriverActivityData(activity)
+		tachograph.SetEventsData(eventsData)
+		tachograph.SetVehiclesUsed(vehiclesUsed)
+		file := &cardv1.DriverCardFile{}
+		file.SetTachograph(tachograph)
+		return file
+	}
+
+	invalid := &cardv1.DriverActivityData_DailyRecord{}
+	invalid.SetValid(false)
+	invalid.SetRawData([]byte{0x01, 0x02})
+
+	// The older read has days 1-3; the newer read has lost day 1 to the
+	// cyclic buffer, updated day 3, and recorded day 4. The records are also
+	// stored at different positions.
+	a := newFile(
+		[]*cardv1.DriverActivityData_DailyRecord{newDay(1, 100), newDay(2, 200), newDay(3, 300)},
+		[]*cardv1.EventsData_Record{newEvent(2, ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING)},
+		[]*ddv1.CardVehicleRecord{newVehicle(1)},
+	)
+	b := newFile(
+		[]*cardv1.DriverActivityData_DailyRecord{invalid, newDay(4, 400), newDay(2, 200), newDay(3, 350)},
+		[]*cardv1.EventsData_Record{
+			newEvent(2, ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING),
+			newEvent(2, ddv1.EventFaultType_GENERAL_OVER_SPEEDING),
+		},
+		[]*ddv1.CardVehicleRecord{newVehicle(1), newVehicle(4)},
+	)
+
+	diff := DiffDriverCardFile(a, b)
+	if diff.IsEmpty() {
+		t.Fatal("DiffDriverCardFile() returned empty diff")
+	}
+	keys := func(records []*RecordDiff) []string {
+		var result []string
+		for _, record := range records {
+			result = append(result, record.Key)
+		}
+		return result
+	}
+	for _, tt := range []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"added days", keys(diff.ActivityDays.Added), []string{"2024-03-04T00:00:00Z"}},
+		{"removed days", keys(diff.ActivityDays.Removed), []string{"2024-03-01T00:00:00Z"}},
+		{"changed days", keys(diff.ActivityDays.Changed), []string{"2024-03-03T00:00:00Z"}},
+		{"added events", keys(diff.Events.Added), []string{"2024-03-02T08:00:00Z/GENERAL_OVER_SPEEDING"}},
+		{"removed events", keys(diff.Events.Removed), nil},
+		{"added vehicles", keys(diff.VehiclesUsed.Added), []string{"2024-03-04T06:00:00Z"}},
+	} {
+		if diff := cmp.Diff(tt.want, tt.got); diff != "" {
+			t.Errorf("%s mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+	if !diff.Faults.IsEmpty() {
+		t.Errorf("Faults diff = %+v, want empty", diff.Faults)
+	}
+
+	// The diff must be serializable for display purposes.
+	data, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var decoded DriverCardDiff
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got, want := len(decoded.ActivityDays.Changed), 1; got != want {
+		t.Fatalf("decoded changed days = %d, want %d", got, want)
+	}
+	var after map[string]any
+	if err := json.Unmarshal(decoded.ActivityDays.Changed[0].After, &after); err != nil {
+		t.Fatalf("json.Unmarshal(after) error = %v", err)
+	}
+	if got, want := after["activityDayDistance"], float64(350); got != want {
+		t.Errorf("changed day distance = %v, want %v", got, want)
+	}
+
+	if !DiffDriverCardFile(a, a).IsEmpty() {
+		t.Error("DiffDriverCardFile(a, a) returned non-empty diff")
+	}
+}
@@ -2,16 +2,14 @@ package card
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
 
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protoreflect"
-
+	"github.com/way-platform/tachograph-go/internal/dd"
 	"github.com/way-platform/tachograph-go/internal/security"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 // MarshalDriverCardFile serializes a DriverCardFile into binary format.
@@ -20,12 +18,11 @@ func (opts MarshalOptions) MarshalDriverCardFile(file *cardv1.DriverCardFile) ([
 		return nil, fmt.Errorf("driver card file is nil")
 	}
 
-	// Allocate a buffer large enough for the card file
-	buf := make([]byte, 0, 1024*1024) // 1MB initial capacity
-
-	// Use the existing appendDriverCard function
-	// TODO: Pass opts.UseRawData through to append functions
-	return appendDriverCard(buf, file)
+	rawFile, err := opts.unparseDriverCardFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return opts.MarshalRawCardFile(rawFile)
 }
 
 // ParseRawDriverCardFile parses driver card data into a protobuf DriverCardFile message.
@@ -39,16 +36,60 @@ func (opts MarshalOptions) MarshalDriverCardFile(file *cardv1.DriverCardFile) ([
 // - '00'/'01' indicates Gen1 (Tachograph DF)
 // - '02'/'03' indicates Gen2 (Tachograph_G2 DF)
 func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*cardv1.DriverCardFile, error) {
+	return opts.ParseRawDriverCardFileContext(context.Background(), input)
+}
+
+// ParseRawDriverCardFileContext is like ParseRawDriverCardFile, but stops
+// parsing with the context's error when ctx is done. Cancellation is checked
+// between EFs.
+//
+// Errors decoding an EF are reported as a *dd.ParseError.
+func (opts ParseOptions) ParseRawDriverCardFileContext(ctx context.Context, input *cardv1.RawCardFile) (_ *cardv1.DriverCardFile, err error) {
 	var output cardv1.DriverCardFile
 
+	// The record being parsed, to add its context to errors
+	var current *cardv1.RawCardFile_Record
+	defer func() {
+		if err != nil && current != nil {
+			err = &dd.ParseError{
+				Name:       current.GetFile().String(),
+				Generation: current.GetGeneration(),
+				FileOffset: int(current.GetFileOffset()),
+				Err:        err,
+			}
+		}
+	}()
+
 	// DF-level containers - we populate these as we encounter EFs
 	var tachographDF *cardv1.DriverCardFile_Tachograph
 	var tachographG2DF *cardv1.DriverCardFile_TachographG2
+	var unknownRecords []*cardv1.RawCardFile_Record
+
+	signatures, err := opts.pairSignatures(input.GetRecords())
+	if err != nil {
+		return nil, err
+	}
 
 	for i := 0; i < len(input.GetRecords()); i++ {
+		current = nil
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		record := input.GetRecords()[i]
-		if record.GetContentType() != cardv1.ContentType_DATA {
-			return nil, fmt.Errorf("record %d has unexpected content type", i)
+		current = record
+		if record.GetFile() == cardv1.ElementaryFileType_ELEMENTARY_FILE_UNSPECIFIED {
+			if opts.KeepUnknownEFs {
+				unknownRecords = append(unknownRecords, proto.Clone(record).(*cardv1.RawCardFile_Record))
+			}
+			continue
+		}
+		switch record.GetContentType() {
+		case cardv1.ContentType_DATA:
+		case cardv1.ContentType_SIGNATURE:
+			// Signatures are attached to their data records via pairSignatures.
+			continue
+		default:
+			return nil, fmt.Errorf("record %d has unexpected content type %v", i, record.GetContentType())
 		}
 
 		// Use generation already parsed from the TLV tag appendix
@@ -58,14 +99,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 		// Create UnmarshalOptions with PreserveRawData from ParseOptions
 		unmarshalOpts := opts.unmarshal()
 
-		var signature []byte
-		if i+1 < len(input.GetRecords()) {
-			nextRecord := input.GetRecords()[i+1]
-			if nextRecord.GetFile() == record.GetFile() && nextRecord.GetContentType() == cardv1.ContentType_SIGNATURE {
-				signature = nextRecord.GetValue()
-				i++
-			}
-		}
+		signature := signatures[i]
 
 		switch record.GetFile() {
 		case cardv1.ElementaryFileType_EF_ICC:
@@ -124,7 +158,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetIdentification(identification)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_IDENTIFICATION: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_IDENTIFICATION: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION:
@@ -167,7 +201,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				tachographG2DF.SetApplicationIdentification(appIdG2)
 
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_APPLICATION_IDENTIFICATION: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_APPLICATION_IDENTIFICATION: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO:
@@ -196,7 +230,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetDrivingLicenceInfo(drivingLicenceInfo)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_DRIVING_LICENCE_INFO: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_DRIVING_LICENCE_INFO: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_EVENTS_DATA:
@@ -225,7 +259,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetEventsData(eventsData)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_EVENTS_DATA: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_EVENTS_DATA: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_FAULTS_DATA:
@@ -254,7 +288,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetFaultsData(faultsData)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_FAULTS_DATA: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_FAULTS_DATA: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA:
@@ -283,7 +317,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetDriverActivityData(activityData)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_DRIVER_ACTIVITY_DATA: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_DRIVER_ACTIVITY_DATA: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_VEHICLES_USED:
@@ -324,7 +358,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				tachographG2DF.SetVehiclesUsed(vehiclesUsedG2)
 
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_VEHICLES_USED: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_VEHICLES_USED: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_PLACES:
@@ -365,7 +399,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				tachographG2DF.SetPlaces(placesG2)
 
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_PLACES: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_PLACES: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_CURRENT_USAGE:
@@ -394,7 +428,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetCurrentUsage(currentUsage)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_CURRENT_USAGE: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_CURRENT_USAGE: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA:
@@ -423,7 +457,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetControlActivityData(controlActivity)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_CONTROL_ACTIVITY_DATA: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_CONTROL_ACTIVITY_DATA: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS:
@@ -466,7 +500,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				tachographG2DF.SetSpecificConditions(specificConditionsG2)
 
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_SPECIFIC_CONDITIONS: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_SPECIFIC_CONDITIONS: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER:
@@ -496,7 +530,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetCardDownload(cardDownload)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_CARD_DOWNLOAD_DRIVER: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_CARD_DOWNLOAD_DRIVER: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_VEHICLE_UNITS_USED:
@@ -560,7 +594,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 			// Gen1: Card authentication certificate
 			// Only appears in Gen1 DF (Tachograph)
 			if efGeneration != ddv1.Generation_GENERATION_1 {
-				return nil, fmt.Errorf("EF_CARD_CERTIFICATE should only appear in Gen1 DF, got generation: %v", efGeneration)
+				return nil, fmt.Errorf("%w: EF_CARD_CERTIFICATE should only appear in Gen1 DF, got generation: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 			if tachographDF == nil {
 				tachographDF = &cardv1.DriverCardFile_Tachograph{}
@@ -585,7 +619,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 			// Gen2: Card mutual authentication certificate (replaces Gen1 Card_Certificate)
 			// Only appears in Gen2 DF (Tachograph_G2)
 			if efGeneration != ddv1.Generation_GENERATION_2 {
-				return nil, fmt.Errorf("EF_CARD_MA_CERTIFICATE should only appear in Gen2 DF, got generation: %v", efGeneration)
+				return nil, fmt.Errorf("%w: EF_CARD_MA_CERTIFICATE should only appear in Gen2 DF, got generation: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 			if tachographG2DF == nil {
 				tachographG2DF = &cardv1.DriverCardFile_TachographG2{}
@@ -610,7 +644,7 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 			// Gen2: Card signature certificate
 			// Only appears in Gen2 DF (Tachograph_G2) on driver and workshop cards
 			if efGeneration != ddv1.Generation_GENERATION_2 {
-				return nil, fmt.Errorf("EF_CARD_SIGN_CERTIFICATE should only appear in Gen2 DF, got generation: %v", efGeneration)
+				return nil, fmt.Errorf("%w: EF_CARD_SIGN_CERTIFICATE should only appear in Gen2 DF, got generation: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 			if tachographG2DF == nil {
 				tachographG2DF = &cardv1.DriverCardFile_TachographG2{}
@@ -678,14 +712,14 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 				}
 				tachographG2DF.SetCaCertificate(cert)
 			default:
-				return nil, fmt.Errorf("unexpected generation for EF_CA_CERTIFICATE: %v", efGeneration)
+				return nil, fmt.Errorf("%w for EF_CA_CERTIFICATE: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 
 		case cardv1.ElementaryFileType_EF_LINK_CERTIFICATE:
 			// Gen2: Link certificate for CA chaining
 			// Only appears in Gen2 DF (Tachograph_G2)
 			if efGeneration != ddv1.Generation_GENERATION_2 {
-				return nil, fmt.Errorf("EF_LINK_CERTIFICATE should only appear in Gen2 DF, got generation: %v", efGeneration)
+				return nil, fmt.Errorf("%w: EF_LINK_CERTIFICATE should only appear in Gen2 DF, got generation: %v", dd.ErrUnsupportedGeneration, efGeneration)
 			}
 			if tachographG2DF == nil {
 				tachographG2DF = &cardv1.DriverCardFile_TachographG2{}
@@ -715,499 +749,56 @@ func (opts ParseOptions) ParseRawDriverCardFile(input *cardv1.RawCardFile) (*car
 	if tachographG2DF != nil {
 		output.SetTachographG2(tachographG2DF)
 	}
+	output.SetUnknownRecords(unknownRecords)
 
 	return &output, nil
 }
 
-// appendDriverCard orchestrates the writing of a driver card file.
-// The order follows the actual file structure observed in real DDD files.
-func appendDriverCard(dst []byte, card *cardv1.DriverCardFile) ([]byte, error) {
-	var err error
-
-	// Create default MarshalOptions for internal calls
-	opts := MarshalOptions{}
-
-	// 1. EF_ICC (0x0002) - no signature
-	if icc := card.GetIcc(); icc != nil {
-		dataBytes, err := opts.MarshalIcc(icc)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_ICC,
-			dataBytes,
-			nil, // no signature
-			0x00)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// 2. EF_IC (0x0005) - no signature
-	if ic := card.GetIc(); ic != nil {
-		dataBytes, err := opts.MarshalCardIc(ic)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_IC,
-			dataBytes,
-			nil, // no signature
-			0x00)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// 3. EF_APPLICATION_IDENTIFICATION (0x0501)
-	if appId := card.GetTachograph().GetApplicationIdentification(); appId != nil {
-		dataBytes, err := opts.MarshalCardApplicationIdentification(appId)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION,
-			dataBytes,
-			appId.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if drivingLicence := card.GetTachograph().GetDrivingLicenceInfo(); drivingLicence != nil {
-		dataBytes, err := opts.MarshalDrivingLicenceInfo(drivingLicence)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO,
-			dataBytes,
-			drivingLicence.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// 4. EF_IDENTIFICATION (0x0520)
-	if identification := card.GetTachograph().GetIdentification(); identification != nil {
-		dataBytes, err := opts.MarshalDriverCardIdentification(identification)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_IDENTIFICATION,
-			dataBytes,
-			identification.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if eventsData := card.GetTachograph().GetEventsData(); eventsData != nil {
-		dataBytes, err := opts.MarshalEventsData(eventsData)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_EVENTS_DATA,
-			dataBytes,
-			eventsData.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if faultsData := card.GetTachograph().GetFaultsData(); faultsData != nil {
-		dataBytes, err := opts.MarshalFaultsData(faultsData)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_FAULTS_DATA,
-			dataBytes,
-			faultsData.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if driverActivity := card.GetTachograph().GetDriverActivityData(); driverActivity != nil {
-		dataBytes, err := opts.MarshalDriverActivity(driverActivity)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA,
-			dataBytes,
-			driverActivity.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if vehiclesUsed := card.GetTachograph().GetVehiclesUsed(); vehiclesUsed != nil {
-		dataBytes, err := opts.MarshalVehiclesUsed(vehiclesUsed)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_VEHICLES_USED,
-			dataBytes,
-			vehiclesUsed.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if places := card.GetTachograph().GetPlaces(); places != nil {
-		dataBytes, err := opts.MarshalPlaces(places)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_PLACES,
-			dataBytes,
-			places.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if currentUsage := card.GetTachograph().GetCurrentUsage(); currentUsage != nil {
-		dataBytes, err := opts.MarshalCurrentUsage(currentUsage)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_CURRENT_USAGE,
-			dataBytes,
-			currentUsage.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if controlActivity := card.GetTachograph().GetControlActivityData(); controlActivity != nil {
-		dataBytes, err := opts.MarshalCardControlActivityData(controlActivity)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA,
-			dataBytes,
-			controlActivity.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if specificConditions := card.GetTachograph().GetSpecificConditions(); specificConditions != nil {
-		dataBytes, err := opts.MarshalCardSpecificConditions(specificConditions)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS,
-			dataBytes,
-			specificConditions.GetSignature(),
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if cardDownload := card.GetTachograph().GetCardDownload(); cardDownload != nil {
-		dataBytes, err := opts.MarshalCardDownload(cardDownload)
-		if err != nil {
-			return nil, err
-		}
-		dst, err = appendTlvBlock(dst,
-			cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER,
-			dataBytes,
-			nil,  // no signature
-			0x00) // Gen1
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// Gen2 DF - marshal all Gen2 EFs with appendix 0x02/0x03
-	if tachographG2 := card.GetTachographG2(); tachographG2 != nil {
-		// Marshal Gen2 versions of shared EFs
-
-		// ApplicationIdentification (Gen2)
-		if appId := tachographG2.GetApplicationIdentification(); appId != nil {
-			dataBytes, err := opts.MarshalCardApplicationIdentificationG2(appId)
-			if err != nil {
-				return nil, err
-			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION,
-				dataBytes,
-				appId.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if vehiclesUsed := tachographG2.GetVehiclesUsed(); vehiclesUsed != nil {
-			dataBytes, err := opts.MarshalVehiclesUsedG2(vehiclesUsed)
-			if err != nil {
-				return nil, err
-			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_VEHICLES_USED,
-				dataBytes,
-				vehiclesUsed.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if places := tachographG2.GetPlaces(); places != nil {
-			dataBytes, err := opts.MarshalPlacesG2(places)
-			if err != nil {
-				return nil, err
-			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_PLACES,
-				dataBytes,
-				places.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		// SpecificConditions (Gen2)
-		if specificConditions := tachographG2.GetSpecificConditions(); specificConditions != nil {
-			dataBytes, err := opts.MarshalCardSpecificConditionsG2(specificConditions)
-			if err != nil {
-				return nil, err
-			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS,
-				dataBytes,
-				specificConditions.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		// Marshal Gen2-exclusive EFs
-		if vehicleUnitsUsed := tachographG2.GetVehicleUnitsUsed(); vehicleUnitsUsed != nil {
-			dataBytes, err := opts.MarshalCardVehicleUnitsUsed(vehicleUnitsUsed)
-			if err != nil {
-				return nil, err
-			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_VEHICLE_UNITS_USED,
-				dataBytes,
-				vehicleUnitsUsed.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if gnssPlaces := tachographG2.GetGnssPlaces(); gnssPlaces != nil {
-			dataBytes, err := opts.MarshalCardGnssPlaces(gnssPlaces)
-			if err != nil {
-				return nil, err
-			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_GNSS_PLACES,
-				dataBytes,
-				gnssPlaces.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		if appIdV2 := tachographG2.GetApplicationIdentificationV2(); appIdV2 != nil {
-			dataBytes, err := opts.MarshalCardApplicationIdentificationV2(appIdV2)
-			if err != nil {
-				return nil, err
+// pairSignatures returns the signatures of the data records of a card file,
+// keyed by the index of the data record.
+//
+// A signature record normally directly follows the data record of the same EF
+// and generation. A signature that does not is an error, unless
+// LenientSignatureOrder is set: then it is paired with the first unsigned data
+// record of its EF and generation, wherever that appears in the file, and
+// dropped if there is none.
+func (opts ParseOptions) pairSignatures(records []*cardv1.RawCardFile_Record) (map[int][]byte, error) {
+	signatures := make(map[int][]byte)
+	// dataRecord returns the index of the first data record of the same EF
+	// and generation as record, optionally skipping records already signed.
+	dataRecord := func(record *cardv1.RawCardFile_Record, unsigned bool) int {
+		for i, data := range records {
+			if data.GetContentType() != cardv1.ContentType_DATA ||
+				data.GetFile() != record.GetFile() ||
+				data.GetGeneration() != record.GetGeneration() {
+				continue
 			}
-			dst, err = appendTlvBlock(dst,
-				cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION_V2,
-				dataBytes,
-				appIdV2.GetSignature(),
-				0x02) // Gen2
-			if err != nil {
-				return nil, err
+			if _, signed := signatures[i]; unsigned && signed {
+				continue
 			}
+			return i
 		}
+		return -1
 	}
-
-	// Append certificate EFs from Gen1 DF (in regulation order: SFID 2, 4)
-	if tachograph := card.GetTachograph(); tachograph != nil {
-		// Card authentication certificate (FID C100h)
-		if cert := tachograph.GetCardCertificate(); cert != nil {
-			if rsaCert := cert.GetRsaCertificate(); rsaCert != nil {
-				dst, err = appendCertificateEF(dst, cardv1.ElementaryFileType_EF_CARD_CERTIFICATE, rsaCert.GetRawData())
-				if err != nil {
-					return nil, err
-				}
-			}
+	for i, record := range records {
+		if record.GetContentType() != cardv1.ContentType_SIGNATURE {
+			continue
 		}
-
-		// CA certificate (FID C108h)
-		if cert := tachograph.GetCaCertificate(); cert != nil {
-			if rsaCert := cert.GetRsaCertificate(); rsaCert != nil {
-				dst, err = appendCertificateEF(dst, cardv1.ElementaryFileType_EF_CA_CERTIFICATE, rsaCert.GetRawData())
-				if err != nil {
-					return nil, err
-				}
-			}
+		if i > 0 && dataRecord(record, true) == i-1 {
+			signatures[i-1] = record.GetValue()
+			continue
 		}
-	}
-
-	// Append certificate EFs from Gen2 DF (in regulation order: SFID 2, 3, 4, 5)
-	if tachographG2 := card.GetTachographG2(); tachographG2 != nil {
-		// Card mutual authentication certificate (FID C100h)
-		if cert := tachographG2.GetCardMaCertificate(); cert != nil {
-			if eccCert := cert.GetEccCertificate(); eccCert != nil {
-				dst, err = appendCertificateEFG2(dst, cardv1.ElementaryFileType_EF_CARD_MA_CERTIFICATE, eccCert.GetRawData())
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
-		// Card signature certificate (FID C101h)
-		if cert := tachographG2.GetCardSignCertificate(); cert != nil {
-			if eccCert := cert.GetEccCertificate(); eccCert != nil {
-				dst, err = appendCertificateEFG2(dst, cardv1.ElementaryFileType_EF_CARD_SIGN_CERTIFICATE, eccCert.GetRawData())
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
-
-		// CA certificate (FID C108h)
-		if cert := tachographG2.GetCaCertificate(); cert != nil {
-			if eccCert := cert.GetEccCertificate(); eccCert != nil {
-				dst, err = appendCertificateEFG2(dst, cardv1.ElementaryFileType_EF_CA_CERTIFICATE, eccCert.GetRawData())
-				if err != nil {
-					return nil, err
-				}
+		if !opts.LenientSignatureOrder {
+			if j := dataRecord(record, false); j >= 0 {
+				return nil, fmt.Errorf("signature record %d for %v (%v) does not directly follow its data record %d", i, record.GetFile(), record.GetGeneration(), j)
 			}
+			return nil, fmt.Errorf("orphan signature record %d for %v (%v): no data record for the EF", i, record.GetFile(), record.GetGeneration())
 		}
-
-		// Link certificate (FID C109h)
-		if cert := tachographG2.GetLinkCertificate(); cert != nil {
-			if eccCert := cert.GetEccCertificate(); eccCert != nil {
-				dst, err = appendCertificateEFG2(dst, cardv1.ElementaryFileType_EF_LINK_CERTIFICATE, eccCert.GetRawData())
-				if err != nil {
-					return nil, err
-				}
-			}
+		if j := dataRecord(record, true); j >= 0 {
+			signatures[j] = record.GetValue()
 		}
 	}
-
-	// Note: Any remaining proprietary EFs would be handled here if needed
-
-	return dst, nil
-}
-
-// appendCertificateEF appends a Gen1 certificate EF (which are not signed)
-// Uses appendix 0x00 for Gen1 DF (Tachograph)
-func appendCertificateEF(dst []byte, fileType cardv1.ElementaryFileType, certData []byte) ([]byte, error) {
-	if len(certData) == 0 {
-		return dst, nil // Skip empty certificates
-	}
-
-	opts := fileType.Descriptor().Values().ByNumber(protoreflect.EnumNumber(fileType)).Options()
-	tag := proto.GetExtension(opts, cardv1.E_FileId).(int32)
-
-	// Write data tag (FID + appendix 0x00) - Gen1 DF certificates are NOT signed
-	dst = binary.BigEndian.AppendUint16(dst, uint16(tag))
-	dst = append(dst, 0x00) // appendix for Gen1 data
-	dst = binary.BigEndian.AppendUint16(dst, uint16(len(certData)))
-	dst = append(dst, certData...)
-
-	// Note: Certificates do NOT have signature blocks
-	return dst, nil
-}
-
-// appendCertificateEFG2 appends a Gen2 certificate EF (which are not signed)
-// Uses appendix 0x02 for Gen2 DF (Tachograph_G2)
-func appendCertificateEFG2(dst []byte, fileType cardv1.ElementaryFileType, certData []byte) ([]byte, error) {
-	if len(certData) == 0 {
-		return dst, nil // Skip empty certificates
-	}
-
-	opts := fileType.Descriptor().Values().ByNumber(protoreflect.EnumNumber(fileType)).Options()
-	tag := proto.GetExtension(opts, cardv1.E_FileId).(int32)
-
-	// Write data tag (FID + appendix 0x02) - Gen2 DF certificates are NOT signed
-	dst = binary.BigEndian.AppendUint16(dst, uint16(tag))
-	dst = append(dst, 0x02) // appendix for Gen2 data
-	dst = binary.BigEndian.AppendUint16(dst, uint16(len(certData)))
-	dst = append(dst, certData...)
-
-	// Note: Certificates do NOT have signature blocks
-	return dst, nil
-}
-
-// appendTlvBlock writes a TLV block (and optional signature block) to dst.
-//
-// Parameters:
-//   - fileType: The Elementary File type (used to look up the FID tag)
-//   - dataBytes: Pre-marshalled data bytes (nil = skip writing entirely)
-//   - signature: Signature bytes (nil/empty = skip signature block)
-//   - appendix: Tag appendix byte (0x00 for Gen1 data, 0x02 for Gen2 data)
-//
-// The function writes:
-//  1. Data block: [FID:2][appendix:1][length:2][data:N]
-//  2. Signature block (if signature present): [FID:2][appendix+1:1][length:2][signature:N]
-func appendTlvBlock(
-	dst []byte,
-	fileType cardv1.ElementaryFileType,
-	dataBytes []byte,
-	signature []byte,
-	appendix byte,
-) ([]byte, error) {
-	// Skip if no data to write
-	if dataBytes == nil {
-		return dst, nil
-	}
-
-	// Get FID tag from protobuf enum options
-	opts := fileType.Descriptor().Values().ByNumber(protoreflect.EnumNumber(fileType)).Options()
-	tag := proto.GetExtension(opts, cardv1.E_FileId).(int32)
-
-	// Write data block: [FID][appendix][length][value]
-	dst = binary.BigEndian.AppendUint16(dst, uint16(tag))
-	dst = append(dst, appendix)
-	dst = binary.BigEndian.AppendUint16(dst, uint16(len(dataBytes)))
-	dst = append(dst, dataBytes...)
-
-	// Write signature block if present: [FID][appendix+1][length][signature]
-	if len(signature) > 0 {
-		sigAppendix := appendix + 1 // 0x01 for Gen1, 0x03 for Gen2
-		dst = binary.BigEndian.AppendUint16(dst, uint16(tag))
-		dst = append(dst, sigAppendix)
-		dst = binary.BigEndian.AppendUint16(dst, uint16(len(signature)))
-		dst = append(dst, signature...)
-	}
-
-	return dst, nil
+	return signatures, nil
 }
 
 // CertificateResolver provides access to tachograph certificates
@@ -1216,6 +807,9 @@ type CertificateResolver interface {
 	// GetRootCertificate retrieves the European Root CA certificate.
 	GetRootCertificate(ctx context.Context) (*securityv1.RootCertificate, error)
 
+	// GetEccRootCertificate retrieves the Gen2 European Root CA certificate (ECC).
+	GetEccRootCertificate(ctx context.Context) (*securityv1.EccCertificate, error)
+
 	// GetRsaCertificate retrieves an RSA certificate (Generation 1)
 	// by its Certificate Holder Reference (CHR).
 	GetRsaCertificate(ctx context.Context, chr string) (*securityv1.RsaCertificate, error)
@@ -1239,15 +833,18 @@ type VerifyOptions struct {
 //   - Generation 1: Card certificate using the CA certificate
 //   - Generation 2: Card sign certificate using the CA certificate
 //
-// The verification process uses a certificate resolver to fetch CA certificates
-// by their Certificate Authority Reference (CAR). If no resolver is configured,
-// it falls back to using the embedded CA certificates from the card file itself,
-// which contain the public keys needed to verify the card's certificates.
+// If a certificate resolver is configured, the chain of each card certificate
+// is walked by Certificate Authority Reference (CAR) up to the European root,
+// using the CA and link certificates of the card file and fetching missing
+// certificates from the resolver. If no resolver is configured, it falls back
+// to using the embedded CA certificates from the card file itself, which
+// contain the public keys needed to verify the card's certificates.
 //
 // This function mutates the certificate structures by setting their signature_valid
 // fields to true or false based on the verification result.
 //
-// Returns an error if verification fails for any certificate.
+// Returns an error if verification fails for any certificate, naming the
+// CAR at which the chain is broken.
 func (o VerifyOptions) VerifyDriverCardFile(ctx context.Context, file *cardv1.DriverCardFile) error {
 	if file == nil {
 		return fmt.Errorf("driver card file cannot be nil")
@@ -1271,8 +868,9 @@ func (o VerifyOptions) VerifyDriverCardFile(ctx context.Context, file *cardv1.Dr
 }
 
 // verifyGen1Certificates verifies Generation 1 RSA certificates.
-// If a certificate resolver is configured, it fetches CA certificates from the resolver.
-// Otherwise, it uses the embedded CA certificate from the card file.
+// If a certificate resolver is configured, it walks the chain of the card
+// certificate up to the root. Otherwise, it uses the embedded CA certificate
+// from the card file.
 func (o VerifyOptions) verifyGen1Certificates(ctx context.Context, tachograph *cardv1.DriverCardFile_Tachograph) error {
 	cardCert := tachograph.GetCardCertificate().GetRsaCertificate()
 
@@ -1280,38 +878,21 @@ func (o VerifyOptions) verifyGen1Certificates(ctx context.Context, tachograph *c
 		return fmt.Errorf("card certificate is missing")
 	}
 
-	var caCert *securityv1.RsaCertificate
-	var err error
-
 	if o.CertificateResolver != nil {
-		// Use certificate resolver to fetch CA certificate
-		car := cardCert.GetCertificateAuthorityReference()
-		caCert, err = o.CertificateResolver.GetRsaCertificate(ctx, car)
-		if err != nil {
-			return fmt.Errorf("failed to fetch CA certificate from resolver: %w", err)
-		}
-
-		// For RSA certificates, the public key is extracted during signature recovery.
-		// If the CA certificate doesn't have its public key yet, we need to verify it
-		// against the root CA first to populate it.
-		if len(caCert.GetRsaModulus()) == 0 || len(caCert.GetRsaExponent()) == 0 {
-			// Fetch the root CA certificate
-			rootCert, err := o.CertificateResolver.GetRootCertificate(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to get root CA certificate: %w", err)
-			}
-
-			// Verify the CA certificate against the root CA to populate its public key
-			if err := security.VerifyRsaCertificateWithRoot(caCert, rootCert); err != nil {
-				return fmt.Errorf("CA certificate verification failed: %w", err)
-			}
+		var intermediates []*securityv1.RsaCertificate
+		if caCert := tachograph.GetCaCertificate().GetRsaCertificate(); caCert != nil {
+			intermediates = append(intermediates, caCert)
 		}
-	} else {
-		// Fall back to embedded CA certificate from card file
-		caCert = tachograph.GetCaCertificate().GetRsaCertificate()
-		if caCert == nil {
-			return fmt.Errorf("CA certificate is missing from card file")
+		if _, err := security.VerifyRsaCertificateChain(ctx, cardCert, intermediates, o.CertificateResolver); err != nil {
+			return fmt.Errorf("card certificate verification failed: %w", err)
 		}
+		return nil
+	}
+
+	// Fall back to embedded CA certificate from card file
+	caCert := tachograph.GetCaCertificate().GetRsaCertificate()
+	if caCert == nil {
+		return fmt.Errorf("CA certificate is missing from card file")
 	}
 
 	// Verify the card certificate using the CA certificate
@@ -1323,8 +904,9 @@ func (o VerifyOptions) verifyGen1Certificates(ctx context.Context, tachograph *c
 }
 
 // verifyGen2Certificates verifies Generation 2 ECC certificates.
-// If a certificate resolver is configured, it fetches CA certificates from the resolver.
-// Otherwise, it uses the embedded CA certificate from the card file.
+// If a certificate resolver is configured, it walks the chain of the card
+// sign certificate up to the root, following link certificates. Otherwise,
+// it uses the embedded CA certificate from the card file.
 func (o VerifyOptions) verifyGen2Certificates(ctx context.Context, tachographG2 *cardv1.DriverCardFile_TachographG2) error {
 	cardSignCert := tachographG2.GetCardSignCertificate().GetEccCertificate()
 
@@ -1332,22 +914,26 @@ func (o VerifyOptions) verifyGen2Certificates(ctx context.Context, tachographG2
 		return fmt.Errorf("card sign certificate is missing")
 	}
 
-	var caCert *securityv1.EccCertificate
-	var err error
-
 	if o.CertificateResolver != nil {
-		// Use certificate resolver to fetch CA certificate
-		car := cardSignCert.GetCertificateAuthorityReference()
-		caCert, err = o.CertificateResolver.GetEccCertificate(ctx, car)
-		if err != nil {
-			return fmt.Errorf("failed to fetch CA certificate from resolver: %w", err)
+		var intermediates []*securityv1.EccCertificate
+		for _, cert := range []*securityv1.EccCertificate{
+			tachographG2.GetCaCertificate().GetEccCertificate(),
+			tachographG2.GetLinkCertificate().GetEccCertificate(),
+		} {
+			if cert != nil {
+				intermediates = append(intermediates, cert)
+			}
 		}
-	} else {
-		// Fall back to embedded CA certificate from card file
-		caCert = tachographG2.GetCaCertificate().GetEccCertificate()
-		if caCert == nil {
-			return fmt.Errorf("CA certificate is missing from card file")
+		if _, err := security.VerifyEccCertificateChain(ctx, cardSignCert, intermediates, o.CertificateResolver); err != nil {
+			return fmt.Errorf("card sign certificate verification failed: %w", err)
 		}
+		return nil
+	}
+
+	// Fall back to embedded CA certificate from card file
+	caCert := tachographG2.GetCaCertificate().GetEccCertificate()
+	if caCert == nil {
+		return fmt.Errorf("CA certificate is missing from card file")
 	}
 
 	// Verify the card sign certificate using the CA certificate
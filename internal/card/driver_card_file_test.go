@@ -0,0 +1,345 @@
+package card
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestParseRawDriverCardFile_signatureOrder(t *testing.T) {
+	icData := []byte{0x00, 0x05, 0x00, 0x00, 0x08, 1, 2, 3, 4, 5, 6, 7, 8}
+	icSignature := []byte{0x00, 0x05, 0x01, 0x00, 0x02, 0xAA, 0xBB}
+	iccSignature := []byte{0x00, 0x02, 0x01, 0x00, 0x02, 0xCC, 0xDD}
+
+	for _, tt := range []struct {
+		name          string
+		records       [][]byte
+		wantStrictErr string
+		wantSignature []byte
+	}{
+		{
+			name:          "signature after data",
+			records:       [][]byte{icData, icSignature},
+			wantSignature: []byte{0xAA, 0xBB},
+		},
+		{
+			name:          "signature before data",
+			records:       [][]byte{icSignature, icData},
+			wantStrictErr: "signature record 0 for EF_IC (GENERATION_1) does not directly follow its data record 1",
+			wantSignature: []byte{0xAA, 0xBB},
+		},
+		{
+			name:          "orphan signature",
+			records:       [][]byte{icData, iccSignature},
+			wantStrictErr: "orphan signature record 1 for EF_ICC (GENERATION_1)",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(bytes.Join(tt.records, nil))
+			if err != nil {
+				t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+			}
+
+			file, err := ParseOptions{}.ParseRawDriverCardFile(rawFile)
+			if tt.wantStrictErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantStrictErr) {
+					t.Errorf("strict ParseRawDriverCardFile error = %v, want %q", err, tt.wantStrictErr)
+				}
+				file, err = ParseOptions{LenientSignatureOrder: true}.ParseRawDriverCardFile(rawFile)
+			}
+			if err != nil {
+				t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+			}
+			if got := file.GetIc().GetSignature(); !bytes.Equal(got, tt.wantSignature) {
+				t.Errorf("EF_IC signature = %x, want %x", got, tt.wantSignature)
+			}
+			if file.HasIcc() {
+				t.Error("orphan EF_ICC signature created an EF_ICC")
+			}
+		})
+	}
+}
+
+func TestMarshalDriverCardFile_applicationIdentificationV2(t *testing.T) {
+	appIdV2, err := UnmarshalOptions{}.unmarshalApplicationIdentificationV2([]byte{0x05, 0x06, 0x07, 0x08})
+	if err != nil {
+		t.Fatalf("unmarshalApplicationIdentificationV2 failed: %v", err)
+	}
+	appIdV2.SetSignature([]byte{0xAA, 0xBB})
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetApplicationIdentificationV2(appIdV2)
+	file := &cardv1.DriverCardFile{}
+	file.SetTachographG2(tachographG2)
+
+	rawFile, err := UnparseDriverCardFile(file)
+	if err != nil {
+		t.Fatalf("UnparseDriverCardFile failed: %v", err)
+	}
+	data, err := MarshalOptions{}.MarshalDriverCardFile(file)
+	if err != nil {
+		t.Fatalf("MarshalDriverCardFile failed: %v", err)
+	}
+	rawData, err := MarshalOptions{}.MarshalRawCardFile(rawFile)
+	if err != nil {
+		t.Fatalf("MarshalRawCardFile failed: %v", err)
+	}
+	if !bytes.Equal(data, rawData) {
+		t.Errorf("MarshalDriverCardFile = %x, want %x", data, rawData)
+	}
+
+	gotRawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+	got, err := ParseOptions{}.ParseRawDriverCardFile(gotRawFile)
+	if err != nil {
+		t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+	}
+	if diff := cmp.Diff(appIdV2, got.GetTachographG2().GetApplicationIdentificationV2(), protocmp.Transform()); diff != "" {
+		t.Errorf("EF_APPLICATION_IDENTIFICATION_V2 round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMarshalDriverCardFile_matchesUnparse(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/records/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			data := readRecordsAsCardFile(t, dir)
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(data)
+			if err != nil {
+				t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+			}
+			file, err := ParseOptions{}.ParseRawDriverCardFile(rawFile)
+			if err != nil {
+				t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+			}
+
+			unparsed, err := UnparseDriverCardFile(file)
+			if err != nil {
+				t.Fatalf("UnparseDriverCardFile failed: %v", err)
+			}
+			want, err := MarshalOptions{}.MarshalRawCardFile(unparsed)
+			if err != nil {
+				t.Fatalf("MarshalRawCardFile failed: %v", err)
+			}
+			got, err := MarshalOptions{}.MarshalDriverCardFile(file)
+			if err != nil {
+				t.Fatalf("MarshalDriverCardFile failed: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("MarshalDriverCardFile and UnparseDriverCardFile differ: got %d bytes, want %d bytes", len(got), len(want))
+			}
+			size, err := MarshalOptions{}.EstimateSize(file)
+			if err != nil {
+				t.Fatalf("EstimateSize failed: %v", err)
+			}
+			if size != len(got) {
+				t.Errorf("EstimateSize = %d, want %d", size, len(got))
+			}
+
+			gotRawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(got)
+			if err != nil {
+				t.Fatalf("UnmarshalRawCardFile(marshalled) failed: %v", err)
+			}
+			reparsed, err := ParseOptions{}.ParseRawDriverCardFile(gotRawFile)
+			if err != nil {
+				t.Fatalf("ParseRawDriverCardFile(marshalled) failed: %v", err)
+			}
+			if !proto.Equal(file, reparsed) {
+				t.Errorf("round-trip mismatch (-want +got):\n%s", cmp.Diff(file, reparsed, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestEstimateSize_anonymized(t *testing.T) {
+	// Anonymization clears the raw data of most EFs, so that their sizes are
+	// computed from their record counts rather than their raw data.
+	dirs, err := filepath.Glob("testdata/records/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(readRecordsAsCardFile(t, dir))
+			if err != nil {
+				t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+			}
+			file, err := ParseOptions{}.ParseRawDriverCardFile(rawFile)
+			if err != nil {
+				t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+			}
+			anonymized, err := AnonymizeOptions{}.AnonymizeDriverCardFile(file)
+			if err != nil {
+				t.Fatalf("AnonymizeDriverCardFile failed: %v", err)
+			}
+			data, err := MarshalOptions{}.MarshalDriverCardFile(anonymized)
+			if err != nil {
+				t.Fatalf("MarshalDriverCardFile failed: %v", err)
+			}
+			size, err := MarshalOptions{}.EstimateSize(anonymized)
+			if err != nil {
+				t.Fatalf("EstimateSize failed: %v", err)
+			}
+			if size != len(data) {
+				t.Errorf("EstimateSize = %d, want %d", size, len(data))
+			}
+		})
+	}
+}
+
+// readRecordsAsCardFile reassembles the TLV records extracted from a card into
+// the card file they were extracted from, in their original order.
+func readRecordsAsCardFile(t *testing.T, dir string) []byte {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.hexdump"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for _, path := range paths {
+		// Example: "003-EF_IDENTIFICATION-GENERATION_1-DATA.hexdump"
+		parts := strings.Split(strings.TrimSuffix(filepath.Base(path), ".hexdump"), "-")
+		if len(parts) != 4 {
+			t.Fatalf("unexpected record file name: %s", path)
+		}
+		fid, ok := getFileId(cardv1.ElementaryFileType(cardv1.ElementaryFileType_value[parts[1]]))
+		if !ok {
+			t.Fatalf("no FID for %s", parts[1])
+		}
+		var appendix byte
+		if parts[2] == ddv1.Generation_GENERATION_2.String() {
+			appendix |= 0x02
+		}
+		if parts[3] == cardv1.ContentType_SIGNATURE.String() {
+			appendix |= 0x01
+		}
+		value, err := readHexdump(path)
+		if err != nil {
+			t.Fatalf("Failed to read hexdump: %v", err)
+		}
+		data = binary.BigEndian.AppendUint16(data, fid)
+		data = append(data, appendix)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(value)))
+		data = append(data, value...)
+	}
+	return data
+}
+
+func TestParseRawDriverCardFile_parseError(t *testing.T) {
+	// EF_ICC with a truncated CardIccIdentification.
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile([]byte{0x00, 0x02, 0x00, 0x00, 0x02, 0x01, 0x02})
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+	_, err = ParseOptions{}.ParseRawDriverCardFile(rawFile)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ParseRawDriverCardFile error = %v, want io.ErrUnexpectedEOF", err)
+	}
+	var parseErr *dd.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("ParseRawDriverCardFile error = %v, want a *dd.ParseError", err)
+	}
+	if parseErr.Name != "EF_ICC" || parseErr.Generation != ddv1.Generation_GENERATION_1 || parseErr.FileOffset != 0 {
+		t.Errorf("ParseError = {%s, %v, %d}, want {EF_ICC, GENERATION_1, 0}", parseErr.Name, parseErr.Generation, parseErr.FileOffset)
+	}
+}
+
+func TestParseRawDriverCardFile_keepUnknownEFs(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/records/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) == 0 {
+		t.Skip("no card records in testdata")
+	}
+	// A proprietary EF with FID '0F00'h, followed by its signature.
+	unknown := []byte{
+		0x0F, 0x00, 0x02, 0x00, 0x03, 0x01, 0x02, 0x03,
+		0x0F, 0x00, 0x03, 0x00, 0x02, 0xAA, 0xBB,
+	}
+	data := append(readRecordsAsCardFile(t, dirs[0]), unknown...)
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+
+	file, err := ParseOptions{PreserveRawData: true}.ParseRawDriverCardFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+	}
+	if got := len(file.GetUnknownRecords()); got != 0 {
+		t.Errorf("without KeepUnknownEFs: got %d unknown records, want 0", got)
+	}
+
+	file, err = ParseOptions{PreserveRawData: true, KeepUnknownEFs: true}.ParseRawDriverCardFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+	}
+	if got := len(file.GetUnknownRecords()); got != 2 {
+		t.Fatalf("with KeepUnknownEFs: got %d unknown records, want 2", got)
+	}
+	got, err := MarshalOptions{}.MarshalDriverCardFile(file)
+	if err != nil {
+		t.Fatalf("MarshalDriverCardFile failed: %v", err)
+	}
+	if !bytes.HasSuffix(got, unknown) {
+		t.Errorf("MarshalDriverCardFile output does not end with the unknown EF records")
+	}
+}
+
+func TestMarshalDriverCardFile_unknownEFsInPlace(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/records/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) == 0 {
+		t.Skip("no card records in testdata")
+	}
+	// A proprietary EF with FID '0F00'h, followed by its signature, between
+	// the first and second recognized EFs.
+	unknown := []byte{
+		0x0F, 0x00, 0x02, 0x00, 0x03, 0x01, 0x02, 0x03,
+		0x0F, 0x00, 0x03, 0x00, 0x02, 0xAA, 0xBB,
+	}
+	original := readRecordsAsCardFile(t, dirs[0])
+	offset, err := tlvRecordSize(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append(append(append([]byte(nil), original[:offset]...), unknown...), original[offset:]...)
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+	file, err := ParseOptions{PreserveRawData: true, KeepUnknownEFs: true}.ParseRawDriverCardFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+	}
+	got, err := MarshalOptions{MarshalOptions: dd.MarshalOptions{UseRawData: true}}.MarshalDriverCardFile(file)
+	if err != nil {
+		t.Fatalf("MarshalDriverCardFile failed: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		if i := bytes.Index(got, unknown); i != offset {
+			t.Errorf("unknown EF records written at offset %d, want %d", i, offset)
+		} else {
+			t.Errorf("MarshalDriverCardFile output differs from the input")
+		}
+	}
+}
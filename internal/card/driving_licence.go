@@ -1,8 +1,8 @@
 package card
 
 import (
-	"errors"
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 
@@ -27,14 +27,14 @@ func (opts UnmarshalOptions) unmarshalDrivingLicenceInfo(data []byte) (*cardv1.D
 	)
 
 	if len(data) < lenCardDrivingLicenceInformation {
-		return nil, errors.New("not enough data for DrivingLicenceInfo")
+		return nil, fmt.Errorf("not enough data for DrivingLicenceInfo: %w", io.ErrUnexpectedEOF)
 	}
 	var dli cardv1.DrivingLicenceInfo
 	offset := 0
 
 	// Read driving licence issuing authority (36 bytes)
 	if offset+36 > len(data) {
-		return nil, fmt.Errorf("insufficient data for driving licence issuing authority")
+		return nil, fmt.Errorf("insufficient data for driving licence issuing authority: %w", io.ErrUnexpectedEOF)
 	}
 	authority, err := opts.UnmarshalStringValue(data[offset : offset+36])
 	if err != nil {
@@ -45,9 +45,9 @@ func (opts UnmarshalOptions) unmarshalDrivingLicenceInfo(data []byte) (*cardv1.D
 
 	// Read driving licence issuing nation (1 byte)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for driving licence issuing nation")
+		return nil, fmt.Errorf("insufficient data for driving licence issuing nation: %w", io.ErrUnexpectedEOF)
 	}
-	if nation, err := dd.UnmarshalEnum[ddv1.NationNumeric](data[offset]); err == nil {
+	if nation, err := dd.UnmarshalEnumOrReport[ddv1.NationNumeric](opts.UnmarshalOptions, "CardDrivingLicenceInformation.drivingLicenceIssuingNation", data[offset]); err == nil {
 		dli.SetDrivingLicenceIssuingNation(nation)
 	} else {
 		// Value not recognized - set UNRECOGNIZED (no unrecognized field for this type)
@@ -57,7 +57,7 @@ func (opts UnmarshalOptions) unmarshalDrivingLicenceInfo(data []byte) (*cardv1.D
 
 	// Read driving licence number (16 bytes)
 	if offset+16 > len(data) {
-		return nil, fmt.Errorf("insufficient data for driving licence number")
+		return nil, fmt.Errorf("insufficient data for driving licence number: %w", io.ErrUnexpectedEOF)
 	}
 	licenceNumber, err := opts.UnmarshalIa5StringValue(data[offset : offset+16])
 	if err != nil {
@@ -119,10 +119,7 @@ func (opts AnonymizeOptions) anonymizeDrivingLicenceInfo(dli *cardv1.DrivingLice
 	anonymized := &cardv1.DrivingLicenceInfo{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize issuing authority
 	if dli.GetDrivingLicenceIssuingAuthority() != nil {
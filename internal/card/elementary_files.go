@@ -0,0 +1,88 @@
+package card
+
+import (
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// RangeElementaryFiles calls fn for each EF present in a driver card file.
+//
+// The common EFs (EF_ICC and EF_IC) are reported first, as Generation 1,
+// followed by the EFs of the Tachograph DF (Generation 1) and the EFs of the
+// Tachograph_G2 DF (Generation 2), in download order.
+//
+// The msg passed to fn is the parsed EF message, such as *cardv1.EventsData,
+// and signature is the signature stored with the EF, or nil if it has none.
+func RangeElementaryFiles(
+	file *cardv1.DriverCardFile,
+	fn func(ef cardv1.ElementaryFileType, gen ddv1.Generation, msg proto.Message, signature []byte),
+) {
+	visit := func(ef cardv1.ElementaryFileType, gen ddv1.Generation, msg proto.Message, present bool) {
+		if !present {
+			return
+		}
+		var signature []byte
+		if signed, ok := msg.(interface{ GetSignature() []byte }); ok {
+			signature = signed.GetSignature()
+		}
+		fn(ef, gen, msg, signature)
+	}
+
+	const (
+		gen1 = ddv1.Generation_GENERATION_1
+		gen2 = ddv1.Generation_GENERATION_2
+	)
+
+	// Common EFs
+	visit(cardv1.ElementaryFileType_EF_ICC, gen1, file.GetIcc(), file.HasIcc())
+	visit(cardv1.ElementaryFileType_EF_IC, gen1, file.GetIc(), file.HasIc())
+
+	// Tachograph DF
+	if df := file.GetTachograph(); df != nil {
+		visit(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION, gen1, df.GetApplicationIdentification(), df.HasApplicationIdentification())
+		visit(cardv1.ElementaryFileType_EF_CARD_CERTIFICATE, gen1, df.GetCardCertificate(), df.HasCardCertificate())
+		visit(cardv1.ElementaryFileType_EF_CA_CERTIFICATE, gen1, df.GetCaCertificate(), df.HasCaCertificate())
+		visit(cardv1.ElementaryFileType_EF_IDENTIFICATION, gen1, df.GetIdentification(), df.HasIdentification())
+		visit(cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER, gen1, df.GetCardDownload(), df.HasCardDownload())
+		visit(cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO, gen1, df.GetDrivingLicenceInfo(), df.HasDrivingLicenceInfo())
+		visit(cardv1.ElementaryFileType_EF_EVENTS_DATA, gen1, df.GetEventsData(), df.HasEventsData())
+		visit(cardv1.ElementaryFileType_EF_FAULTS_DATA, gen1, df.GetFaultsData(), df.HasFaultsData())
+		visit(cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA, gen1, df.GetDriverActivityData(), df.HasDriverActivityData())
+		visit(cardv1.ElementaryFileType_EF_VEHICLES_USED, gen1, df.GetVehiclesUsed(), df.HasVehiclesUsed())
+		visit(cardv1.ElementaryFileType_EF_PLACES, gen1, df.GetPlaces(), df.HasPlaces())
+		visit(cardv1.ElementaryFileType_EF_CURRENT_USAGE, gen1, df.GetCurrentUsage(), df.HasCurrentUsage())
+		visit(cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA, gen1, df.GetControlActivityData(), df.HasControlActivityData())
+		visit(cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS, gen1, df.GetSpecificConditions(), df.HasSpecificConditions())
+	}
+
+	// Tachograph_G2 DF
+	if df := file.GetTachographG2(); df != nil {
+		visit(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION, gen2, df.GetApplicationIdentification(), df.HasApplicationIdentification())
+		visit(cardv1.ElementaryFileType_EF_CARD_MA_CERTIFICATE, gen2, df.GetCardMaCertificate(), df.HasCardMaCertificate())
+		visit(cardv1.ElementaryFileType_EF_CARD_SIGN_CERTIFICATE, gen2, df.GetCardSignCertificate(), df.HasCardSignCertificate())
+		visit(cardv1.ElementaryFileType_EF_CA_CERTIFICATE, gen2, df.GetCaCertificate(), df.HasCaCertificate())
+		visit(cardv1.ElementaryFileType_EF_LINK_CERTIFICATE, gen2, df.GetLinkCertificate(), df.HasLinkCertificate())
+		visit(cardv1.ElementaryFileType_EF_IDENTIFICATION, gen2, df.GetIdentification(), df.HasIdentification())
+		visit(cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER, gen2, df.GetCardDownload(), df.HasCardDownload())
+		visit(cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO, gen2, df.GetDrivingLicenceInfo(), df.HasDrivingLicenceInfo())
+		visit(cardv1.ElementaryFileType_EF_EVENTS_DATA, gen2, df.GetEventsData(), df.HasEventsData())
+		visit(cardv1.ElementaryFileType_EF_FAULTS_DATA, gen2, df.GetFaultsData(), df.HasFaultsData())
+		visit(cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA, gen2, df.GetDriverActivityData(), df.HasDriverActivityData())
+		visit(cardv1.ElementaryFileType_EF_VEHICLES_USED, gen2, df.GetVehiclesUsed(), df.HasVehiclesUsed())
+		visit(cardv1.ElementaryFileType_EF_PLACES, gen2, df.GetPlaces(), df.HasPlaces())
+		visit(cardv1.ElementaryFileType_EF_CURRENT_USAGE, gen2, df.GetCurrentUsage(), df.HasCurrentUsage())
+		visit(cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA, gen2, df.GetControlActivityData(), df.HasControlActivityData())
+		visit(cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS, gen2, df.GetSpecificConditions(), df.HasSpecificConditions())
+		visit(cardv1.ElementaryFileType_EF_VEHICLE_UNITS_USED, gen2, df.GetVehicleUnitsUsed(), df.HasVehicleUnitsUsed())
+		visit(cardv1.ElementaryFileType_EF_GNSS_PLACES, gen2, df.GetGnssPlaces(), df.HasGnssPlaces())
+		visit(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION_V2, gen2, df.GetApplicationIdentificationV2(), df.HasApplicationIdentificationV2())
+		visit(cardv1.ElementaryFileType_EF_PLACES_AUTHENTICATION, gen2, df.GetPlacesAuthentication(), df.HasPlacesAuthentication())
+		visit(cardv1.ElementaryFileType_EF_GNSS_PLACES_AUTHENTICATION, gen2, df.GetGnssPlacesAuthentication(), df.HasGnssPlacesAuthentication())
+		visit(cardv1.ElementaryFileType_EF_BORDER_CROSSINGS, gen2, df.GetBorderCrossings(), df.HasBorderCrossings())
+		visit(cardv1.ElementaryFileType_EF_LOAD_UNLOAD_OPERATIONS, gen2, df.GetLoadUnloadOperations(), df.HasLoadUnloadOperations())
+		visit(cardv1.ElementaryFileType_EF_LOAD_TYPE_ENTRIES, gen2, df.GetLoadTypeEntries(), df.HasLoadTypeEntries())
+		visit(cardv1.ElementaryFileType_EF_COMPANY_ACTIVITY_DATA, gen2, df.GetCompanyActivityData(), df.HasCompanyActivityData())
+		visit(cardv1.ElementaryFileType_EF_VU_CONFIGURATION, gen2, df.GetVuConfiguration(), df.HasVuConfiguration())
+	}
+}
@@ -0,0 +1,47 @@
+package card
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestRangeElementaryFiles(t *testing.T) {
+	events := &cardv1.EventsData{}
+	events.SetSignature([]byte{0xAA})
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetEventsData(events)
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetEventsData(&cardv1.EventsData{})
+	tachographG2.SetBorderCrossings(&cardv1.BorderCrossings{})
+	file := &cardv1.DriverCardFile{}
+	file.SetIcc(&cardv1.Icc{})
+	file.SetTachograph(tachograph)
+	file.SetTachographG2(tachographG2)
+
+	type visit struct {
+		EF         cardv1.ElementaryFileType
+		Generation ddv1.Generation
+		Signature  []byte
+	}
+	var got []visit
+	RangeElementaryFiles(file, func(ef cardv1.ElementaryFileType, gen ddv1.Generation, msg proto.Message, signature []byte) {
+		if msg == nil {
+			t.Errorf("nil message for %v (%v)", ef, gen)
+		}
+		got = append(got, visit{EF: ef, Generation: gen, Signature: signature})
+	})
+	want := []visit{
+		{EF: cardv1.ElementaryFileType_EF_ICC, Generation: ddv1.Generation_GENERATION_1},
+		{EF: cardv1.ElementaryFileType_EF_EVENTS_DATA, Generation: ddv1.Generation_GENERATION_1, Signature: []byte{0xAA}},
+		{EF: cardv1.ElementaryFileType_EF_EVENTS_DATA, Generation: ddv1.Generation_GENERATION_2},
+		{EF: cardv1.ElementaryFileType_EF_BORDER_CROSSINGS, Generation: ddv1.Generation_GENERATION_2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RangeElementaryFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
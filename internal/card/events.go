@@ -1,10 +1,9 @@
 package card
 
 import (
-	"bufio"
-	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 
@@ -32,29 +31,20 @@ const (
 	cardEventRecordSize = 24
 )
 
-// splitCardEventRecord returns a SplitFunc that splits data into 24-byte event records
-func splitCardEventRecord(data []byte, atEOF bool) (advance int, token []byte, err error) {
-	if len(data) < cardEventRecordSize {
-		if atEOF {
-			return 0, nil, nil // No more complete records, but not an error
-		}
-		return 0, nil, nil // Need more data
-	}
-
-	return cardEventRecordSize, data[:cardEventRecordSize], nil
-}
-
 func (opts UnmarshalOptions) unmarshalEventsData(data []byte) (*cardv1.EventsData, error) {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Split(splitCardEventRecord)
+	// A partial record at the end of the data is an error in strict mode, and
+	// is dropped otherwise.
+	if partial := len(data) % cardEventRecordSize; partial != 0 && opts.Strict {
+		return nil, fmt.Errorf("partial event record: got %d bytes, want %d: %w", partial, cardEventRecordSize, io.ErrUnexpectedEOF)
+	}
 
 	var records []*cardv1.EventsData_Record
-	for scanner.Scan() {
-		recordData := scanner.Bytes()
+	for offset := 0; offset+cardEventRecordSize <= len(data); offset += cardEventRecordSize {
+		recordData := data[offset : offset+cardEventRecordSize]
 		// Check if this is a valid record by examining the event begin time (first 4 bytes after event type)
 		// Event type is 1 byte, so event begin time starts at byte 1
 		eventBeginTime := binary.BigEndian.Uint32(recordData[1:5])
-		if eventBeginTime == 0 {
+		if eventBeginTime == 0 || isEmptyRecord(recordData) {
 			// Non-valid record: preserve original bytes
 			rec := &cardv1.EventsData_Record{}
 			rec.SetValid(false)
@@ -71,10 +61,6 @@ func (opts UnmarshalOptions) unmarshalEventsData(data []byte) (*cardv1.EventsDat
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-
 	// Use simplified schema with single events array in chronological order
 	var ed cardv1.EventsData
 	ed.SetEvents(records)
@@ -103,7 +89,7 @@ func (opts UnmarshalOptions) unmarshalEventRecord(data []byte) (*cardv1.EventsDa
 	)
 
 	if len(data) < lenCardEventRecord {
-		return nil, fmt.Errorf("insufficient data for event record: got %d bytes, need %d", len(data), lenCardEventRecord)
+		return nil, fmt.Errorf("insufficient data for event record: got %d bytes, need %d: %w", len(data), lenCardEventRecord, io.ErrUnexpectedEOF)
 	}
 
 	var rec cardv1.EventsData_Record
@@ -111,9 +97,9 @@ func (opts UnmarshalOptions) unmarshalEventRecord(data []byte) (*cardv1.EventsDa
 
 	// Read event type (1 byte) and convert using generic enum helper
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for event type")
+		return nil, fmt.Errorf("insufficient data for event type: %w", io.ErrUnexpectedEOF)
 	}
-	if eventTypeEnum, err := dd.UnmarshalEnum[ddv1.EventFaultType](data[offset]); err == nil {
+	if eventTypeEnum, err := dd.UnmarshalEnumOrReport[ddv1.EventFaultType](opts.UnmarshalOptions, "CardEventRecord.eventType", data[offset]); err == nil {
 		rec.SetEventType(eventTypeEnum)
 	} else {
 		return nil, fmt.Errorf("invalid event type: %w", err)
@@ -122,7 +108,7 @@ func (opts UnmarshalOptions) unmarshalEventRecord(data []byte) (*cardv1.EventsDa
 
 	// Read event begin time (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for event begin time")
+		return nil, fmt.Errorf("insufficient data for event begin time: %w", io.ErrUnexpectedEOF)
 	}
 	eventBeginTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -133,7 +119,7 @@ func (opts UnmarshalOptions) unmarshalEventRecord(data []byte) (*cardv1.EventsDa
 
 	// Read event end time (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for event end time")
+		return nil, fmt.Errorf("insufficient data for event end time: %w", io.ErrUnexpectedEOF)
 	}
 	eventEndTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -144,7 +130,7 @@ func (opts UnmarshalOptions) unmarshalEventRecord(data []byte) (*cardv1.EventsDa
 
 	// Read vehicle registration (15 bytes: 1 byte nation + 14 bytes number)
 	if offset+15 > len(data) {
-		return nil, fmt.Errorf("insufficient data for vehicle registration")
+		return nil, fmt.Errorf("insufficient data for vehicle registration: %w", io.ErrUnexpectedEOF)
 	}
 	vehicleReg, err := opts.UnmarshalVehicleRegistration(data[offset : offset+15])
 	if err != nil {
@@ -244,10 +230,7 @@ func (opts AnonymizeOptions) anonymizeEventsData(events *cardv1.EventsData) *car
 	anonymized := &cardv1.EventsData{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Base timestamp for anonymization: 2020-01-01 00:00:00 UTC (epoch: 1577836800)
 	baseEpoch := int64(1577836800)
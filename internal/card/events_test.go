@@ -1,6 +1,9 @@
 package card
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"strings"
 	"testing"
 
@@ -111,3 +114,74 @@ func TestEvents_Generation2(t *testing.T) {
 		})
 	}
 }
+
+func TestEvents_EmptySlots(t *testing.T) {
+	event := []byte{
+		0x02,                   // eventType
+		0x5E, 0x0C, 0x5A, 0x80, // eventBeginTime
+		0x5E, 0x0C, 0x68, 0x90, // eventEndTime
+		0x11, 0x01, 'T', 'E', 'S', 'T', '-', 'V', 'R', 'N', ' ', ' ', ' ', ' ', ' ', // eventVehicleRegistration
+	}
+	var data []byte
+	data = append(data, event...)
+	data = append(data, bytes.Repeat([]byte{0x00}, cardEventRecordSize)...)
+	data = append(data, bytes.Repeat([]byte{0xFF}, cardEventRecordSize)...)
+
+	events, err := UnmarshalOptions{}.unmarshalEventsData(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	var gotValid []bool
+	for _, record := range events.GetEvents() {
+		gotValid = append(gotValid, record.GetValid())
+	}
+	if diff := cmp.Diff([]bool{true, false, false}, gotValid); diff != "" {
+		t.Errorf("valid flags mismatch (-want +got):\n%s", diff)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalEventsData(events)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEvents_ManyEmptySlots(t *testing.T) {
+	// Enough empty slots to span several reads of a buffered reader, each
+	// with a distinct event type to tell the preserved raw data apart.
+	var data []byte
+	for i := range 256 {
+		data = append(data, byte(i))
+		data = append(data, make([]byte, cardEventRecordSize-1)...)
+	}
+	events, err := UnmarshalOptions{}.unmarshalEventsData(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	marshaled, err := MarshalOptions{}.MarshalEventsData(events)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEvents_TruncatedRecord(t *testing.T) {
+	data := append(bytes.Repeat([]byte{0x00}, cardEventRecordSize), 0x02, 0x5E, 0x0C)
+
+	_, err := UnmarshalOptions{Strict: true}.unmarshalEventsData(data)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("strict unmarshal error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+
+	events, err := UnmarshalOptions{}.unmarshalEventsData(data)
+	if err != nil {
+		t.Fatalf("non-strict unmarshal failed: %v", err)
+	}
+	if n := len(events.GetEvents()); n != 1 {
+		t.Errorf("got %d events, want 1", n)
+	}
+}
@@ -1,10 +1,9 @@
 package card
 
 import (
-	"bufio"
-	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 
@@ -32,32 +31,23 @@ const (
 	cardFaultRecordSize = 24
 )
 
-// splitCardFaultRecord returns a SplitFunc that splits data into 24-byte fault records
-func splitCardFaultRecord(data []byte, atEOF bool) (advance int, token []byte, err error) {
-	if len(data) < cardFaultRecordSize {
-		if atEOF {
-			return 0, nil, nil // No more complete records, but not an error
-		}
-		return 0, nil, nil // Need more data
-	}
-
-	return cardFaultRecordSize, data[:cardFaultRecordSize], nil
-}
-
 func (opts UnmarshalOptions) unmarshalFaultsData(data []byte) (*cardv1.FaultsData, error) {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Split(splitCardFaultRecord)
+	// A partial record at the end of the data is an error in strict mode, and
+	// is dropped otherwise.
+	if partial := len(data) % cardFaultRecordSize; partial != 0 && opts.Strict {
+		return nil, fmt.Errorf("partial fault record: got %d bytes, want %d: %w", partial, cardFaultRecordSize, io.ErrUnexpectedEOF)
+	}
 
 	var records []*cardv1.FaultsData_Record
-	for scanner.Scan() {
-		recordData := scanner.Bytes()
+	for offset := 0; offset+cardFaultRecordSize <= len(data); offset += cardFaultRecordSize {
+		recordData := data[offset : offset+cardFaultRecordSize]
 		// Check if this is a valid record by examining the fault begin time (first 4 bytes after fault type)
 		// Fault type is 1 byte, so fault begin time starts at byte 1
 		faultBeginTime := binary.BigEndian.Uint32(recordData[1:5])
 
 		rec := &cardv1.FaultsData_Record{}
 
-		if faultBeginTime == 0 {
+		if faultBeginTime == 0 || isEmptyRecord(recordData) {
 			// Non-valid record: preserve original bytes
 			rec.SetValid(false)
 			rec.SetRawData(recordData)
@@ -72,10 +62,6 @@ func (opts UnmarshalOptions) unmarshalFaultsData(data []byte) (*cardv1.FaultsDat
 		records = append(records, rec)
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
-	}
-
 	// Use simplified schema with single faults array in chronological order
 	var fd cardv1.FaultsData
 	fd.SetFaults(records)
@@ -104,16 +90,16 @@ func (opts UnmarshalOptions) unmarshalFaultRecord(data []byte, rec *cardv1.Fault
 	)
 
 	if len(data) < lenCardFaultRecord {
-		return fmt.Errorf("insufficient data for fault record: got %d bytes, need %d", len(data), lenCardFaultRecord)
+		return fmt.Errorf("insufficient data for fault record: got %d bytes, need %d: %w", len(data), lenCardFaultRecord, io.ErrUnexpectedEOF)
 	}
 
 	offset := 0
 
 	// Read fault type (1 byte) and convert using generic enum helper
 	if offset+1 > len(data) {
-		return fmt.Errorf("insufficient data for fault type")
+		return fmt.Errorf("insufficient data for fault type: %w", io.ErrUnexpectedEOF)
 	}
-	if faultTypeEnum, err := dd.UnmarshalEnum[ddv1.EventFaultType](data[offset]); err == nil {
+	if faultTypeEnum, err := dd.UnmarshalEnumOrReport[ddv1.EventFaultType](opts.UnmarshalOptions, "CardFaultRecord.faultType", data[offset]); err == nil {
 		rec.SetFaultType(faultTypeEnum)
 	} else {
 		return fmt.Errorf("invalid fault type: %w", err)
@@ -122,7 +108,7 @@ func (opts UnmarshalOptions) unmarshalFaultRecord(data []byte, rec *cardv1.Fault
 
 	// Read fault begin time (4 bytes)
 	if offset+4 > len(data) {
-		return fmt.Errorf("insufficient data for fault begin time")
+		return fmt.Errorf("insufficient data for fault begin time: %w", io.ErrUnexpectedEOF)
 	}
 	faultBeginTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -133,7 +119,7 @@ func (opts UnmarshalOptions) unmarshalFaultRecord(data []byte, rec *cardv1.Fault
 
 	// Read fault end time (4 bytes)
 	if offset+4 > len(data) {
-		return fmt.Errorf("insufficient data for fault end time")
+		return fmt.Errorf("insufficient data for fault end time: %w", io.ErrUnexpectedEOF)
 	}
 	faultEndTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -144,7 +130,7 @@ func (opts UnmarshalOptions) unmarshalFaultRecord(data []byte, rec *cardv1.Fault
 
 	// Read vehicle registration (15 bytes: 1 byte nation + 14 bytes number)
 	if offset+15 > len(data) {
-		return fmt.Errorf("insufficient data for vehicle registration")
+		return fmt.Errorf("insufficient data for vehicle registration: %w", io.ErrUnexpectedEOF)
 	}
 	vehicleReg, err := opts.UnmarshalVehicleRegistration(data[offset : offset+15])
 	if err != nil {
@@ -244,10 +230,7 @@ func (opts AnonymizeOptions) anonymizeFaultsData(faults *cardv1.FaultsData) *car
 	anonymized := &cardv1.FaultsData{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Base timestamp for anonymization: 2020-01-01 00:00:00 UTC (epoch: 1577836800)
 	baseEpoch := int64(1577836800)
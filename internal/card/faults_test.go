@@ -1,6 +1,7 @@
 package card
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -111,3 +112,36 @@ func TestFaults_Generation2(t *testing.T) {
 		})
 	}
 }
+
+func TestFaults_EmptySlots(t *testing.T) {
+	fault := []byte{
+		0x31,                   // faultType
+		0x5E, 0x0C, 0x5A, 0x80, // faultBeginTime
+		0x5E, 0x0C, 0x68, 0x90, // faultEndTime
+		0x11, 0x01, 'T', 'E', 'S', 'T', '-', 'V', 'R', 'N', ' ', ' ', ' ', ' ', ' ', // faultVehicleRegistration
+	}
+	var data []byte
+	data = append(data, bytes.Repeat([]byte{0xFF}, cardFaultRecordSize)...)
+	data = append(data, fault...)
+	data = append(data, bytes.Repeat([]byte{0x00}, cardFaultRecordSize)...)
+
+	faults, err := UnmarshalOptions{}.unmarshalFaultsData(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	var gotValid []bool
+	for _, record := range faults.GetFaults() {
+		gotValid = append(gotValid, record.GetValid())
+	}
+	if diff := cmp.Diff([]bool{false, true, false}, gotValid); diff != "" {
+		t.Errorf("valid flags mismatch (-want +got):\n%s", diff)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalFaultsData(faults)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -1,12 +1,10 @@
 package card
 
 import (
-	"bufio"
-	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
-	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -58,7 +56,7 @@ func (opts UnmarshalOptions) unmarshalGnssPlaces(data []byte) (*cardv1.GnssPlace
 	newestRecordIndex := binary.BigEndian.Uint16(data[idxNewestRecordIndex:])
 	target.SetNewestRecordIndex(int32(newestRecordIndex))
 
-	// Parse records using bufio.Scanner pattern
+	// Parse records
 	records, err := opts.unmarshalGNSSAccumulatedDrivingRecords(data[lenNewestRecordIndex:])
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse GNSS accumulated driving records: %w", err)
@@ -68,38 +66,25 @@ func (opts UnmarshalOptions) unmarshalGnssPlaces(data []byte) (*cardv1.GnssPlace
 	return &target, nil
 }
 
-// splitGNSSAccumulatedDrivingRecord is a bufio.SplitFunc for parsing GNSSAccumulatedDrivingRecord entries.
-func splitGNSSAccumulatedDrivingRecord(data []byte, atEOF bool) (advance int, token []byte, err error) {
+// unmarshalGNSSAccumulatedDrivingRecords parses the fixed-size array of GNSS accumulated driving records.
+// A partial record at the end of the data is an error in strict mode, and is
+// dropped otherwise.
+func (opts UnmarshalOptions) unmarshalGNSSAccumulatedDrivingRecords(data []byte) ([]*cardv1.GnssPlaces_Record, error) {
 	const lenGNSSAccumulatedDrivingRecord = 18
 
-	if len(data) < lenGNSSAccumulatedDrivingRecord {
-		if atEOF {
-			return 0, nil, nil
-		}
-		return 0, nil, nil
+	if partial := len(data) % lenGNSSAccumulatedDrivingRecord; partial != 0 && opts.Strict {
+		return nil, fmt.Errorf("partial GNSS accumulated driving record: got %d bytes, want %d: %w", partial, lenGNSSAccumulatedDrivingRecord, io.ErrUnexpectedEOF)
 	}
 
-	return lenGNSSAccumulatedDrivingRecord, data[:lenGNSSAccumulatedDrivingRecord], nil
-}
-
-// unmarshalGNSSAccumulatedDrivingRecords parses the fixed-size array of GNSS accumulated driving records.
-func (opts UnmarshalOptions) unmarshalGNSSAccumulatedDrivingRecords(data []byte) ([]*cardv1.GnssPlaces_Record, error) {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Split(splitGNSSAccumulatedDrivingRecord)
-
 	var records []*cardv1.GnssPlaces_Record
-	for scanner.Scan() {
-		record, err := opts.unmarshalGNSSAccumulatedDrivingRecord(scanner.Bytes())
+	for offset := 0; offset+lenGNSSAccumulatedDrivingRecord <= len(data); offset += lenGNSSAccumulatedDrivingRecord {
+		record, err := opts.unmarshalGNSSAccumulatedDrivingRecord(data[offset : offset+lenGNSSAccumulatedDrivingRecord])
 		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal GNSS accumulated driving record: %w", err)
 		}
 		records = append(records, record)
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("scanner error: %w", err)
-	}
-
 	return records, nil
 }
 
@@ -274,10 +259,7 @@ func (opts AnonymizeOptions) anonymizeGNSSAccumulatedDrivingRecord(record *cardv
 	result := &cardv1.GnssPlaces_Record{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Replace outer timestamp with sequential test timestamps
 	// Base: 2020-01-01 00:00:00 UTC (epoch: 1577836800)
@@ -293,7 +275,7 @@ func (opts AnonymizeOptions) anonymizeGNSSAccumulatedDrivingRecord(record *cardv
 	}
 	// else: Zero timestamp - leave unset (nil)
 
-	// Anonymize GNSS place record (replaces coordinates with Helsinki)
+	// Anonymize GNSS place record (replaces coordinates with the base location)
 	gnssPlaceRecord := record.GetGnssPlaceRecord()
 	if gnssPlaceRecord != nil {
 		anonymizedGnssPlace := ddOpts.AnonymizeGNSSPlaceRecord(gnssPlaceRecord)
@@ -3,6 +3,7 @@ package card
 import (
 	"bytes"
 	"fmt"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 )
@@ -25,7 +26,7 @@ func (opts UnmarshalOptions) unmarshalIc(data []byte) (*cardv1.Ic, error) {
 	)
 
 	if len(data) < lenCardChipIdentification {
-		return nil, fmt.Errorf("insufficient data for IC identification: got %d bytes, need %d", len(data), lenCardChipIdentification)
+		return nil, fmt.Errorf("insufficient data for IC identification: got %d bytes, need %d: %w", len(data), lenCardChipIdentification, io.ErrUnexpectedEOF)
 	}
 
 	var target cardv1.Ic
@@ -2,8 +2,8 @@ package card
 
 import (
 	"encoding/binary"
-	"errors"
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 
@@ -38,16 +38,16 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 
 	var icc cardv1.Icc
 	if len(data) < lenCardIccIdentification {
-		return nil, errors.New("not enough data for IccIdentification")
+		return nil, fmt.Errorf("not enough data for IccIdentification: %w", io.ErrUnexpectedEOF)
 	}
 	offset := 0
 
 	// Read clock stop (1 byte)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for clock stop")
+		return nil, fmt.Errorf("insufficient data for clock stop: %w", io.ErrUnexpectedEOF)
 	}
 	// Convert clock stop byte to ClockStopMode enum using generic helper
-	if clockStopMode, err := dd.UnmarshalEnum[ddv1.ClockStopMode](data[offset]); err == nil {
+	if clockStopMode, err := dd.UnmarshalEnumOrReport[ddv1.ClockStopMode](opts.UnmarshalOptions, "CardIccIdentification.clockStop", data[offset]); err == nil {
 		icc.SetClockStop(clockStopMode)
 	} else {
 		return nil, fmt.Errorf("invalid clock stop mode: %w", err)
@@ -58,7 +58,7 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 	esn := &ddv1.ExtendedSerialNumber{}
 	// Read the 8-byte extended serial number
 	if offset+lenCardExtendedSerialNumber > len(data) {
-		return nil, fmt.Errorf("insufficient data for card extended serial number")
+		return nil, fmt.Errorf("insufficient data for card extended serial number: %w", io.ErrUnexpectedEOF)
 	}
 	serialBytes := data[offset : offset+lenCardExtendedSerialNumber]
 	offset += lenCardExtendedSerialNumber
@@ -79,7 +79,7 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 
 		// Next byte: equipment type (convert from protocol value using generic helper)
 		if len(serialBytes) > 6 {
-			if equipmentType, err := dd.UnmarshalEnum[ddv1.EquipmentType](serialBytes[6]); err == nil {
+			if equipmentType, err := dd.UnmarshalEnumOrReport[ddv1.EquipmentType](opts.UnmarshalOptions, "ExtendedSerialNumber.type", serialBytes[6]); err == nil {
 				esn.SetType(equipmentType)
 			} else {
 				return nil, fmt.Errorf("invalid equipment type in extended serial number: %w", err)
@@ -95,7 +95,7 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 
 	// Read card approval number (8 bytes)
 	if offset+lenCardApprovalNumber > len(data) {
-		return nil, fmt.Errorf("insufficient data for card approval number")
+		return nil, fmt.Errorf("insufficient data for card approval number: %w", io.ErrUnexpectedEOF)
 	}
 	cardApprovalNumber, err := opts.UnmarshalIa5StringValue(data[offset : offset+lenCardApprovalNumber])
 	if err != nil {
@@ -106,7 +106,7 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 
 	// Read card personaliser ID (1 byte)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for card personaliser ID")
+		return nil, fmt.Errorf("insufficient data for card personaliser ID: %w", io.ErrUnexpectedEOF)
 	}
 	personaliser := data[offset]
 	icc.SetCardPersonaliserId(int32(personaliser))
@@ -114,7 +114,7 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 
 	// Create EmbedderIcAssemblerId structure (5 bytes)
 	if offset+lenEmbedderIcAssemblerId > len(data) {
-		return nil, fmt.Errorf("insufficient data for embedder IC assembler ID")
+		return nil, fmt.Errorf("insufficient data for embedder IC assembler ID: %w", io.ErrUnexpectedEOF)
 	}
 	embedder := data[offset : offset+lenEmbedderIcAssemblerId]
 	offset += lenEmbedderIcAssemblerId
@@ -141,7 +141,7 @@ func (opts UnmarshalOptions) unmarshalIcc(data []byte) (*cardv1.Icc, error) {
 
 	// Read IC identifier (2 bytes)
 	if offset+lenIcIdentifier > len(data) {
-		return nil, fmt.Errorf("insufficient data for IC identifier")
+		return nil, fmt.Errorf("insufficient data for IC identifier: %w", io.ErrUnexpectedEOF)
 	}
 	icIdentifier := data[offset : offset+lenIcIdentifier]
 	// offset += lenIcIdentifier // Not needed as this is the last field
@@ -252,10 +252,7 @@ func (opts AnonymizeOptions) anonymizeIcc(icc *cardv1.Icc) *cardv1.Icc {
 	anonymized := &cardv1.Icc{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Preserve clock stop mode (not sensitive)
 	anonymized.SetClockStop(icc.GetClockStop())
@@ -2,6 +2,7 @@ package card
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 
@@ -19,6 +20,11 @@ import (
 //
 // Total binary size: 143 bytes (fixed)
 //
+// The layout is the same in the Tachograph and Tachograph_G2 DFs: the card
+// structure version is recorded in EF_Application_Identification, not in
+// CardIdentification, so the split between the two structures does not depend
+// on the generation.
+//
 // CardIdentification ASN.1 Specification (Data Dictionary Section 2.24):
 //
 //	CardIdentification ::= SEQUENCE {
@@ -93,7 +99,7 @@ func (opts UnmarshalOptions) unmarshalDriverCardIdentification(data []byte) (*ca
 	// Parse CardIdentification part (65 bytes)
 
 	// Nation (1 byte)
-	if nation, err := dd.UnmarshalEnum[ddv1.NationNumeric](data[idxIssuingMemberState]); err == nil {
+	if nation, err := dd.UnmarshalEnumOrReport[ddv1.NationNumeric](opts.UnmarshalOptions, "CardIdentification.cardIssuingMemberState", data[idxIssuingMemberState]); err == nil {
 		id.SetCardIssuingMemberState(nation)
 	} else {
 		id.SetCardIssuingMemberState(ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED)
@@ -321,6 +327,78 @@ func (opts MarshalOptions) MarshalDriverCardIdentification(id *cardv1.DriverCard
 	return dst, nil
 }
 
+// IssuingMemberState returns the member state that issued a driver card.
+//
+// The value is taken from the cardIssuingMemberState field of the card
+// identification (Data Dictionary, Section 2.24), preferring the Generation 2
+// application when present. Use dd.NationToISO to map it to a country code.
+func IssuingMemberState(file *cardv1.DriverCardFile) ddv1.NationNumeric {
+	if id := file.GetTachographG2().GetIdentification(); id != nil {
+		return id.GetCardIssuingMemberState()
+	}
+	return file.GetTachograph().GetIdentification().GetCardIssuingMemberState()
+}
+
+// CardNumber returns the card number of a driver card: the driver
+// identification number followed by the card replacement and renewal indices.
+//
+// The value is taken from the cardNumber field of the card identification
+// (Data Dictionary, Sections 2.24 and 2.26), preferring the Generation 2
+// application when present.
+func CardNumber(file *cardv1.DriverCardFile) string {
+	driverID := driverIdentification(file)
+	return driverID.GetDriverIdentificationNumber().GetValue() +
+		driverID.GetCardReplacementIndex().GetValue() +
+		driverID.GetCardRenewalIndex().GetValue()
+}
+
+// ReplacementIndex returns the card replacement index of a driver card,
+// incremented each time the card is replaced after loss, theft or malfunction
+// (Data Dictionary, Section 2.31).
+func ReplacementIndex(file *cardv1.DriverCardFile) string {
+	return driverIdentification(file).GetCardReplacementIndex().GetValue()
+}
+
+// RenewalIndex returns the card renewal index of a driver card, incremented
+// each time the card is renewed (Data Dictionary, Section 2.30).
+func RenewalIndex(file *cardv1.DriverCardFile) string {
+	return driverIdentification(file).GetCardRenewalIndex().GetValue()
+}
+
+// DriverIdentification returns the driver identification number of a driver
+// card, without the replacement and renewal indices and trailing padding.
+//
+// The number is the same on every card issued to a driver by a member state,
+// so it identifies the driver across replaced and renewed cards. It is unique
+// within the issuing member state only; combine it with IssuingMemberState for
+// a key across member states.
+func DriverIdentification(file *cardv1.DriverCardFile) string {
+	return strings.TrimSpace(driverIdentification(file).GetDriverIdentificationNumber().GetValue())
+}
+
+// PreferredLanguage returns the preferred language of the card holder of a
+// driver card, such as "en", or an empty string if the card has none.
+//
+// The value is taken from the cardHolderPreferredLanguage field of the card
+// holder identification (Data Dictionary, Section 2.62), preferring the
+// Generation 2 application when present.
+func PreferredLanguage(file *cardv1.DriverCardFile) string {
+	id := file.GetTachographG2().GetIdentification()
+	if id == nil {
+		id = file.GetTachograph().GetIdentification()
+	}
+	return strings.TrimSpace(id.GetCardHolderPreferredLanguage().GetValue())
+}
+
+// driverIdentification returns the driverIdentification of the card number of
+// a driver card, preferring the Generation 2 application when present.
+func driverIdentification(file *cardv1.DriverCardFile) *ddv1.DriverIdentification {
+	if id := file.GetTachographG2().GetIdentification(); id != nil {
+		return id.GetDriverIdentification()
+	}
+	return file.GetTachograph().GetIdentification().GetDriverIdentification()
+}
+
 // anonymizeDriverCardIdentification creates an anonymized copy of DriverCardIdentification,
 // replacing all personally identifiable information with safe, deterministic test values while
 // preserving the structure and validity for testing.
@@ -341,10 +419,7 @@ func (opts AnonymizeOptions) anonymizeDriverCardIdentification(id *cardv1.Driver
 	result := &cardv1.DriverCardIdentification{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Preserve country (structural info)
 	result.SetCardIssuingMemberState(id.GetCardIssuingMemberState())
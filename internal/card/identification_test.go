@@ -1,10 +1,14 @@
 package card
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -113,3 +117,194 @@ func TestIdentification_Generation2(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentification_unparse(t *testing.T) {
+	// EF_Identification has the same 143-byte layout in both generations, so
+	// both DFs must round-trip through unparse and parse unchanged.
+	for _, tt := range []struct {
+		generation  ddv1.Generation
+		hexdumpPath string
+	}{
+		{ddv1.Generation_GENERATION_1, "testdata/records/003-anonymized/003-EF_IDENTIFICATION-GENERATION_1-DATA.hexdump"},
+		{ddv1.Generation_GENERATION_2, "testdata/records/003-anonymized/015-EF_IDENTIFICATION-GENERATION_2-DATA.hexdump"},
+	} {
+		t.Run(tt.generation.String(), func(t *testing.T) {
+			data, err := readHexdump(tt.hexdumpPath)
+			if err != nil {
+				t.Fatalf("Failed to read hexdump: %v", err)
+			}
+			identification, err := UnmarshalOptions{}.unmarshalDriverCardIdentification(data)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			file := &cardv1.DriverCardFile{}
+			if tt.generation == ddv1.Generation_GENERATION_2 {
+				tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+				tachographG2.SetIdentification(identification)
+				file.SetTachographG2(tachographG2)
+			} else {
+				tachograph := &cardv1.DriverCardFile_Tachograph{}
+				tachograph.SetIdentification(identification)
+				file.SetTachograph(tachograph)
+			}
+
+			rawFile, err := UnparseDriverCardFile(file)
+			if err != nil {
+				t.Fatalf("UnparseDriverCardFile failed: %v", err)
+			}
+			if n := len(rawFile.GetRecords()); n != 1 {
+				t.Fatalf("got %d records, want 1", n)
+			}
+			record := rawFile.GetRecords()[0]
+			if record.GetGeneration() != tt.generation {
+				t.Errorf("record generation = %v, want %v", record.GetGeneration(), tt.generation)
+			}
+			if diff := cmp.Diff(data, record.GetValue()); diff != "" {
+				t.Errorf("EF_Identification mismatch (-want +got):\n%s", diff)
+			}
+
+			parsed, err := ParseOptions{}.ParseRawDriverCardFile(rawFile)
+			if err != nil {
+				t.Fatalf("ParseRawDriverCardFile failed: %v", err)
+			}
+			got := parsed.GetTachograph().GetIdentification()
+			if tt.generation == ddv1.Generation_GENERATION_2 {
+				got = parsed.GetTachographG2().GetIdentification()
+			}
+			if diff := cmp.Diff(identification, got, protocmp.Transform()); diff != "" {
+				t.Errorf("parsed identification mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIssuingMemberState(t *testing.T) {
+	data, err := readHexdump("testdata/records/000-anonymized/003-EF_IDENTIFICATION-GENERATION_1-DATA.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	germany, err := dd.MarshalEnum(ddv1.NationNumeric_GERMANY)
+	if err != nil {
+		t.Fatalf("MarshalEnum failed: %v", err)
+	}
+	// cardIssuingMemberState is the first byte of the identification.
+	germanData := append([]byte{germany}, data[1:]...)
+
+	for _, tt := range []struct {
+		name string
+		data []byte
+		g2   bool
+		want ddv1.NationNumeric
+	}{
+		{name: "finnish card", data: data, want: ddv1.NationNumeric_FINLAND},
+		{name: "german card", data: germanData, want: ddv1.NationNumeric_GERMANY},
+		{name: "german card gen2", data: germanData, g2: true, want: ddv1.NationNumeric_GERMANY},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := UnmarshalOptions{}.unmarshalDriverCardIdentification(tt.data)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			file := &cardv1.DriverCardFile{}
+			if tt.g2 {
+				tachograph := &cardv1.DriverCardFile_TachographG2{}
+				tachograph.SetIdentification(id)
+				file.SetTachographG2(tachograph)
+			} else {
+				tachograph := &cardv1.DriverCardFile_Tachograph{}
+				tachograph.SetIdentification(id)
+				file.SetTachograph(tachograph)
+			}
+			if got := IssuingMemberState(file); got != tt.want {
+				t.Errorf("IssuingMemberState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardNumber(t *testing.T) {
+	data, err := readHexdump("testdata/records/000-anonymized/003-EF_IDENTIFICATION-GENERATION_1-DATA.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	// The card number follows cardIssuingMemberState and ends with the
+	// replacement and renewal indices.
+	reissued := bytes.Clone(data)
+	reissued[15], reissued[16] = '1', '2'
+
+	for _, tt := range []struct {
+		name            string
+		data            []byte
+		wantCardNumber  string
+		wantReplacement string
+		wantRenewal     string
+	}{
+		{name: "original card", data: data, wantCardNumber: "DRIVER0000000100", wantReplacement: "0", wantRenewal: "0"},
+		{name: "reissued card", data: reissued, wantCardNumber: "DRIVER0000000112", wantReplacement: "1", wantRenewal: "2"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := UnmarshalOptions{}.unmarshalDriverCardIdentification(tt.data)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			tachograph := &cardv1.DriverCardFile_Tachograph{}
+			tachograph.SetIdentification(id)
+			file := &cardv1.DriverCardFile{}
+			file.SetTachograph(tachograph)
+
+			if got := CardNumber(file); got != tt.wantCardNumber {
+				t.Errorf("CardNumber() = %q, want %q", got, tt.wantCardNumber)
+			}
+			if got := ReplacementIndex(file); got != tt.wantReplacement {
+				t.Errorf("ReplacementIndex() = %q, want %q", got, tt.wantReplacement)
+			}
+			if got := RenewalIndex(file); got != tt.wantRenewal {
+				t.Errorf("RenewalIndex() = %q, want %q", got, tt.wantRenewal)
+			}
+			if got, want := DriverIdentification(file), "DRIVER00000001"; got != want {
+				t.Errorf("DriverIdentification() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestPreferredLanguage(t *testing.T) {
+	data, err := readHexdump("testdata/records/000-anonymized/003-EF_IDENTIFICATION-GENERATION_1-DATA.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	// cardHolderPreferredLanguage is the last 2 bytes of the identification.
+	german := bytes.Clone(data)
+	copy(german[len(german)-2:], "de")
+
+	for _, tt := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "finnish card", data: data, want: "fi"},
+		{name: "german card", data: german, want: "de"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := UnmarshalOptions{}.unmarshalDriverCardIdentification(tt.data)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			tachograph := &cardv1.DriverCardFile_TachographG2{}
+			tachograph.SetIdentification(id)
+			file := &cardv1.DriverCardFile{}
+			file.SetTachographG2(tachograph)
+			if got := PreferredLanguage(file); got != tt.want {
+				t.Errorf("PreferredLanguage() = %q, want %q", got, tt.want)
+			}
+
+			marshaled, err := MarshalOptions{}.MarshalDriverCardIdentification(id)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if !bytes.Equal(marshaled, tt.data) {
+				t.Errorf("Marshal() does not round-trip the identification")
+			}
+		})
+	}
+}
@@ -1,13 +1,63 @@
 package card
 
 import (
+	"slices"
+
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// InferenceDetails explains how InferFileType determined a card type.
+type InferenceDetails struct {
+	// VehicleUnit reports whether the input looks like a vehicle unit
+	// download rather than a card, as its first tag has the TREP prefix 0x76.
+	VehicleUnit bool
+
+	// Files lists the EFs with data present in the input, in file order.
+	Files []cardv1.ElementaryFileType
+
+	// Candidates lists the card types whose file structure includes all of
+	// Files, in enum order. The first candidate is chosen unless the
+	// application identifier names another one.
+	Candidates []cardv1.CardType
+
+	// ApplicationCardType is the card type stated by the typeOfTachographCardId
+	// of EF_Application_Identification (Data Dictionary, Section 2.61), or
+	// CARD_TYPE_UNSPECIFIED if the EF is not present.
+	ApplicationCardType cardv1.CardType
+}
+
 // InferFileType determines the card type from raw card data.
-func InferFileType(input *cardv1.RawCardFile) cardv1.CardType {
+//
+// The card type is the one whose file structure includes all EFs with data
+// present in the input. When several card types match, as the workshop card
+// includes all EFs of the driver card, the one stated by the application
+// identifier is preferred. The details explain the decision; the card type is
+// CARD_TYPE_UNSPECIFIED if no card type matches.
+func InferFileType(input *cardv1.RawCardFile) (cardv1.CardType, InferenceDetails) {
+	var details InferenceDetails
+	records := input.GetRecords()
+	if len(records) > 0 && records[0].GetTag()>>16 == 0x76 {
+		details.VehicleUnit = true
+		return cardv1.CardType_CARD_TYPE_UNSPECIFIED, details
+	}
+	for _, record := range records {
+		if record.GetContentType() != cardv1.ContentType_DATA {
+			continue
+		}
+		details.Files = append(details.Files, record.GetFile())
+		if record.GetFile() == cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION &&
+			record.GetGeneration() == ddv1.Generation_GENERATION_1 && len(record.GetValue()) > 0 {
+			// typeOfTachographCardId is an EquipmentType, whose card values
+			// coincide with the CardType enum numbers.
+			if cardType := cardv1.CardType(record.GetValue()[0]); cardType >= cardv1.CardType_DRIVER_CARD && cardType <= cardv1.CardType_COMPANY_CARD {
+				details.ApplicationCardType = cardType
+			}
+		}
+	}
+
 	// The File field is already set during raw parsing, so we can use the records directly
 	enumDesc := cardv1.CardType_CARD_TYPE_UNSPECIFIED.Descriptor()
 	for i := 0; i < enumDesc.Values().Len(); i++ {
@@ -16,11 +66,17 @@ func InferFileType(input *cardv1.RawCardFile) cardv1.CardType {
 		if !ok {
 			continue
 		}
-		if hasAllElementaryFiles(fileStructure, input.GetRecords()) {
-			return cardv1.CardType(enumValue.Number())
+		if hasAllElementaryFiles(fileStructure, records) {
+			details.Candidates = append(details.Candidates, cardv1.CardType(enumValue.Number()))
 		}
 	}
-	return cardv1.CardType_CARD_TYPE_UNSPECIFIED
+	if len(details.Candidates) == 0 {
+		return cardv1.CardType_CARD_TYPE_UNSPECIFIED, details
+	}
+	if slices.Contains(details.Candidates, details.ApplicationCardType) {
+		return details.ApplicationCardType, details
+	}
+	return details.Candidates[0], details
 }
 
 // mapFidToElementaryFileType maps a FID to its ElementaryFileType using protobuf annotations.
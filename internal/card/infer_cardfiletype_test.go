@@ -6,7 +6,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
 func TestInferCardFileType(t *testing.T) {
@@ -48,10 +51,10 @@ func TestInferCardFileType(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Failed to unmarshal: %v", err)
 			}
-			inferredCardType := InferFileType(rawCardFile)
+			inferredCardType, details := InferFileType(rawCardFile)
 			if inferredCardType != expectedCardType {
-				t.Errorf("Expected %s, got %s (%d records)",
-					expectedCardType, inferredCardType, len(rawCardFile.GetRecords()))
+				t.Errorf("Expected %s, got %s (%d records, details %+v)",
+					expectedCardType, inferredCardType, len(rawCardFile.GetRecords()), details)
 			}
 		})
 		return nil
@@ -59,3 +62,68 @@ func TestInferCardFileType(t *testing.T) {
 		t.Fatalf("Failed to walk directory: %v", err)
 	}
 }
+
+func TestInferFileType_details(t *testing.T) {
+	record := func(file cardv1.ElementaryFileType, value ...byte) *cardv1.RawCardFile_Record {
+		r := &cardv1.RawCardFile_Record{}
+		r.SetFile(file)
+		r.SetGeneration(ddv1.Generation_GENERATION_1)
+		r.SetContentType(cardv1.ContentType_DATA)
+		r.SetValue(value)
+		return r
+	}
+	rawCardFile := func(records ...*cardv1.RawCardFile_Record) *cardv1.RawCardFile {
+		f := &cardv1.RawCardFile{}
+		f.SetRecords(records)
+		return f
+	}
+
+	t.Run("driver", func(t *testing.T) {
+		input := rawCardFile(
+			record(cardv1.ElementaryFileType_EF_ICC),
+			record(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION, 0x01),
+		)
+		cardType, details := InferFileType(input)
+		if cardType != cardv1.CardType_DRIVER_CARD {
+			t.Errorf("InferFileType() = %v, want %v", cardType, cardv1.CardType_DRIVER_CARD)
+		}
+		if details.ApplicationCardType != cardv1.CardType_DRIVER_CARD {
+			t.Errorf("ApplicationCardType = %v, want %v", details.ApplicationCardType, cardv1.CardType_DRIVER_CARD)
+		}
+		wantFiles := []cardv1.ElementaryFileType{
+			cardv1.ElementaryFileType_EF_ICC,
+			cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION,
+		}
+		if diff := cmp.Diff(wantFiles, details.Files); diff != "" {
+			t.Errorf("Files mismatch (-want +got):\n%s", diff)
+		}
+		if len(details.Candidates) < 2 {
+			t.Errorf("Candidates = %v, want several card types sharing the driver card EFs", details.Candidates)
+		}
+	})
+
+	t.Run("application identifier", func(t *testing.T) {
+		input := rawCardFile(
+			record(cardv1.ElementaryFileType_EF_ICC),
+			record(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION, 0x02),
+		)
+		cardType, _ := InferFileType(input)
+		if cardType != cardv1.CardType_WORKSHOP_CARD {
+			t.Errorf("InferFileType() = %v, want %v", cardType, cardv1.CardType_WORKSHOP_CARD)
+		}
+	})
+
+	t.Run("vehicle unit", func(t *testing.T) {
+		input, err := UnmarshalOptions{}.UnmarshalRawCardFile([]byte{0x76, 0x01, 0x00, 0x00, 0x00})
+		if err != nil {
+			t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+		}
+		cardType, details := InferFileType(input)
+		if cardType != cardv1.CardType_CARD_TYPE_UNSPECIFIED {
+			t.Errorf("InferFileType() = %v, want %v", cardType, cardv1.CardType_CARD_TYPE_UNSPECIFIED)
+		}
+		if !details.VehicleUnit {
+			t.Error("VehicleUnit = false, want true")
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package card
+
+import (
+	"time"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// ManualEntry is a period of driver activity declared manually, while the
+// driver card was not inserted in a vehicle unit.
+type ManualEntry struct {
+	// Start and End bound the period.
+	Start, End time.Time
+
+	// Activity is the declared activity.
+	Activity ddv1.DriverActivityValue
+
+	// Crew is true if the period was declared in crew mode.
+	Crew bool
+}
+
+// ExtractManualEntries returns the activities recorded on a driver card while
+// the card was not inserted, ordered chronologically.
+//
+// When a card is inserted, the vehicle unit asks the driver to declare the
+// activities since the card was last withdrawn, and sets the ManualInputFlag
+// of its VuCardIWRecord (Data Dictionary, Section 2.93) if any were entered.
+// The card itself has no such flag: the declared activities are recorded in
+// its CardActivityDailyRecords with the card status of ActivityChangeInfo
+// (Data Dictionary, Section 2.1) set to not inserted. Each such activity
+// lasts until the next change in the same record, or until the end of the
+// record's day, and contiguous entries of the same activity are merged across
+// midnight.
+//
+// Days are taken from [UnifiedDriverActivity].
+func ExtractManualEntries(file *cardv1.DriverCardFile) []*ManualEntry {
+	const day = 24 * time.Hour
+	var result []*ManualEntry
+	for _, activityDay := range UnifiedDriverActivity(file) {
+		changes := activityDay.ActivityChanges
+		for i, change := range changes {
+			if change.GetInserted() {
+				continue
+			}
+			start := activityDay.Date.Add(time.Duration(change.GetTimeOfChangeMinutes()) * time.Minute)
+			end := activityDay.Date.Add(day)
+			if i+1 < len(changes) {
+				end = activityDay.Date.Add(time.Duration(changes[i+1].GetTimeOfChangeMinutes()) * time.Minute)
+			}
+			if !start.Before(end) {
+				continue
+			}
+			if n := len(result); n > 0 {
+				last := result[n-1]
+				if last.End.Equal(start) && last.Activity == change.GetActivity() && last.Crew == change.GetCrew() {
+					last.End = end
+					continue
+				}
+			}
+			result = append(result, &ManualEntry{
+				Start:    start,
+				End:      end,
+				Activity: change.GetActivity(),
+				Crew:     change.GetCrew(),
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestExtractManualEntries(t *testing.T) {
+	newChange := func(minutes int32, activity ddv1.DriverActivityValue, inserted bool) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetTimeOfChangeMinutes(minutes)
+		change.SetActivity(activity)
+		change.SetInserted(inserted)
+		return change
+	}
+	newRecord := func(date time.Time, changes ...*ddv1.ActivityChangeInfo) *cardv1.DriverActivityData_DailyRecord {
+		record := &cardv1.DriverActivityData_DailyRecord{}
+		record.SetValid(true)
+		record.SetActivityRecordDate(timestamppb.New(date))
+		record.SetActivityChangeInfo(changes)
+		return record
+	}
+	day1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	// The card is withdrawn at 18:00 on day 1. At insertion at 08:00 on day 2
+	// the driver declares rest until 07:00 and work until 08:00.
+	data := &cardv1.DriverActivityData{}
+	data.SetDailyRecords([]*cardv1.DriverActivityData_DailyRecord{
+		newRecord(day1,
+			newChange(0, ddv1.DriverActivityValue_BREAK_REST, true),
+			newChange(8*60, ddv1.DriverActivityValue_DRIVING, true),
+			newChange(18*60, ddv1.DriverActivityValue_BREAK_REST, false),
+		),
+		newRecord(day2,
+			newChange(0, ddv1.DriverActivityValue_BREAK_REST, false),
+			newChange(7*60, ddv1.DriverActivityValue_WORK, false),
+			newChange(8*60, ddv1.DriverActivityValue_DRIVING, true),
+		),
+	})
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetDriverActivityData(data)
+	file := &cardv1.DriverCardFile{}
+	file.SetTachograph(tachograph)
+
+	want := []*ManualEntry{
+		{Start: day1.Add(18 * time.Hour), End: day2.Add(7 * time.Hour), Activity: ddv1.DriverActivityValue_BREAK_REST},
+		{Start: day2.Add(7 * time.Hour), End: day2.Add(8 * time.Hour), Activity: ddv1.DriverActivityValue_WORK},
+	}
+	if diff := cmp.Diff(want, ExtractManualEntries(file)); diff != "" {
+		t.Errorf("ExtractManualEntries() mismatch (-want +got):\n%s", diff)
+	}
+}
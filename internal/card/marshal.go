@@ -2,9 +2,13 @@ package card
 
 import (
 	"encoding/binary"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
 // MarshalOptions configures the marshaling of card files into binary format.
@@ -15,7 +19,7 @@ type MarshalOptions struct {
 
 // MarshalRawCardFile serializes a RawCardFile into binary format.
 func (opts MarshalOptions) MarshalRawCardFile(file *cardv1.RawCardFile) ([]byte, error) {
-	var result []byte
+	result := make([]byte, 0, rawCardFileSize(file))
 	for _, record := range file.GetRecords() {
 		// Write tag (FID + appendix)
 		result = binary.BigEndian.AppendUint16(result, uint16(record.GetTag()>>8))
@@ -27,3 +31,190 @@ func (opts MarshalOptions) MarshalRawCardFile(file *cardv1.RawCardFile) ([]byte,
 	}
 	return result, nil
 }
+
+// EstimateSize returns the length of the output of MarshalDriverCardFile for
+// file, so that callers can size their buffers before marshaling.
+//
+// The size is computed from the record counts and fixed record lengths of the
+// EFs, and the lengths of the raw data they are written from, without
+// encoding them. It is exact for files that MarshalDriverCardFile can encode,
+// and an error is returned for EFs that cannot be encoded without raw data.
+func (opts MarshalOptions) EstimateSize(file *cardv1.DriverCardFile) (int, error) {
+	if file == nil {
+		return 0, fmt.Errorf("driver card file is nil")
+	}
+	var size int
+	var err error
+	RangeElementaryFiles(file, func(ef cardv1.ElementaryFileType, gen ddv1.Generation, msg proto.Message, signature []byte) {
+		if err != nil {
+			return
+		}
+		n, ok, efErr := sizeOfElementaryFile(msg)
+		if efErr != nil {
+			err = fmt.Errorf("failed to size %v (%v): %w", ef, gen, efErr)
+			return
+		}
+		if !ok {
+			return
+		}
+		size += lenTLVHeader + n
+		if len(signature) > 0 {
+			size += lenTLVHeader + len(signature)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, record := range file.GetUnknownRecords() {
+		size += lenTLVHeader + len(record.GetValue())
+	}
+	return size, nil
+}
+
+// sizeOfElementaryFile returns the length of the data of a parsed EF message,
+// as encoded by marshalElementaryFile. It reports false for EFs that are not
+// written, because they have no binary encoding yet or no records.
+func sizeOfElementaryFile(msg proto.Message) (int, bool, error) {
+	const (
+		lenIcc                             = 25
+		lenIc                              = 8
+		lenEfApplicationIdentificationGen1 = 10
+		lenEfApplicationIdentificationG2   = 17
+		lenEfApplicationIdentificationV2   = 4
+		lenDriverCardIdentification        = 143
+		lenCardDownload                    = 4
+		lenCardDrivingLicenceInformation   = 53
+		lenCardCurrentUse                  = 19
+		lenCardControlActivityDataRecord   = 46
+		lenCardEventRecord                 = 24
+		lenCardFaultRecord                 = 24
+		lenNewestRecordPointer             = 2
+		lenPlacePointer                    = 1
+		lenPlaceRecord                     = 10
+		lenPlaceRecordG2                   = 21
+		lenCardVehicleRecord               = 31
+		lenCardVehicleRecordG2             = 48
+		lenSpecificConditionRecord         = 5
+		lenCardVehicleUnitRecord           = 10
+		lenGNSSAccumulatedDrivingRecord    = 18
+		lenActivityPointers                = 4
+	)
+	switch msg := msg.(type) {
+	case *cardv1.Icc:
+		return lenIcc, true, nil
+	case *cardv1.Ic:
+		return lenIc, true, nil
+	case *cardv1.ApplicationIdentification:
+		return lenEfApplicationIdentificationGen1, true, nil
+	case *cardv1.ApplicationIdentificationG2:
+		return lenEfApplicationIdentificationG2, true, nil
+	case *cardv1.ApplicationIdentificationV2:
+		return lenEfApplicationIdentificationV2, true, nil
+	case *cardv1.CardCertificate:
+		return sizeOfCertificate(msg.GetRsaCertificate().GetRawData())
+	case *cardv1.CaCertificate:
+		return sizeOfCertificate(msg.GetRsaCertificate().GetRawData())
+	case *cardv1.CardMaCertificate:
+		return sizeOfCertificate(msg.GetEccCertificate().GetRawData())
+	case *cardv1.CardSignCertificate:
+		return sizeOfCertificate(msg.GetEccCertificate().GetRawData())
+	case *cardv1.CaCertificateG2:
+		return sizeOfCertificate(msg.GetEccCertificate().GetRawData())
+	case *cardv1.LinkCertificate:
+		return sizeOfCertificate(msg.GetEccCertificate().GetRawData())
+	case *cardv1.DriverCardIdentification:
+		return lenDriverCardIdentification, true, nil
+	case *cardv1.CardDownloadDriver:
+		return lenCardDownload, true, nil
+	case *cardv1.DrivingLicenceInfo:
+		return lenCardDrivingLicenceInformation, true, nil
+	case *cardv1.EventsData:
+		var size int
+		for _, record := range msg.GetEvents() {
+			if record.GetValid() {
+				size += lenCardEventRecord
+			} else {
+				size += len(record.GetRawData())
+			}
+		}
+		return size, size > 0, nil
+	case *cardv1.FaultsData:
+		var size int
+		for _, record := range msg.GetFaults() {
+			if record.GetValid() {
+				size += lenCardFaultRecord
+			} else {
+				size += len(record.GetRawData())
+			}
+		}
+		return size, size > 0, nil
+	case *cardv1.DriverActivityData:
+		if raw := msg.GetRawData(); len(raw) > 0 {
+			return lenActivityPointers + len(raw), true, nil
+		}
+		size := lenActivityPointers
+		for i, record := range msg.GetDailyRecords() {
+			if !record.GetValid() {
+				if len(record.GetRawData()) == 0 {
+					return 0, false, fmt.Errorf("invalid record %d has no raw data", i)
+				}
+				size += len(record.GetRawData())
+				continue
+			}
+			n, err := calculateRecordSize(record)
+			if err != nil {
+				return 0, false, err
+			}
+			size += n
+		}
+		return size, true, nil
+	case *cardv1.VehiclesUsed:
+		return lenNewestRecordPointer + len(msg.GetRecords())*lenCardVehicleRecord, true, nil
+	case *cardv1.VehiclesUsedG2:
+		return lenNewestRecordPointer + len(msg.GetRecords())*lenCardVehicleRecordG2, true, nil
+	case *cardv1.Places:
+		return lenPlacePointer + len(msg.GetRecords())*lenPlaceRecord, true, nil
+	case *cardv1.PlacesG2:
+		return lenNewestRecordPointer + len(msg.GetRecords())*lenPlaceRecordG2, true, nil
+	case *cardv1.CurrentUsage:
+		return lenCardCurrentUse, true, nil
+	case *cardv1.ControlActivityData:
+		return lenCardControlActivityDataRecord, true, nil
+	case *cardv1.SpecificConditions:
+		// An empty EF is only written when it was read empty.
+		size := len(msg.GetRecords()) * lenSpecificConditionRecord
+		return size, size > 0 || len(msg.GetRawData()) == 0, nil
+	case *cardv1.SpecificConditionsG2:
+		// The raw data is reused, with its trailing bytes, if all records fit.
+		size := lenNewestRecordPointer + len(msg.GetRecords())*lenSpecificConditionRecord
+		if raw := msg.GetRawData(); len(raw) > 0 && size <= len(raw) {
+			return len(raw), true, nil
+		}
+		return size, true, nil
+	case *cardv1.VehicleUnitsUsed:
+		return lenNewestRecordPointer + len(msg.GetRecords())*lenCardVehicleUnitRecord, true, nil
+	case *cardv1.GnssPlaces:
+		return lenNewestRecordPointer + len(msg.GetRecords())*lenGNSSAccumulatedDrivingRecord, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+// sizeOfCertificate returns the length of a certificate EF, which is only
+// written from its raw data.
+func sizeOfCertificate(raw []byte) (int, bool, error) {
+	if len(raw) == 0 {
+		return 0, false, fmt.Errorf("certificate has no raw data")
+	}
+	return len(raw), true, nil
+}
+
+// rawCardFileSize returns the length of the binary encoding of a RawCardFile.
+func rawCardFileSize(file *cardv1.RawCardFile) int {
+	const lenTagAndLength = 5 // FID (2 bytes) + appendix (1 byte) + length (2 bytes)
+	size := 0
+	for _, record := range file.GetRecords() {
+		size += lenTagAndLength + len(record.GetValue())
+	}
+	return size
+}
@@ -9,6 +9,25 @@ type ParseOptions struct {
 	// PreserveRawData controls whether raw byte slices are stored in
 	// the raw_data field of parsed protobuf messages.
 	PreserveRawData bool
+
+	// LenientSignatureOrder controls how signature records that do not
+	// directly follow the data record of their EF are handled.
+	//
+	// If false (default), such signatures are an error. If true, they are
+	// attached to the data record of the same EF and generation regardless of
+	// their position, and dropped if there is none.
+	LenientSignatureOrder bool
+
+	// KeepUnknownEFs controls how records of unrecognized EFs are handled.
+	//
+	// If false (default), they are ignored. If true, they are kept unchanged
+	// in the unknown_records field of the parsed file, so that they are
+	// written back on unparse.
+	KeepUnknownEFs bool
+
+	// OnUnrecognized, if set, is called for each enum value that has no
+	// matching value in the Data Dictionary, see dd.UnmarshalOptions.
+	OnUnrecognized func(context string, rawValue uint64)
 }
 
 // unmarshal returns UnmarshalOptions configured from ParseOptions.
@@ -16,6 +35,7 @@ func (o ParseOptions) unmarshal() UnmarshalOptions {
 	return UnmarshalOptions{
 		UnmarshalOptions: dd.UnmarshalOptions{
 			PreserveRawData: o.PreserveRawData,
+			OnUnrecognized:  o.OnUnrecognized,
 		},
 	}
 }
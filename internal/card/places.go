@@ -2,11 +2,11 @@ package card
 
 import (
 	"fmt"
-
-	"github.com/way-platform/tachograph-go/internal/dd"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -17,7 +17,7 @@ import (
 // - placeRecords: N × 10 bytes (84-112 records for driver cards)
 func (opts UnmarshalOptions) unmarshalPlaces(data []byte) (*cardv1.Places, error) {
 	if len(data) < 1 {
-		return nil, fmt.Errorf("insufficient data for places: got %d bytes, need at least 1", len(data))
+		return nil, fmt.Errorf("insufficient data for places: got %d bytes, need at least 1: %w", len(data), io.ErrUnexpectedEOF)
 	}
 
 	target := &cardv1.Places{}
@@ -52,12 +52,23 @@ func (opts UnmarshalOptions) unmarshalCircularPlaceRecordsGen1(data []byte, newe
 		end := start + recordSize
 		recordData := data[start:end]
 
+		if isEmptyRecord(recordData) {
+			// Unused slot: preserve original bytes
+			record := &ddv1.PlaceRecord{}
+			record.SetValid(false)
+			record.SetRawData(recordData)
+			records = append(records, record)
+			continue
+		}
+
 		record, err := opts.UnmarshalOptions.UnmarshalPlaceRecord(recordData)
 		if err != nil {
 			// Mark record as invalid on parse error
 			record = &ddv1.PlaceRecord{}
 			record.SetValid(false)
 			record.SetRawData(recordData)
+		} else {
+			record.SetValid(true)
 		}
 
 		records = append(records, record)
@@ -66,6 +77,57 @@ func (opts UnmarshalOptions) unmarshalCircularPlaceRecordsGen1(data []byte, newe
 	return records, trailingBytes
 }
 
+// PackPlaces lays out place records in the cyclic buffer of EF_Places (Gen1
+// format), for synthesizing or editing a driver card.
+//
+// The records must be in chronological order, oldest first. capacity is the
+// number of slots of the buffer, noOfCardPlaceRecords in the application
+// identification of the card (84 to 112 for driver cards). The records fill
+// the buffer from the first slot and placePointerNewestRecord points at the
+// last one, as if the card had written them into an empty buffer; if there
+// are more records than slots, only the newest ones are kept. Unused slots are
+// filled with zeros, and an empty buffer points at its last slot, so the
+// oldest record always follows the pointer.
+//
+// The input records are not modified.
+func PackPlaces(records []*ddv1.PlaceRecord, capacity int) (*cardv1.Places, error) {
+	const recordSize = 10
+	if capacity <= 0 || capacity > 256 {
+		return nil, fmt.Errorf("invalid places capacity %d: must be between 1 and 256", capacity)
+	}
+	if len(records) > capacity {
+		records = records[len(records)-capacity:]
+	}
+	var opts MarshalOptions
+	slots := make([]*ddv1.PlaceRecord, 0, capacity)
+	for i, record := range records {
+		if record == nil {
+			return nil, fmt.Errorf("place record %d is nil", i)
+		}
+		packed := proto.CloneOf(record)
+		packed.SetValid(true)
+		packed.ClearRawData()
+		if _, err := opts.MarshalPlaceRecord(packed); err != nil {
+			return nil, fmt.Errorf("place record %d: %w", i, err)
+		}
+		slots = append(slots, packed)
+	}
+	for len(slots) < capacity {
+		unused := &ddv1.PlaceRecord{}
+		unused.SetValid(false)
+		unused.SetRawData(make([]byte, recordSize))
+		slots = append(slots, unused)
+	}
+	newest := len(records) - 1
+	if newest < 0 {
+		newest = capacity - 1
+	}
+	places := &cardv1.Places{}
+	places.SetNewestRecordIndex(int32(newest))
+	places.SetRecords(slots)
+	return places, nil
+}
+
 // MarshalPlaces marshals the EF_Places data (Gen1 format).
 //
 // Gen1 Structure (TCS_150):
@@ -94,7 +156,7 @@ func (opts MarshalOptions) MarshalPlaces(p *cardv1.Places) ([]byte, error) {
 
 		offset := 1
 		for _, record := range p.GetRecords() {
-			recordBytes, err := opts.MarshalPlaceRecord(record)
+			recordBytes, err := opts.marshalPlaceRecord(record)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal Gen1 place record: %w", err)
 			}
@@ -114,7 +176,7 @@ func (opts MarshalOptions) MarshalPlaces(p *cardv1.Places) ([]byte, error) {
 	dst = append(dst, newestRecordIndex)
 
 	for _, record := range p.GetRecords() {
-		recordBytes, err := opts.MarshalPlaceRecord(record)
+		recordBytes, err := opts.marshalPlaceRecord(record)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal Gen1 place record: %w", err)
 		}
@@ -124,6 +186,16 @@ func (opts MarshalOptions) MarshalPlaces(p *cardv1.Places) ([]byte, error) {
 	return dst, nil
 }
 
+// marshalPlaceRecord marshals a single Gen1 place record, returning the
+// original bytes of invalid records (e.g. unused slots) verbatim.
+func (opts MarshalOptions) marshalPlaceRecord(record *ddv1.PlaceRecord) ([]byte, error) {
+	const recordSize = 10
+	if !record.GetValid() && len(record.GetRawData()) == recordSize {
+		return record.GetRawData(), nil
+	}
+	return opts.MarshalPlaceRecord(record)
+}
+
 // AnonymizePlaces creates an anonymized copy of Places (Gen1), replacing potentially
 // sensitive location data while preserving the structure for testing.
 //
@@ -140,10 +212,7 @@ func (opts AnonymizeOptions) anonymizePlaces(p *cardv1.Places) *cardv1.Places {
 	result := &cardv1.Places{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Preserve structural metadata
 	result.SetNewestRecordIndex(p.GetNewestRecordIndex())
@@ -151,7 +220,17 @@ func (opts AnonymizeOptions) anonymizePlaces(p *cardv1.Places) *cardv1.Places {
 	// Anonymize each record (timestamps anonymized below)
 	var anonymizedRecords []*ddv1.PlaceRecord
 	for _, record := range p.GetRecords() {
-		anonymizedRecords = append(anonymizedRecords, ddOpts.AnonymizePlaceRecord(record))
+		if !record.GetValid() {
+			// Preserve invalid records as-is
+			invalid := &ddv1.PlaceRecord{}
+			invalid.SetValid(false)
+			invalid.SetRawData(record.GetRawData())
+			anonymizedRecords = append(anonymizedRecords, invalid)
+			continue
+		}
+		anonymized := ddOpts.AnonymizePlaceRecord(record)
+		anonymized.SetValid(true)
+		anonymizedRecords = append(anonymizedRecords, anonymized)
 	}
 	result.SetRecords(anonymizedRecords)
 
@@ -180,6 +259,9 @@ func anonymizeTimestampsInPlace(records []*ddv1.PlaceRecord) {
 
 	// Replace all timestamps with static incremented values
 	for i, record := range records {
+		if !record.GetValid() {
+			continue
+		}
 		// Set entry time: base + (i * 1 hour)
 		staticTimestamp := testEpoch + (int64(i) * oneHour)
 		if record.GetEntryTime() != nil || i == 0 {
@@ -3,8 +3,7 @@ package card
 import (
 	"encoding/binary"
 	"fmt"
-
-	"github.com/way-platform/tachograph-go/internal/dd"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -18,7 +17,7 @@ import (
 // - placeRecords: N × 21 bytes (112 records for driver cards)
 func (opts UnmarshalOptions) unmarshalPlacesG2(data []byte) (*cardv1.PlacesG2, error) {
 	if len(data) < 2 {
-		return nil, fmt.Errorf("insufficient data for places: got %d bytes, need at least 2", len(data))
+		return nil, fmt.Errorf("insufficient data for places: got %d bytes, need at least 2: %w", len(data), io.ErrUnexpectedEOF)
 	}
 
 	target := &cardv1.PlacesG2{}
@@ -53,12 +52,23 @@ func (opts UnmarshalOptions) unmarshalCircularPlaceRecordsG2(data []byte, newest
 		end := start + recordSize
 		recordData := data[start:end]
 
+		if isEmptyRecord(recordData) {
+			// Unused slot: preserve original bytes
+			record := &ddv1.PlaceRecordG2{}
+			record.SetValid(false)
+			record.SetRawData(recordData)
+			records = append(records, record)
+			continue
+		}
+
 		record, err := opts.UnmarshalOptions.UnmarshalPlaceRecordG2(recordData)
 		if err != nil {
 			// Mark record as invalid on parse error
 			record = &ddv1.PlaceRecordG2{}
 			record.SetValid(false)
 			record.SetRawData(recordData)
+		} else {
+			record.SetValid(true)
 		}
 
 		records = append(records, record)
@@ -95,7 +105,7 @@ func (opts MarshalOptions) MarshalPlacesG2(p *cardv1.PlacesG2) ([]byte, error) {
 
 		offset := 2
 		for _, record := range p.GetRecords() {
-			recordBytes, err := opts.MarshalPlaceRecordG2(record)
+			recordBytes, err := opts.marshalPlaceRecordG2(record)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal Gen2 place record: %w", err)
 			}
@@ -115,7 +125,7 @@ func (opts MarshalOptions) MarshalPlacesG2(p *cardv1.PlacesG2) ([]byte, error) {
 	dst = binary.BigEndian.AppendUint16(dst, newestRecordIndex)
 
 	for _, record := range p.GetRecords() {
-		recordBytes, err := opts.MarshalPlaceRecordG2(record)
+		recordBytes, err := opts.marshalPlaceRecordG2(record)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal Gen2 place record: %w", err)
 		}
@@ -125,6 +135,16 @@ func (opts MarshalOptions) MarshalPlacesG2(p *cardv1.PlacesG2) ([]byte, error) {
 	return dst, nil
 }
 
+// marshalPlaceRecordG2 marshals a single Gen2 place record, returning the
+// original bytes of invalid records (e.g. unused slots) verbatim.
+func (opts MarshalOptions) marshalPlaceRecordG2(record *ddv1.PlaceRecordG2) ([]byte, error) {
+	const recordSize = 21
+	if !record.GetValid() && len(record.GetRawData()) == recordSize {
+		return record.GetRawData(), nil
+	}
+	return opts.MarshalPlaceRecordG2(record)
+}
+
 // AnonymizePlacesG2 creates an anonymized copy of PlacesG2 (Gen2), replacing potentially
 // sensitive location data (including GNSS coordinates) while preserving the structure
 // for testing.
@@ -142,10 +162,7 @@ func (opts AnonymizeOptions) anonymizePlacesG2(p *cardv1.PlacesG2) *cardv1.Place
 	result := &cardv1.PlacesG2{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Preserve structural metadata
 	result.SetNewestRecordIndex(p.GetNewestRecordIndex())
@@ -153,7 +170,17 @@ func (opts AnonymizeOptions) anonymizePlacesG2(p *cardv1.PlacesG2) *cardv1.Place
 	// Anonymize each record (timestamps anonymized below)
 	var anonymizedRecords []*ddv1.PlaceRecordG2
 	for _, record := range p.GetRecords() {
-		anonymizedRecords = append(anonymizedRecords, ddOpts.AnonymizePlaceRecordG2(record))
+		if !record.GetValid() {
+			// Preserve invalid records as-is
+			invalid := &ddv1.PlaceRecordG2{}
+			invalid.SetValid(false)
+			invalid.SetRawData(record.GetRawData())
+			anonymizedRecords = append(anonymizedRecords, invalid)
+			continue
+		}
+		anonymized := ddOpts.AnonymizePlaceRecordG2(record)
+		anonymized.SetValid(true)
+		anonymizedRecords = append(anonymizedRecords, anonymized)
 	}
 	result.SetRecords(anonymizedRecords)
 
@@ -182,6 +209,9 @@ func anonymizeTimestampsInPlaceG2(records []*ddv1.PlaceRecordG2) {
 
 	// Replace all timestamps with static incremented values
 	for i, record := range records {
+		if !record.GetValid() {
+			continue
+		}
 		// Set main entry time: base + (i * 1 hour)
 		staticTimestamp := testEpoch + (int64(i) * oneHour)
 		if record.GetEntryTime() != nil || i == 0 {
@@ -1,6 +1,7 @@
 package card
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -60,3 +61,122 @@ func TestPlaces_Generation1(t *testing.T) {
 		})
 	}
 }
+
+func TestPlaces_EmptySlots(t *testing.T) {
+	place := []byte{
+		0x5E, 0x0C, 0x5A, 0x80, // entryTime
+		0x00,             // entryTypeDailyWorkPeriod
+		0x11,             // dailyWorkPeriodCountry
+		0x00,             // dailyWorkPeriodRegion
+		0x00, 0x30, 0x39, // vehicleOdometerValue
+	}
+	data := []byte{0x00} // placePointerNewestRecord
+	data = append(data, place...)
+	data = append(data, bytes.Repeat([]byte{0x00}, 10)...)
+	data = append(data, bytes.Repeat([]byte{0xFF}, 10)...)
+
+	places, err := UnmarshalOptions{}.unmarshalPlaces(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	var gotValid []bool
+	for _, record := range places.GetRecords() {
+		gotValid = append(gotValid, record.GetValid())
+	}
+	if diff := cmp.Diff([]bool{true, false, false}, gotValid); diff != "" {
+		t.Errorf("valid flags mismatch (-want +got):\n%s", diff)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalPlaces(places)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPackPlaces(t *testing.T) {
+	hexdumpFiles, err := findHexdumpFiles(
+		cardv1.ElementaryFileType_EF_PLACES,
+		ddv1.Generation_GENERATION_1,
+		cardv1.ContentType_DATA,
+	)
+	if err != nil {
+		t.Fatalf("Failed to discover hexdump files: %v", err)
+	}
+	if len(hexdumpFiles) == 0 {
+		t.Fatal("No hexdump files found for EF_PLACES GENERATION_1")
+	}
+	data, err := readHexdump(hexdumpFiles[0])
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	parsed, err := UnmarshalOptions{}.unmarshalPlaces(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	// placeRecordBytes returns the bytes of the valid records of a buffer,
+	// oldest first.
+	placeRecordBytes := func(places *cardv1.Places) [][]byte {
+		var result [][]byte
+		records := places.GetRecords()
+		for _, i := range cyclicOrder(len(records), places.GetNewestRecordIndex()) {
+			if records[i].GetValid() {
+				result = append(result, records[i].GetRawData())
+			}
+		}
+		return result
+	}
+	var records []*ddv1.PlaceRecord
+	for _, i := range cyclicOrder(len(parsed.GetRecords()), parsed.GetNewestRecordIndex()) {
+		if record := parsed.GetRecords()[i]; record.GetValid() {
+			records = append(records, record)
+		}
+	}
+	if len(records) < 3 {
+		t.Fatalf("got %d valid place records, need at least 3", len(records))
+	}
+	want := placeRecordBytes(parsed)
+
+	for _, tt := range []struct {
+		name     string
+		capacity int
+		want     [][]byte
+	}{
+		{name: "all records", capacity: len(parsed.GetRecords()), want: want},
+		{name: "newest records", capacity: 2, want: want[len(want)-2:]},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			places, err := PackPlaces(records, tt.capacity)
+			if err != nil {
+				t.Fatalf("PackPlaces failed: %v", err)
+			}
+			marshaled, err := MarshalOptions{}.MarshalPlaces(places)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if got, want := len(marshaled), 1+10*tt.capacity; got != want {
+				t.Fatalf("marshaled size = %d, want %d", got, want)
+			}
+			reparsed, err := UnmarshalOptions{}.unmarshalPlaces(marshaled)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, placeRecordBytes(reparsed)); diff != "" {
+				t.Errorf("place records mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	empty, err := PackPlaces(nil, 84)
+	if err != nil {
+		t.Fatalf("PackPlaces failed: %v", err)
+	}
+	if got := empty.GetNewestRecordIndex(); got != 83 {
+		t.Errorf("empty buffer newest record index = %d, want 83", got)
+	}
+	if _, err := PackPlaces(records, 0); err == nil {
+		t.Error("PackPlaces with zero capacity succeeded, want error")
+	}
+}
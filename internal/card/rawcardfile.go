@@ -1,67 +1,138 @@
 package card
 
 import (
-	"bufio"
-	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
 // UnmarshalRawCardFile parses raw card data with the configured options.
+//
+// The declared length of each TLV record is checked against the remaining
+// input. In strict mode, a record that overruns the input is an error;
+// otherwise the truncated record is skipped.
 func (opts UnmarshalOptions) UnmarshalRawCardFile(input []byte) (*cardv1.RawCardFile, error) {
 	var output cardv1.RawCardFile
-	sc := bufio.NewScanner(bytes.NewReader(input))
-	sc.Split(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
-		return scanCardFile(data, atEOF, opts.Strict)
-	})
-	for sc.Scan() {
-		record, err := unmarshalRawCardFileRecord(sc.Bytes(), opts.Strict)
+	for offset := 0; offset < len(input); {
+		size, err := tlvRecordSize(input[offset:])
+		if opts.RepairByteOrder && !isPlausibleTLVRecord(input[offset:], size, err) {
+			if swappedSize, ok := swappedTLVRecordSize(input[offset:]); ok {
+				size, err = swappedSize, nil
+			}
+		}
+		if err != nil {
+			if opts.Strict {
+				return nil, fmt.Errorf("card file record at offset %d: %w", offset, err)
+			}
+			break // Skip the truncated record at the end of the input
+		}
+		record, err := unmarshalRawCardFileRecord(input[offset:offset+size], opts.Strict)
 		if err != nil {
 			return nil, err
 		}
+		record.SetFileOffset(int32(offset))
+		offset += size
 		output.SetRecords(append(output.GetRecords(), record))
 	}
-	if err := sc.Err(); err != nil {
+	return &output, nil
+}
+
+// UnmarshalRawCardFiles parses raw data holding one or more concatenated card
+// files, as produced by systems that bundle several card downloads into one
+// .DDD file.
+//
+// A new card file starts at each EF_ICC data record that follows other records,
+// since every card download begins with the EF_ICC and EF_IC common EFs. Each
+// card file is unmarshalled as if it were read on its own, so file offsets are
+// relative to the start of that card file.
+func (opts UnmarshalOptions) UnmarshalRawCardFiles(input []byte) ([]*cardv1.RawCardFile, error) {
+	all, err := opts.UnmarshalRawCardFile(input)
+	if err != nil {
 		return nil, err
 	}
-	return &output, nil
+	var starts []int
+	for i, record := range all.GetRecords() {
+		if i == 0 || record.GetFile() == cardv1.ElementaryFileType_EF_ICC && record.GetContentType() == cardv1.ContentType_DATA {
+			starts = append(starts, int(record.GetFileOffset()))
+		}
+	}
+	if len(starts) <= 1 {
+		return []*cardv1.RawCardFile{all}, nil
+	}
+	files := make([]*cardv1.RawCardFile, 0, len(starts))
+	for i, start := range starts {
+		end := len(input)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		file, err := opts.UnmarshalRawCardFile(input[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("card file %d at offset %d: %w", i, start, err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
 }
 
-// scanCardFile is a [bufio.SplitFunc] that splits a card file into separate TLV records.
-// The strict parameter controls whether unrecognized tags cause an error or are skipped.
-func scanCardFile(data []byte, atEOF bool, strict bool) (advance int, token []byte, err error) {
+// lenTLVHeader is the size of a card file TLV header: a 3-byte tag (FID and
+// appendix) followed by a 2-byte length.
+const lenTLVHeader = 5
+
+// tlvRecordSize returns the size of the TLV record at the start of data,
+// including its header.
+//
+// A truncated record, whose header or declared length overruns data, is an
+// error wrapping [io.ErrUnexpectedEOF].
+func tlvRecordSize(data []byte) (int, error) {
 	// Need at least 5 bytes for TLV header (3 bytes tag + 2 bytes length)
-	if len(data) < 5 {
-		if atEOF {
-			if len(data) == 0 {
-				// No more data - this is normal EOF
-				return 0, nil, nil
-			}
-			// We have some data but not enough for a complete header
-			return 0, nil, io.ErrUnexpectedEOF
-		}
-		// Request more data
-		return 0, nil, nil
+	if len(data) < lenTLVHeader {
+		return 0, fmt.Errorf("truncated TLV header: got %d bytes, want %d: %w", len(data), lenTLVHeader, io.ErrUnexpectedEOF)
 	}
 	// Read the length field (bytes 3-4, big-endian)
 	length := binary.BigEndian.Uint16(data[3:5])
 	// Calculate total record size: 5 bytes header + length bytes value
-	totalSize := 5 + int(length)
-	// Check if we have enough data for the complete record
+	totalSize := lenTLVHeader + int(length)
 	if len(data) < totalSize {
-		if atEOF {
-			// We're at EOF but don't have enough data - this is an error condition
-			return 0, nil, io.ErrUnexpectedEOF
-		}
-		// Request more data
-		return 0, nil, nil
+		tag := uint32(binary.BigEndian.Uint16(data[0:2]))<<8 | uint32(data[2])
+		return 0, fmt.Errorf("TLV record with tag 0x%06X declares length %d, but only %d bytes are available: %w", tag, length, len(data)-lenTLVHeader, io.ErrUnexpectedEOF)
+	}
+	return totalSize, nil
+}
+
+// isPlausibleTLVRecord reports whether a TLV record of the given size, as
+// returned by tlvRecordSize, fits in data and is followed either by the end of
+// data or by the header of a record of a known elementary file.
+func isPlausibleTLVRecord(data []byte, size int, err error) bool {
+	if err != nil {
+		return false
+	}
+	next := data[size:]
+	if len(next) == 0 {
+		return true
+	}
+	if len(next) < lenTLVHeader {
+		return false
+	}
+	_, ok := mapFidToElementaryFileType(binary.BigEndian.Uint16(next[0:2]))
+	return ok
+}
+
+// swappedTLVRecordSize is like tlvRecordSize, but reads the length of the TLV
+// record in little-endian byte order. It reports false if the record is not
+// plausible with the swapped length.
+func swappedTLVRecordSize(data []byte) (int, bool) {
+	if len(data) < lenTLVHeader {
+		return 0, false
+	}
+	size := lenTLVHeader + int(binary.LittleEndian.Uint16(data[3:5]))
+	if len(data) < size || !isPlausibleTLVRecord(data, size, nil) {
+		return 0, false
 	}
-	// We have a complete TLV record.
-	return totalSize, data[:totalSize], nil
+	return size, true
 }
 
 // unmarshalRawCardFileRecord unmarshals a single raw card file record
@@ -71,12 +142,14 @@ func unmarshalRawCardFileRecord(input []byte, strict bool) (*cardv1.RawCardFile_
 	fid := binary.BigEndian.Uint16(input[0:2])
 	appendix := input[2]
 	output.SetTag((int32(fid) << 8) | int32(appendix))
-	// Parse length (2 bytes)
-	length := binary.BigEndian.Uint16(input[3:5])
+	// The length (2 bytes) was read by tlvRecordSize, and input ends at the
+	// end of the value, so it is taken from the size of input. This also
+	// holds for records read with RepairByteOrder.
+	length := len(input) - lenTLVHeader
 	output.SetLength(int32(length))
-	// Parse value - make a copy since input slice may be reused by scanner
+	// Parse value - make a copy so that the record does not alias the input
 	value := make([]byte, length)
-	copy(value, input[5:5+length])
+	copy(value, input[lenTLVHeader:])
 	output.SetValue(value)
 	// Determine content type and generation based on appendix byte
 	// Per Chapter 12: Appendix encodes both content type and generation in bit pattern
@@ -103,7 +176,7 @@ func unmarshalRawCardFileRecord(input []byte, strict bool) (*cardv1.RawCardFile_
 	// Map FID to elementary file type
 	fileType, found := mapFidToElementaryFileType(fid)
 	if !found && strict {
-		return nil, fmt.Errorf("unrecognized file ID 0x%04X in strict mode", fid)
+		return nil, fmt.Errorf("%w: unrecognized file ID 0x%04X in strict mode", dd.ErrUnknownTag, fid)
 	}
 	output.SetFile(fileType)
 	return &output, nil
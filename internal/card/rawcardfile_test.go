@@ -3,6 +3,8 @@ package card
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -209,3 +211,145 @@ func TestUnmarshalOptions_UnmarshalRawCardFile_golden(t *testing.T) {
 		t.Fatalf("Failed to walk testdata/card directory: %v", err)
 	}
 }
+
+func TestUnmarshalOptions_UnmarshalRawCardFile_fileOffset(t *testing.T) {
+	// EF_ICC (0x0002) data with 3 bytes, followed by EF_IC (0x0005) data with 1 byte.
+	data := []byte{
+		0x00, 0x02, 0x00, 0x00, 0x03, 0xAA, 0xBB, 0xCC,
+		0x00, 0x05, 0x00, 0x00, 0x01, 0xDD,
+	}
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+	var got []int32
+	for _, record := range rawFile.GetRecords() {
+		got = append(got, record.GetFileOffset())
+	}
+	if diff := cmp.Diff([]int32{0, 8}, got); diff != "" {
+		t.Errorf("file offsets mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnmarshalOptions_UnmarshalRawCardFile_maxLength(t *testing.T) {
+	// EF_ICC (0x0002) data followed by a signature record with the maximum
+	// length of 65535 bytes.
+	data := []byte{0x00, 0x02, 0x00, 0x00, 0x01, 0xAA}
+	data = append(data, 0x00, 0x02, 0x01, 0xFF, 0xFF)
+	data = append(data, bytes.Repeat([]byte{0x5A}, 0xFFFF)...)
+	rawFile, err := UnmarshalOptions{Strict: true}.UnmarshalRawCardFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+	records := rawFile.GetRecords()
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	if n := len(records[1].GetValue()); n != 0xFFFF {
+		t.Errorf("got %d bytes of signature value, want %d", n, 0xFFFF)
+	}
+}
+
+func TestUnmarshalOptions_UnmarshalRawCardFile_repairByteOrder(t *testing.T) {
+	// EF_ICC (0x0002) with 3 bytes of data and its length byte-swapped,
+	// followed by EF_IC (0x0005) with 2 bytes of data and a correct length.
+	data := []byte{0x00, 0x02, 0x00, 0x03, 0x00, 0xAA, 0xBB, 0xCC}
+	data = append(data, 0x00, 0x05, 0x00, 0x00, 0x02, 0xDD, 0xEE)
+
+	if _, err := (UnmarshalOptions{Strict: true}).UnmarshalRawCardFile(data); err == nil {
+		t.Fatal("UnmarshalRawCardFile without RepairByteOrder succeeded, want error")
+	}
+	rawFile, err := UnmarshalOptions{Strict: true, RepairByteOrder: true}.UnmarshalRawCardFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+	}
+	type record struct {
+		Tag    int32
+		Length int32
+		Value  []byte
+	}
+	var got []record
+	for _, r := range rawFile.GetRecords() {
+		got = append(got, record{r.GetTag(), r.GetLength(), r.GetValue()})
+	}
+	want := []record{
+		{0x000200, 3, []byte{0xAA, 0xBB, 0xCC}},
+		{0x000500, 2, []byte{0xDD, 0xEE}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("records mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnmarshalOptions_UnmarshalRawCardFile_truncated(t *testing.T) {
+	// EF_ICC (0x0002) with 3 bytes of data.
+	record := []byte{0x00, 0x02, 0x00, 0x00, 0x03, 0xAA, 0xBB, 0xCC}
+	for _, tt := range []struct {
+		name      string
+		data      []byte
+		wantError string
+	}{
+		{
+			name: "length overruns input",
+			// EF_IC (0x0005) declaring 16 bytes, with only 2 available.
+			data:      append(append([]byte{}, record...), 0x00, 0x05, 0x00, 0x00, 0x10, 0xDD, 0xEE),
+			wantError: "card file record at offset 8: TLV record with tag 0x000500 declares length 16, but only 2 bytes are available: unexpected EOF",
+		},
+		{
+			name:      "truncated header",
+			data:      append(append([]byte{}, record...), 0x00, 0x05, 0x00),
+			wantError: "card file record at offset 8: truncated TLV header: got 3 bytes, want 5: unexpected EOF",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalOptions{Strict: true}.UnmarshalRawCardFile(tt.data)
+			if err == nil || err.Error() != tt.wantError || !errors.Is(err, io.ErrUnexpectedEOF) {
+				t.Errorf("strict UnmarshalRawCardFile error = %v, want %q", err, tt.wantError)
+			}
+
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawCardFile(tt.data)
+			if err != nil {
+				t.Fatalf("non-strict UnmarshalRawCardFile failed: %v", err)
+			}
+			if n := len(rawFile.GetRecords()); n != 1 {
+				t.Errorf("got %d records, want 1", n)
+			}
+		})
+	}
+}
+
+func TestUnmarshalOptions_UnmarshalRawCardFiles(t *testing.T) {
+	icc := []byte{0x00, 0x02, 0x00, 0x00, 0x03, 0xAA, 0xBB, 0xCC}
+	ic := []byte{0x00, 0x05, 0x00, 0x00, 0x02, 0xDD, 0xEE}
+	icSignature := []byte{0x00, 0x05, 0x01, 0x00, 0x01, 0xFF}
+	data := bytes.Join([][]byte{icc, ic, icSignature, icc, ic}, nil)
+
+	files, err := UnmarshalOptions{Strict: true}.UnmarshalRawCardFiles(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFiles failed: %v", err)
+	}
+	want := [][]byte{
+		bytes.Join([][]byte{icc, ic, icSignature}, nil),
+		bytes.Join([][]byte{icc, ic}, nil),
+	}
+	if len(files) != len(want) {
+		t.Fatalf("got %d card files, want %d", len(files), len(want))
+	}
+	for i, file := range files {
+		wantFile, err := UnmarshalOptions{Strict: true}.UnmarshalRawCardFile(want[i])
+		if err != nil {
+			t.Fatalf("UnmarshalRawCardFile failed: %v", err)
+		}
+		if diff := cmp.Diff(wantFile, file, protocmp.Transform()); diff != "" {
+			t.Errorf("card file %d mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+
+	files, err = UnmarshalOptions{Strict: true}.UnmarshalRawCardFiles(want[0])
+	if err != nil {
+		t.Fatalf("UnmarshalRawCardFiles failed: %v", err)
+	}
+	if len(files) != 1 {
+		t.Errorf("got %d card files for a single card, want 1", len(files))
+	}
+}
@@ -0,0 +1,117 @@
+package card
+
+import (
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ElementaryFile identifies an EF within the application of a generation.
+type ElementaryFile struct {
+	// Type is the elementary file type.
+	Type cardv1.ElementaryFileType
+
+	// Generation is the generation of the application holding the EF:
+	// GENERATION_1 for the common EFs and the Tachograph DF, and
+	// GENERATION_2 for the Tachograph_G2 DF.
+	Generation ddv1.Generation
+}
+
+// PresentElementaryFiles returns the EFs present in a driver card file, with
+// the generation of the application they are present in.
+//
+// EFs present in both the Tachograph and Tachograph_G2 applications, such as
+// EF_Events_Data, are reported as GENERATION_2. See [RangeElementaryFiles]
+// for the EFs considered.
+func PresentElementaryFiles(file *cardv1.DriverCardFile) map[cardv1.ElementaryFileType]ddv1.Generation {
+	result := map[cardv1.ElementaryFileType]ddv1.Generation{}
+	RangeElementaryFiles(file, func(ef cardv1.ElementaryFileType, gen ddv1.Generation, _ proto.Message, _ []byte) {
+		result[ef] = max(result[ef], gen)
+	})
+	return result
+}
+
+// requiredElementaryFilesGen1 are the EFs of a driver card download that are
+// mandatory for all cards: the common EFs and the EFs of the Tachograph DF
+// (Appendix 7, Section 3).
+var requiredElementaryFilesGen1 = []cardv1.ElementaryFileType{
+	cardv1.ElementaryFileType_EF_ICC,
+	cardv1.ElementaryFileType_EF_IC,
+	cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION,
+	cardv1.ElementaryFileType_EF_CARD_CERTIFICATE,
+	cardv1.ElementaryFileType_EF_CA_CERTIFICATE,
+	cardv1.ElementaryFileType_EF_IDENTIFICATION,
+	cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER,
+	cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO,
+	cardv1.ElementaryFileType_EF_EVENTS_DATA,
+	cardv1.ElementaryFileType_EF_FAULTS_DATA,
+	cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA,
+	cardv1.ElementaryFileType_EF_VEHICLES_USED,
+	cardv1.ElementaryFileType_EF_PLACES,
+	cardv1.ElementaryFileType_EF_CURRENT_USAGE,
+	cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA,
+	cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS,
+}
+
+// requiredElementaryFilesGen2 are the EFs of the Tachograph_G2 DF that are
+// mandatory in the download of a Generation 2 driver card. EF_Link_Certificate
+// is only present after a change of the European root key.
+var requiredElementaryFilesGen2 = []cardv1.ElementaryFileType{
+	cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION,
+	cardv1.ElementaryFileType_EF_CARD_SIGN_CERTIFICATE,
+	cardv1.ElementaryFileType_EF_CA_CERTIFICATE,
+	cardv1.ElementaryFileType_EF_IDENTIFICATION,
+	cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER,
+	cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO,
+	cardv1.ElementaryFileType_EF_EVENTS_DATA,
+	cardv1.ElementaryFileType_EF_FAULTS_DATA,
+	cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA,
+	cardv1.ElementaryFileType_EF_VEHICLES_USED,
+	cardv1.ElementaryFileType_EF_PLACES,
+	cardv1.ElementaryFileType_EF_CURRENT_USAGE,
+	cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA,
+	cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS,
+	cardv1.ElementaryFileType_EF_VEHICLE_UNITS_USED,
+	cardv1.ElementaryFileType_EF_GNSS_PLACES,
+}
+
+// requiredElementaryFilesGen2V2 are the EFs added to the Tachograph_G2 DF by
+// version 2 of Generation 2, mandatory in the download of such cards.
+var requiredElementaryFilesGen2V2 = []cardv1.ElementaryFileType{
+	cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION_V2,
+	cardv1.ElementaryFileType_EF_PLACES_AUTHENTICATION,
+	cardv1.ElementaryFileType_EF_GNSS_PLACES_AUTHENTICATION,
+	cardv1.ElementaryFileType_EF_BORDER_CROSSINGS,
+	cardv1.ElementaryFileType_EF_LOAD_UNLOAD_OPERATIONS,
+	cardv1.ElementaryFileType_EF_LOAD_TYPE_ENTRIES,
+}
+
+// MissingRequiredEFs returns the EFs that the regulation mandates in the
+// download of a driver card, but that are absent from file, in download order.
+//
+// The common EFs and the EFs of the Tachograph DF are mandatory for all
+// driver cards. The EFs of the Tachograph_G2 DF are mandatory once the DF is
+// present, and the EFs added by version 2 of Generation 2 once
+// EF_Application_Identification_V2 is present, since it identifies such cards.
+func MissingRequiredEFs(file *cardv1.DriverCardFile) []ElementaryFile {
+	present := map[ElementaryFile]bool{}
+	RangeElementaryFiles(file, func(ef cardv1.ElementaryFileType, gen ddv1.Generation, _ proto.Message, _ []byte) {
+		present[ElementaryFile{Type: ef, Generation: gen}] = true
+	})
+	var missing []ElementaryFile
+	check := func(gen ddv1.Generation, efs []cardv1.ElementaryFileType) {
+		for _, ef := range efs {
+			if required := (ElementaryFile{Type: ef, Generation: gen}); !present[required] {
+				missing = append(missing, required)
+			}
+		}
+	}
+	check(ddv1.Generation_GENERATION_1, requiredElementaryFilesGen1)
+	if df := file.GetTachographG2(); df != nil {
+		check(ddv1.Generation_GENERATION_2, requiredElementaryFilesGen2)
+		if df.HasApplicationIdentificationV2() {
+			check(ddv1.Generation_GENERATION_2, requiredElementaryFilesGen2V2)
+		}
+	}
+	return missing
+}
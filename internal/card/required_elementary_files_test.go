@@ -0,0 +1,76 @@
+package card
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestPresentElementaryFiles(t *testing.T) {
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetEventsData(&cardv1.EventsData{})
+	tachograph.SetPlaces(&cardv1.Places{})
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetEventsData(&cardv1.EventsData{})
+	file := &cardv1.DriverCardFile{}
+	file.SetIcc(&cardv1.Icc{})
+	file.SetTachograph(tachograph)
+	file.SetTachographG2(tachographG2)
+
+	want := map[cardv1.ElementaryFileType]ddv1.Generation{
+		cardv1.ElementaryFileType_EF_ICC:         ddv1.Generation_GENERATION_1,
+		cardv1.ElementaryFileType_EF_PLACES:      ddv1.Generation_GENERATION_1,
+		cardv1.ElementaryFileType_EF_EVENTS_DATA: ddv1.Generation_GENERATION_2,
+	}
+	if diff := cmp.Diff(want, PresentElementaryFiles(file)); diff != "" {
+		t.Errorf("PresentElementaryFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMissingRequiredEFs(t *testing.T) {
+	// A Generation 1 card download lacking EF_Events_Data.
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetApplicationIdentification(&cardv1.ApplicationIdentification{})
+	tachograph.SetCardCertificate(&cardv1.CardCertificate{})
+	tachograph.SetCaCertificate(&cardv1.CaCertificate{})
+	tachograph.SetIdentification(&cardv1.DriverCardIdentification{})
+	tachograph.SetCardDownload(&cardv1.CardDownloadDriver{})
+	tachograph.SetDrivingLicenceInfo(&cardv1.DrivingLicenceInfo{})
+	tachograph.SetFaultsData(&cardv1.FaultsData{})
+	tachograph.SetDriverActivityData(&cardv1.DriverActivityData{})
+	tachograph.SetVehiclesUsed(&cardv1.VehiclesUsed{})
+	tachograph.SetPlaces(&cardv1.Places{})
+	tachograph.SetCurrentUsage(&cardv1.CurrentUsage{})
+	tachograph.SetControlActivityData(&cardv1.ControlActivityData{})
+	tachograph.SetSpecificConditions(&cardv1.SpecificConditions{})
+	file := &cardv1.DriverCardFile{}
+	file.SetIcc(&cardv1.Icc{})
+	file.SetIc(&cardv1.Ic{})
+	file.SetTachograph(tachograph)
+
+	want := []ElementaryFile{
+		{Type: cardv1.ElementaryFileType_EF_EVENTS_DATA, Generation: ddv1.Generation_GENERATION_1},
+	}
+	if diff := cmp.Diff(want, MissingRequiredEFs(file)); diff != "" {
+		t.Errorf("MissingRequiredEFs() mismatch (-want +got):\n%s", diff)
+	}
+
+	// A Tachograph_G2 DF with EF_Application_Identification_V2 only requires
+	// all Generation 2 and version 2 EFs.
+	tachograph.SetEventsData(&cardv1.EventsData{})
+	tachographG2 := &cardv1.DriverCardFile_TachographG2{}
+	tachographG2.SetApplicationIdentificationV2(&cardv1.ApplicationIdentificationV2{})
+	file.SetTachographG2(tachographG2)
+	missing := MissingRequiredEFs(file)
+	if got, want := len(missing), len(requiredElementaryFilesGen2)+len(requiredElementaryFilesGen2V2)-1; got != want {
+		t.Errorf("MissingRequiredEFs() returned %d EFs, want %d", got, want)
+	}
+	for _, ef := range missing {
+		if ef.Generation != ddv1.Generation_GENERATION_2 || ef.Type == cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION_V2 {
+			t.Errorf("MissingRequiredEFs() unexpectedly returned %v", ef)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package card
+
+import (
+	"sort"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// RoutePoint is a GNSS position recorded on a driver card, linked to the
+// driving period in which it was recorded.
+type RoutePoint struct {
+	// Time is when the accumulated driving time reached a multiple of three
+	// hours and the position was recorded.
+	Time time.Time
+
+	// GNSSTime is the time of the GNSS fix, which may precede Time.
+	GNSSTime time.Time
+
+	// Latitude and Longitude are the position in decimal degrees.
+	// They are only meaningful when HasPosition is true.
+	Latitude, Longitude float64
+
+	// HasPosition is false if the card recorded the unknown position marker.
+	HasPosition bool
+
+	// GNSSAccuracy is the raw GNSS accuracy value of the fix.
+	GNSSAccuracy int32
+
+	// OdometerKm is the vehicle odometer value when the position was recorded.
+	OdometerKm int32
+
+	// DrivingPeriod is the continuous driving period, derived from the
+	// card's activity data, during which the position was recorded.
+	// It is nil if no matching driving period is recorded on the card.
+	DrivingPeriod *DrivingPeriod
+}
+
+// DrivingPeriod is a continuous period of driving activity.
+type DrivingPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Route reconstructs the route of a Gen2 driver card from its EF_GNSS_Places
+// records, ordered chronologically.
+//
+// The records of EF_GNSS_Places (GNSSAccumulatedDrivingRecord, Data Dictionary,
+// Section 2.79) are stored in a cyclic buffer and written each time the
+// accumulated driving time reaches a multiple of three hours. Each point is
+// linked to the driving period of EF_Driver_Activity_Data that contains it.
+//
+// Unused slots of the cyclic buffer (records without a timestamp) are skipped.
+// Gen1 cards do not record GNSS positions, so the route of a Gen1-only card
+// is empty.
+func Route(file *cardv1.DriverCardFile) []*RoutePoint {
+	tachograph := file.GetTachographG2()
+	periods := drivingPeriods(tachograph.GetDriverActivityData())
+	var route []*RoutePoint
+	for _, record := range tachograph.GetGnssPlaces().GetRecords() {
+		if dd.IsUnsetTime(record.GetTimestamp()) {
+			continue
+		}
+		point := &RoutePoint{
+			Time:       record.GetTimestamp().AsTime().UTC(),
+			OdometerKm: record.GetVehicleOdometerKm(),
+		}
+		if place := record.GetGnssPlaceRecord(); place != nil {
+			if place.HasTimestamp() {
+				point.GNSSTime = place.GetTimestamp().AsTime().UTC()
+			}
+			point.GNSSAccuracy = place.GetGnssAccuracy()
+			point.Latitude, point.Longitude, point.HasPosition = dd.GeoCoordinatesToDegrees(place.GetGeoCoordinates())
+		}
+		point.DrivingPeriod = findDrivingPeriod(periods, point.Time)
+		route = append(route, point)
+	}
+	sort.SliceStable(route, func(i, j int) bool {
+		return route[i].Time.Before(route[j].Time)
+	})
+	return route
+}
+
+// drivingPeriods returns the continuous driving periods of the activity data.
+// Driving that continues across midnight is merged into a single period.
+func drivingPeriods(data *cardv1.DriverActivityData) []*DrivingPeriod {
+	var periods []*DrivingPeriod
+	for _, segment := range activitySegments(data) {
+		if segment.activity != ddv1.DriverActivityValue_DRIVING {
+			continue
+		}
+		if n := len(periods); n > 0 && periods[n-1].End.Equal(segment.start) {
+			periods[n-1].End = segment.end
+			continue
+		}
+		periods = append(periods, &DrivingPeriod{Start: segment.start, End: segment.end})
+	}
+	return periods
+}
+
+// findDrivingPeriod returns the driving period containing t. Positions are
+// recorded at the moment the accumulated driving threshold is reached, so
+// the end of a period is inclusive.
+func findDrivingPeriod(periods []*DrivingPeriod, t time.Time) *DrivingPeriod {
+	i := sort.Search(len(periods), func(i int) bool {
+		return !periods[i].End.Before(t)
+	})
+	if i < len(periods) && !t.Before(periods[i].Start) {
+		return periods[i]
+	}
+	return nil
+}
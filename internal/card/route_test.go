@@ -0,0 +1,108 @@
+package card
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestRoute(t *testing.T) {
+	data, err := readHexdump("testdata/records/003-anonymized/027-EF_GNSS_PLACES-GENERATION_2-DATA.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	gnssPlaces, err := UnmarshalOptions{}.unmarshalGnssPlaces(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	// Drive from 00:30 until 02:00, rest, then drive from 23:00 across midnight.
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newChange := func(minutes int32, activity ddv1.DriverActivityValue) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetTimeOfChangeMinutes(minutes)
+		change.SetActivity(activity)
+		return change
+	}
+	record := &cardv1.DriverActivityData_DailyRecord{}
+	record.SetValid(true)
+	record.SetActivityRecordDate(timestamppb.New(day))
+	record.SetActivityChangeInfo([]*ddv1.ActivityChangeInfo{
+		newChange(0, ddv1.DriverActivityValue_BREAK_REST),
+		newChange(30, ddv1.DriverActivityValue_DRIVING),
+		newChange(120, ddv1.DriverActivityValue_BREAK_REST),
+		newChange(23*60, ddv1.DriverActivityValue_DRIVING),
+	})
+	nextRecord := &cardv1.DriverActivityData_DailyRecord{}
+	nextRecord.SetValid(true)
+	nextRecord.SetActivityRecordDate(timestamppb.New(day.AddDate(0, 0, 1)))
+	nextRecord.SetActivityChangeInfo([]*ddv1.ActivityChangeInfo{
+		newChange(0, ddv1.DriverActivityValue_DRIVING),
+		newChange(60, ddv1.DriverActivityValue_BREAK_REST),
+	})
+	activity := &cardv1.DriverActivityData{}
+	activity.SetDailyRecords([]*cardv1.DriverActivityData_DailyRecord{record, nextRecord})
+
+	tachograph := &cardv1.DriverCardFile_TachographG2{}
+	tachograph.SetGnssPlaces(gnssPlaces)
+	tachograph.SetDriverActivityData(activity)
+	file := &cardv1.DriverCardFile{}
+	file.SetTachographG2(tachograph)
+
+	route := Route(file)
+	if len(route) == 0 {
+		t.Fatal("Route() returned no points")
+	}
+	for i := 1; i < len(route); i++ {
+		if route[i].Time.Before(route[i-1].Time) {
+			t.Errorf("route[%d].Time = %v is before route[%d].Time = %v", i, route[i].Time, i-1, route[i-1].Time)
+		}
+	}
+
+	first := route[0]
+	if !first.HasPosition {
+		t.Fatal("route[0].HasPosition = false, want true")
+	}
+	// 60100 encodes 60°10.0' and 24560 encodes 24°56.0'.
+	if want := 60 + 10.0/60; math.Abs(first.Latitude-want) > 1e-9 {
+		t.Errorf("route[0].Latitude = %v, want %v", first.Latitude, want)
+	}
+	if want := 24 + 56.0/60; math.Abs(first.Longitude-want) > 1e-9 {
+		t.Errorf("route[0].Longitude = %v, want %v", first.Longitude, want)
+	}
+
+	wantPeriods := map[time.Time]*DrivingPeriod{
+		day:                    nil,
+		day.Add(1 * time.Hour): {Start: day.Add(30 * time.Minute), End: day.Add(2 * time.Hour)},
+		day.Add(2 * time.Hour): {Start: day.Add(30 * time.Minute), End: day.Add(2 * time.Hour)},
+		day.Add(3 * time.Hour): nil,
+	}
+	for _, point := range route {
+		want, ok := wantPeriods[point.Time]
+		if !ok {
+			continue
+		}
+		got := point.DrivingPeriod
+		switch {
+		case want == nil && got != nil:
+			t.Errorf("point at %v: DrivingPeriod = %+v, want nil", point.Time, got)
+		case want != nil && got == nil:
+			t.Errorf("point at %v: DrivingPeriod = nil, want %+v", point.Time, want)
+		case want != nil && (!got.Start.Equal(want.Start) || !got.End.Equal(want.End)):
+			t.Errorf("point at %v: DrivingPeriod = %+v, want %+v", point.Time, got, want)
+		}
+	}
+
+	periods := drivingPeriods(activity)
+	if len(periods) != 2 {
+		t.Fatalf("drivingPeriods() returned %d periods, want 2", len(periods))
+	}
+	if want := day.Add(25 * time.Hour); !periods[1].End.Equal(want) {
+		t.Errorf("cross-midnight period end = %v, want %v", periods[1].End, want)
+	}
+}
@@ -0,0 +1,51 @@
+package card
+
+import (
+	"fmt"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+)
+
+// lenSensorInstallationSecData is the size of a Generation 1 SensorInstallationSecData.
+const lenSensorInstallationSecData = 16
+
+// UnmarshalSensorInstallationData unmarshals the sensor installation data of a
+// Generation 1 workshop card (EF_Sensor_Installation_Data).
+//
+// The data type `SensorInstallationSecData` is specified in the Data Dictionary, Section 2.142.
+//
+// ASN.1 Definition:
+//
+//	SensorInstallationSecData ::= OCTET STRING (SIZE(16))
+//
+// The data is the encrypted key material used to pair a motion sensor with a
+// vehicle unit (Appendix 11), and holds no sensor identification. The serial
+// number of a sensor and the date it was paired are recorded in the
+// calibration records of the workshop card (see UnmarshalCalibration) and in
+// the technical data of the vehicle unit.
+//
+// Workshop card files are not parsed as a whole, so this is exported for
+// parsing the EF directly.
+func (opts UnmarshalOptions) UnmarshalSensorInstallationData(data []byte) (*cardv1.SensorInstallationData, error) {
+	if len(data) != lenSensorInstallationSecData {
+		return nil, fmt.Errorf("invalid data length for SensorInstallationSecData: got %d, want %d", len(data), lenSensorInstallationSecData)
+	}
+	var target cardv1.SensorInstallationData
+	target.SetData(data)
+	return &target, nil
+}
+
+// MarshalSensorInstallationData marshals the sensor installation data of a
+// Generation 1 workshop card (EF_Sensor_Installation_Data).
+//
+// The data type `SensorInstallationSecData` is specified in the Data Dictionary, Section 2.142.
+func (opts MarshalOptions) MarshalSensorInstallationData(sensorInstallationData *cardv1.SensorInstallationData) ([]byte, error) {
+	if sensorInstallationData == nil {
+		return nil, nil
+	}
+	data := sensorInstallationData.GetData()
+	if len(data) != lenSensorInstallationSecData {
+		return nil, fmt.Errorf("invalid data length for SensorInstallationSecData: got %d, want %d", len(data), lenSensorInstallationSecData)
+	}
+	return append([]byte{}, data...), nil
+}
@@ -0,0 +1,31 @@
+package card
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSensorInstallationData_RoundTrip(t *testing.T) {
+	data := []byte{
+		0x3a, 0x7f, 0x01, 0x9c, 0x52, 0xe4, 0x0b, 0x66,
+		0xd1, 0x28, 0x8e, 0x47, 0xb0, 0x15, 0xfa, 0x93,
+	}
+	sensorInstallationData, err := UnmarshalOptions{}.UnmarshalSensorInstallationData(data)
+	if err != nil {
+		t.Fatalf("UnmarshalSensorInstallationData failed: %v", err)
+	}
+	if got := sensorInstallationData.GetData(); !bytes.Equal(got, data) {
+		t.Errorf("data = %x, want %x", got, data)
+	}
+	marshalled, err := MarshalOptions{}.MarshalSensorInstallationData(sensorInstallationData)
+	if err != nil {
+		t.Fatalf("MarshalSensorInstallationData failed: %v", err)
+	}
+	if !bytes.Equal(marshalled, data) {
+		t.Errorf("marshalled = %x, want %x", marshalled, data)
+	}
+
+	if _, err := (UnmarshalOptions{}).UnmarshalSensorInstallationData(data[:15]); err == nil {
+		t.Error("UnmarshalSensorInstallationData of 15 bytes succeeded, want error")
+	}
+}
@@ -0,0 +1,28 @@
+package card
+
+import (
+	"fmt"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+)
+
+// SignedBytes returns the bytes of an EF over which its signature is
+// computed, as checked by AuthenticateOptions.AuthenticateRawCardFile.
+//
+// The signature of an EF is computed over its whole data content, as read
+// from the card, and stored in the signature record that follows the data
+// record in the download (Appendix 7, Section 3). This holds for both
+// generations; only the algorithm differs.
+//
+// The returned slice aliases the value of the record. An error is returned for
+// signature records and for EFs that are not signed, such as the certificates
+// and EF_ICC.
+func SignedBytes(record *cardv1.RawCardFile_Record) ([]byte, error) {
+	if record.GetContentType() != cardv1.ContentType_DATA {
+		return nil, fmt.Errorf("%v record is not a data record", record.GetFile())
+	}
+	if !isSignedEF(record.GetFile()) {
+		return nil, fmt.Errorf("%v is not signed", record.GetFile())
+	}
+	return record.GetValue(), nil
+}
@@ -0,0 +1,51 @@
+package card
+
+import (
+	"bytes"
+	"testing"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+)
+
+func TestSignedBytes(t *testing.T) {
+	newRecord := func(file cardv1.ElementaryFileType, contentType cardv1.ContentType, value []byte) *cardv1.RawCardFile_Record {
+		record := &cardv1.RawCardFile_Record{}
+		record.SetFile(file)
+		record.SetContentType(contentType)
+		record.SetValue(value)
+		return record
+	}
+	value := []byte{0x01, 0x02, 0x03}
+	for _, tt := range []struct {
+		name    string
+		record  *cardv1.RawCardFile_Record
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:   "signed EF",
+			record: newRecord(cardv1.ElementaryFileType_EF_EVENTS_DATA, cardv1.ContentType_DATA, value),
+			want:   value,
+		},
+		{
+			name:    "signature record",
+			record:  newRecord(cardv1.ElementaryFileType_EF_EVENTS_DATA, cardv1.ContentType_SIGNATURE, value),
+			wantErr: true,
+		},
+		{
+			name:    "unsigned EF",
+			record:  newRecord(cardv1.ElementaryFileType_EF_CARD_CERTIFICATE, cardv1.ContentType_DATA, value),
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SignedBytes(tt.record)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SignedBytes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("SignedBytes() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package card
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -26,7 +27,7 @@ func (opts UnmarshalOptions) unmarshalSpecificConditionsG2(data []byte) (*cardv1
 	)
 
 	if len(data) < lenPointer {
-		return nil, fmt.Errorf("insufficient data for Gen2 specific conditions: got %d bytes, need at least %d", len(data), lenPointer)
+		return nil, fmt.Errorf("insufficient data for Gen2 specific conditions: got %d bytes, need at least %d: %w", len(data), lenPointer, io.ErrUnexpectedEOF)
 	}
 
 	target := &cardv1.SpecificConditionsG2{}
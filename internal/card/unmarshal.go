@@ -18,9 +18,43 @@ type UnmarshalOptions struct {
 	// - PreserveRawData: controls whether raw byte slices are stored
 	dd.UnmarshalOptions
 
-	// Strict controls how the parser handles unrecognized TLV tags.
+	// Strict controls how the parser handles unrecognized TLV tags and
+	// truncated TLV records.
 	//
-	// If true (default), the parser will return an error on any unrecognized tags.
-	// If false, the parser will skip over unrecognized tags and continue parsing.
+	// If true (default), the parser will return an error on any unrecognized
+	// tags, and on a record whose declared length overruns the input.
+	// If false, the parser will skip over unrecognized tags and truncated
+	// records and continue parsing.
 	Strict bool
+
+	// RepairByteOrder enables a best-effort repair of TLV records whose
+	// 2-byte length was written in little-endian byte order, as done by some
+	// faulty card readers.
+	//
+	// If true, a record whose declared length is implausible, because it
+	// overruns the input or is not followed by another card file record, is
+	// read with the bytes of its length swapped if that yields a plausible
+	// record. The repaired length is stored in big-endian byte order.
+	RepairByteOrder bool
+}
+
+// isEmptyRecord reports whether a fixed-size record slot is unused.
+//
+// Cyclic record arrays on the card (events, faults, places, vehicles used) are
+// allocated in full at personalisation, and slots that have never been
+// written are filled entirely with '00'H or 'FF'H.
+func isEmptyRecord(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	fill := data[0]
+	if fill != 0x00 && fill != 0xFF {
+		return false
+	}
+	for _, b := range data[1:] {
+		if b != fill {
+			return false
+		}
+	}
+	return true
 }
@@ -6,17 +6,42 @@ import (
 	"github.com/way-platform/tachograph-go/internal/security"
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 // UnparseDriverCardFile converts a parsed DriverCardFile back into its raw TLV representation.
 // This is the inverse of ParseRawDriverCardFile.
 func UnparseDriverCardFile(file *cardv1.DriverCardFile) (*cardv1.RawCardFile, error) {
+	return MarshalOptions{}.unparseDriverCardFile(file)
+}
+
+// unparseDriverCardFile converts a DriverCardFile into raw TLV records, one data
+// record per EF reported by RangeElementaryFiles, each followed by its signature
+// record if present. Unknown EFs are re-inserted at their original file offset.
+//
+// This is the single serialization path for driver card files: MarshalDriverCardFile
+// encodes the records returned here.
+func (opts MarshalOptions) unparseDriverCardFile(file *cardv1.DriverCardFile) (*cardv1.RawCardFile, error) {
 	if file == nil {
 		return nil, fmt.Errorf("driver card file cannot be nil")
 	}
 
 	var records []*cardv1.RawCardFile_Record
-	marshalOpts := MarshalOptions{}
+
+	// Unknown EFs are written back unchanged, before the first record that
+	// would otherwise start past their original file offset. The offsets match
+	// as long as the recognized EFs keep their original lengths.
+	unknown := file.GetUnknownRecords()
+	var offset int
+	emit := func(record *cardv1.RawCardFile_Record) {
+		for len(unknown) > 0 && int(unknown[0].GetFileOffset()) <= offset {
+			records = append(records, proto.Clone(unknown[0]).(*cardv1.RawCardFile_Record))
+			offset += lenTLVHeader + len(unknown[0].GetValue())
+			unknown = unknown[1:]
+		}
+		records = append(records, record)
+		offset += lenTLVHeader + len(record.GetValue())
+	}
 
 	// Helper to append a TLV record (data + optional signature)
 	appendRecord := func(
@@ -54,7 +79,7 @@ func UnparseDriverCardFile(file *cardv1.DriverCardFile) (*cardv1.RawCardFile, er
 		dataRecord.SetContentType(cardv1.ContentType_DATA)
 		dataRecord.SetLength(int32(len(dataBytes)))
 		dataRecord.SetValue(dataBytes)
-		records = append(records, dataRecord)
+		emit(dataRecord)
 
 		// Create signature record if present
 		if len(signature) > 0 {
@@ -67,394 +92,97 @@ func UnparseDriverCardFile(file *cardv1.DriverCardFile) (*cardv1.RawCardFile, er
 			sigRecord.SetContentType(cardv1.ContentType_SIGNATURE)
 			sigRecord.SetLength(int32(len(signature)))
 			sigRecord.SetValue(signature)
-			records = append(records, sigRecord)
+			emit(sigRecord)
 		}
 
 		return nil
 	}
 
-	// 1. EF_ICC - common file
-	if icc := file.GetIcc(); icc != nil {
-		dataBytes, err := marshalOpts.MarshalIcc(icc)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal EF_ICC: %w", err)
-		}
-		if err := appendRecord(cardv1.ElementaryFileType_EF_ICC, ddv1.Generation_GENERATION_1, dataBytes, nil); err != nil {
-			return nil, err
-		}
-	}
-
-	// 2. EF_IC - common file
-	if ic := file.GetIc(); ic != nil {
-		dataBytes, err := marshalOpts.MarshalCardIc(ic)
+	var err error
+	RangeElementaryFiles(file, func(ef cardv1.ElementaryFileType, gen ddv1.Generation, msg proto.Message, signature []byte) {
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal EF_IC: %w", err)
-		}
-		if err := appendRecord(cardv1.ElementaryFileType_EF_IC, ddv1.Generation_GENERATION_1, dataBytes, nil); err != nil {
-			return nil, err
-		}
-	}
-
-	// 3. Gen1 DF (Tachograph)
-	if tachograph := file.GetTachograph(); tachograph != nil {
-		// EF_APPLICATION_IDENTIFICATION
-		if appId := tachograph.GetApplicationIdentification(); appId != nil {
-			dataBytes, err := marshalOpts.MarshalCardApplicationIdentification(appId)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_APPLICATION_IDENTIFICATION (Gen1): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION, ddv1.Generation_GENERATION_1, dataBytes, appId.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CARD_CERTIFICATE
-		if cert := tachograph.GetCardCertificate(); cert != nil {
-			dataBytes, err := security.MarshalRsaCertificate(cert.GetRsaCertificate())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CARD_CERTIFICATE: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CARD_CERTIFICATE, ddv1.Generation_GENERATION_1, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CA_CERTIFICATE (Gen1)
-		if cert := tachograph.GetCaCertificate(); cert != nil {
-			dataBytes, err := security.MarshalRsaCertificate(cert.GetRsaCertificate())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CA_CERTIFICATE (Gen1): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CA_CERTIFICATE, ddv1.Generation_GENERATION_1, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_IDENTIFICATION
-		if identification := tachograph.GetIdentification(); identification != nil {
-			dataBytes, err := marshalOpts.MarshalDriverCardIdentification(identification)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal DriverCardIdentification: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_IDENTIFICATION, ddv1.Generation_GENERATION_1, dataBytes, identification.GetSignature()); err != nil {
-				return nil, err
-			}
+			return
 		}
-
-		// EF_CARD_DOWNLOAD
-		if cardDownload := tachograph.GetCardDownload(); cardDownload != nil {
-			dataBytes, err := marshalOpts.MarshalCardDownload(cardDownload)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CARD_DOWNLOAD: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER, ddv1.Generation_GENERATION_1, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_DRIVING_LICENCE_INFO
-		if dli := tachograph.GetDrivingLicenceInfo(); dli != nil {
-			dataBytes, err := marshalOpts.MarshalDrivingLicenceInfo(dli)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_DRIVING_LICENCE_INFO: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO, ddv1.Generation_GENERATION_1, dataBytes, dli.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_EVENTS_DATA
-		if events := tachograph.GetEventsData(); events != nil {
-			dataBytes, err := marshalOpts.MarshalEventsData(events)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_EVENTS_DATA: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_EVENTS_DATA, ddv1.Generation_GENERATION_1, dataBytes, events.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_FAULTS_DATA
-		if faults := tachograph.GetFaultsData(); faults != nil {
-			dataBytes, err := marshalOpts.MarshalFaultsData(faults)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_FAULTS_DATA: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_FAULTS_DATA, ddv1.Generation_GENERATION_1, dataBytes, faults.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_DRIVER_ACTIVITY_DATA
-		if activity := tachograph.GetDriverActivityData(); activity != nil {
-			dataBytes, err := marshalOpts.MarshalDriverActivity(activity)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_DRIVER_ACTIVITY_DATA: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA, ddv1.Generation_GENERATION_1, dataBytes, activity.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_VEHICLES_USED
-		if vehicles := tachograph.GetVehiclesUsed(); vehicles != nil {
-			dataBytes, err := marshalOpts.MarshalVehiclesUsed(vehicles)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_VEHICLES_USED: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_VEHICLES_USED, ddv1.Generation_GENERATION_1, dataBytes, vehicles.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_PLACES
-		if places := tachograph.GetPlaces(); places != nil {
-			dataBytes, err := marshalOpts.MarshalPlaces(places)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_PLACES: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_PLACES, ddv1.Generation_GENERATION_1, dataBytes, places.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CURRENT_USAGE
-		if currentUsage := tachograph.GetCurrentUsage(); currentUsage != nil {
-			dataBytes, err := marshalOpts.MarshalCurrentUsage(currentUsage)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CURRENT_USAGE: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CURRENT_USAGE, ddv1.Generation_GENERATION_1, dataBytes, currentUsage.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CONTROL_ACTIVITY_DATA
-		if controlActivity := tachograph.GetControlActivityData(); controlActivity != nil {
-			dataBytes, err := marshalOpts.MarshalCardControlActivityData(controlActivity)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CONTROL_ACTIVITY_DATA: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA, ddv1.Generation_GENERATION_1, dataBytes, controlActivity.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_SPECIFIC_CONDITIONS
-		if specificConditions := tachograph.GetSpecificConditions(); specificConditions != nil {
-			dataBytes, err := marshalOpts.MarshalCardSpecificConditions(specificConditions)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_SPECIFIC_CONDITIONS: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS, ddv1.Generation_GENERATION_1, dataBytes, specificConditions.GetSignature()); err != nil {
-				return nil, err
-			}
+		var dataBytes []byte
+		if dataBytes, err = opts.marshalElementaryFile(msg); err != nil {
+			err = fmt.Errorf("failed to marshal %v (%v): %w", ef, gen, err)
+			return
 		}
+		err = appendRecord(ef, gen, dataBytes, signature)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 4. Gen2 DF (Tachograph_G2)
-	if tachographG2 := file.GetTachographG2(); tachographG2 != nil {
-		// EF_APPLICATION_IDENTIFICATION (Gen2)
-		if appIdG2 := tachographG2.GetApplicationIdentification(); appIdG2 != nil {
-			dataBytes, err := marshalOpts.MarshalCardApplicationIdentificationG2(appIdG2)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_APPLICATION_IDENTIFICATION (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_APPLICATION_IDENTIFICATION, ddv1.Generation_GENERATION_2, dataBytes, appIdG2.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CARD_MA_CERTIFICATE
-		if cert := tachographG2.GetCardMaCertificate(); cert != nil {
-			dataBytes, err := security.MarshalEccCertificate(cert.GetEccCertificate())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CARD_MA_CERTIFICATE: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CARD_MA_CERTIFICATE, ddv1.Generation_GENERATION_2, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CARD_SIGN_CERTIFICATE
-		if cert := tachographG2.GetCardSignCertificate(); cert != nil {
-			dataBytes, err := security.MarshalEccCertificate(cert.GetEccCertificate())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CARD_SIGN_CERTIFICATE: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CARD_SIGN_CERTIFICATE, ddv1.Generation_GENERATION_2, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CA_CERTIFICATE (Gen2)
-		if cert := tachographG2.GetCaCertificate(); cert != nil {
-			dataBytes, err := security.MarshalEccCertificate(cert.GetEccCertificate())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CA_CERTIFICATE (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CA_CERTIFICATE, ddv1.Generation_GENERATION_2, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_LINK_CERTIFICATE
-		if cert := tachographG2.GetLinkCertificate(); cert != nil {
-			dataBytes, err := security.MarshalEccCertificate(cert.GetEccCertificate())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_LINK_CERTIFICATE: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_LINK_CERTIFICATE, ddv1.Generation_GENERATION_2, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_IDENTIFICATION (Gen2)
-		if identification := tachographG2.GetIdentification(); identification != nil {
-			dataBytes, err := marshalOpts.MarshalDriverCardIdentification(identification)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal DriverCardIdentification (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_IDENTIFICATION, ddv1.Generation_GENERATION_2, dataBytes, identification.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// Continue with other Gen2 EFs...
-		// EF_CARD_DOWNLOAD
-		if cardDownload := tachographG2.GetCardDownload(); cardDownload != nil {
-			dataBytes, err := marshalOpts.MarshalCardDownload(cardDownload)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CARD_DOWNLOAD (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER, ddv1.Generation_GENERATION_2, dataBytes, nil); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_DRIVING_LICENCE_INFO (Gen2)
-		if dli := tachographG2.GetDrivingLicenceInfo(); dli != nil {
-			dataBytes, err := marshalOpts.MarshalDrivingLicenceInfo(dli)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_DRIVING_LICENCE_INFO (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_DRIVING_LICENCE_INFO, ddv1.Generation_GENERATION_2, dataBytes, dli.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_EVENTS_DATA (Gen2)
-		if events := tachographG2.GetEventsData(); events != nil {
-			dataBytes, err := marshalOpts.MarshalEventsData(events)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_EVENTS_DATA (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_EVENTS_DATA, ddv1.Generation_GENERATION_2, dataBytes, events.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_FAULTS_DATA (Gen2)
-		if faults := tachographG2.GetFaultsData(); faults != nil {
-			dataBytes, err := marshalOpts.MarshalFaultsData(faults)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_FAULTS_DATA (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_FAULTS_DATA, ddv1.Generation_GENERATION_2, dataBytes, faults.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_DRIVER_ACTIVITY_DATA (Gen2)
-		if activity := tachographG2.GetDriverActivityData(); activity != nil {
-			dataBytes, err := marshalOpts.MarshalDriverActivity(activity)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_DRIVER_ACTIVITY_DATA (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_DRIVER_ACTIVITY_DATA, ddv1.Generation_GENERATION_2, dataBytes, activity.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_VEHICLES_USED (Gen2)
-		if vehicles := tachographG2.GetVehiclesUsed(); vehicles != nil {
-			dataBytes, err := marshalOpts.MarshalVehiclesUsedG2(vehicles)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_VEHICLES_USED (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_VEHICLES_USED, ddv1.Generation_GENERATION_2, dataBytes, vehicles.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_PLACES (Gen2)
-		if places := tachographG2.GetPlaces(); places != nil {
-			dataBytes, err := marshalOpts.MarshalPlacesG2(places)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_PLACES (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_PLACES, ddv1.Generation_GENERATION_2, dataBytes, places.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CURRENT_USAGE (Gen2)
-		if currentUsage := tachographG2.GetCurrentUsage(); currentUsage != nil {
-			dataBytes, err := marshalOpts.MarshalCurrentUsage(currentUsage)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CURRENT_USAGE (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CURRENT_USAGE, ddv1.Generation_GENERATION_2, dataBytes, currentUsage.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_CONTROL_ACTIVITY_DATA (Gen2)
-		if controlActivity := tachographG2.GetControlActivityData(); controlActivity != nil {
-			dataBytes, err := marshalOpts.MarshalCardControlActivityData(controlActivity)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_CONTROL_ACTIVITY_DATA (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_CONTROL_ACTIVITY_DATA, ddv1.Generation_GENERATION_2, dataBytes, controlActivity.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_SPECIFIC_CONDITIONS (Gen2)
-		if specificConditions := tachographG2.GetSpecificConditions(); specificConditions != nil {
-			dataBytes, err := marshalOpts.MarshalCardSpecificConditionsG2(specificConditions)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_SPECIFIC_CONDITIONS (Gen2): %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_SPECIFIC_CONDITIONS, ddv1.Generation_GENERATION_2, dataBytes, specificConditions.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_VEHICLE_UNITS_USED (Gen2 only)
-		if vehicleUnitsUsed := tachographG2.GetVehicleUnitsUsed(); vehicleUnitsUsed != nil {
-			dataBytes, err := marshalOpts.MarshalCardVehicleUnitsUsed(vehicleUnitsUsed)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_VEHICLE_UNITS_USED: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_VEHICLE_UNITS_USED, ddv1.Generation_GENERATION_2, dataBytes, vehicleUnitsUsed.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
-
-		// EF_GNSS_PLACES (Gen2 only)
-		if gnssPlaces := tachographG2.GetGnssPlaces(); gnssPlaces != nil {
-			dataBytes, err := marshalOpts.MarshalCardGnssPlaces(gnssPlaces)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal EF_GNSS_PLACES: %w", err)
-			}
-			if err := appendRecord(cardv1.ElementaryFileType_EF_GNSS_PLACES, ddv1.Generation_GENERATION_2, dataBytes, gnssPlaces.GetSignature()); err != nil {
-				return nil, err
-			}
-		}
+	// Unknown EFs past the last recognized EF
+	for _, record := range unknown {
+		records = append(records, proto.Clone(record).(*cardv1.RawCardFile_Record))
 	}
 
 	rawFile := &cardv1.RawCardFile{}
 	rawFile.SetRecords(records)
 	return rawFile, nil
 }
+
+// marshalElementaryFile marshals the data of a parsed EF message, as reported by
+// RangeElementaryFiles. EFs without a binary encoding yet return nil data.
+func (opts MarshalOptions) marshalElementaryFile(msg proto.Message) ([]byte, error) {
+	switch msg := msg.(type) {
+	case *cardv1.Icc:
+		return opts.MarshalIcc(msg)
+	case *cardv1.Ic:
+		return opts.MarshalCardIc(msg)
+	case *cardv1.ApplicationIdentification:
+		return opts.MarshalCardApplicationIdentification(msg)
+	case *cardv1.ApplicationIdentificationG2:
+		return opts.MarshalCardApplicationIdentificationG2(msg)
+	case *cardv1.ApplicationIdentificationV2:
+		return opts.MarshalCardApplicationIdentificationV2(msg)
+	case *cardv1.CardCertificate:
+		return security.MarshalRsaCertificate(msg.GetRsaCertificate())
+	case *cardv1.CaCertificate:
+		return security.MarshalRsaCertificate(msg.GetRsaCertificate())
+	case *cardv1.CardMaCertificate:
+		return security.MarshalEccCertificate(msg.GetEccCertificate())
+	case *cardv1.CardSignCertificate:
+		return security.MarshalEccCertificate(msg.GetEccCertificate())
+	case *cardv1.CaCertificateG2:
+		return security.MarshalEccCertificate(msg.GetEccCertificate())
+	case *cardv1.LinkCertificate:
+		return security.MarshalEccCertificate(msg.GetEccCertificate())
+	case *cardv1.DriverCardIdentification:
+		return opts.MarshalDriverCardIdentification(msg)
+	case *cardv1.CardDownloadDriver:
+		return opts.MarshalCardDownload(msg)
+	case *cardv1.DrivingLicenceInfo:
+		return opts.MarshalDrivingLicenceInfo(msg)
+	case *cardv1.EventsData:
+		return opts.MarshalEventsData(msg)
+	case *cardv1.FaultsData:
+		return opts.MarshalFaultsData(msg)
+	case *cardv1.DriverActivityData:
+		return opts.MarshalDriverActivity(msg)
+	case *cardv1.VehiclesUsed:
+		return opts.MarshalVehiclesUsed(msg)
+	case *cardv1.VehiclesUsedG2:
+		return opts.MarshalVehiclesUsedG2(msg)
+	case *cardv1.Places:
+		return opts.MarshalPlaces(msg)
+	case *cardv1.PlacesG2:
+		return opts.MarshalPlacesG2(msg)
+	case *cardv1.CurrentUsage:
+		return opts.MarshalCurrentUsage(msg)
+	case *cardv1.ControlActivityData:
+		return opts.MarshalCardControlActivityData(msg)
+	case *cardv1.SpecificConditions:
+		return opts.MarshalCardSpecificConditions(msg)
+	case *cardv1.SpecificConditionsG2:
+		return opts.MarshalCardSpecificConditionsG2(msg)
+	case *cardv1.VehicleUnitsUsed:
+		return opts.MarshalCardVehicleUnitsUsed(msg)
+	case *cardv1.GnssPlaces:
+		return opts.MarshalCardGnssPlaces(msg)
+	default:
+		return nil, nil
+	}
+}
@@ -1,12 +1,13 @@
 package card
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -59,7 +60,7 @@ func (opts UnmarshalOptions) unmarshalVehicleUnitsUsed(data []byte) (*cardv1.Veh
 	newestRecordPointer := binary.BigEndian.Uint16(data[idxNewestRecordPointer:])
 	target.SetVehicleUnitPointerNewestRecord(int32(newestRecordPointer))
 
-	// Parse records using bufio.Scanner pattern
+	// Parse records
 	records, err := opts.unmarshalCardVehicleUnitRecords(data[lenNewestRecordPointer:])
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse vehicle unit records: %w", err)
@@ -69,38 +70,25 @@ func (opts UnmarshalOptions) unmarshalVehicleUnitsUsed(data []byte) (*cardv1.Veh
 	return &target, nil
 }
 
-// splitCardVehicleUnitRecord is a bufio.SplitFunc for parsing CardVehicleUnitRecord entries.
-func splitCardVehicleUnitRecord(data []byte, atEOF bool) (advance int, token []byte, err error) {
+// unmarshalCardVehicleUnitRecords parses the fixed-size array of vehicle unit records.
+// A partial record at the end of the data is an error in strict mode, and is
+// dropped otherwise.
+func (opts UnmarshalOptions) unmarshalCardVehicleUnitRecords(data []byte) ([]*cardv1.VehicleUnitsUsed_Record, error) {
 	const lenCardVehicleUnitRecord = 10
 
-	if len(data) < lenCardVehicleUnitRecord {
-		if atEOF {
-			return 0, nil, nil
-		}
-		return 0, nil, nil
+	if partial := len(data) % lenCardVehicleUnitRecord; partial != 0 && opts.Strict {
+		return nil, fmt.Errorf("partial vehicle unit record: got %d bytes, want %d: %w", partial, lenCardVehicleUnitRecord, io.ErrUnexpectedEOF)
 	}
 
-	return lenCardVehicleUnitRecord, data[:lenCardVehicleUnitRecord], nil
-}
-
-// unmarshalCardVehicleUnitRecords parses the fixed-size array of vehicle unit records.
-func (opts UnmarshalOptions) unmarshalCardVehicleUnitRecords(data []byte) ([]*cardv1.VehicleUnitsUsed_Record, error) {
-	scanner := bufio.NewScanner(bytes.NewReader(data))
-	scanner.Split(splitCardVehicleUnitRecord)
-
 	var records []*cardv1.VehicleUnitsUsed_Record
-	for scanner.Scan() {
-		record, err := opts.unmarshalCardVehicleUnitRecord(scanner.Bytes())
+	for offset := 0; offset+lenCardVehicleUnitRecord <= len(data); offset += lenCardVehicleUnitRecord {
+		record, err := opts.unmarshalCardVehicleUnitRecord(data[offset : offset+lenCardVehicleUnitRecord])
 		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal vehicle unit record: %w", err)
 		}
 		records = append(records, record)
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("scanner error: %w", err)
-	}
-
 	return records, nil
 }
 
@@ -133,6 +121,11 @@ func (opts UnmarshalOptions) unmarshalCardVehicleUnitRecord(data []byte) (*cardv
 	}
 	record.SetTimestamp(timestamp)
 
+	// Only Generation 2 VUs write EF_VehicleUnits_Used, see the proto
+	if timestamp != nil {
+		record.SetVuGeneration(ddv1.Generation_GENERATION_2)
+	}
+
 	// Parse manufacturer code (1 byte)
 	record.SetManufacturerCode(int32(data[idxManufacturerCode]))
 
@@ -303,6 +296,10 @@ func (opts AnonymizeOptions) anonymizeVehicleUnitRecord(record *cardv1.VehicleUn
 	}
 	// else: Zero timestamp - leave unset (nil)
 
+	if record.HasVuGeneration() {
+		result.SetVuGeneration(record.GetVuGeneration())
+	}
+
 	// Replace manufacturer code with test value 0x40 for non-zero values
 	if record.GetManufacturerCode() != 0 {
 		result.SetManufacturerCode(0x40)
@@ -0,0 +1,130 @@
+package card
+
+import (
+	"sort"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// VehicleUsage is a period during which a driver card was used in a vehicle.
+type VehicleUsage struct {
+	// FirstUse and LastUse bound the period of use of the vehicle.
+	// LastUse is zero while the card is still inserted.
+	FirstUse, LastUse time.Time
+
+	// OdometerBeginKm and OdometerEndKm are the vehicle odometer values at
+	// the start and end of the period.
+	OdometerBeginKm, OdometerEndKm int32
+
+	// Registration is the registration of the vehicle.
+	Registration *ddv1.VehicleRegistrationIdentification
+
+	// VehicleIdentificationNumber is the VIN of the vehicle.
+	// It is only recorded by Gen2 cards.
+	VehicleIdentificationNumber string
+}
+
+// DistanceKm returns the distance driven with the vehicle during the period,
+// or zero while the card is still inserted.
+//
+// A rollover of the odometer during the period is accounted for, see
+// [dd.UnwrapOdometer].
+func (u *VehicleUsage) DistanceKm() int32 {
+	if u.LastUse.IsZero() {
+		return 0
+	}
+	return max(0, dd.UnwrapOdometer(u.OdometerBeginKm, u.OdometerEndKm)-u.OdometerBeginKm)
+}
+
+// VehicleUsageTimeline returns the vehicles used with a driver card, ordered
+// chronologically, preferring the Generation 2 application when present.
+//
+// The records of EF_Vehicles_Used (CardVehiclesUsed, Data Dictionary,
+// Section 2.38) are stored in a cyclic buffer, and vehiclePointerNewestRecord
+// points at the most recently written slot. Once the buffer has wrapped, the
+// slots after the pointer hold the oldest records, so physical slot order is
+// not chronological. Records are ordered by their first use, and then by last
+// use; records with equal times keep their order in the cyclic buffer, starting
+// from the slot after the pointer.
+//
+// Records without a first use time, such as unused slots, are skipped.
+func VehicleUsageTimeline(file *cardv1.DriverCardFile) []*VehicleUsage {
+	var usages []*VehicleUsage
+	if vehiclesUsed := file.GetTachographG2().GetVehiclesUsed(); vehiclesUsed != nil {
+		records := vehiclesUsed.GetRecords()
+		for _, i := range cyclicOrder(len(records), vehiclesUsed.GetNewestRecordIndex()) {
+			record := records[i]
+			if usage := newVehicleUsage(record.GetVehicleFirstUse(), record.GetVehicleLastUse()); usage != nil {
+				usage.OdometerBeginKm = record.GetVehicleOdometerBeginKm()
+				usage.OdometerEndKm = record.GetVehicleOdometerEndKm()
+				usage.Registration = record.GetVehicleRegistration()
+				usage.VehicleIdentificationNumber = record.GetVehicleIdentificationNumber()
+				usages = append(usages, usage)
+			}
+		}
+	} else {
+		vehiclesUsed := file.GetTachograph().GetVehiclesUsed()
+		records := vehiclesUsed.GetRecords()
+		for _, i := range cyclicOrder(len(records), vehiclesUsed.GetNewestRecordIndex()) {
+			record := records[i]
+			if usage := newVehicleUsage(record.GetVehicleFirstUse(), record.GetVehicleLastUse()); usage != nil {
+				usage.OdometerBeginKm = record.GetVehicleOdometerBeginKm()
+				usage.OdometerEndKm = record.GetVehicleOdometerEndKm()
+				usage.Registration = record.GetVehicleRegistration()
+				usages = append(usages, usage)
+			}
+		}
+	}
+	sort.SliceStable(usages, func(i, j int) bool {
+		if !usages[i].FirstUse.Equal(usages[j].FirstUse) {
+			return usages[i].FirstUse.Before(usages[j].FirstUse)
+		}
+		return usages[i].LastUse.Before(usages[j].LastUse)
+	})
+	return usages
+}
+
+// newVehicleUsage returns a usage spanning the given times, or nil if the
+// first use is not set.
+func newVehicleUsage(firstUse, lastUse *timestamppb.Timestamp) *VehicleUsage {
+	if dd.IsUnsetTime(firstUse) {
+		return nil
+	}
+	usage := &VehicleUsage{FirstUse: firstUse.AsTime().UTC()}
+	if !dd.IsUnsetTime(lastUse) {
+		usage.LastUse = lastUse.AsTime().UTC()
+	}
+	return usage
+}
+
+// inCyclicOrder returns the records of a cyclic buffer starting from the
+// oldest record, see cyclicOrder.
+func inCyclicOrder[T any](records []T, newest int32) []T {
+	result := make([]T, 0, len(records))
+	for _, i := range cyclicOrder(len(records), newest) {
+		result = append(result, records[i])
+	}
+	return result
+}
+
+// cyclicOrder returns the indices of a cyclic buffer of n records, starting
+// from the oldest record, i.e. the slot after the newest record. A pointer
+// outside the buffer is treated as pointing at the last slot.
+//
+// Unused slots are kept as invalid records when parsing, so the indices are
+// those of the slots of the buffer.
+func cyclicOrder(n int, newest int32) []int {
+	start := int(newest) + 1
+	if start <= 0 || start >= n {
+		start = 0
+	}
+	order := make([]int, 0, n)
+	for i := range n {
+		order = append(order, (start+i)%n)
+	}
+	return order
+}
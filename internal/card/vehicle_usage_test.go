@@ -0,0 +1,126 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestVehicleUsageTimeline(t *testing.T) {
+	day := func(n int) time.Time {
+		return time.Date(2024, 3, n, 8, 0, 0, 0, time.UTC)
+	}
+	// newRecord creates a record used on the given day, identified by its
+	// odometer begin value.
+	newRecord := func(odometerBeginKm int32, firstUse time.Time) *ddv1.CardVehicleRecord {
+		record := &ddv1.CardVehicleRecord{}
+		record.SetVehicleOdometerBeginKm(odometerBeginKm)
+		record.SetVehicleOdometerEndKm(odometerBeginKm + 100)
+		record.SetVehicleFirstUse(timestamppb.New(firstUse))
+		record.SetVehicleLastUse(timestamppb.New(firstUse.Add(8 * time.Hour)))
+		return record
+	}
+
+	tests := []struct {
+		name    string
+		newest  int32
+		records []*ddv1.CardVehicleRecord
+		want    []int32
+	}{
+		{
+			name:   "wrapped buffer",
+			newest: 1,
+			records: []*ddv1.CardVehicleRecord{
+				newRecord(3000, day(3)),
+				newRecord(4000, day(4)),
+				newRecord(1000, day(1)),
+				newRecord(2000, day(2)),
+			},
+			want: []int32{1000, 2000, 3000, 4000},
+		},
+		{
+			name:   "equal times keep cyclic order",
+			newest: 0,
+			records: []*ddv1.CardVehicleRecord{
+				newRecord(3000, day(2)),
+				newRecord(1000, day(1)),
+				newRecord(2000, day(2)),
+			},
+			want: []int32{1000, 2000, 3000},
+		},
+		{
+			name:   "not wrapped",
+			newest: 1,
+			records: []*ddv1.CardVehicleRecord{
+				newRecord(1000, day(1)),
+				newRecord(2000, day(2)),
+			},
+			want: []int32{1000, 2000},
+		},
+		{
+			name:   "record without first use",
+			newest: 1,
+			records: []*ddv1.CardVehicleRecord{
+				newRecord(1000, day(1)),
+				newRecord(2000, time.Unix(0, 0)),
+			},
+			want: []int32{1000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vehiclesUsed := &cardv1.VehiclesUsed{}
+			vehiclesUsed.SetNewestRecordIndex(tt.newest)
+			vehiclesUsed.SetRecords(tt.records)
+			tachograph := &cardv1.DriverCardFile_Tachograph{}
+			tachograph.SetVehiclesUsed(vehiclesUsed)
+			file := &cardv1.DriverCardFile{}
+			file.SetTachograph(tachograph)
+
+			var got []int32
+			for _, usage := range VehicleUsageTimeline(file) {
+				got = append(got, usage.OdometerBeginKm)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("VehicleUsageTimeline() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVehicleUsage_DistanceKm(t *testing.T) {
+	firstUse := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		usage VehicleUsage
+		want  int32
+	}{
+		{
+			name:  "ended",
+			usage: VehicleUsage{FirstUse: firstUse, LastUse: firstUse.Add(time.Hour), OdometerBeginKm: 1000, OdometerEndKm: 1250},
+			want:  250,
+		},
+		{
+			name:  "odometer rollover",
+			usage: VehicleUsage{FirstUse: firstUse, LastUse: firstUse.Add(time.Hour), OdometerBeginKm: 9999900, OdometerEndKm: 150},
+			want:  250,
+		},
+		{
+			name:  "card still inserted",
+			usage: VehicleUsage{FirstUse: firstUse, OdometerBeginKm: 1000, OdometerEndKm: 1250},
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.usage.DistanceKm(); got != tt.want {
+				t.Errorf("DistanceKm() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
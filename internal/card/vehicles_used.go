@@ -4,8 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-
-	"github.com/way-platform/tachograph-go/internal/dd"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -18,7 +17,7 @@ func (opts UnmarshalOptions) unmarshalVehiclesUsed(data []byte) (*cardv1.Vehicle
 	)
 
 	if len(data) < lenMinEfVehiclesUsed {
-		return nil, fmt.Errorf("insufficient data for vehicles used: got %d bytes, need at least %d", len(data), lenMinEfVehiclesUsed)
+		return nil, fmt.Errorf("insufficient data for vehicles used: got %d bytes, need at least %d: %w", len(data), lenMinEfVehiclesUsed, io.ErrUnexpectedEOF)
 	}
 
 	var target cardv1.VehiclesUsed
@@ -56,11 +55,20 @@ func (opts UnmarshalOptions) unmarshalVehicleRecordsGen1(r *bytes.Reader) ([]*dd
 		if _, err := r.Read(recordBytes); err != nil {
 			break // Stop parsing on error, but return what we have
 		}
+		if isEmptyRecord(recordBytes) {
+			// Unused slot: preserve original bytes
+			record := &ddv1.CardVehicleRecord{}
+			record.SetValid(false)
+			record.SetRawData(recordBytes)
+			records = append(records, record)
+			continue
+		}
 
 		record, err := opts.UnmarshalOptions.UnmarshalCardVehicleRecord(recordBytes)
 		if err != nil {
 			return records, fmt.Errorf("failed to parse Gen1 vehicle record: %w", err)
 		}
+		record.SetValid(true)
 		records = append(records, record)
 	}
 
@@ -73,8 +81,8 @@ func (opts MarshalOptions) MarshalVehiclesUsed(vehiclesUsed *cardv1.VehiclesUsed
 		return nil, nil
 	}
 
-	// Calculate expected size: 2 bytes (pointer) + N records × 31 bytes
 	const recordSize = 31
+
 	numRecords := len(vehiclesUsed.GetRecords())
 	expectedSize := 2 + (numRecords * recordSize)
 
@@ -91,7 +99,7 @@ func (opts MarshalOptions) MarshalVehiclesUsed(vehiclesUsed *cardv1.VehiclesUsed
 
 		offset := 2
 		for _, record := range vehiclesUsed.GetRecords() {
-			recordBytes, err := opts.MarshalCardVehicleRecord(record)
+			recordBytes, err := opts.marshalCardVehicleRecord(record)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal Gen1 vehicle record: %w", err)
 			}
@@ -111,7 +119,7 @@ func (opts MarshalOptions) MarshalVehiclesUsed(vehiclesUsed *cardv1.VehiclesUsed
 	dst = binary.BigEndian.AppendUint16(dst, newestRecordIndex)
 
 	for _, record := range vehiclesUsed.GetRecords() {
-		recordBytes, err := opts.MarshalCardVehicleRecord(record)
+		recordBytes, err := opts.marshalCardVehicleRecord(record)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal Gen1 vehicle record: %w", err)
 		}
@@ -121,6 +129,16 @@ func (opts MarshalOptions) MarshalVehiclesUsed(vehiclesUsed *cardv1.VehiclesUsed
 	return dst, nil
 }
 
+// marshalCardVehicleRecord marshals a single Gen1 vehicle record, returning the
+// original bytes of invalid records (e.g. unused slots) verbatim.
+func (opts MarshalOptions) marshalCardVehicleRecord(record *ddv1.CardVehicleRecord) ([]byte, error) {
+	const recordSize = 31
+	if !record.GetValid() && len(record.GetRawData()) == recordSize {
+		return record.GetRawData(), nil
+	}
+	return opts.MarshalCardVehicleRecord(record)
+}
+
 // AnonymizeVehiclesUsed creates an anonymized copy, replacing sensitive data
 // with static, deterministic test values while preserving structure.
 //
@@ -139,10 +157,7 @@ func (opts AnonymizeOptions) anonymizeVehiclesUsed(v *cardv1.VehiclesUsed) *card
 	result := &cardv1.VehiclesUsed{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Preserve pointer (structural info)
 	result.SetNewestRecordIndex(v.GetNewestRecordIndex())
@@ -150,7 +165,16 @@ func (opts AnonymizeOptions) anonymizeVehiclesUsed(v *cardv1.VehiclesUsed) *card
 	// Anonymize records
 	var anonymizedRecords []*ddv1.CardVehicleRecord
 	for i, record := range v.GetRecords() {
+		if !record.GetValid() {
+			// Preserve invalid records as-is
+			invalid := &ddv1.CardVehicleRecord{}
+			invalid.SetValid(false)
+			invalid.SetRawData(record.GetRawData())
+			anonymizedRecords = append(anonymizedRecords, invalid)
+			continue
+		}
 		anonymizedRecord := ddOpts.AnonymizeCardVehicleRecord(record, i)
+		anonymizedRecord.SetValid(true)
 		anonymizedRecords = append(anonymizedRecords, anonymizedRecord)
 	}
 	result.SetRecords(anonymizedRecords)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -17,7 +18,7 @@ func (opts UnmarshalOptions) unmarshalVehiclesUsedG2(data []byte) (*cardv1.Vehic
 	)
 
 	if len(data) < lenMinEfVehiclesUsed {
-		return nil, fmt.Errorf("insufficient data for vehicles used: got %d bytes, need at least %d", len(data), lenMinEfVehiclesUsed)
+		return nil, fmt.Errorf("insufficient data for vehicles used: got %d bytes, need at least %d: %w", len(data), lenMinEfVehiclesUsed, io.ErrUnexpectedEOF)
 	}
 
 	var target cardv1.VehiclesUsedG2
@@ -55,11 +56,20 @@ func (opts UnmarshalOptions) unmarshalVehicleRecordsGen2(r *bytes.Reader) ([]*dd
 		if _, err := r.Read(recordBytes); err != nil {
 			break // Stop parsing on error, but return what we have
 		}
+		if isEmptyRecord(recordBytes) {
+			// Unused slot: preserve original bytes
+			record := &ddv1.CardVehicleRecordG2{}
+			record.SetValid(false)
+			record.SetRawData(recordBytes)
+			records = append(records, record)
+			continue
+		}
 
 		record, err := opts.UnmarshalOptions.UnmarshalCardVehicleRecordG2(recordBytes)
 		if err != nil {
 			return records, fmt.Errorf("failed to parse Gen2 vehicle record: %w", err)
 		}
+		record.SetValid(true)
 		records = append(records, record)
 	}
 
@@ -72,8 +82,8 @@ func (opts MarshalOptions) MarshalVehiclesUsedG2(vehiclesUsed *cardv1.VehiclesUs
 		return nil, nil
 	}
 
-	// Calculate expected size: 2 bytes (pointer) + N records × 48 bytes
 	const recordSize = 48
+
 	numRecords := len(vehiclesUsed.GetRecords())
 	expectedSize := 2 + (numRecords * recordSize)
 
@@ -90,7 +100,7 @@ func (opts MarshalOptions) MarshalVehiclesUsedG2(vehiclesUsed *cardv1.VehiclesUs
 
 		offset := 2
 		for _, record := range vehiclesUsed.GetRecords() {
-			recordBytes, err := opts.MarshalCardVehicleRecordG2(record)
+			recordBytes, err := opts.marshalCardVehicleRecordG2(record)
 			if err != nil {
 				return nil, fmt.Errorf("failed to marshal Gen2 vehicle record: %w", err)
 			}
@@ -110,7 +120,7 @@ func (opts MarshalOptions) MarshalVehiclesUsedG2(vehiclesUsed *cardv1.VehiclesUs
 	dst = binary.BigEndian.AppendUint16(dst, newestRecordIndex)
 
 	for _, record := range vehiclesUsed.GetRecords() {
-		recordBytes, err := opts.MarshalCardVehicleRecordG2(record)
+		recordBytes, err := opts.marshalCardVehicleRecordG2(record)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal Gen2 vehicle record: %w", err)
 		}
@@ -120,6 +130,16 @@ func (opts MarshalOptions) MarshalVehiclesUsedG2(vehiclesUsed *cardv1.VehiclesUs
 	return dst, nil
 }
 
+// marshalCardVehicleRecordG2 marshals a single Gen2 vehicle record, returning
+// the original bytes of invalid records (e.g. unused slots) verbatim.
+func (opts MarshalOptions) marshalCardVehicleRecordG2(record *ddv1.CardVehicleRecordG2) ([]byte, error) {
+	const recordSize = 48
+	if !record.GetValid() && len(record.GetRawData()) == recordSize {
+		return record.GetRawData(), nil
+	}
+	return opts.MarshalCardVehicleRecordG2(record)
+}
+
 // anonymizeVehiclesUsedG2 anonymizes a VehiclesUsedG2 record.
 //
 // Anonymization strategy (similar to Gen1):
@@ -143,6 +163,15 @@ func (opts AnonymizeOptions) anonymizeVehiclesUsedG2(v *cardv1.VehiclesUsedG2) *
 	// Anonymize records
 	var anonymizedRecords []*ddv1.CardVehicleRecordG2
 	for i, record := range v.GetRecords() {
+		if !record.GetValid() {
+			// Preserve invalid records as-is
+			invalid := &ddv1.CardVehicleRecordG2{}
+			invalid.SetValid(false)
+			invalid.SetRawData(record.GetRawData())
+			anonymizedRecords = append(anonymizedRecords, invalid)
+			continue
+		}
+
 		// Clone and anonymize
 		anonRecord := proto.Clone(record).(*ddv1.CardVehicleRecordG2)
 
@@ -1,6 +1,7 @@
 package card
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -60,3 +61,41 @@ func TestVehiclesUsed_Generation1(t *testing.T) {
 		})
 	}
 }
+
+func TestVehiclesUsed_EmptySlots(t *testing.T) {
+	newVehicle := func(counter byte) []byte {
+		return []byte{
+			0x00, 0x30, 0x39, // vehicleOdometerBegin
+			0x00, 0x30, 0x3A, // vehicleOdometerEnd
+			0x5E, 0x0C, 0x5A, 0x80, // vehicleFirstUse
+			0x5E, 0x0C, 0x68, 0x90, // vehicleLastUse
+			0x11, 0x01, 'T', 'E', 'S', 'T', '-', 'V', 'R', 'N', ' ', ' ', ' ', ' ', ' ', // vehicleRegistration
+			0x00, counter, // vuDataBlockCounter
+		}
+	}
+	data := []byte{0x00, 0x02} // vehiclePointerNewestRecord
+	data = append(data, newVehicle(1)...)
+	data = append(data, bytes.Repeat([]byte{0xFF}, 31)...)
+	data = append(data, newVehicle(2)...)
+	data = append(data, bytes.Repeat([]byte{0x00}, 31)...)
+
+	vehiclesUsed, err := UnmarshalOptions{}.unmarshalVehiclesUsed(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	var valid []bool
+	for _, record := range vehiclesUsed.GetRecords() {
+		valid = append(valid, record.GetValid())
+	}
+	if diff := cmp.Diff([]bool{true, false, true, false}, valid); diff != "" {
+		t.Fatalf("record validity mismatch (-want +got):\n%s", diff)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalVehiclesUsed(vehiclesUsed)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
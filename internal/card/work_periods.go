@@ -0,0 +1,152 @@
+package card
+
+import (
+	"sort"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WorkPeriod is a daily work period of a driver, bounded by the places
+// entered at its begin and end.
+type WorkPeriod struct {
+	// Start and End bound the work period.
+	// Start is zero if the begin of the period is not recorded on the card,
+	// and End is zero if the end is not recorded, e.g. while the period is
+	// ongoing or because the cyclic buffer was overwritten.
+	Start, End time.Time
+
+	// StartCountry and StartRegion are the place entered at the begin of
+	// the period.
+	StartCountry ddv1.NationNumeric
+	StartRegion  int32
+
+	// EndCountry and EndRegion are the place entered at the end of the period.
+	EndCountry ddv1.NationNumeric
+	EndRegion  int32
+
+	// OdometerStartKm and OdometerEndKm are the vehicle odometer values
+	// entered at the begin and end of the period.
+	OdometerStartKm, OdometerEndKm int32
+}
+
+// DistanceKm returns the distance driven during the work period, or zero if
+// either its begin or end is not recorded.
+//
+// A rollover of the odometer during the period is accounted for, see
+// [dd.UnwrapOdometer].
+func (p *WorkPeriod) DistanceKm() int32 {
+	if p.Start.IsZero() || p.End.IsZero() {
+		return 0
+	}
+	return max(0, dd.UnwrapOdometer(p.OdometerStartKm, p.OdometerEndKm)-p.OdometerStartKm)
+}
+
+// PlaceRecord is a place record of either generation, *ddv1.PlaceRecord or
+// *ddv1.PlaceRecordG2, as stored in the EF_Places of driver cards and in the
+// activities of vehicle units.
+type PlaceRecord interface {
+	GetEntryTime() *timestamppb.Timestamp
+	GetEntryTypeDailyWorkPeriod() ddv1.EntryTypeDailyWorkPeriod
+	GetDailyWorkPeriodCountry() ddv1.NationNumeric
+	GetDailyWorkPeriodRegion() int32
+	GetVehicleOdometerKm() int32
+}
+
+// DriverCardWorkPeriods returns the daily work periods recorded in the
+// EF_Places of a driver card, see [DeriveWorkPeriods], preferring the
+// Generation 2 application when present.
+//
+// The records of EF_Places are stored in a cyclic buffer, and are passed on
+// starting from the oldest slot, so that records with equal entry times keep
+// the order in which they were written.
+func DriverCardWorkPeriods(file *cardv1.DriverCardFile) []*WorkPeriod {
+	if places := file.GetTachographG2().GetPlaces(); places != nil {
+		return DeriveWorkPeriods(inCyclicOrder(places.GetRecords(), places.GetNewestRecordIndex()))
+	}
+	places := file.GetTachograph().GetPlaces()
+	return DeriveWorkPeriods(inCyclicOrder(places.GetRecords(), places.GetNewestRecordIndex()))
+}
+
+// DeriveWorkPeriods pairs the begin and end places of daily work periods,
+// ordered chronologically.
+//
+// Place records (PlaceRecord, Data Dictionary, Section 2.117) each record the
+// entry of a place at the begin or end of a daily work period
+// (EntryTypeDailyWorkPeriod, Section 2.66). They are found in the EF_Places of
+// driver cards, and in the activities of vehicle units: the places of Gen2
+// activities, and the place records of the VuPlaceDailyWorkPeriodRecords of
+// Gen1 activities.
+//
+// Each begin entry is paired with the next end entry. A begin entry without a
+// matching end, or an end entry without a matching begin, yields a period with
+// only that side recorded, as happens at the edges of a cyclic buffer or of a
+// download period.
+//
+// Records without an entry time, such as unused slots, are skipped.
+func DeriveWorkPeriods[T PlaceRecord](places []T) []*WorkPeriod {
+	var entries []placeEntry
+	for _, record := range places {
+		if dd.IsUnsetTime(record.GetEntryTime()) {
+			continue
+		}
+		entries = append(entries, placeEntry{
+			time:       record.GetEntryTime().AsTime().UTC(),
+			entryType:  record.GetEntryTypeDailyWorkPeriod(),
+			country:    record.GetDailyWorkPeriodCountry(),
+			region:     record.GetDailyWorkPeriodRegion(),
+			odometerKm: record.GetVehicleOdometerKm(),
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].time.Before(entries[j].time)
+	})
+
+	var periods []*WorkPeriod
+	var open *WorkPeriod
+	for _, entry := range entries {
+		switch entry.entryType {
+		case ddv1.EntryTypeDailyWorkPeriod_BEGIN,
+			ddv1.EntryTypeDailyWorkPeriod_BEGIN_GNSS,
+			ddv1.EntryTypeDailyWorkPeriod_BEGIN_ITS:
+			if open != nil {
+				periods = append(periods, open)
+			}
+			open = &WorkPeriod{
+				Start:           entry.time,
+				StartCountry:    entry.country,
+				StartRegion:     entry.region,
+				OdometerStartKm: entry.odometerKm,
+			}
+		case ddv1.EntryTypeDailyWorkPeriod_END,
+			ddv1.EntryTypeDailyWorkPeriod_END_GNSS,
+			ddv1.EntryTypeDailyWorkPeriod_END_ITS:
+			period := open
+			if period == nil {
+				period = &WorkPeriod{}
+			}
+			period.End = entry.time
+			period.EndCountry = entry.country
+			period.EndRegion = entry.region
+			period.OdometerEndKm = entry.odometerKm
+			periods = append(periods, period)
+			open = nil
+		}
+	}
+	if open != nil {
+		periods = append(periods, open)
+	}
+	return periods
+}
+
+// placeEntry is the part of a place record needed to pair work periods.
+type placeEntry struct {
+	time       time.Time
+	entryType  ddv1.EntryTypeDailyWorkPeriod
+	country    ddv1.NationNumeric
+	region     int32
+	odometerKm int32
+}
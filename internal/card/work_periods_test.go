@@ -0,0 +1,120 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestDriverCardWorkPeriods(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	newRecord := func(entryTime time.Time, entryType ddv1.EntryTypeDailyWorkPeriod, country ddv1.NationNumeric, odometerKm int32) *ddv1.PlaceRecord {
+		record := &ddv1.PlaceRecord{}
+		record.SetEntryTime(timestamppb.New(entryTime))
+		record.SetEntryTypeDailyWorkPeriod(entryType)
+		record.SetDailyWorkPeriodCountry(country)
+		record.SetVehicleOdometerKm(odometerKm)
+		return record
+	}
+	const (
+		begin   = ddv1.EntryTypeDailyWorkPeriod_BEGIN
+		end     = ddv1.EntryTypeDailyWorkPeriod_END
+		finland = ddv1.NationNumeric_FINLAND
+		sweden  = ddv1.NationNumeric_SWEDEN
+	)
+
+	places := &cardv1.Places{}
+	// A wrapped cyclic buffer, where the begin of the first period was
+	// overwritten and the last period is still ongoing.
+	places.SetNewestRecordIndex(2)
+	places.SetRecords([]*ddv1.PlaceRecord{
+		newRecord(at(2, 6), begin, finland, 1300),
+		newRecord(at(2, 17), end, sweden, 1700),
+		newRecord(at(3, 6), begin, sweden, 1700),
+		newRecord(at(1, 16), end, finland, 1200),
+		{}, // unused slot
+	})
+	tachograph := &cardv1.DriverCardFile_Tachograph{}
+	tachograph.SetPlaces(places)
+	file := &cardv1.DriverCardFile{}
+	file.SetTachograph(tachograph)
+
+	type period struct {
+		Start, End               time.Time
+		StartCountry, EndCountry ddv1.NationNumeric
+		DistanceKm               int32
+	}
+	var got []period
+	for _, p := range DriverCardWorkPeriods(file) {
+		got = append(got, period{
+			Start:        p.Start,
+			End:          p.End,
+			StartCountry: p.StartCountry,
+			EndCountry:   p.EndCountry,
+			DistanceKm:   p.DistanceKm(),
+		})
+	}
+	want := []period{
+		{End: at(1, 16), EndCountry: finland},
+		{Start: at(2, 6), End: at(2, 17), StartCountry: finland, EndCountry: sweden, DistanceKm: 400},
+		{Start: at(3, 6), StartCountry: sweden},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DriverCardWorkPeriods() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeriveWorkPeriods(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2024, 3, day, hour, 0, 0, 0, time.UTC)
+	}
+	newRecord := func(entryTime time.Time, entryType ddv1.EntryTypeDailyWorkPeriod, region, odometerKm int32) *ddv1.PlaceRecordG2 {
+		record := &ddv1.PlaceRecordG2{}
+		record.SetEntryTime(timestamppb.New(entryTime))
+		record.SetEntryTypeDailyWorkPeriod(entryType)
+		record.SetDailyWorkPeriodCountry(ddv1.NationNumeric_SPAIN)
+		record.SetDailyWorkPeriodRegion(region)
+		record.SetVehicleOdometerKm(odometerKm)
+		return record
+	}
+
+	// Places of the activities of a vehicle unit, where a period begun with
+	// the GNSS position and the begin of the next period are recorded, but
+	// the end of the last period falls after the download.
+	got := DeriveWorkPeriods([]*ddv1.PlaceRecordG2{
+		newRecord(at(1, 6), ddv1.EntryTypeDailyWorkPeriod_BEGIN_GNSS, 0x01, 500),
+		newRecord(at(2, 7), ddv1.EntryTypeDailyWorkPeriod_BEGIN, 0x03, 900),
+		newRecord(at(1, 18), ddv1.EntryTypeDailyWorkPeriod_END, 0x02, 750),
+	})
+	want := []*WorkPeriod{
+		{
+			Start:           at(1, 6),
+			End:             at(1, 18),
+			StartCountry:    ddv1.NationNumeric_SPAIN,
+			StartRegion:     0x01,
+			EndCountry:      ddv1.NationNumeric_SPAIN,
+			EndRegion:       0x02,
+			OdometerStartKm: 500,
+			OdometerEndKm:   750,
+		},
+		{
+			Start:           at(2, 7),
+			StartCountry:    ddv1.NationNumeric_SPAIN,
+			StartRegion:     0x03,
+			OdometerStartKm: 900,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("DeriveWorkPeriods() mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := got[0].DistanceKm(), int32(250); got != want {
+		t.Errorf("DistanceKm() = %d, want %d", got, want)
+	}
+}
@@ -33,27 +33,11 @@ func (opts UnmarshalOptions) UnmarshalActivityChangeInfo(input []byte) (*ddv1.Ac
 		output.SetRawData(input)
 	}
 	value := binary.BigEndian.Uint16(input)
-	slot := (value >> 15) & 0x1            // bit 15
-	drivingStatus := (value >> 14) & 0x1   // bit 14
-	cardStatus := (value >> 13) & 0x1      // bit 13
-	activity := int32((value >> 11) & 0x3) // bits 12-11
-	timeMinutes := int32(value & 0x7FF)    // bits 10-0
-
-	// Convert bit values
-	if slotEnum, err := UnmarshalEnum[ddv1.CardSlotNumber](byte(slot)); err == nil {
-		output.SetSlot(slotEnum)
-	} else {
-		return nil, fmt.Errorf("invalid slot value %d: %w", slot, err)
-	}
-	output.SetCrew(drivingStatus == 1)
-	output.SetInserted(cardStatus == 0)
-
-	if activityEnum, err := UnmarshalEnum[ddv1.DriverActivityValue](byte(activity)); err == nil {
-		output.SetActivity(activityEnum)
-	} else {
-		return nil, fmt.Errorf("invalid activity value %d: %w", activity, err)
-	}
-	output.SetTimeOfChangeMinutes(timeMinutes)
+	output.SetSlot(SlotOfChange(value))
+	output.SetCrew(CrewOfChange(value))
+	output.SetInserted(CardPresent(value))
+	output.SetActivity(ActivityOfChange(value))
+	output.SetTimeOfChangeMinutes(MinutesSinceMidnight(value))
 	return &output, nil
 }
 
@@ -115,30 +99,101 @@ func (opts MarshalOptions) MarshalActivityChangeInfo(ac *ddv1.ActivityChangeInfo
 		}
 		copy(canvas[:], ac.GetRawData())
 	}
-	// Convert enum and boolean to bit values
-	slot, err := MarshalEnum(ac.GetSlot())
+	aci, err := PackActivityChangeInfo(ac.GetSlot(), ac.GetCrew(), ac.GetInserted(), ac.GetActivity(), ac.GetTimeOfChangeMinutes())
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal slot: %w", err)
-	}
-	var drivingStatus uint16
-	if ac.GetCrew() {
-		drivingStatus = 1
+		return nil, err
 	}
-	// Convert boolean to protocol value: 0 = inserted, 1 = not inserted
-	var cardInserted uint16 = 1
-	if ac.GetInserted() {
-		cardInserted = 0
+	binary.BigEndian.PutUint16(canvas[:], aci)
+	return canvas[:], nil
+}
+
+// Bit positions and masks of the packed ActivityChangeInfo value 'scpaattttttttttt'B.
+const (
+	activityChangeSlotBit          = 15
+	activityChangeDrivingStatusBit = 14
+	activityChangeCardStatusBit    = 13
+	activityChangeActivityShift    = 11
+	activityChangeActivityMask     = 0x3
+	activityChangeMinutesMask      = 0x7FF
+)
+
+// activityChangeSlots maps the slot bit of an ActivityChangeInfo value.
+var activityChangeSlots = [...]ddv1.CardSlotNumber{
+	ddv1.CardSlotNumber_DRIVER_SLOT,
+	ddv1.CardSlotNumber_CO_DRIVER_SLOT,
+}
+
+// activityChangeActivities maps the activity bits of an ActivityChangeInfo value.
+var activityChangeActivities = [...]ddv1.DriverActivityValue{
+	ddv1.DriverActivityValue_BREAK_REST,
+	ddv1.DriverActivityValue_AVAILABILITY,
+	ddv1.DriverActivityValue_WORK,
+	ddv1.DriverActivityValue_DRIVING,
+}
+
+// SlotOfChange returns the slot ('s' bit) of a packed ActivityChangeInfo value.
+func SlotOfChange(value uint16) ddv1.CardSlotNumber {
+	return activityChangeSlots[(value>>activityChangeSlotBit)&0x1]
+}
+
+// CrewOfChange reports whether the driving status ('c' bit) of a packed
+// ActivityChangeInfo value is CREW.
+func CrewOfChange(value uint16) bool {
+	return (value>>activityChangeDrivingStatusBit)&0x1 == 1
+}
+
+// CardPresent reports whether the card status ('p' bit) of a packed
+// ActivityChangeInfo value is INSERTED. Note that the bit is '0'B when the
+// card is inserted.
+func CardPresent(value uint16) bool {
+	return (value>>activityChangeCardStatusBit)&0x1 == 0
+}
+
+// ActivityOfChange returns the activity ('aa' bits) of a packed
+// ActivityChangeInfo value.
+func ActivityOfChange(value uint16) ddv1.DriverActivityValue {
+	return activityChangeActivities[(value>>activityChangeActivityShift)&activityChangeActivityMask]
+}
+
+// MinutesSinceMidnight returns the time of change ('t' bits) of a packed
+// ActivityChangeInfo value, in minutes since 00h00.
+func MinutesSinceMidnight(value uint16) int32 {
+	return int32(value & activityChangeMinutesMask)
+}
+
+// PackActivityChangeInfo packs the fields of an ActivityChangeInfo into its
+// 16-bit value, the inverse of SlotOfChange, CrewOfChange, CardPresent,
+// ActivityOfChange and MinutesSinceMidnight.
+//
+// It returns an error if slot or activity have no protocol value, or if
+// minutes does not fit the 11 bits of the time of change.
+func PackActivityChangeInfo(
+	slot ddv1.CardSlotNumber,
+	crew bool,
+	cardPresent bool,
+	activity ddv1.DriverActivityValue,
+	minutes int32,
+) (uint16, error) {
+	slotValue, err := MarshalEnum(slot)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal slot: %w", err)
 	}
-	activity, err := MarshalEnum(ac.GetActivity())
+	activityValue, err := MarshalEnum(activity)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal activity: %w", err)
+		return 0, fmt.Errorf("failed to marshal activity: %w", err)
 	}
-	var aci uint16
-	aci |= (uint16(slot) & 0x1) << 15
-	aci |= (drivingStatus & 0x1) << 14
-	aci |= (cardInserted & 0x1) << 13
-	aci |= (uint16(activity) & 0x3) << 11
-	aci |= (uint16(ac.GetTimeOfChangeMinutes()) & 0x7FF)
-	binary.BigEndian.PutUint16(canvas[:], aci)
-	return canvas[:], nil
+	if minutes < 0 || minutes > activityChangeMinutesMask {
+		return 0, fmt.Errorf("invalid time of change for ActivityChangeInfo: %d minutes", minutes)
+	}
+	var value uint16
+	value |= (uint16(slotValue) & 0x1) << activityChangeSlotBit
+	if crew {
+		value |= 1 << activityChangeDrivingStatusBit
+	}
+	if !cardPresent {
+		value |= 1 << activityChangeCardStatusBit
+	}
+	value |= (uint16(activityValue) & activityChangeActivityMask) << activityChangeActivityShift
+	value |= uint16(minutes)
+	return value, nil
 }
@@ -0,0 +1,66 @@
+package dd
+
+import (
+	"testing"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestPackActivityChangeInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       uint16
+		slot        ddv1.CardSlotNumber
+		crew        bool
+		cardPresent bool
+		activity    ddv1.DriverActivityValue
+		minutes     int32
+	}{
+		{
+			name:        "driver driving inserted",
+			value:       0b0001_1000_0011_1100, // 'scpaattttttttttt'B
+			slot:        ddv1.CardSlotNumber_DRIVER_SLOT,
+			cardPresent: true,
+			activity:    ddv1.DriverActivityValue_DRIVING,
+			minutes:     60,
+		},
+		{
+			name:     "co-driver crew break not inserted",
+			value:    0b1110_0101_1001_1111,
+			slot:     ddv1.CardSlotNumber_CO_DRIVER_SLOT,
+			crew:     true,
+			activity: ddv1.DriverActivityValue_BREAK_REST,
+			minutes:  1439,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SlotOfChange(tt.value); got != tt.slot {
+				t.Errorf("SlotOfChange() = %v, want %v", got, tt.slot)
+			}
+			if got := CrewOfChange(tt.value); got != tt.crew {
+				t.Errorf("CrewOfChange() = %v, want %v", got, tt.crew)
+			}
+			if got := CardPresent(tt.value); got != tt.cardPresent {
+				t.Errorf("CardPresent() = %v, want %v", got, tt.cardPresent)
+			}
+			if got := ActivityOfChange(tt.value); got != tt.activity {
+				t.Errorf("ActivityOfChange() = %v, want %v", got, tt.activity)
+			}
+			if got := MinutesSinceMidnight(tt.value); got != tt.minutes {
+				t.Errorf("MinutesSinceMidnight() = %d, want %d", got, tt.minutes)
+			}
+			got, err := PackActivityChangeInfo(tt.slot, tt.crew, tt.cardPresent, tt.activity, tt.minutes)
+			if err != nil {
+				t.Fatalf("PackActivityChangeInfo() error = %v", err)
+			}
+			if got != tt.value {
+				t.Errorf("PackActivityChangeInfo() = %#016b, want %#016b", got, tt.value)
+			}
+		})
+	}
+
+	if _, err := PackActivityChangeInfo(ddv1.CardSlotNumber_DRIVER_SLOT, false, true, ddv1.DriverActivityValue_WORK, 2048); err == nil {
+		t.Error("PackActivityChangeInfo() with 2048 minutes succeeded, want error")
+	}
+}
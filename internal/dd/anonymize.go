@@ -1,7 +1,11 @@
 package dd
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"time"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
 // AnonymizeOptions configures anonymization behavior for DD-level helpers.
@@ -9,7 +13,130 @@ type AnonymizeOptions struct {
 	PreserveDistanceAndTrips bool
 	PreserveTimestamps       bool
 	TimestampEpoch           time.Time // Base epoch for relative timestamp shifts
+
+	// BaseLatitude and BaseLongitude are the coordinates replacing anonymized
+	// positions, encoded as GeoCoordinates (±DDMM.M * 10).
+	// If both are zero, DefaultBaseLatitude and DefaultBaseLongitude are used.
+	BaseLatitude, BaseLongitude int32
+
+	// HomeNation replaces anonymized nations, such as the country of a place.
+	// If unspecified, Finland is used.
+	HomeNation ddv1.NationNumeric
+
+	// NeighborNation is the country entered when anonymizing border crossings
+	// from HomeNation. If unspecified, Sweden is used.
+	NeighborNation ddv1.NationNumeric
+
+	// PreserveNations keeps the original nations and regions, such as the
+	// countries of places and border crossings, instead of replacing them
+	// with HomeNation and NeighborNation.
+	PreserveNations bool
+
+	// Key is the secret key of the HMAC-SHA256 deriving anonymized card
+	// numbers from the original ones. If empty, DefaultAnonymizationKey is
+	// used.
+	Key []byte
 }
 
 // DefaultTimestampEpoch is the default epoch for timestamp anonymization (2020-01-01 00:00:00 UTC).
 var DefaultTimestampEpoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Default anonymized coordinates (Helsinki, 60°10.0'N 24°56.0'E).
+const (
+	DefaultBaseLatitude  = 60100
+	DefaultBaseLongitude = 24560
+)
+
+// AnonymizedGeoCoordinates returns the coordinates replacing anonymized positions.
+func (opts AnonymizeOptions) AnonymizedGeoCoordinates() *ddv1.GeoCoordinates {
+	latitude, longitude := opts.BaseLatitude, opts.BaseLongitude
+	if latitude == 0 && longitude == 0 {
+		latitude, longitude = DefaultBaseLatitude, DefaultBaseLongitude
+	}
+	coordinates := &ddv1.GeoCoordinates{}
+	coordinates.SetLatitude(latitude)
+	coordinates.SetLongitude(longitude)
+	return coordinates
+}
+
+// AnonymizedHomeNation returns the nation replacing anonymized nations.
+func (opts AnonymizeOptions) AnonymizedHomeNation() ddv1.NationNumeric {
+	if opts.HomeNation == ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED {
+		return ddv1.NationNumeric_FINLAND
+	}
+	return opts.HomeNation
+}
+
+// AnonymizedNeighborNation returns the nation entered by anonymized border crossings.
+func (opts AnonymizeOptions) AnonymizedNeighborNation() ddv1.NationNumeric {
+	if opts.NeighborNation == ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED {
+		return ddv1.NationNumeric_SWEDEN
+	}
+	return opts.NeighborNation
+}
+
+// anonymizedNation returns the nation replacing nation: nation itself if
+// PreserveNations is set, and the anonymized home nation otherwise.
+func (opts AnonymizeOptions) anonymizedNation(nation ddv1.NationNumeric) ddv1.NationNumeric {
+	if opts.PreserveNations {
+		return nation
+	}
+	return opts.AnonymizedHomeNation()
+}
+
+// DefaultAnonymizationKey is the key deriving anonymized card numbers when
+// no Key is set. It is public, so it keeps anonymized numbers stable across
+// runs, such as for test data, but does not keep the original numbers from
+// being recovered by anonymizing candidates.
+const DefaultAnonymizationKey = "tachograph-go anonymization"
+
+// key returns the key deriving anonymized card numbers.
+func (opts AnonymizeOptions) key() []byte {
+	if len(opts.Key) == 0 {
+		return []byte(DefaultAnonymizationKey)
+	}
+	return opts.Key
+}
+
+// anonymizedCardIdentifier returns a synthetic, all-digit replacement for an
+// identifier of a card number, keeping its length.
+//
+// The digits are derived from an HMAC-SHA256 of the original identifier,
+// keyed by opts.Key, so a card is always anonymized to the same number with
+// the same key, and distinct cards remain distinct, also across files. The
+// key keeps the number from being recovered by hashing candidate numbers,
+// which are few enough to enumerate.
+func (opts AnonymizeOptions) anonymizedCardIdentifier(original *ddv1.Ia5StringValue) *ddv1.Ia5StringValue {
+	if original == nil {
+		return nil
+	}
+	length := original.GetLength()
+	if length == 0 {
+		length = int32(len(original.GetValue()))
+	}
+	digits := make([]byte, 0, length)
+	mac := hmac.New(sha256.New, opts.key())
+	mac.Write([]byte(original.GetValue()))
+	sum := mac.Sum(nil)
+	for len(digits) < int(length) {
+		for _, b := range sum {
+			if len(digits) == int(length) {
+				break
+			}
+			digits = append(digits, '0'+b%10)
+		}
+		next := sha256.Sum256(sum)
+		sum = next[:]
+	}
+	return NewIa5StringValue(length, string(digits))
+}
+
+// anonymizedCardIndex returns a copy of a card index, such as a renewal or
+// replacement index. Indices identify the card of a holder, not the holder,
+// and keep distinct cards of the same holder distinct.
+func anonymizedCardIndex(index *ddv1.Ia5StringValue, defaultValue string) *ddv1.Ia5StringValue {
+	if index == nil || index.GetValue() == "" {
+		return NewIa5StringValue(1, defaultValue)
+	}
+	return NewIa5StringValue(index.GetLength(), index.GetValue())
+}
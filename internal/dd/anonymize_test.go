@@ -0,0 +1,181 @@
+package dd
+
+import (
+	"testing"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestAnonymizeOptions_AnonymizePlaceRecordG2_locale(t *testing.T) {
+	gnss := &ddv1.GNSSPlaceRecord{}
+	coordinates := &ddv1.GeoCoordinates{}
+	coordinates.SetLatitude(51300)
+	coordinates.SetLongitude(-1200)
+	gnss.SetGeoCoordinates(coordinates)
+	record := &ddv1.PlaceRecordG2{}
+	record.SetDailyWorkPeriodCountry(ddv1.NationNumeric_UNITED_KINGDOM)
+	record.SetEntryGnssPlaceRecord(gnss)
+
+	tests := []struct {
+		name                   string
+		opts                   AnonymizeOptions
+		wantLatitude           int32
+		wantLongitude          int32
+		wantHome, wantNeighbor ddv1.NationNumeric
+	}{
+		{
+			name:          "default",
+			wantLatitude:  DefaultBaseLatitude,
+			wantLongitude: DefaultBaseLongitude,
+			wantHome:      ddv1.NationNumeric_FINLAND,
+			wantNeighbor:  ddv1.NationNumeric_SWEDEN,
+		},
+		{
+			name: "central europe",
+			opts: AnonymizeOptions{
+				BaseLatitude:   48125,
+				BaseLongitude:  11345,
+				HomeNation:     ddv1.NationNumeric_GERMANY,
+				NeighborNation: ddv1.NationNumeric_AUSTRIA,
+			},
+			wantLatitude:  48125,
+			wantLongitude: 11345,
+			wantHome:      ddv1.NationNumeric_GERMANY,
+			wantNeighbor:  ddv1.NationNumeric_AUSTRIA,
+		},
+		{
+			name:          "preserve nations",
+			opts:          AnonymizeOptions{PreserveNations: true},
+			wantLatitude:  DefaultBaseLatitude,
+			wantLongitude: DefaultBaseLongitude,
+			wantHome:      ddv1.NationNumeric_UNITED_KINGDOM,
+			wantNeighbor:  ddv1.NationNumeric_SWEDEN,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.opts.AnonymizePlaceRecordG2(record)
+			if got.GetDailyWorkPeriodCountry() != tt.wantHome {
+				t.Errorf("DailyWorkPeriodCountry = %v, want %v", got.GetDailyWorkPeriodCountry(), tt.wantHome)
+			}
+			geo := got.GetEntryGnssPlaceRecord().GetGeoCoordinates()
+			if geo.GetLatitude() != tt.wantLatitude || geo.GetLongitude() != tt.wantLongitude {
+				t.Errorf("GeoCoordinates = (%d, %d), want (%d, %d)", geo.GetLatitude(), geo.GetLongitude(), tt.wantLatitude, tt.wantLongitude)
+			}
+			if _, err := (MarshalOptions{}).MarshalGeoCoordinates(geo); err != nil {
+				t.Errorf("MarshalGeoCoordinates failed: %v", err)
+			}
+			if neighbor := tt.opts.AnonymizedNeighborNation(); neighbor != tt.wantNeighbor {
+				t.Errorf("AnonymizedNeighborNation() = %v, want %v", neighbor, tt.wantNeighbor)
+			}
+		})
+	}
+}
+
+func TestAnonymizeOptions_AnonymizeFullCardNumber(t *testing.T) {
+	newDriverCard := func(number, renewal string) *ddv1.FullCardNumber {
+		driverID := &ddv1.DriverIdentification{}
+		driverID.SetDriverIdentificationNumber(NewIa5StringValue(14, number))
+		driverID.SetCardReplacementIndex(NewIa5StringValue(1, "0"))
+		driverID.SetCardRenewalIndex(NewIa5StringValue(1, renewal))
+		fc := &ddv1.FullCardNumber{}
+		fc.SetCardType(ddv1.EquipmentType_DRIVER_CARD)
+		fc.SetCardIssuingMemberState(ddv1.NationNumeric_GERMANY)
+		fc.SetDriverIdentification(driverID)
+		return fc
+	}
+	opts := AnonymizeOptions{}
+	anonymizedNumber := func(fc *ddv1.FullCardNumber) string {
+		return opts.AnonymizeFullCardNumber(fc).GetDriverIdentification().GetDriverIdentificationNumber().GetValue()
+	}
+
+	first := opts.AnonymizeFullCardNumber(newDriverCard("DF000012345678", "1"))
+	if got := first.GetCardIssuingMemberState(); got != ddv1.NationNumeric_FINLAND {
+		t.Errorf("CardIssuingMemberState = %v, want FINLAND", got)
+	}
+	if got := first.GetDriverIdentification().GetCardRenewalIndex().GetValue(); got != "1" {
+		t.Errorf("CardRenewalIndex = %q, want %q", got, "1")
+	}
+	number := first.GetDriverIdentification().GetDriverIdentificationNumber().GetValue()
+	if len(number) != 14 || number == "DF000012345678" {
+		t.Errorf("DriverIdentificationNumber = %q, want a different 14 character number", number)
+	}
+	if again := anonymizedNumber(newDriverCard("DF000012345678", "2")); again != number {
+		t.Errorf("renewed card anonymized to %q, want %q", again, number)
+	}
+	if other := anonymizedNumber(newDriverCard("DF000087654321", "1")); other == number {
+		t.Errorf("distinct drivers both anonymized to %q", number)
+	}
+
+	// The anonymized card number must survive a binary round-trip.
+	data, err := (MarshalOptions{}).MarshalFullCardNumber(first)
+	if err != nil {
+		t.Fatalf("MarshalFullCardNumber failed: %v", err)
+	}
+	parsed, err := (UnmarshalOptions{}).UnmarshalFullCardNumber(data)
+	if err != nil {
+		t.Fatalf("UnmarshalFullCardNumber failed: %v", err)
+	}
+	if got := parsed.GetDriverIdentification().GetDriverIdentificationNumber().GetValue(); got != number {
+		t.Errorf("re-parsed DriverIdentificationNumber = %q, want %q", got, number)
+	}
+
+	empty := opts.AnonymizeFullCardNumber(&ddv1.FullCardNumber{})
+	if empty.GetCardIssuingMemberState() != ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED || empty.HasDriverIdentification() {
+		t.Errorf("empty card number anonymized to %v, want empty", empty)
+	}
+}
+
+func TestAnonymizeOptions_PreserveNations(t *testing.T) {
+	place := &ddv1.PlaceRecord{}
+	place.SetDailyWorkPeriodCountry(ddv1.NationNumeric_SPAIN)
+	place.SetDailyWorkPeriodRegion(0x0A)
+	fc := &ddv1.FullCardNumber{}
+	fc.SetCardIssuingMemberState(ddv1.NationNumeric_SPAIN)
+	fc.SetDriverIdentification(&ddv1.DriverIdentification{})
+
+	for _, tt := range []struct {
+		opts       AnonymizeOptions
+		wantNation ddv1.NationNumeric
+		wantRegion int32
+	}{
+		{opts: AnonymizeOptions{}, wantNation: ddv1.NationNumeric_FINLAND, wantRegion: 0x01},
+		{opts: AnonymizeOptions{PreserveNations: true}, wantNation: ddv1.NationNumeric_SPAIN, wantRegion: 0x0A},
+	} {
+		gotPlace := tt.opts.AnonymizePlaceRecord(place)
+		if got := gotPlace.GetDailyWorkPeriodCountry(); got != tt.wantNation {
+			t.Errorf("PreserveNations=%v: DailyWorkPeriodCountry = %v, want %v", tt.opts.PreserveNations, got, tt.wantNation)
+		}
+		if got := gotPlace.GetDailyWorkPeriodRegion(); got != tt.wantRegion {
+			t.Errorf("PreserveNations=%v: DailyWorkPeriodRegion = %#02x, want %#02x", tt.opts.PreserveNations, got, tt.wantRegion)
+		}
+		if got := tt.opts.AnonymizeFullCardNumber(fc).GetCardIssuingMemberState(); got != tt.wantNation {
+			t.Errorf("PreserveNations=%v: CardIssuingMemberState = %v, want %v", tt.opts.PreserveNations, got, tt.wantNation)
+		}
+	}
+}
+
+func TestAnonymizeOptions_Key(t *testing.T) {
+	number := NewIa5StringValue(14, "DF000012345678")
+	anonymized := func(opts AnonymizeOptions) string {
+		return opts.anonymizedCardIdentifier(number).GetValue()
+	}
+
+	keyed := anonymized(AnonymizeOptions{Key: []byte("first key")})
+	if again := anonymized(AnonymizeOptions{Key: []byte("first key")}); again != keyed {
+		t.Errorf("same key anonymized to %q and %q", keyed, again)
+	}
+	if other := anonymized(AnonymizeOptions{Key: []byte("second key")}); other == keyed {
+		t.Errorf("distinct keys both anonymized to %q", keyed)
+	}
+
+	// Without a key, the default key is used, so the number does not change
+	// from run to run.
+	const wantDefault = "43142053964395"
+	if got := anonymized(AnonymizeOptions{}); got != wantDefault {
+		t.Errorf("default key anonymized to %q, want %q", got, wantDefault)
+	}
+	if got := anonymized(AnonymizeOptions{Key: []byte(DefaultAnonymizationKey)}); got != wantDefault {
+		t.Errorf("DefaultAnonymizationKey anonymized to %q, want %q", got, wantDefault)
+	}
+}
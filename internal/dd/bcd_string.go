@@ -2,6 +2,7 @@ package dd
 
 import (
 	"fmt"
+	"io"
 
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
@@ -18,7 +19,7 @@ import (
 //   - BCD String (variable): BCD-encoded bytes
 func (opts UnmarshalOptions) UnmarshalBcdString(input []byte) (*ddv1.BcdString, error) {
 	if len(input) == 0 {
-		return nil, fmt.Errorf("insufficient data for BcdString: got %d, want at least 1", len(input))
+		return nil, fmt.Errorf("insufficient data for BcdString: got %d, want at least 1: %w", len(input), io.ErrUnexpectedEOF)
 	}
 	value, err := decodeBCD(input)
 	if err != nil {
@@ -47,14 +47,14 @@ func (opts UnmarshalOptions) UnmarshalCardBorderCrossingRecord(data []byte) (*dd
 	}
 
 	// countryLeft (1 byte)
-	countryLeft, err := UnmarshalEnum[ddv1.NationNumeric](data[idxCountryLeft])
+	countryLeft, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "CardBorderCrossingRecord.countryLeft", data[idxCountryLeft])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal country left: %w", err)
 	}
 	record.SetCountryLeft(countryLeft)
 
 	// countryEntered (1 byte)
-	countryEntered, err := UnmarshalEnum[ddv1.NationNumeric](data[idxCountryEntered])
+	countryEntered, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "CardBorderCrossingRecord.countryEntered", data[idxCountryEntered])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal country entered: %w", err)
 	}
@@ -47,7 +47,7 @@ func (opts UnmarshalOptions) UnmarshalCardLoadTypeEntryRecord(data []byte) (*ddv
 	record.SetTimeStamp(timeStamp)
 
 	// loadTypeEntered (1 byte)
-	loadTypeEntered, err := UnmarshalEnum[ddv1.LoadType](data[idxLoadTypeEntered])
+	loadTypeEntered, err := UnmarshalEnumOrReport[ddv1.LoadType](opts, "CardLoadTypeEntryRecord.loadTypeEntered", data[idxLoadTypeEntered])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal load type entered: %w", err)
 	}
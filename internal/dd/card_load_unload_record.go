@@ -55,7 +55,7 @@ func (opts UnmarshalOptions) UnmarshalCardLoadUnloadRecord(data []byte) (*ddv1.C
 	record.SetTimeStamp(timeStamp)
 
 	// operationType (1 byte)
-	operationType, err := UnmarshalEnum[ddv1.OperationType](data[idxOperationType])
+	operationType, err := UnmarshalEnumOrReport[ddv1.OperationType](opts, "CardLoadUnloadRecord.operationType", data[idxOperationType])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal operation type: %w", err)
 	}
@@ -2,6 +2,7 @@ package dd
 
 import (
 	"fmt"
+	"time"
 
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
@@ -36,6 +37,46 @@ func (opts UnmarshalOptions) UnmarshalDate(input []byte) (*ddv1.Date, error) {
 	return &output, nil
 }
 
+// DecodeDatef decodes a BCD-encoded date to midnight UTC at the start of
+// the day.
+//
+// The data type `Datef` is specified in the Data Dictionary, Section 2.57.
+//
+// Datef holds a calendar date without a time of day or time zone. It is
+// decoded in UTC, like TimeReal, so that it compares with TimeReal timestamps
+// of the same day; interpreting it in local time shifts it to the previous
+// or next day in UTC. The date '00000000'H is not set, and decodes to the zero
+// time. An error is returned for digits that are not BCD and for days that
+// do not exist, such as 31 February.
+func DecodeDatef(input []byte) (time.Time, error) {
+	for _, b := range input {
+		if b>>4 > 9 || b&0x0F > 9 {
+			return time.Time{}, fmt.Errorf("invalid BCD digits in Datef: %X", input)
+		}
+	}
+	date, err := UnmarshalOptions{}.UnmarshalDate(input)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return DateTime(date)
+}
+
+// DateTime returns a Date as midnight UTC at the start of the day, or the zero
+// time for a date that is not set (year, month and day zero). See DecodeDatef.
+//
+// An error is returned for a day that does not exist, such as 31 February.
+func DateTime(date *ddv1.Date) (time.Time, error) {
+	year, month, day := int(date.GetYear()), int(date.GetMonth()), int(date.GetDay())
+	if year == 0 && month == 0 && day == 0 {
+		return time.Time{}, nil
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year || int(t.Month()) != month || t.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid Datef: %04d-%02d-%02d", year, month, day)
+	}
+	return t, nil
+}
+
 // MarshalDate marshals a 4-byte BCD-encoded date from the Date type.
 //
 // The data type `Datef` is specified in the Data Dictionary, Section 2.57.
@@ -2,6 +2,7 @@ package dd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -263,3 +264,51 @@ func TestDateRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeDatef(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "valid date",
+			input: []byte{0x20, 0x25, 0x09, 0x30},
+			want:  time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "not set",
+			input: []byte{0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name:    "invalid BCD digit",
+			input:   []byte{0x20, 0x2A, 0x01, 0x01},
+			wantErr: true,
+		},
+		{
+			name:    "non-existent day",
+			input:   []byte{0x20, 0x25, 0x02, 0x31},
+			wantErr: true,
+		},
+		{
+			name:    "invalid length",
+			input:   []byte{0x20, 0x25, 0x09},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeDatef(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeDatef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("DecodeDatef() = %v, want %v", got, tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("DecodeDatef() location = %v, want UTC", got.Location())
+			}
+		})
+	}
+}
@@ -105,30 +105,24 @@ func (opts MarshalOptions) MarshalDriverIdentification(driverID *ddv1.DriverIden
 }
 
 // AnonymizeDriverIdentification creates an anonymized copy of DriverIdentification,
-// replacing the driver identification number with a safe, deterministic value while
-// maintaining the correct format and length.
+// replacing the driver identification number with a synthetic number derived
+// from a hash of the original, while maintaining the correct format and length.
+// The replacement and renewal indices are preserved.
 func (opts AnonymizeOptions) AnonymizeDriverIdentification(driverID *ddv1.DriverIdentification) *ddv1.DriverIdentification {
 	if driverID == nil {
 		return nil
 	}
 	result := &ddv1.DriverIdentification{}
 	// Anonymize driver identification number (IA5String, 14 bytes)
-	testDriverID := &ddv1.Ia5StringValue{}
-	testDriverID.SetValue("DRIVER00000001")
-	testDriverID.SetLength(14)
-	result.SetDriverIdentificationNumber(testDriverID)
-
-	// Card replacement index (IA5String, 1 byte)
-	replacementIndex := &ddv1.Ia5StringValue{}
-	replacementIndex.SetValue("0")
-	replacementIndex.SetLength(1)
-	result.SetCardReplacementIndex(replacementIndex)
-
-	// Card renewal index (IA5String, 1 byte)
-	renewalIndex := &ddv1.Ia5StringValue{}
-	renewalIndex.SetValue("0")
-	renewalIndex.SetLength(1)
-	result.SetCardRenewalIndex(renewalIndex)
+	number := driverID.GetDriverIdentificationNumber()
+	if number == nil {
+		number = NewIa5StringValue(14, "")
+	}
+	result.SetDriverIdentificationNumber(opts.anonymizedCardIdentifier(number))
+
+	// Card replacement and renewal indices (IA5String, 1 byte each)
+	result.SetCardReplacementIndex(anonymizedCardIndex(driverID.GetCardReplacementIndex(), "0"))
+	result.SetCardRenewalIndex(anonymizedCardIndex(driverID.GetCardRenewalIndex(), "0"))
 
 	return result
 }
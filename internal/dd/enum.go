@@ -39,6 +39,20 @@ func UnmarshalEnum[T interface {
 	)
 }
 
+// UnmarshalEnumOrReport is like UnmarshalEnum, but also reports a value
+// without a matching enum value to opts.OnUnrecognized, naming the data
+// element it was read for.
+func UnmarshalEnumOrReport[T interface {
+	~int32
+	protoreflect.Enum
+}](opts UnmarshalOptions, context string, rawValue byte) (T, error) {
+	value, err := UnmarshalEnum[T](rawValue)
+	if err != nil && opts.OnUnrecognized != nil {
+		opts.OnUnrecognized(context, uint64(rawValue))
+	}
+	return value, err
+}
+
 // MarshalEnum converts a typed enum to a raw protocol byte value.
 // Returns an error if the enum value doesn't have a protocol_enum_value annotation.
 //
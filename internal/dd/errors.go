@@ -0,0 +1,59 @@
+package dd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+var (
+	// ErrTruncated is wrapped by errors for data that ends before the
+	// structure being decoded. It is io.ErrUnexpectedEOF.
+	ErrTruncated = io.ErrUnexpectedEOF
+
+	// ErrUnknownTag is wrapped by errors for unrecognized card file IDs and
+	// vehicle unit transfer types in strict mode.
+	ErrUnknownTag = errors.New("unknown tag")
+
+	// ErrEmptyTransfer is wrapped by errors for vehicle unit transfers whose
+	// tag is followed by no value, in strict mode.
+	ErrEmptyTransfer = errors.New("empty transfer")
+
+	// ErrUnsupportedGeneration is wrapped by errors for records or files of a
+	// generation that is not supported where it occurs.
+	ErrUnsupportedGeneration = errors.New("unsupported generation")
+
+	// ErrInvalidSignature is wrapped by errors for data signatures that are
+	// missing or do not match their data.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// ParseError is an error decoding a single record of a file: an elementary
+// file (EF) of a card, or a transfer of a vehicle unit.
+type ParseError struct {
+	// Name is the elementary file type of a card record (e.g.
+	// "EF_IDENTIFICATION"), or the transfer type of a vehicle unit record
+	// (e.g. "ACTIVITIES_GEN1").
+	Name string
+
+	// Generation is the generation of the record.
+	Generation ddv1.Generation
+
+	// FileOffset is the byte offset of the record in the file.
+	FileOffset int
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s (%v) at offset %d: %v", e.Name, e.Generation, e.FileOffset, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,100 @@
+package dd
+
+import (
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// DescribeEventFault returns the description of an EventFaultType, as worded
+// in the regulation, such as "Card insertion while driving".
+//
+// The data type `EventFaultType` is specified in the Data Dictionary, Section 2.70.
+// The descriptions cover the value assignments of both generations; values
+// assigned by Generation 2 only are reserved (RFU) in Generation 1.
+//
+// The returned description is empty for unspecified and unrecognized values.
+func DescribeEventFault(eventFaultType ddv1.EventFaultType) string {
+	return eventFaultDescriptions[eventFaultType]
+}
+
+// DescribeEventFaultCategory returns the category of an EventFaultType, as
+// worded in the regulation, such as "General events" or "Card faults".
+//
+// The category is given by the upper nibble of the EventFaultType value. The
+// returned category is empty for unspecified and unrecognized values.
+func DescribeEventFaultCategory(eventFaultType ddv1.EventFaultType) string {
+	protocolValue, err := MarshalEnum(eventFaultType)
+	if err != nil {
+		return ""
+	}
+	return eventFaultCategories[protocolValue>>4]
+}
+
+// eventFaultCategories maps the upper nibble of an EventFaultType value to
+// the category of the events and faults it qualifies.
+var eventFaultCategories = map[byte]string{
+	0x0: "General events",
+	0x1: "Vehicle unit related security breach attempt events",
+	0x2: "Sensor related security breach attempt events",
+	0x3: "Recording equipment faults",
+	0x4: "Card faults",
+}
+
+// eventFaultDescriptions maps each EventFaultType to its description.
+var eventFaultDescriptions = map[ddv1.EventFaultType]string{
+	// General events ('0x'H)
+	ddv1.EventFaultType_GENERAL_NO_FURTHER_DETAILS:                     "No further details",
+	ddv1.EventFaultType_GENERAL_INSERTION_OF_NON_VALID_CARD:            "Insertion of a non-valid card",
+	ddv1.EventFaultType_GENERAL_CARD_CONFLICT:                          "Card conflict",
+	ddv1.EventFaultType_GENERAL_TIME_OVERLAP:                           "Time overlap",
+	ddv1.EventFaultType_GENERAL_DRIVING_WITHOUT_APPROPRIATE_CARD:       "Driving without an appropriate card",
+	ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING:           "Card insertion while driving",
+	ddv1.EventFaultType_GENERAL_LAST_CARD_SESSION_NOT_CORRECTLY_CLOSED: "Last card session not correctly closed",
+	ddv1.EventFaultType_GENERAL_OVER_SPEEDING:                          "Over speeding",
+	ddv1.EventFaultType_GENERAL_POWER_SUPPLY_INTERRUPTION:              "Power supply interruption",
+	ddv1.EventFaultType_GENERAL_MOTION_DATA_ERROR:                      "Motion data error",
+	ddv1.EventFaultType_GENERAL_VEHICLE_MOTION_CONFLICT:                "Vehicle motion conflict",
+	ddv1.EventFaultType_GENERAL_TIME_CONFLICT_GNSS_VS_VU:               "Time conflict (GNSS versus VU internal clock)",
+	ddv1.EventFaultType_GENERAL_COMM_ERROR_REMOTE_COMM_FACILITY:        "Communication error with the remote communication facility",
+	ddv1.EventFaultType_GENERAL_ABSENCE_OF_POSITION_INFO_FROM_GNSS:     "Absence of position information from GNSS receiver",
+	ddv1.EventFaultType_GENERAL_COMM_ERROR_EXTERNAL_GNSS_FACILITY:      "Communication error with the external GNSS facility",
+	ddv1.EventFaultType_GENERAL_GNSS_ANOMALY:                           "GNSS anomaly",
+
+	// Vehicle unit related security breach attempt events ('1x'H)
+	ddv1.EventFaultType_VU_SEC_NO_FURTHER_DETAILS:                   "No further details",
+	ddv1.EventFaultType_VU_SEC_MOTION_SENSOR_AUTH_FAILURE:           "Motion sensor authentication failure",
+	ddv1.EventFaultType_VU_SEC_TACHOGRAPH_CARD_AUTH_FAILURE:         "Tachograph card authentication failure",
+	ddv1.EventFaultType_VU_SEC_UNAUTHORISED_CHANGE_OF_MOTION_SENSOR: "Unauthorised change of motion sensor",
+	ddv1.EventFaultType_VU_SEC_CARD_DATA_INPUT_INTEGRITY_ERROR:      "Card data input integrity error",
+	ddv1.EventFaultType_VU_SEC_STORED_USER_DATA_INTEGRITY_ERROR:     "Stored user data integrity error",
+	ddv1.EventFaultType_VU_SEC_INTERNAL_DATA_TRANSFER_ERROR:         "Internal data transfer error",
+	ddv1.EventFaultType_VU_SEC_UNAUTHORISED_CASE_OPENING:            "Unauthorised case opening",
+	ddv1.EventFaultType_VU_SEC_HARDWARE_SABOTAGE:                    "Hardware sabotage",
+	ddv1.EventFaultType_VU_SEC_TAMPER_DETECTION_OF_GNSS:             "Tamper detection of GNSS",
+	ddv1.EventFaultType_VU_SEC_EXTERNAL_GNSS_FACILITY_AUTH_FAILURE:  "External GNSS facility authentication failure",
+	ddv1.EventFaultType_VU_SEC_EXTERNAL_GNSS_FACILITY_CERT_EXPIRED:  "External GNSS facility certificate expired",
+	ddv1.EventFaultType_VU_SEC_INCONSISTENCY_MOTION_VS_ACTIVITY:     "Inconsistency between motion data and stored driver activity data",
+
+	// Sensor related security breach attempt events ('2x'H)
+	ddv1.EventFaultType_SENSOR_SEC_NO_FURTHER_DETAILS:           "No further details",
+	ddv1.EventFaultType_SENSOR_SEC_AUTHENTICATION_FAILURE:       "Authentication failure",
+	ddv1.EventFaultType_SENSOR_SEC_STORED_DATA_INTEGRITY_ERROR:  "Stored data integrity error",
+	ddv1.EventFaultType_SENSOR_SEC_INTERNAL_DATA_TRANSFER_ERROR: "Internal data transfer error",
+	ddv1.EventFaultType_SENSOR_SEC_UNAUTHORISED_CASE_OPENING:    "Unauthorised case opening",
+	ddv1.EventFaultType_SENSOR_SEC_HARDWARE_SABOTAGE:            "Hardware sabotage",
+
+	// Recording equipment faults ('3x'H)
+	ddv1.EventFaultType_FAULT_REC_EQ_NO_FURTHER_DETAILS:     "No further details",
+	ddv1.EventFaultType_FAULT_REC_EQ_VU_INTERNAL_FAULT:      "VU internal fault",
+	ddv1.EventFaultType_FAULT_REC_EQ_PRINTER_FAULT:          "Printer fault",
+	ddv1.EventFaultType_FAULT_REC_EQ_DISPLAY_FAULT:          "Display fault",
+	ddv1.EventFaultType_FAULT_REC_EQ_DOWNLOADING_FAULT:      "Downloading fault",
+	ddv1.EventFaultType_FAULT_REC_EQ_SENSOR_FAULT:           "Sensor fault",
+	ddv1.EventFaultType_FAULT_REC_EQ_INTERNAL_GNSS_RECEIVER: "Internal GNSS receiver",
+	ddv1.EventFaultType_FAULT_REC_EQ_EXTERNAL_GNSS_FACILITY: "External GNSS facility",
+	ddv1.EventFaultType_FAULT_REC_EQ_REMOTE_COMM_FACILITY:   "Remote communication facility",
+	ddv1.EventFaultType_FAULT_REC_EQ_ITS_INTERFACE:          "ITS interface",
+	ddv1.EventFaultType_FAULT_REC_EQ_INTERNAL_SENSOR_FAULT:  "Internal sensor fault",
+
+	// Card faults ('4x'H)
+	ddv1.EventFaultType_FAULT_CARD_NO_FURTHER_DETAILS: "No further details",
+}
@@ -0,0 +1,75 @@
+package dd
+
+import (
+	"testing"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestDescribeEventFault(t *testing.T) {
+	tests := []struct {
+		eventFaultType  ddv1.EventFaultType
+		wantDescription string
+		wantCategory    string
+	}{
+		{
+			eventFaultType:  ddv1.EventFaultType_GENERAL_CARD_INSERTION_WHILE_DRIVING,
+			wantDescription: "Card insertion while driving",
+			wantCategory:    "General events",
+		},
+		{
+			eventFaultType:  ddv1.EventFaultType_GENERAL_MOTION_DATA_ERROR,
+			wantDescription: "Motion data error",
+			wantCategory:    "General events",
+		},
+		{
+			eventFaultType:  ddv1.EventFaultType_VU_SEC_HARDWARE_SABOTAGE,
+			wantDescription: "Hardware sabotage",
+			wantCategory:    "Vehicle unit related security breach attempt events",
+		},
+		{
+			eventFaultType:  ddv1.EventFaultType_SENSOR_SEC_AUTHENTICATION_FAILURE,
+			wantDescription: "Authentication failure",
+			wantCategory:    "Sensor related security breach attempt events",
+		},
+		{
+			eventFaultType:  ddv1.EventFaultType_FAULT_REC_EQ_ITS_INTERFACE,
+			wantDescription: "ITS interface",
+			wantCategory:    "Recording equipment faults",
+		},
+		{
+			eventFaultType:  ddv1.EventFaultType_FAULT_CARD_NO_FURTHER_DETAILS,
+			wantDescription: "No further details",
+			wantCategory:    "Card faults",
+		},
+		{eventFaultType: ddv1.EventFaultType_EVENT_FAULT_TYPE_UNSPECIFIED},
+		{eventFaultType: ddv1.EventFaultType_EVENT_FAULT_TYPE_UNRECOGNIZED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventFaultType.String(), func(t *testing.T) {
+			if got := DescribeEventFault(tt.eventFaultType); got != tt.wantDescription {
+				t.Errorf("DescribeEventFault(%v) = %q, want %q", tt.eventFaultType, got, tt.wantDescription)
+			}
+			if got := DescribeEventFaultCategory(tt.eventFaultType); got != tt.wantCategory {
+				t.Errorf("DescribeEventFaultCategory(%v) = %q, want %q", tt.eventFaultType, got, tt.wantCategory)
+			}
+		})
+	}
+}
+
+func TestDescribeEventFault_AllValuesDescribed(t *testing.T) {
+	values := ddv1.EventFaultType(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		eventFaultType := ddv1.EventFaultType(values.Get(i).Number())
+		switch eventFaultType {
+		case ddv1.EventFaultType_EVENT_FAULT_TYPE_UNSPECIFIED, ddv1.EventFaultType_EVENT_FAULT_TYPE_UNRECOGNIZED:
+			continue
+		}
+		if DescribeEventFault(eventFaultType) == "" {
+			t.Errorf("DescribeEventFault(%v) is empty", eventFaultType)
+		}
+		if DescribeEventFaultCategory(eventFaultType) == "" {
+			t.Errorf("DescribeEventFaultCategory(%v) is empty", eventFaultType)
+		}
+	}
+}
@@ -50,7 +50,7 @@ func (opts UnmarshalOptions) UnmarshalExtendedSerialNumber(data []byte) (*ddv1.E
 	esn.SetMonthYear(monthYear)
 
 	// Parse equipment type (1 byte)
-	if equipmentType, err := UnmarshalEnum[ddv1.EquipmentType](data[6]); err == nil {
+	if equipmentType, err := UnmarshalEnumOrReport[ddv1.EquipmentType](opts, "ExtendedSerialNumber.type", data[6]); err == nil {
 		esn.SetType(equipmentType)
 	} else {
 		// Return UNRECOGNIZED for unknown values
@@ -52,7 +52,7 @@ func (opts UnmarshalOptions) UnmarshalFullCardNumber(data []byte) (*ddv1.FullCar
 
 	// Parse card type (1 byte)
 	// If the card type is unrecognized, treat it as an empty/invalid card
-	cardType, err := UnmarshalEnum[ddv1.EquipmentType](cardTypeByte)
+	cardType, err := UnmarshalEnumOrReport[ddv1.EquipmentType](opts, "FullCardNumber.cardType", cardTypeByte)
 	if err != nil {
 		// Unrecognized card type - treat as empty card
 		return cardNumber, nil
@@ -73,7 +73,7 @@ func (opts UnmarshalOptions) UnmarshalFullCardNumber(data []byte) (*ddv1.FullCar
 			return nil, fmt.Errorf("failed to parse driver identification: %w", err)
 		}
 		cardNumber.SetDriverIdentification(driverID)
-	case ddv1.EquipmentType_WORKSHOP_CARD, ddv1.EquipmentType_COMPANY_CARD:
+	case ddv1.EquipmentType_WORKSHOP_CARD, ddv1.EquipmentType_CONTROL_CARD, ddv1.EquipmentType_COMPANY_CARD:
 		// OwnerIdentification is 16 bytes
 		ownerID, err := opts.UnmarshalOwnerIdentification(cardNumberData)
 		if err != nil {
@@ -163,7 +163,7 @@ func (opts MarshalOptions) MarshalFullCardNumber(cardNumber *ddv1.FullCardNumber
 			}
 			copy(canvas[2:18], driverBytes)
 		}
-	case ddv1.EquipmentType_WORKSHOP_CARD, ddv1.EquipmentType_COMPANY_CARD:
+	case ddv1.EquipmentType_WORKSHOP_CARD, ddv1.EquipmentType_CONTROL_CARD, ddv1.EquipmentType_COMPANY_CARD:
 		if ownerID := cardNumber.GetOwnerIdentification(); ownerID != nil {
 			// OwnerIdentification is 16 bytes
 			ownerBytes, err := opts.MarshalOwnerIdentification(ownerID)
@@ -190,7 +190,7 @@ func (opts MarshalOptions) MarshalFullCardNumberAsString(cardNumber *ddv1.FullCa
 		if driverID := cardNumber.GetDriverIdentification(); driverID != nil {
 			return opts.MarshalIa5StringValue(driverID.GetDriverIdentificationNumber())
 		}
-	case ddv1.EquipmentType_WORKSHOP_CARD, ddv1.EquipmentType_COMPANY_CARD:
+	case ddv1.EquipmentType_WORKSHOP_CARD, ddv1.EquipmentType_CONTROL_CARD, ddv1.EquipmentType_COMPANY_CARD:
 		if ownerID := cardNumber.GetOwnerIdentification(); ownerID != nil {
 			return opts.MarshalIa5StringValue(ownerID.GetOwnerIdentification())
 		}
@@ -199,7 +199,14 @@ func (opts MarshalOptions) MarshalFullCardNumberAsString(cardNumber *ddv1.FullCa
 	return opts.MarshalStringValue(nil)
 }
 
-// AnonymizeFullCardNumber replaces a card number with test values while preserving structure.
+// AnonymizeFullCardNumber replaces a card number with a synthetic card number
+// while preserving structure.
+//
+// The card number is derived from a hash of the original, so the same card is
+// always anonymized to the same number and distinct cards remain distinct.
+// The issuing member state is replaced with the anonymized home nation,
+// unless PreserveNations is set.
+// Empty card numbers (no card inserted) are kept empty.
 func (opts AnonymizeOptions) AnonymizeFullCardNumber(fc *ddv1.FullCardNumber) *ddv1.FullCardNumber {
 	if fc == nil {
 		return nil
@@ -208,24 +215,16 @@ func (opts AnonymizeOptions) AnonymizeFullCardNumber(fc *ddv1.FullCardNumber) *d
 	result := &ddv1.FullCardNumber{}
 	// Preserve the card type from the original
 	result.SetCardType(fc.GetCardType())
-	// Set issuing member state to UNSPECIFIED
-	result.SetCardIssuingMemberState(ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED)
 
-	// Anonymize driver identification if present
 	if driverID := fc.GetDriverIdentification(); driverID != nil {
-		anonDriverID := &ddv1.DriverIdentification{}
-		anonDriverID.SetDriverIdentificationNumber(opts.AnonymizeIa5StringValue(driverID.GetDriverIdentificationNumber()))
-		anonDriverID.SetCardReplacementIndex(opts.AnonymizeIa5StringValue(driverID.GetCardReplacementIndex()))
-		anonDriverID.SetCardRenewalIndex(opts.AnonymizeIa5StringValue(driverID.GetCardRenewalIndex()))
-		result.SetDriverIdentification(anonDriverID)
+		result.SetCardIssuingMemberState(opts.anonymizedNation(fc.GetCardIssuingMemberState()))
+		result.SetDriverIdentification(opts.AnonymizeDriverIdentification(driverID))
 	} else if ownerID := fc.GetOwnerIdentification(); ownerID != nil {
 		// Anonymize owner identification if present (company cards)
-		anonOwnerID := &ddv1.OwnerIdentification{}
-		anonOwnerID.SetOwnerIdentification(opts.AnonymizeIa5StringValue(ownerID.GetOwnerIdentification()))
-		anonOwnerID.SetConsecutiveIndex(opts.AnonymizeIa5StringValue(ownerID.GetConsecutiveIndex()))
-		anonOwnerID.SetReplacementIndex(opts.AnonymizeIa5StringValue(ownerID.GetReplacementIndex()))
-		anonOwnerID.SetRenewalIndex(opts.AnonymizeIa5StringValue(ownerID.GetRenewalIndex()))
-		result.SetOwnerIdentification(anonOwnerID)
+		result.SetCardIssuingMemberState(opts.anonymizedNation(fc.GetCardIssuingMemberState()))
+		result.SetOwnerIdentification(opts.AnonymizeOwnerIdentification(ownerID))
+	} else {
+		result.SetCardIssuingMemberState(ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED)
 	}
 
 	return result
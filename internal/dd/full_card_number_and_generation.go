@@ -2,6 +2,7 @@ package dd
 
 import (
 	"fmt"
+	"io"
 
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
@@ -22,7 +23,7 @@ import (
 //   - Generation (1 byte): Generation enum value
 func (opts UnmarshalOptions) UnmarshalFullCardNumberAndGeneration(data []byte) (*ddv1.FullCardNumberAndGeneration, error) {
 	if len(data) < 1 {
-		return nil, fmt.Errorf("insufficient data for FullCardNumberAndGeneration: got %d, want at least 1", len(data))
+		return nil, fmt.Errorf("insufficient data for FullCardNumberAndGeneration: got %d, want at least 1: %w", len(data), io.ErrUnexpectedEOF)
 	}
 
 	fullCardNumberAndGen := &ddv1.FullCardNumberAndGeneration{}
@@ -32,11 +33,11 @@ func (opts UnmarshalOptions) UnmarshalFullCardNumberAndGeneration(data []byte) (
 	// For now, we'll assume it's the last 1 byte is the generation
 	// and everything before that is the FullCardNumber
 	if len(data) < 1 {
-		return nil, fmt.Errorf("insufficient data for FullCardNumberAndGeneration")
+		return nil, fmt.Errorf("insufficient data for FullCardNumberAndGeneration: %w", io.ErrUnexpectedEOF)
 	}
 
 	// Parse generation (last byte)
-	if generation, err := UnmarshalEnum[ddv1.Generation](data[len(data)-1]); err == nil {
+	if generation, err := UnmarshalEnumOrReport[ddv1.Generation](opts, "FullCardNumberAndGeneration.generation", data[len(data)-1]); err == nil {
 		fullCardNumberAndGen.SetGeneration(generation)
 	} else {
 		return nil, fmt.Errorf("failed to parse generation: %w", err)
@@ -62,3 +62,35 @@ func (opts MarshalOptions) MarshalGeoCoordinates(geoCoords *ddv1.GeoCoordinates)
 	copy(canvas[3:6], longBytes)
 	return canvas[:], nil
 }
+
+// GeoCoordinatesToDegrees converts GeoCoordinates to decimal degrees.
+//
+// The data type `GeoCoordinates` is specified in the Data Dictionary, Section 2.76.
+// Each coordinate is encoded as ±DDMM.M × 10 (latitude) or ±DDDMM.M × 10
+// (longitude), e.g. 60305 for 60°30.5'.
+//
+// The returned ok value is false if geoCoords is nil or if either coordinate
+// holds the unknown position marker 0x7FFFFF.
+func GeoCoordinatesToDegrees(geoCoords *ddv1.GeoCoordinates) (latitude, longitude float64, ok bool) {
+	const unknownPosition = 0x7FFFFF
+	if geoCoords == nil {
+		return 0, 0, false
+	}
+	lat, lon := geoCoords.GetLatitude(), geoCoords.GetLongitude()
+	if lat == unknownPosition || lon == unknownPosition {
+		return 0, 0, false
+	}
+	return ddmmToDegrees(lat), ddmmToDegrees(lon), true
+}
+
+// ddmmToDegrees converts a ±DDMM.M × 10 value to decimal degrees.
+func ddmmToDegrees(value int32) float64 {
+	sign := 1.0
+	if value < 0 {
+		sign = -1.0
+		value = -value
+	}
+	degrees := value / 1000
+	tenthsOfMinutes := value % 1000
+	return sign * (float64(degrees) + float64(tenthsOfMinutes)/600)
+}
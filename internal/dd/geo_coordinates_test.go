@@ -0,0 +1,45 @@
+package dd
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestGeoCoordinates_southernWesternHemisphere(t *testing.T) {
+	// São Paulo, 23°33.0' S 46°38.0' W: -23330 and -46380 as 24-bit two's
+	// complement.
+	geo := []byte{0xFF, 0xA4, 0xDE, 0xFF, 0x4A, 0xD4}
+
+	// GNSSPlaceRecord, as embedded in GNSS accumulated driving, border crossing
+	// and load/unload records: timeStamp, gnssAccuracy, geoCoordinates.
+	data := append([]byte{0x66, 0x38, 0x9A, 0x80, 0x0C}, geo...)
+	record, err := UnmarshalOptions{}.UnmarshalGNSSPlaceRecord(data)
+	if err != nil {
+		t.Fatalf("UnmarshalGNSSPlaceRecord failed: %v", err)
+	}
+	coords := record.GetGeoCoordinates()
+	if coords.GetLatitude() != -23330 || coords.GetLongitude() != -46380 {
+		t.Errorf("GeoCoordinates = (%d, %d), want (-23330, -46380)", coords.GetLatitude(), coords.GetLongitude())
+	}
+	latitude, longitude, ok := GeoCoordinatesToDegrees(coords)
+	if !ok || math.Abs(latitude-(-23.55)) > 1e-9 || math.Abs(longitude-(-(46+38.0/60))) > 1e-9 {
+		t.Errorf("GeoCoordinatesToDegrees() = %v, %v, %v; want -23.55, -46.633, true", latitude, longitude, ok)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalGeoCoordinates(coords)
+	if err != nil {
+		t.Fatalf("MarshalGeoCoordinates failed: %v", err)
+	}
+	if !bytes.Equal(marshaled, geo) {
+		t.Errorf("MarshalGeoCoordinates() = % X, want % X", marshaled, geo)
+	}
+
+	unknown, err := UnmarshalOptions{}.UnmarshalGeoCoordinates([]byte{0x7F, 0xFF, 0xFF, 0x7F, 0xFF, 0xFF})
+	if err != nil {
+		t.Fatalf("UnmarshalGeoCoordinates failed: %v", err)
+	}
+	if _, _, ok := GeoCoordinatesToDegrees(unknown); ok {
+		t.Error("GeoCoordinatesToDegrees(unknown position) reported ok")
+	}
+}
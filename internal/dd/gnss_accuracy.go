@@ -0,0 +1,28 @@
+package dd
+
+// GNSSAccuracyToHDOP converts a GNSSAccuracy value to the horizontal dilution
+// of precision (HDOP) of the GNSS fix.
+//
+// The data type `GNSSAccuracy` is specified in the Data Dictionary, Section 2.77.
+//
+// ASN.1 Definition:
+//
+//	GNSSAccuracy ::= INTEGER (1..100)
+//
+// The value is the HDOP, the minimum of the HDOP values of the available GNSS
+// systems, multiplied by ten: 1 to 100 encode an HDOP of 0.1 to 10.0. The HDOP
+// is a dimensionless factor rather than a distance; the position error grows
+// with it, and values up to about 2 are usually considered good.
+//
+// The returned ok value is false for values outside the domain, such as the
+// zero value of unused records.
+func GNSSAccuracyToHDOP(raw int32) (hdop float64, ok bool) {
+	const (
+		minGNSSAccuracy = 1
+		maxGNSSAccuracy = 100
+	)
+	if raw < minGNSSAccuracy || raw > maxGNSSAccuracy {
+		return 0, false
+	}
+	return float64(raw) / 10, true
+}
@@ -0,0 +1,24 @@
+package dd
+
+import "testing"
+
+func TestGNSSAccuracyToHDOP(t *testing.T) {
+	for _, tt := range []struct {
+		raw      int32
+		wantHDOP float64
+		wantOK   bool
+	}{
+		{raw: 1, wantHDOP: 0.1, wantOK: true},
+		{raw: 12, wantHDOP: 1.2, wantOK: true},
+		{raw: 100, wantHDOP: 10, wantOK: true},
+		{raw: 0},
+		{raw: 101},
+		{raw: 255},
+		{raw: -1},
+	} {
+		hdop, ok := GNSSAccuracyToHDOP(tt.raw)
+		if hdop != tt.wantHDOP || ok != tt.wantOK {
+			t.Errorf("GNSSAccuracyToHDOP(%d) = %v, %v; want %v, %v", tt.raw, hdop, ok, tt.wantHDOP, tt.wantOK)
+		}
+	}
+}
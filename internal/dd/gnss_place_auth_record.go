@@ -58,7 +58,7 @@ func (opts UnmarshalOptions) UnmarshalGNSSPlaceAuthRecord(data []byte) (*ddv1.GN
 	record.SetGeoCoordinates(geoCoords)
 
 	// Parse authenticationStatus (1 byte)
-	if authStatus, err := UnmarshalEnum[ddv1.PositionAuthenticationStatus](data[idxAuthStatus]); err == nil {
+	if authStatus, err := UnmarshalEnumOrReport[ddv1.PositionAuthenticationStatus](opts, "GNSSPlaceAuthRecord.authenticationStatus", data[idxAuthStatus]); err == nil {
 		record.SetAuthenticationStatus(authStatus)
 	} else {
 		record.SetAuthenticationStatus(ddv1.PositionAuthenticationStatus_POSITION_AUTHENTICATION_STATUS_UNRECOGNIZED)
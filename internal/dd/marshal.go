@@ -10,7 +10,8 @@ type MarshalOptions struct {
 	//
 	// If false, data is always encoded from semantic fields, ignoring raw_data.
 	//
-	// NOTE: This option is currently always treated as true. Full implementation
-	// of the UseRawData=false behavior is deferred to a future phase.
+	// NOTE: This option is currently only honored by transfers that cannot be
+	// painted field by field, such as Gen2 VU activities, and is otherwise
+	// treated as true.
 	UseRawData bool
 }
@@ -0,0 +1,81 @@
+package dd
+
+import (
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// NationToISO returns the ISO 3166-1 alpha-2 and alpha-3 codes of a NationNumeric.
+//
+// The data type `NationNumeric` is specified in the Data Dictionary, Section 2.101.
+// The value assignment is maintained by the laboratory appointed to carry out
+// interoperability testing (Annex 1C, requirement 440).
+//
+// The returned ok value is false for values that do not denote a single
+// country: NATION_NUMERIC_DEFAULT ('00'H), EUROPEAN_COMMUNITY ('FD'H),
+// REST_OF_WORLD ('FE'H), NATION_NUMERIC_EMPTY ('FF'H, no information
+// available), as well as unspecified and unrecognized values.
+func NationToISO(nation ddv1.NationNumeric) (alpha2, alpha3 string, ok bool) {
+	codes, ok := nationISOCodes[nation]
+	if !ok {
+		return "", "", false
+	}
+	return codes[0], codes[1], true
+}
+
+// nationISOCodes maps each country NationNumeric to its ISO 3166-1 alpha-2
+// and alpha-3 codes.
+var nationISOCodes = map[ddv1.NationNumeric][2]string{
+	ddv1.NationNumeric_AUSTRIA:            {"AT", "AUT"},
+	ddv1.NationNumeric_ALBANIA:            {"AL", "ALB"},
+	ddv1.NationNumeric_ANDORRA:            {"AD", "AND"},
+	ddv1.NationNumeric_ARMENIA:            {"AM", "ARM"},
+	ddv1.NationNumeric_AZERBAIJAN:         {"AZ", "AZE"},
+	ddv1.NationNumeric_BELGIUM:            {"BE", "BEL"},
+	ddv1.NationNumeric_BULGARIA:           {"BG", "BGR"},
+	ddv1.NationNumeric_BOSNIA_HERZEGOVINA: {"BA", "BIH"},
+	ddv1.NationNumeric_BELARUS:            {"BY", "BLR"},
+	ddv1.NationNumeric_SWITZERLAND:        {"CH", "CHE"},
+	ddv1.NationNumeric_CYPRUS:             {"CY", "CYP"},
+	ddv1.NationNumeric_CZECH_REPUBLIC:     {"CZ", "CZE"},
+	ddv1.NationNumeric_GERMANY:            {"DE", "DEU"},
+	ddv1.NationNumeric_DENMARK:            {"DK", "DNK"},
+	ddv1.NationNumeric_SPAIN:              {"ES", "ESP"},
+	ddv1.NationNumeric_ESTONIA:            {"EE", "EST"},
+	ddv1.NationNumeric_FRANCE:             {"FR", "FRA"},
+	ddv1.NationNumeric_FINLAND:            {"FI", "FIN"},
+	ddv1.NationNumeric_LIECHTENSTEIN:      {"LI", "LIE"},
+	ddv1.NationNumeric_FAROE_ISLANDS:      {"FO", "FRO"},
+	ddv1.NationNumeric_UNITED_KINGDOM:     {"GB", "GBR"},
+	ddv1.NationNumeric_GEORGIA:            {"GE", "GEO"},
+	ddv1.NationNumeric_GREECE:             {"GR", "GRC"},
+	ddv1.NationNumeric_HUNGARY:            {"HU", "HUN"},
+	ddv1.NationNumeric_CROATIA:            {"HR", "HRV"},
+	ddv1.NationNumeric_ITALY:              {"IT", "ITA"},
+	ddv1.NationNumeric_IRELAND:            {"IE", "IRL"},
+	ddv1.NationNumeric_ICELAND:            {"IS", "ISL"},
+	ddv1.NationNumeric_KAZAKHSTAN:         {"KZ", "KAZ"},
+	ddv1.NationNumeric_LUXEMBOURG:         {"LU", "LUX"},
+	ddv1.NationNumeric_LITHUANIA:          {"LT", "LTU"},
+	ddv1.NationNumeric_LATVIA:             {"LV", "LVA"},
+	ddv1.NationNumeric_MALTA:              {"MT", "MLT"},
+	ddv1.NationNumeric_MONACO:             {"MC", "MCO"},
+	ddv1.NationNumeric_MOLDOVA:            {"MD", "MDA"},
+	ddv1.NationNumeric_NORTH_MACEDONIA:    {"MK", "MKD"},
+	ddv1.NationNumeric_NORWAY:             {"NO", "NOR"},
+	ddv1.NationNumeric_NETHERLANDS:        {"NL", "NLD"},
+	ddv1.NationNumeric_PORTUGAL:           {"PT", "PRT"},
+	ddv1.NationNumeric_POLAND:             {"PL", "POL"},
+	ddv1.NationNumeric_ROMANIA:            {"RO", "ROU"},
+	ddv1.NationNumeric_SAN_MARINO:         {"SM", "SMR"},
+	ddv1.NationNumeric_RUSSIA:             {"RU", "RUS"},
+	ddv1.NationNumeric_SWEDEN:             {"SE", "SWE"},
+	ddv1.NationNumeric_SLOVAKIA:           {"SK", "SVK"},
+	ddv1.NationNumeric_SLOVENIA:           {"SI", "SVN"},
+	ddv1.NationNumeric_TURKMENISTAN:       {"TM", "TKM"},
+	ddv1.NationNumeric_TURKEY:             {"TR", "TUR"},
+	ddv1.NationNumeric_UKRAINE:            {"UA", "UKR"},
+	ddv1.NationNumeric_VATICAN_CITY:       {"VA", "VAT"},
+	ddv1.NationNumeric_SERBIA:             {"RS", "SRB"},
+	ddv1.NationNumeric_MONTENEGRO:         {"ME", "MNE"},
+	ddv1.NationNumeric_KYRGYZ_REPUBLIC:    {"KG", "KGZ"},
+}
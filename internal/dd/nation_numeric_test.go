@@ -0,0 +1,67 @@
+package dd
+
+import (
+	"testing"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestNationToISO(t *testing.T) {
+	tests := []struct {
+		nation     ddv1.NationNumeric
+		wantAlpha2 string
+		wantAlpha3 string
+		wantOK     bool
+	}{
+		{nation: ddv1.NationNumeric_FINLAND, wantAlpha2: "FI", wantAlpha3: "FIN", wantOK: true},
+		{nation: ddv1.NationNumeric_SWEDEN, wantAlpha2: "SE", wantAlpha3: "SWE", wantOK: true},
+		{nation: ddv1.NationNumeric_UNITED_KINGDOM, wantAlpha2: "GB", wantAlpha3: "GBR", wantOK: true},
+		{nation: ddv1.NationNumeric_KYRGYZ_REPUBLIC, wantAlpha2: "KG", wantAlpha3: "KGZ", wantOK: true},
+		{nation: ddv1.NationNumeric_EUROPEAN_COMMUNITY},
+		{nation: ddv1.NationNumeric_REST_OF_WORLD},
+		{nation: ddv1.NationNumeric_NATION_NUMERIC_EMPTY},
+		{nation: ddv1.NationNumeric_NATION_NUMERIC_DEFAULT},
+		{nation: ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED},
+		{nation: ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.nation.String(), func(t *testing.T) {
+			alpha2, alpha3, ok := NationToISO(tt.nation)
+			if alpha2 != tt.wantAlpha2 || alpha3 != tt.wantAlpha3 || ok != tt.wantOK {
+				t.Errorf("NationToISO(%v) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.nation, alpha2, alpha3, ok, tt.wantAlpha2, tt.wantAlpha3, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNationToISO_AllCountriesMapped(t *testing.T) {
+	sentinels := map[ddv1.NationNumeric]bool{
+		ddv1.NationNumeric_NATION_NUMERIC_UNSPECIFIED:  true,
+		ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED: true,
+		ddv1.NationNumeric_NATION_NUMERIC_DEFAULT:      true,
+		ddv1.NationNumeric_NATION_NUMERIC_EMPTY:        true,
+		ddv1.NationNumeric_EUROPEAN_COMMUNITY:          true,
+		ddv1.NationNumeric_REST_OF_WORLD:               true,
+	}
+	seen := map[string]ddv1.NationNumeric{}
+	values := ddv1.NationNumeric(0).Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		nation := ddv1.NationNumeric(values.Get(i).Number())
+		alpha2, alpha3, ok := NationToISO(nation)
+		if sentinels[nation] {
+			if ok {
+				t.Errorf("NationToISO(%v) ok = true, want false for sentinel", nation)
+			}
+			continue
+		}
+		if !ok || len(alpha2) != 2 || len(alpha3) != 3 {
+			t.Errorf("NationToISO(%v) = (%q, %q, %v), want valid ISO codes", nation, alpha2, alpha3, ok)
+			continue
+		}
+		if other, dup := seen[alpha2]; dup {
+			t.Errorf("NationToISO(%v) alpha-2 %q duplicates %v", nation, alpha2, other)
+		}
+		seen[alpha2] = nation
+	}
+}
@@ -30,7 +30,7 @@ func (opts MarshalOptions) MarshalOdometer(odometer int32) ([]byte, error) {
 //
 // ASN.1 Definition:
 //
-//	OdometerShort ::= INTEGER(0..999999)
+//	OdometerShort ::= INTEGER(0..9999999)
 //
 // Binary Layout (3 bytes):
 //   - Odometer Value (3 bytes): Big-endian unsigned integer
@@ -46,6 +46,33 @@ func (opts UnmarshalOptions) UnmarshalOdometer(data []byte) (uint32, error) {
 	return value, nil
 }
 
+// odometerShortModulus is the number of values of an OdometerShort, after which
+// the odometer rolls over to zero.
+const odometerShortModulus = 10_000_000
+
+// UnwrapOdometer returns the odometer reading current, continuing the sequence
+// of readings that prev is part of, so that distances computed from the two
+// readings do not go negative when the odometer rolls over.
+//
+// The data type `OdometerShort` is specified in the Data Dictionary,
+// Section 2.113, and ranges from 0 to 9 999 999 km, after which the odometer
+// wraps around to zero. The reading prev may itself have been unwrapped, and
+// current is a raw OdometerShort value.
+//
+// A reading below prev is taken as a rollover only if it is more than half
+// the odometer range below prev; smaller decreases, such as from an odometer
+// adjusted at calibration, are returned as is.
+func UnwrapOdometer(prev, current int32) int32 {
+	unwrapped := prev - prev%odometerShortModulus + current%odometerShortModulus
+	switch {
+	case prev-unwrapped > odometerShortModulus/2:
+		unwrapped += odometerShortModulus
+	case unwrapped-prev > odometerShortModulus/2 && unwrapped >= odometerShortModulus:
+		unwrapped -= odometerShortModulus
+	}
+	return unwrapped
+}
+
 // AnonymizeOdometerValue anonymizes odometer values based on options.
 // If PreserveDistanceAndTrips is false, rounds to nearest 1000km.
 func (opts AnonymizeOptions) AnonymizeOdometerValue(km int32) int32 {
@@ -188,3 +188,29 @@ func TestOdometerRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestUnwrapOdometer(t *testing.T) {
+	tests := []struct {
+		name    string
+		prev    int32
+		current int32
+		want    int32
+	}{
+		{name: "increase", prev: 123456, current: 123556, want: 123556},
+		{name: "rollover", prev: 9999900, current: 100, want: 10000100},
+		{name: "after rollover", prev: 10000100, current: 200, want: 10000200},
+		{name: "second rollover", prev: 19999950, current: 50, want: 20000050},
+		{name: "small decrease", prev: 123456, current: 123400, want: 123400},
+		{name: "small decrease across rollover", prev: 10000100, current: 9999950, want: 9999950},
+		{name: "unchanged", prev: 9999999, current: 9999999, want: 9999999},
+		{name: "past former wrap point", prev: 999900, current: 1000100, want: 1000100},
+		{name: "large decrease", prev: 1200000, current: 400000, want: 400000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnwrapOdometer(tt.prev, tt.current); got != tt.want {
+				t.Errorf("UnwrapOdometer(%d, %d) = %d, want %d", tt.prev, tt.current, got, tt.want)
+			}
+		})
+	}
+}
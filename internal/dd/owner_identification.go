@@ -121,3 +121,19 @@ func (opts MarshalOptions) MarshalOwnerIdentification(ownerID *ddv1.OwnerIdentif
 
 	return dst, nil
 }
+
+// AnonymizeOwnerIdentification anonymizes an owner identification, replacing
+// the owner identification with a synthetic number derived from a hash of the
+// original and preserving the consecutive, replacement and renewal indices.
+func (opts AnonymizeOptions) AnonymizeOwnerIdentification(ownerID *ddv1.OwnerIdentification) *ddv1.OwnerIdentification {
+	if ownerID == nil {
+		return nil
+	}
+
+	result := &ddv1.OwnerIdentification{}
+	result.SetOwnerIdentification(opts.anonymizedCardIdentifier(ownerID.GetOwnerIdentification()))
+	result.SetConsecutiveIndex(anonymizedCardIndex(ownerID.GetConsecutiveIndex(), "0"))
+	result.SetReplacementIndex(anonymizedCardIndex(ownerID.GetReplacementIndex(), "0"))
+	result.SetRenewalIndex(anonymizedCardIndex(ownerID.GetRenewalIndex(), "0"))
+	return result
+}
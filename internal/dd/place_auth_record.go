@@ -63,21 +63,21 @@ func (opts UnmarshalOptions) UnmarshalPlaceAuthRecord(data []byte) (*ddv1.PlaceA
 	record.SetEntryTime(entryTime)
 
 	// entryTypeDailyWorkPeriod (1 byte)
-	entryTypeDailyWorkPeriod, err := UnmarshalEnum[ddv1.EntryTypeDailyWorkPeriod](data[idxEntryTypeDailyWorkPeriod])
+	entryTypeDailyWorkPeriod, err := UnmarshalEnumOrReport[ddv1.EntryTypeDailyWorkPeriod](opts, "PlaceAuthRecord.entryTypeDailyWorkPeriod", data[idxEntryTypeDailyWorkPeriod])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal entry type daily work period: %w", err)
 	}
 	record.SetEntryTypeDailyWorkPeriod(entryTypeDailyWorkPeriod)
 
 	// dailyWorkPeriodCountry (1 byte)
-	dailyWorkPeriodCountry, err := UnmarshalEnum[ddv1.NationNumeric](data[idxDailyWorkPeriodCountry])
+	dailyWorkPeriodCountry, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "PlaceAuthRecord.dailyWorkPeriodCountry", data[idxDailyWorkPeriodCountry])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal daily work period country: %w", err)
 	}
 	record.SetDailyWorkPeriodCountry(dailyWorkPeriodCountry)
 
 	// dailyWorkPeriodRegion (1 byte)
-	dailyWorkPeriodRegion, err := UnmarshalEnum[ddv1.RegionNumeric](data[idxDailyWorkPeriodRegion])
+	dailyWorkPeriodRegion, err := UnmarshalEnumOrReport[ddv1.RegionNumeric](opts, "PlaceAuthRecord.dailyWorkPeriodRegion", data[idxDailyWorkPeriodRegion])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal daily work period region: %w", err)
 	}
@@ -47,7 +47,7 @@ func (opts UnmarshalOptions) UnmarshalPlaceAuthStatusRecord(data []byte) (*ddv1.
 	record.SetEntryTime(entryTime)
 
 	// authenticationStatus (1 byte)
-	authenticationStatus, err := UnmarshalEnum[ddv1.PositionAuthenticationStatus](data[idxAuthenticationStatus])
+	authenticationStatus, err := UnmarshalEnumOrReport[ddv1.PositionAuthenticationStatus](opts, "PlaceAuthStatusRecord.authenticationStatus", data[idxAuthenticationStatus])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal authentication status: %w", err)
 	}
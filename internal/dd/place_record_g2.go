@@ -50,7 +50,7 @@ func (opts UnmarshalOptions) UnmarshalPlaceRecordG2(data []byte) (*ddv1.PlaceRec
 
 	// Parse entry type (1 byte)
 	entryTypeByte := data[idxEntryType]
-	entryType, err := UnmarshalEnum[ddv1.EntryTypeDailyWorkPeriod](entryTypeByte)
+	entryType, err := UnmarshalEnumOrReport[ddv1.EntryTypeDailyWorkPeriod](opts, "PlaceRecord.entryTypeDailyWorkPeriod", entryTypeByte)
 	if err != nil {
 		record.SetEntryTypeDailyWorkPeriod(ddv1.EntryTypeDailyWorkPeriod_ENTRY_TYPE_DAILY_WORK_PERIOD_UNRECOGNIZED)
 		record.SetUnrecognizedEntryTypeDailyWorkPeriod(int32(entryTypeByte))
@@ -60,7 +60,7 @@ func (opts UnmarshalOptions) UnmarshalPlaceRecordG2(data []byte) (*ddv1.PlaceRec
 
 	// Parse country (1 byte)
 	countryByte := data[idxCountry]
-	country, err := UnmarshalEnum[ddv1.NationNumeric](countryByte)
+	country, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "PlaceRecord.dailyWorkPeriodCountry", countryByte)
 	if err != nil {
 		record.SetDailyWorkPeriodCountry(ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED)
 		record.SetUnrecognizedDailyWorkPeriodCountry(int32(countryByte))
@@ -69,7 +69,7 @@ func (opts UnmarshalOptions) UnmarshalPlaceRecordG2(data []byte) (*ddv1.PlaceRec
 	}
 
 	// Parse region (1 byte)
-	record.SetDailyWorkPeriodRegion([]byte{data[idxRegion]})
+	record.SetDailyWorkPeriodRegion(int32(data[idxRegion]))
 
 	// Parse odometer (3 bytes)
 	odometerBytes := data[idxOdometer : idxOdometer+3]
@@ -125,11 +125,11 @@ func (opts MarshalOptions) MarshalPlaceRecordG2(rec *ddv1.PlaceRecordG2) ([]byte
 	canvas[5] = countryProtocol
 
 	// Region (1 byte)
-	regionBytes := rec.GetDailyWorkPeriodRegion()
-	if len(regionBytes) > 0 {
-		canvas[6] = regionBytes[0]
+	region := rec.GetDailyWorkPeriodRegion()
+	if region < 0 || region > 0xFF {
+		return nil, fmt.Errorf("region %d out of range 0-255", region)
 	}
-	// Otherwise leave as zero (or preserved from raw_data)
+	canvas[6] = byte(region)
 
 	// Odometer (3 bytes)
 	odometerBytes, err := opts.MarshalOdometer(rec.GetVehicleOdometerKm())
@@ -156,7 +156,7 @@ func (opts MarshalOptions) MarshalPlaceRecordG2(rec *ddv1.PlaceRecordG2) ([]byte
 // - Normalizes country/region to generic values
 // - Rounds odometer to nearest 100km
 // - Preserves entry type (needed for structure testing)
-// - Anonymizes GNSS coordinates (set to Helsinki, Finland: 60.17°N, 24.94°E)
+// - Anonymizes GNSS coordinates (set to the anonymized base location)
 func (opts AnonymizeOptions) AnonymizePlaceRecordG2(rec *ddv1.PlaceRecordG2) *ddv1.PlaceRecordG2 {
 	if rec == nil {
 		return nil
@@ -170,11 +170,19 @@ func (opts AnonymizeOptions) AnonymizePlaceRecordG2(rec *ddv1.PlaceRecordG2) *dd
 		result.SetUnrecognizedEntryTypeDailyWorkPeriod(rec.GetUnrecognizedEntryTypeDailyWorkPeriod())
 	}
 
-	// Anonymize country (use a generic test country code)
-	result.SetDailyWorkPeriodCountry(ddv1.NationNumeric_FINLAND) // Finland as test default
+	if opts.PreserveNations {
+		result.SetDailyWorkPeriodCountry(rec.GetDailyWorkPeriodCountry())
+		if rec.HasUnrecognizedDailyWorkPeriodCountry() {
+			result.SetUnrecognizedDailyWorkPeriodCountry(rec.GetUnrecognizedDailyWorkPeriodCountry())
+		}
+		result.SetDailyWorkPeriodRegion(rec.GetDailyWorkPeriodRegion())
+	} else {
+		// Anonymize country (use the test home nation)
+		result.SetDailyWorkPeriodCountry(opts.AnonymizedHomeNation())
 
-	// Anonymize region (use generic value)
-	result.SetDailyWorkPeriodRegion([]byte{0x01})
+		// Anonymize region (use generic value)
+		result.SetDailyWorkPeriodRegion(0x01)
+	}
 
 	// Round odometer to nearest 100km (preserves magnitude but not exact location correlation)
 	originalOdometer := rec.GetVehicleOdometerKm()
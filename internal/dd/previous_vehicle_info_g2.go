@@ -48,7 +48,7 @@ func (opts UnmarshalOptions) UnmarshalPreviousVehicleInfoG2(data []byte) (*ddv1.
 	result.SetCardWithdrawalTime(withdrawalTime)
 
 	// Parse vuGeneration (1 byte)
-	vuGen, err := UnmarshalEnum[ddv1.Generation](data[idxVuGeneration])
+	vuGen, err := UnmarshalEnumOrReport[ddv1.Generation](opts, "PreviousVehicleInfo.vuGeneration", data[idxVuGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal vu generation: %w", err)
 	}
@@ -0,0 +1,50 @@
+package dd
+
+import (
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// ResolveRegion returns the name of the region identified by a RegionNumeric,
+// as held by the daily_work_period_region fields, within the given country.
+//
+// The data type `RegionNumeric` is specified in the Data Dictionary, Section 2.122.
+//
+// ASN.1 Definition:
+//
+//	RegionNumeric ::= OCTET STRING (SIZE (1))
+//
+// The regulation only assigns region codes for Spain. For Generation 2, further
+// codes are held on a list maintained by the laboratory appointed to carry out
+// interoperability testing. The returned ok value is false for '00'H (no
+// information available) and for codes that are not assigned.
+func ResolveRegion(nation ddv1.NationNumeric, region int32) (name string, ok bool) {
+	regions, ok := regionNames[nation]
+	if !ok {
+		return "", false
+	}
+	name, ok = regions[region]
+	return name, ok
+}
+
+// regionNames holds the RegionNumeric value assignment per country.
+var regionNames = map[ddv1.NationNumeric]map[int32]string{
+	ddv1.NationNumeric_SPAIN: {
+		0x01: "Andalucía",
+		0x02: "Aragón",
+		0x03: "Asturias",
+		0x04: "Cantabria",
+		0x05: "Cataluña",
+		0x06: "Castilla-León",
+		0x07: "Castilla-La-Mancha",
+		0x08: "Valencia",
+		0x09: "Extremadura",
+		0x0A: "Galicia",
+		0x0B: "Baleares",
+		0x0C: "Canarias",
+		0x0D: "La Rioja",
+		0x0E: "Madrid",
+		0x0F: "Murcia",
+		0x10: "Navarra",
+		0x11: "País Vasco",
+	},
+}
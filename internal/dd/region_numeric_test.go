@@ -0,0 +1,33 @@
+package dd
+
+import (
+	"testing"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestResolveRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		nation   ddv1.NationNumeric
+		region   int32
+		wantName string
+		wantOK   bool
+	}{
+		{name: "spain first", nation: ddv1.NationNumeric_SPAIN, region: 0x01, wantName: "Andalucía", wantOK: true},
+		{name: "spain madrid", nation: ddv1.NationNumeric_SPAIN, region: 0x0E, wantName: "Madrid", wantOK: true},
+		{name: "spain last", nation: ddv1.NationNumeric_SPAIN, region: 0x11, wantName: "País Vasco", wantOK: true},
+		{name: "spain no information", nation: ddv1.NationNumeric_SPAIN, region: 0x00},
+		{name: "spain unassigned", nation: ddv1.NationNumeric_SPAIN, region: 0x12},
+		{name: "country without regions", nation: ddv1.NationNumeric_FINLAND, region: 0x01},
+		{name: "out of range", nation: ddv1.NationNumeric_SPAIN, region: 0x101},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := ResolveRegion(tt.nation, tt.region)
+			if name != tt.wantName || ok != tt.wantOK {
+				t.Errorf("ResolveRegion(%v, %#02x) = (%q, %v), want (%q, %v)", tt.nation, tt.region, name, ok, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+}
@@ -16,6 +16,10 @@ import (
 //	    entryTime TimeReal,
 //	    specificConditionType SpecificConditionType
 //	}
+//
+// The layout is identical in all generations: 5 bytes on Gen1 and Gen2 cards,
+// in the Gen1 VuSpecificConditionData, and in the Gen2 VuSpecificConditionRecordArray
+// (Appendix 7, Section 2.2.6.3), so no generation-specific variant is needed.
 func (opts UnmarshalOptions) UnmarshalSpecificConditionRecord(data []byte) (*ddv1.SpecificConditionRecord, error) {
 	const (
 		lenSpecificConditionRecord = 5
@@ -37,7 +41,7 @@ func (opts UnmarshalOptions) UnmarshalSpecificConditionRecord(data []byte) (*ddv
 	record.SetEntryTime(entryTime)
 
 	// Parse specificConditionType (1 byte)
-	if conditionType, err := UnmarshalEnum[ddv1.SpecificConditionType](data[idxSpecificConditionType]); err == nil {
+	if conditionType, err := UnmarshalEnumOrReport[ddv1.SpecificConditionType](opts, "SpecificConditionRecord.specificConditionType", data[idxSpecificConditionType]); err == nil {
 		record.SetSpecificConditionType(conditionType)
 	} else {
 		record.SetSpecificConditionType(ddv1.SpecificConditionType_SPECIFIC_CONDITION_TYPE_UNRECOGNIZED)
@@ -0,0 +1,105 @@
+package dd
+
+import (
+	"sort"
+	"time"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+// DescribeSpecificCondition returns the description of a SpecificConditionType,
+// as worded in the regulation, such as "Out of scope - Begin".
+//
+// The data type `SpecificConditionType` is specified in the Data Dictionary, Section 2.154.
+// The Generation 1 value "Ferry/Train crossing" shares its value with the
+// Generation 2 value "Ferry/Train crossing - Begin".
+//
+// The returned description is empty for unspecified and unrecognized values.
+func DescribeSpecificCondition(conditionType ddv1.SpecificConditionType) string {
+	return specificConditionDescriptions[conditionType]
+}
+
+// specificConditionDescriptions maps each SpecificConditionType to its description.
+var specificConditionDescriptions = map[ddv1.SpecificConditionType]string{
+	ddv1.SpecificConditionType_RFU:                        "RFU",
+	ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN:         "Out of scope - Begin",
+	ddv1.SpecificConditionType_OUT_OF_SCOPE_END:           "Out of scope - End",
+	ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_BEGIN: "Ferry/Train crossing - Begin",
+	ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_END:   "Ferry/Train crossing - End",
+}
+
+// specificConditionEnds maps each begin SpecificConditionType to the type that
+// ends the condition.
+var specificConditionEnds = map[ddv1.SpecificConditionType]ddv1.SpecificConditionType{
+	ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN:         ddv1.SpecificConditionType_OUT_OF_SCOPE_END,
+	ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_BEGIN: ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_END,
+}
+
+// ConditionPeriod is a period during which a specific condition applied,
+// bounded by a begin and an end SpecificConditionRecord.
+type ConditionPeriod struct {
+	// Condition is the begin type of the condition, such as OUT_OF_SCOPE_BEGIN.
+	Condition ddv1.SpecificConditionType
+	// Begin is the entry time of the begin record.
+	// Begin is zero when an end record has no matching begin record.
+	Begin time.Time
+	// End is the entry time of the end record.
+	// End is zero when the condition is still open, or when the record marks a
+	// Generation 1 ferry/train crossing, which has no end record.
+	End time.Time
+	// Duration is the time between Begin and End, or zero if either is unknown.
+	Duration time.Duration
+}
+
+// Open reports whether the period has no end record.
+func (p ConditionPeriod) Open() bool {
+	return p.End.IsZero()
+}
+
+// PairSpecificConditions matches the begin and end records of specific
+// conditions into periods.
+//
+// Records are ordered by entry time, and each end record closes the most
+// recent open begin record of the same condition. A begin record that
+// repeats an already open condition closes nothing and is ignored. Begin
+// records without an end are reported as open periods, and end records
+// without a begin are reported with a zero Begin. Records of other types are
+// ignored. The periods are returned in order of their first known time.
+func PairSpecificConditions(records []*ddv1.SpecificConditionRecord) []ConditionPeriod {
+	sorted := make([]*ddv1.SpecificConditionRecord, 0, len(records))
+	for _, record := range records {
+		if record.GetEntryTime() != nil {
+			sorted = append(sorted, record)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetEntryTime().AsTime().Before(sorted[j].GetEntryTime().AsTime())
+	})
+	var periods []ConditionPeriod
+	open := make(map[ddv1.SpecificConditionType]int)
+	for _, record := range sorted {
+		conditionType := record.GetSpecificConditionType()
+		entryTime := record.GetEntryTime().AsTime()
+		if _, ok := specificConditionEnds[conditionType]; ok {
+			if _, ok := open[conditionType]; ok {
+				continue
+			}
+			open[conditionType] = len(periods)
+			periods = append(periods, ConditionPeriod{Condition: conditionType, Begin: entryTime})
+			continue
+		}
+		for beginType, endType := range specificConditionEnds {
+			if endType != conditionType {
+				continue
+			}
+			if i, ok := open[beginType]; ok {
+				periods[i].End = entryTime
+				periods[i].Duration = entryTime.Sub(periods[i].Begin)
+				delete(open, beginType)
+			} else {
+				periods = append(periods, ConditionPeriod{Condition: beginType, End: entryTime})
+			}
+		}
+	}
+	return periods
+}
@@ -0,0 +1,70 @@
+package dd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDescribeSpecificCondition(t *testing.T) {
+	for conditionType, want := range map[ddv1.SpecificConditionType]string{
+		ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN:                   "Out of scope - Begin",
+		ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_END:             "Ferry/Train crossing - End",
+		ddv1.SpecificConditionType_SPECIFIC_CONDITION_TYPE_UNSPECIFIED:  "",
+		ddv1.SpecificConditionType_SPECIFIC_CONDITION_TYPE_UNRECOGNIZED: "",
+	} {
+		if got := DescribeSpecificCondition(conditionType); got != want {
+			t.Errorf("DescribeSpecificCondition(%v) = %q, want %q", conditionType, got, want)
+		}
+	}
+}
+
+func TestPairSpecificConditions(t *testing.T) {
+	base := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	record := func(offset time.Duration, conditionType ddv1.SpecificConditionType) *ddv1.SpecificConditionRecord {
+		r := &ddv1.SpecificConditionRecord{}
+		r.SetEntryTime(timestamppb.New(base.Add(offset)))
+		r.SetSpecificConditionType(conditionType)
+		return r
+	}
+	records := []*ddv1.SpecificConditionRecord{
+		record(5*time.Hour, ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_BEGIN),
+		record(2*time.Hour, ddv1.SpecificConditionType_OUT_OF_SCOPE_END),
+		record(0, ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN),
+		record(4*time.Hour, ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_END),
+		record(3*time.Hour, ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN),
+		record(8*time.Hour, ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_END),
+	}
+	got := PairSpecificConditions(records)
+	want := []ConditionPeriod{
+		{
+			Condition: ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN,
+			Begin:     base,
+			End:       base.Add(2 * time.Hour),
+			Duration:  2 * time.Hour,
+		},
+		{
+			Condition: ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN,
+			Begin:     base.Add(3 * time.Hour),
+		},
+		{
+			Condition: ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_BEGIN,
+			End:       base.Add(4 * time.Hour),
+		},
+		{
+			Condition: ddv1.SpecificConditionType_FERRY_TRAIN_CROSSING_BEGIN,
+			Begin:     base.Add(5 * time.Hour),
+			End:       base.Add(8 * time.Hour),
+			Duration:  3 * time.Hour,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PairSpecificConditions() mismatch (-want +got):\n%s", diff)
+	}
+	if !got[1].Open() || got[0].Open() {
+		t.Errorf("Open() = %v, %v; want false, true", got[0].Open(), got[1].Open())
+	}
+}
@@ -8,6 +8,21 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// unsetTimeRealMax is the all-ones TimeReal value, which like zero denotes a
+// time that is not set.
+const unsetTimeRealMax = 0xFFFFFFFF
+
+// IsUnsetTime reports whether a timestamp denotes a TimeReal that is not set:
+// nil, or one of the sentinel values '00000000'H and 'FFFFFFFF'H, which would
+// otherwise read as 1970-01-01 and 2106-02-07.
+//
+// UnmarshalTimeReal returns nil for '00000000'H only, to reproduce
+// 'FFFFFFFF'H when marshalling, so consumers should test timestamps with
+// IsUnsetTime rather than against nil.
+func IsUnsetTime(ts *timestamppb.Timestamp) bool {
+	return ts == nil || (ts.GetNanos() == 0 && (ts.GetSeconds() == 0 || ts.GetSeconds() == unsetTimeRealMax))
+}
+
 // UnmarshalTimeReal unmarshals a TimeReal timestamp from a byte slice.
 //
 // The data type `TimeReal` is specified in the Data Dictionary, Section 2.162.
@@ -18,6 +33,16 @@ import (
 //
 // Binary Layout (4 bytes):
 //   - Seconds since Unix epoch (4 bytes): Big-endian uint32
+//
+// The value '00000000'H denotes a time that is not set and is returned as nil.
+// The value 'FFFFFFFF'H also denotes a time that is not set, such as the
+// withdrawal time of a card still inserted, but is returned as is, since
+// marshalling writes nil as zero and would not reproduce it; use IsUnsetTime
+// to test for both.
+//
+// TimeReal counts seconds since 00h00m00s on 1 January 1970 UTC, and
+// tachographs keep their clock in UTC, so the timestamp is in UTC regardless
+// of where it was recorded. Convert it to local time for display only.
 func (opts UnmarshalOptions) UnmarshalTimeReal(data []byte) (*timestamppb.Timestamp, error) {
 	const lenTimeReal = 4
 	if len(data) != lenTimeReal {
@@ -27,7 +52,7 @@ func (opts UnmarshalOptions) UnmarshalTimeReal(data []byte) (*timestamppb.Timest
 	if timeVal == 0 {
 		return nil, nil // Zero time is represented as nil
 	}
-	return timestamppb.New(time.Unix(int64(timeVal), 0)), nil
+	return timestamppb.New(time.Unix(int64(timeVal), 0).UTC()), nil
 }
 
 // MarshalTimeReal marshals a 4-byte TimeReal value.
@@ -36,6 +36,11 @@ func TestUnmarshalTimeReal(t *testing.T) {
 			input:     []byte{0x00, 0x00, 0x00, 0x00},
 			wantIsNil: true,
 		},
+		{
+			name:     "all ones (unset, kept for round trip)",
+			input:    []byte{0xFF, 0xFF, 0xFF, 0xFF},
+			wantUnix: 0xFFFFFFFF,
+		},
 		{
 			name:     "2038-01-19 03:14:07 UTC (max int32)",
 			input:    []byte{0x7F, 0xFF, 0xFF, 0xFF},
@@ -89,6 +94,23 @@ func TestUnmarshalTimeReal(t *testing.T) {
 	}
 }
 
+func TestIsUnsetTime(t *testing.T) {
+	for _, tt := range []struct {
+		ts   *timestamppb.Timestamp
+		want bool
+	}{
+		{nil, true},
+		{&timestamppb.Timestamp{}, true},
+		{&timestamppb.Timestamp{Seconds: 0xFFFFFFFF}, true},
+		{&timestamppb.Timestamp{Seconds: 1759226400}, false},
+		{&timestamppb.Timestamp{Nanos: 1}, false},
+	} {
+		if got := IsUnsetTime(tt.ts); got != tt.want {
+			t.Errorf("IsUnsetTime(%v) = %v, want %v", tt.ts, got, tt.want)
+		}
+	}
+}
+
 func TestAppendTimeReal(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -18,4 +18,14 @@ type UnmarshalOptions struct {
 	// If false, raw_data fields will be left empty, reducing memory usage
 	// but preventing exact binary reconstruction.
 	PreserveRawData bool
+
+	// OnUnrecognized, if set, is called for each enum value that has no
+	// matching value in the Data Dictionary, such as a SlotCardType emitted
+	// by a newer VU.
+	//
+	// The context names the data element holding the value, such as
+	// "CardSlotsStatus.driver", and rawValue is the value as read. The parser
+	// still falls back to the UNRECOGNIZED enum value or returns an error, as
+	// without the callback.
+	OnUnrecognized func(context string, rawValue uint64)
 }
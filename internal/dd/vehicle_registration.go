@@ -30,7 +30,7 @@ func (opts UnmarshalOptions) UnmarshalVehicleRegistration(data []byte) (*ddv1.Ve
 	vehicleReg := &ddv1.VehicleRegistrationIdentification{}
 
 	// Read nation code (1 byte) and convert using protocol annotations
-	if nation, err := UnmarshalEnum[ddv1.NationNumeric](data[0]); err == nil {
+	if nation, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "VehicleRegistrationIdentification.vehicleRegistrationNation", data[0]); err == nil {
 		vehicleReg.SetNation(nation)
 	} else {
 		// Value not recognized - set UNRECOGNIZED (no unrecognized field for this type)
@@ -6,7 +6,7 @@ import (
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
-// UnmarshalVuBorderCrossingRecord parses a VuBorderCrossingRecord (57 bytes).
+// UnmarshalVuBorderCrossingRecord parses a VuBorderCrossingRecord (55 bytes).
 //
 // The data type `VuBorderCrossingRecord` is specified in the Data Dictionary, Section 2.203a.
 //
@@ -21,24 +21,29 @@ import (
 //	    vehicleOdometerValue            OdometerShort
 //	}
 //
-// Binary Layout (fixed length, 57 bytes):
-//   - Bytes 0-19: cardNumberAndGenDriverSlot (FullCardNumberAndGeneration)
-//   - Bytes 20-39: cardNumberAndGenCodriverSlot (FullCardNumberAndGeneration)
-//   - Byte 40: countryLeft (NationNumeric)
-//   - Byte 41: countryEntered (NationNumeric)
-//   - Bytes 42-53: gnssPlaceAuthRecord (GNSSPlaceAuthRecord)
-//   - Bytes 54-56: vehicleOdometerValue (OdometerShort)
+// Binary Layout (fixed length, 55 bytes):
+//   - Bytes 0-18: cardNumberAndGenDriverSlot (FullCardNumberAndGeneration)
+//   - Bytes 19-37: cardNumberAndGenCodriverSlot (FullCardNumberAndGeneration)
+//   - Byte 38: countryLeft (NationNumeric)
+//   - Byte 39: countryEntered (NationNumeric)
+//   - Bytes 40-51: gnssPlaceAuthRecord (GNSSPlaceAuthRecord)
+//   - Bytes 52-54: vehicleOdometerValue (OdometerShort)
+//
+// The countries may hold the special NationNumeric values, such as
+// REST_OF_WORLD when the VU cannot determine the country. Codes that are not
+// known NationNumeric values are kept as NATION_NUMERIC_UNRECOGNIZED, with the
+// raw code in the corresponding unrecognized field.
 func (opts UnmarshalOptions) UnmarshalVuBorderCrossingRecord(data []byte) (*ddv1.VuBorderCrossingRecord, error) {
 	const (
 		idxCardNumberDriverSlot   = 0
-		idxCardNumberCodriverSlot = 20
-		idxCountryLeft            = 40
-		idxCountryEntered         = 41
-		idxGnssPlaceAuthRecord    = 42
-		idxVehicleOdometerValue   = 54
-		lenVuBorderCrossingRecord = 57
-
-		lenFullCardNumberAndGeneration = 20
+		idxCardNumberCodriverSlot = 19
+		idxCountryLeft            = 38
+		idxCountryEntered         = 39
+		idxGnssPlaceAuthRecord    = 40
+		idxVehicleOdometerValue   = 52
+		lenVuBorderCrossingRecord = 55
+
+		lenFullCardNumberAndGeneration = 19
 		lenNationNumeric               = 1
 		lenGNSSPlaceAuthRecord         = 12
 		lenOdometerShort               = 3
@@ -68,18 +73,20 @@ func (opts UnmarshalOptions) UnmarshalVuBorderCrossingRecord(data []byte) (*ddv1
 	record.SetCardNumberCodriverSlot(cardNumberCodriverSlot)
 
 	// countryLeft (1 byte)
-	countryLeft, err := UnmarshalEnum[ddv1.NationNumeric](data[idxCountryLeft])
-	if err != nil {
-		return nil, fmt.Errorf("unmarshal country left: %w", err)
+	if countryLeft, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "VuBorderCrossingRecord.countryLeft", data[idxCountryLeft]); err == nil {
+		record.SetCountryLeft(countryLeft)
+	} else {
+		record.SetCountryLeft(ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED)
+		record.SetUnrecognizedCountryLeft(int32(data[idxCountryLeft]))
 	}
-	record.SetCountryLeft(countryLeft)
 
 	// countryEntered (1 byte)
-	countryEntered, err := UnmarshalEnum[ddv1.NationNumeric](data[idxCountryEntered])
-	if err != nil {
-		return nil, fmt.Errorf("unmarshal country entered: %w", err)
+	if countryEntered, err := UnmarshalEnumOrReport[ddv1.NationNumeric](opts, "VuBorderCrossingRecord.countryEntered", data[idxCountryEntered]); err == nil {
+		record.SetCountryEntered(countryEntered)
+	} else {
+		record.SetCountryEntered(ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED)
+		record.SetUnrecognizedCountryEntered(int32(data[idxCountryEntered]))
 	}
-	record.SetCountryEntered(countryEntered)
 
 	// gnssPlaceAuthRecord (12 bytes)
 	gnssPlaceAuthRecord, err := opts.UnmarshalGNSSPlaceAuthRecord(data[idxGnssPlaceAuthRecord : idxGnssPlaceAuthRecord+lenGNSSPlaceAuthRecord])
@@ -98,13 +105,16 @@ func (opts UnmarshalOptions) UnmarshalVuBorderCrossingRecord(data []byte) (*ddv1
 	return record, nil
 }
 
-// MarshalVuBorderCrossingRecord marshals a VuBorderCrossingRecord (57 bytes) to bytes.
+// MarshalVuBorderCrossingRecord marshals a VuBorderCrossingRecord (55 bytes) to bytes.
 func (opts MarshalOptions) MarshalVuBorderCrossingRecord(record *ddv1.VuBorderCrossingRecord) ([]byte, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record cannot be nil")
 	}
 
-	const lenVuBorderCrossingRecord = 57
+	const (
+		lenVuBorderCrossingRecord      = 55
+		lenFullCardNumberAndGeneration = 19
+	)
 
 	// Use raw data painting strategy if available
 	var canvas [lenVuBorderCrossingRecord]byte
@@ -118,30 +128,36 @@ func (opts MarshalOptions) MarshalVuBorderCrossingRecord(record *ddv1.VuBorderCr
 
 	offset := 0
 
-	// cardNumberAndGenDriverSlot (20 bytes)
+	// cardNumberAndGenDriverSlot (19 bytes)
 	cardNumberDriverSlotBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetCardNumberDriverSlot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal card number driver slot: %w", err)
 	}
-	copy(canvas[offset:offset+20], cardNumberDriverSlotBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], cardNumberDriverSlotBytes)
+	offset += lenFullCardNumberAndGeneration
 
-	// cardNumberAndGenCodriverSlot (20 bytes)
+	// cardNumberAndGenCodriverSlot (19 bytes)
 	cardNumberCodriverSlotBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetCardNumberCodriverSlot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal card number codriver slot: %w", err)
 	}
-	copy(canvas[offset:offset+20], cardNumberCodriverSlotBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], cardNumberCodriverSlotBytes)
+	offset += lenFullCardNumberAndGeneration
 
 	// countryLeft (1 byte)
-	countryLeftByte, _ := MarshalEnum(record.GetCountryLeft())
-	canvas[offset] = countryLeftByte
+	if record.GetCountryLeft() == ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED {
+		canvas[offset] = byte(record.GetUnrecognizedCountryLeft())
+	} else {
+		canvas[offset], _ = MarshalEnum(record.GetCountryLeft())
+	}
 	offset += 1
 
 	// countryEntered (1 byte)
-	countryEnteredByte, _ := MarshalEnum(record.GetCountryEntered())
-	canvas[offset] = countryEnteredByte
+	if record.GetCountryEntered() == ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED {
+		canvas[offset] = byte(record.GetUnrecognizedCountryEntered())
+	} else {
+		canvas[offset], _ = MarshalEnum(record.GetCountryEntered())
+	}
 	offset += 1
 
 	// gnssPlaceAuthRecord (12 bytes)
@@ -0,0 +1,101 @@
+package dd
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestUnmarshalVuBorderCrossingRecord_SpecialCountries(t *testing.T) {
+	const (
+		idxCountryLeft    = 38
+		idxCountryEntered = 39
+	)
+	tests := []struct {
+		name                 string
+		countryLeft          byte
+		countryEntered       byte
+		wantLeft             ddv1.NationNumeric
+		wantEntered          ddv1.NationNumeric
+		wantUnrecognizedLeft int32
+		wantUnrecognized     int32
+	}{
+		{
+			name:           "countries",
+			countryLeft:    14, // DK
+			countryEntered: 13, // D
+			wantLeft:       ddv1.NationNumeric_DENMARK,
+			wantEntered:    ddv1.NationNumeric_GERMANY,
+		},
+		{
+			name:           "rest of world",
+			countryLeft:    13,
+			countryEntered: 0xFE,
+			wantLeft:       ddv1.NationNumeric_GERMANY,
+			wantEntered:    ddv1.NationNumeric_REST_OF_WORLD,
+		},
+		{
+			name:           "no information",
+			countryLeft:    0xFF,
+			countryEntered: 0x00,
+			wantLeft:       ddv1.NationNumeric_NATION_NUMERIC_EMPTY,
+			wantEntered:    ddv1.NationNumeric_NATION_NUMERIC_DEFAULT,
+		},
+		{
+			name:             "unknown country entered",
+			countryLeft:      13,
+			countryEntered:   0xFC,
+			wantLeft:         ddv1.NationNumeric_GERMANY,
+			wantEntered:      ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED,
+			wantUnrecognized: 0xFC,
+		},
+		{
+			name:                 "unknown country left",
+			countryLeft:          51,
+			countryEntered:       13,
+			wantLeft:             ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED,
+			wantEntered:          ddv1.NationNumeric_GERMANY,
+			wantUnrecognizedLeft: 51,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Empty driver and co-driver slots, followed by the countries.
+			data := make([]byte, 55)
+			for i := range 38 {
+				data[i] = 0xFF
+			}
+			data[18] = 0x02
+			data[37] = 0x02
+			data[idxCountryLeft] = tt.countryLeft
+			data[idxCountryEntered] = tt.countryEntered
+
+			record, err := UnmarshalOptions{}.UnmarshalVuBorderCrossingRecord(data)
+			if err != nil {
+				t.Fatalf("UnmarshalVuBorderCrossingRecord failed: %v", err)
+			}
+			if got := record.GetCountryLeft(); got != tt.wantLeft {
+				t.Errorf("CountryLeft = %v, want %v", got, tt.wantLeft)
+			}
+			if got := record.GetCountryEntered(); got != tt.wantEntered {
+				t.Errorf("CountryEntered = %v, want %v", got, tt.wantEntered)
+			}
+			if got := record.GetUnrecognizedCountryLeft(); got != tt.wantUnrecognizedLeft {
+				t.Errorf("UnrecognizedCountryLeft = %d, want %d", got, tt.wantUnrecognizedLeft)
+			}
+			if got := record.GetUnrecognizedCountryEntered(); got != tt.wantUnrecognized {
+				t.Errorf("UnrecognizedCountryEntered = %d, want %d", got, tt.wantUnrecognized)
+			}
+
+			got, err := MarshalOptions{}.MarshalVuBorderCrossingRecord(record)
+			if err != nil {
+				t.Fatalf("MarshalVuBorderCrossingRecord failed: %v", err)
+			}
+			if diff := cmp.Diff(data, got); diff != "" {
+				t.Errorf("round-trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
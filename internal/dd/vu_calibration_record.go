@@ -7,7 +7,6 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
-	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // UnmarshalVuCalibrationRecord parses the VuCalibrationRecord structure.
@@ -90,14 +89,12 @@ func (opts UnmarshalOptions) UnmarshalVuCalibrationRecord(data []byte) (*ddv1.Vu
 	)
 
 	// Parse calibration purpose (1 byte)
-	calibrationPurposeValue := int32(data[idxCalibrationPurpose])
-	calibrationPurpose := ddv1.CalibrationPurpose(calibrationPurposeValue)
-	valueDesc := calibrationPurpose.Descriptor().Values().ByNumber(protoreflect.EnumNumber(calibrationPurposeValue))
-	if valueDesc == nil {
-		record.SetUnrecognizedPurpose(calibrationPurposeValue)
-		calibrationPurpose = ddv1.CalibrationPurpose_CALIBRATION_PURPOSE_UNSPECIFIED
+	if purpose, err := UnmarshalEnumOrReport[ddv1.CalibrationPurpose](opts, "VuCalibrationRecord.calibrationPurpose", data[idxCalibrationPurpose]); err == nil {
+		record.SetPurpose(purpose)
+	} else {
+		record.SetPurpose(ddv1.CalibrationPurpose_CALIBRATION_PURPOSE_UNRECOGNIZED)
+		record.SetUnrecognizedPurpose(int32(data[idxCalibrationPurpose]))
 	}
-	record.SetPurpose(calibrationPurpose)
 
 	// Parse workshop name (36 bytes)
 	workshopName, err := opts.UnmarshalStringValue(
@@ -247,13 +244,15 @@ func (opts MarshalOptions) MarshalVuCalibrationRecord(record *ddv1.VuCalibration
 	offset := 0
 
 	// Marshal calibration purpose (1 byte)
-	var purposeValue int32
-	if record.GetUnrecognizedPurpose() != 0 {
-		purposeValue = record.GetUnrecognizedPurpose()
+	if record.GetPurpose() == ddv1.CalibrationPurpose_CALIBRATION_PURPOSE_UNRECOGNIZED {
+		canvas[offset] = byte(record.GetUnrecognizedPurpose())
 	} else {
-		purposeValue = int32(record.GetPurpose())
+		purpose, err := MarshalEnum(record.GetPurpose())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal calibration purpose: %w", err)
+		}
+		canvas[offset] = purpose
 	}
-	canvas[offset] = byte(purposeValue)
 	offset += 1
 
 	// Marshal workshop name (36 bytes)
@@ -105,7 +105,7 @@ func (opts UnmarshalOptions) UnmarshalVuCardIWRecord(data []byte) (*ddv1.VuCardI
 	record.SetOdometerAtInsertionKm(int32(odometerAtInsertion))
 
 	// cardSlotNumber (1 byte)
-	cardSlotNumber, err := UnmarshalEnum[ddv1.CardSlotNumber](data[idxCardSlotNumber])
+	cardSlotNumber, err := UnmarshalEnumOrReport[ddv1.CardSlotNumber](opts, "VuCardIWRecord.cardSlotNumber", data[idxCardSlotNumber])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal card slot number: %w", err)
 	}
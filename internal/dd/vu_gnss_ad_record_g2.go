@@ -6,7 +6,7 @@ import (
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
-// UnmarshalVuGNSSADRecordG2 parses a VuGNSSADRecord (Generation 2, version 2 - 59 bytes).
+// UnmarshalVuGNSSADRecordG2 parses a VuGNSSADRecord (Generation 2, version 2 - 57 bytes).
 //
 // The data type `VuGNSSADRecord` is specified in the Data Dictionary, Section 2.203.
 //
@@ -20,23 +20,23 @@ import (
 //	    vehicleOdometerValue            OdometerShort
 //	}
 //
-// Binary Layout (fixed length, 59 bytes):
+// Binary Layout (fixed length, 57 bytes):
 //   - Bytes 0-3: timeStamp (TimeReal)
-//   - Bytes 4-23: cardNumberAndGenDriverSlot (FullCardNumberAndGeneration)
-//   - Bytes 24-43: cardNumberAndGenCodriverSlot (FullCardNumberAndGeneration)
-//   - Bytes 44-55: gnssPlaceAuthRecord (GNSSPlaceAuthRecord)
-//   - Bytes 56-58: vehicleOdometerValue (OdometerShort)
+//   - Bytes 4-22: cardNumberAndGenDriverSlot (FullCardNumberAndGeneration)
+//   - Bytes 23-41: cardNumberAndGenCodriverSlot (FullCardNumberAndGeneration)
+//   - Bytes 42-53: gnssPlaceAuthRecord (GNSSPlaceAuthRecord)
+//   - Bytes 54-56: vehicleOdometerValue (OdometerShort)
 func (opts UnmarshalOptions) UnmarshalVuGNSSADRecordG2(data []byte) (*ddv1.VuGNSSADRecordG2, error) {
 	const (
 		idxTimeStamp              = 0
 		idxCardNumberDriverSlot   = 4
-		idxCardNumberCodriverSlot = 24
-		idxGnssPlaceAuthRecord    = 44
-		idxVehicleOdometerValue   = 56
-		lenVuGNSSADRecordG2       = 59
+		idxCardNumberCodriverSlot = 23
+		idxGnssPlaceAuthRecord    = 42
+		idxVehicleOdometerValue   = 54
+		lenVuGNSSADRecordG2       = 57
 
 		lenTimeReal                    = 4
-		lenFullCardNumberAndGeneration = 20
+		lenFullCardNumberAndGeneration = 19
 		lenGNSSPlaceAuthRecord         = 12
 		lenOdometerShort               = 3
 	)
@@ -57,14 +57,14 @@ func (opts UnmarshalOptions) UnmarshalVuGNSSADRecordG2(data []byte) (*ddv1.VuGNS
 	}
 	record.SetTimeStamp(timeStamp)
 
-	// cardNumberAndGenDriverSlot (20 bytes)
+	// cardNumberAndGenDriverSlot (19 bytes)
 	cardNumberDriverSlot, err := opts.UnmarshalFullCardNumberAndGeneration(data[idxCardNumberDriverSlot : idxCardNumberDriverSlot+lenFullCardNumberAndGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal card number driver slot: %w", err)
 	}
 	record.SetCardNumberDriverSlot(cardNumberDriverSlot)
 
-	// cardNumberAndGenCodriverSlot (20 bytes)
+	// cardNumberAndGenCodriverSlot (19 bytes)
 	cardNumberCodriverSlot, err := opts.UnmarshalFullCardNumberAndGeneration(data[idxCardNumberCodriverSlot : idxCardNumberCodriverSlot+lenFullCardNumberAndGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal card number codriver slot: %w", err)
@@ -88,13 +88,16 @@ func (opts UnmarshalOptions) UnmarshalVuGNSSADRecordG2(data []byte) (*ddv1.VuGNS
 	return record, nil
 }
 
-// MarshalVuGNSSADRecordG2 marshals a VuGNSSADRecordG2 (59 bytes) to bytes.
+// MarshalVuGNSSADRecordG2 marshals a VuGNSSADRecordG2 (57 bytes) to bytes.
 func (opts MarshalOptions) MarshalVuGNSSADRecordG2(record *ddv1.VuGNSSADRecordG2) ([]byte, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record cannot be nil")
 	}
 
-	const lenVuGNSSADRecordG2 = 59
+	const (
+		lenVuGNSSADRecordG2            = 57
+		lenFullCardNumberAndGeneration = 19
+	)
 
 	// Use raw data painting strategy if available
 	var canvas [lenVuGNSSADRecordG2]byte
@@ -116,21 +119,21 @@ func (opts MarshalOptions) MarshalVuGNSSADRecordG2(record *ddv1.VuGNSSADRecordG2
 	copy(canvas[offset:offset+4], timeStampBytes)
 	offset += 4
 
-	// cardNumberAndGenDriverSlot (20 bytes)
+	// cardNumberAndGenDriverSlot (19 bytes)
 	cardNumberDriverSlotBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetCardNumberDriverSlot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal card number driver slot: %w", err)
 	}
-	copy(canvas[offset:offset+20], cardNumberDriverSlotBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], cardNumberDriverSlotBytes)
+	offset += lenFullCardNumberAndGeneration
 
-	// cardNumberAndGenCodriverSlot (20 bytes)
+	// cardNumberAndGenCodriverSlot (19 bytes)
 	cardNumberCodriverSlotBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetCardNumberCodriverSlot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal card number codriver slot: %w", err)
 	}
-	copy(canvas[offset:offset+20], cardNumberCodriverSlotBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], cardNumberCodriverSlotBytes)
+	offset += lenFullCardNumberAndGeneration
 
 	// gnssPlaceAuthRecord (12 bytes)
 	gnssPlaceAuthRecordBytes, err := opts.MarshalGNSSPlaceAuthRecord(record.GetGnssPlaceAuthRecord())
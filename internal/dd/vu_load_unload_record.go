@@ -6,7 +6,7 @@ import (
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
-// UnmarshalVuLoadUnloadRecord parses a VuLoadUnloadRecord (60 bytes).
+// UnmarshalVuLoadUnloadRecord parses a VuLoadUnloadRecord (58 bytes).
 //
 // The data type `VuLoadUnloadRecord` is specified in the Data Dictionary, Section 2.208a.
 //
@@ -21,26 +21,26 @@ import (
 //	    vehicleOdometerValue            OdometerShort
 //	}
 //
-// Binary Layout (fixed length, 60 bytes):
+// Binary Layout (fixed length, 58 bytes):
 //   - Bytes 0-3: timeStamp (TimeReal)
 //   - Byte 4: operationType (OperationType)
-//   - Bytes 5-24: cardNumberAndGenDriverSlot (FullCardNumberAndGeneration)
-//   - Bytes 25-44: cardNumberAndGenCodriverSlot (FullCardNumberAndGeneration)
-//   - Bytes 45-56: gnssPlaceAuthRecord (GNSSPlaceAuthRecord)
-//   - Bytes 57-59: vehicleOdometerValue (OdometerShort)
+//   - Bytes 5-23: cardNumberAndGenDriverSlot (FullCardNumberAndGeneration)
+//   - Bytes 24-42: cardNumberAndGenCodriverSlot (FullCardNumberAndGeneration)
+//   - Bytes 43-54: gnssPlaceAuthRecord (GNSSPlaceAuthRecord)
+//   - Bytes 55-57: vehicleOdometerValue (OdometerShort)
 func (opts UnmarshalOptions) UnmarshalVuLoadUnloadRecord(data []byte) (*ddv1.VuLoadUnloadRecord, error) {
 	const (
 		idxTimeStamp              = 0
 		idxOperationType          = 4
 		idxCardNumberDriverSlot   = 5
-		idxCardNumberCodriverSlot = 25
-		idxGnssPlaceAuthRecord    = 45
-		idxVehicleOdometerValue   = 57
-		lenVuLoadUnloadRecord     = 60
+		idxCardNumberCodriverSlot = 24
+		idxGnssPlaceAuthRecord    = 43
+		idxVehicleOdometerValue   = 55
+		lenVuLoadUnloadRecord     = 58
 
 		lenTimeReal                    = 4
 		lenOperationType               = 1
-		lenFullCardNumberAndGeneration = 20
+		lenFullCardNumberAndGeneration = 19
 		lenGNSSPlaceAuthRecord         = 12
 		lenOdometerShort               = 3
 	)
@@ -62,20 +62,20 @@ func (opts UnmarshalOptions) UnmarshalVuLoadUnloadRecord(data []byte) (*ddv1.VuL
 	record.SetTimeStamp(timeStamp)
 
 	// operationType (1 byte)
-	operationType, err := UnmarshalEnum[ddv1.OperationType](data[idxOperationType])
+	operationType, err := UnmarshalEnumOrReport[ddv1.OperationType](opts, "VuLoadUnloadRecord.operationType", data[idxOperationType])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal operation type: %w", err)
 	}
 	record.SetOperationType(operationType)
 
-	// cardNumberAndGenDriverSlot (20 bytes)
+	// cardNumberAndGenDriverSlot (19 bytes)
 	cardNumberDriverSlot, err := opts.UnmarshalFullCardNumberAndGeneration(data[idxCardNumberDriverSlot : idxCardNumberDriverSlot+lenFullCardNumberAndGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal card number driver slot: %w", err)
 	}
 	record.SetCardNumberDriverSlot(cardNumberDriverSlot)
 
-	// cardNumberAndGenCodriverSlot (20 bytes)
+	// cardNumberAndGenCodriverSlot (19 bytes)
 	cardNumberCodriverSlot, err := opts.UnmarshalFullCardNumberAndGeneration(data[idxCardNumberCodriverSlot : idxCardNumberCodriverSlot+lenFullCardNumberAndGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal card number codriver slot: %w", err)
@@ -99,13 +99,16 @@ func (opts UnmarshalOptions) UnmarshalVuLoadUnloadRecord(data []byte) (*ddv1.VuL
 	return record, nil
 }
 
-// MarshalVuLoadUnloadRecord marshals a VuLoadUnloadRecord (60 bytes) to bytes.
+// MarshalVuLoadUnloadRecord marshals a VuLoadUnloadRecord (58 bytes) to bytes.
 func (opts MarshalOptions) MarshalVuLoadUnloadRecord(record *ddv1.VuLoadUnloadRecord) ([]byte, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record cannot be nil")
 	}
 
-	const lenVuLoadUnloadRecord = 60
+	const (
+		lenVuLoadUnloadRecord          = 58
+		lenFullCardNumberAndGeneration = 19
+	)
 
 	// Use raw data painting strategy if available
 	var canvas [lenVuLoadUnloadRecord]byte
@@ -132,21 +135,21 @@ func (opts MarshalOptions) MarshalVuLoadUnloadRecord(record *ddv1.VuLoadUnloadRe
 	canvas[offset] = operationTypeByte
 	offset += 1
 
-	// cardNumberAndGenDriverSlot (20 bytes)
+	// cardNumberAndGenDriverSlot (19 bytes)
 	cardNumberDriverSlotBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetCardNumberDriverSlot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal card number driver slot: %w", err)
 	}
-	copy(canvas[offset:offset+20], cardNumberDriverSlotBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], cardNumberDriverSlotBytes)
+	offset += lenFullCardNumberAndGeneration
 
-	// cardNumberAndGenCodriverSlot (20 bytes)
+	// cardNumberAndGenCodriverSlot (19 bytes)
 	cardNumberCodriverSlotBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetCardNumberCodriverSlot())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal card number codriver slot: %w", err)
 	}
-	copy(canvas[offset:offset+20], cardNumberCodriverSlotBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], cardNumberCodriverSlotBytes)
+	offset += lenFullCardNumberAndGeneration
 
 	// gnssPlaceAuthRecord (12 bytes)
 	gnssPlaceAuthRecordBytes, err := opts.MarshalGNSSPlaceAuthRecord(record.GetGnssPlaceAuthRecord())
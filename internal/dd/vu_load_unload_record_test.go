@@ -0,0 +1,56 @@
+package dd
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestUnmarshalVuLoadUnloadRecord(t *testing.T) {
+	// A load operation with a Gen2 driver card in the driver slot and an empty
+	// co-driver slot. FullCardNumberAndGeneration is 19 bytes: card type,
+	// issuing member state, 16 bytes card number and the generation.
+	var data []byte
+	data = append(data, 0x66, 0x39, 0x1C, 0x80) // timeStamp
+	data = append(data, 0x01)                   // operationType: load
+	data = append(data, 0x01, 0x0D)             // driver card, issuing member state
+	data = append(data, "DF00001234567801"...)
+	data = append(data, 0x02) // generation 2
+	for range 18 {
+		data = append(data, 0xFF)
+	}
+	data = append(data, 0x02)
+	data = append(data, make([]byte, 12)...) // gnssPlaceAuthRecord
+	data = append(data, 0x01, 0xE2, 0x40)    // vehicleOdometerValue: 123456 km
+	if len(data) != 58 {
+		t.Fatalf("test record is %d bytes, want 58", len(data))
+	}
+
+	record, err := UnmarshalOptions{}.UnmarshalVuLoadUnloadRecord(data)
+	if err != nil {
+		t.Fatalf("UnmarshalVuLoadUnloadRecord failed: %v", err)
+	}
+	if got, want := record.GetOperationType(), ddv1.OperationType_LOAD_OPERATION; got != want {
+		t.Errorf("OperationType = %v, want %v", got, want)
+	}
+	driver := record.GetCardNumberDriverSlot()
+	if got, want := driver.GetGeneration(), ddv1.Generation_GENERATION_2; got != want {
+		t.Errorf("driver slot generation = %v, want %v", got, want)
+	}
+	if got, want := driver.GetFullCardNumber().GetDriverIdentification().GetDriverIdentificationNumber().GetValue(), "DF000012345678"; got != want {
+		t.Errorf("driver slot card number = %q, want %q", got, want)
+	}
+	if got, want := record.GetVehicleOdometerKm(), int32(123456); got != want {
+		t.Errorf("VehicleOdometerKm = %d, want %d", got, want)
+	}
+
+	got, err := MarshalOptions{}.MarshalVuLoadUnloadRecord(record)
+	if err != nil {
+		t.Fatalf("MarshalVuLoadUnloadRecord failed: %v", err)
+	}
+	if diff := cmp.Diff(data, got); diff != "" {
+		t.Errorf("round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -6,7 +6,7 @@ import (
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
-// UnmarshalVuPlaceDailyWorkPeriodRecordG2 parses a Generation 2 version 1 VuPlaceDailyWorkPeriodRecord (41 bytes).
+// UnmarshalVuPlaceDailyWorkPeriodRecordG2 parses a Generation 2 version 1 VuPlaceDailyWorkPeriodRecord (40 bytes).
 //
 // The data type `VuPlaceDailyWorkPeriodRecord` is specified in the Data Dictionary, Section 2.219.
 //
@@ -17,16 +17,16 @@ import (
 //	    placeRecord                 PlaceRecord
 //	}
 //
-// Binary Layout (fixed length, 41 bytes):
-//   - Bytes 0-19: fullCardNumberAndGeneration (FullCardNumberAndGeneration)
-//   - Bytes 20-40: placeRecord (PlaceRecordG2)
+// Binary Layout (fixed length, 40 bytes):
+//   - Bytes 0-18: fullCardNumberAndGeneration (FullCardNumberAndGeneration)
+//   - Bytes 19-39: placeRecord (PlaceRecordG2)
 func (opts UnmarshalOptions) UnmarshalVuPlaceDailyWorkPeriodRecordG2(data []byte) (*ddv1.VuPlaceDailyWorkPeriodRecordG2, error) {
 	const (
 		idxFullCardNumber                 = 0
-		idxPlaceRecord                    = 20
-		lenVuPlaceDailyWorkPeriodRecordG2 = 41
+		idxPlaceRecord                    = 19
+		lenVuPlaceDailyWorkPeriodRecordG2 = 40
 
-		lenFullCardNumberAndGeneration = 20
+		lenFullCardNumberAndGeneration = 19
 		lenPlaceRecordG2               = 21
 	)
 
@@ -39,7 +39,7 @@ func (opts UnmarshalOptions) UnmarshalVuPlaceDailyWorkPeriodRecordG2(data []byte
 		record.SetRawData(data)
 	}
 
-	// fullCardNumberAndGeneration (20 bytes)
+	// fullCardNumberAndGeneration (19 bytes)
 	fullCardNumber, err := opts.UnmarshalFullCardNumberAndGeneration(data[idxFullCardNumber : idxFullCardNumber+lenFullCardNumberAndGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal full card number and generation: %w", err)
@@ -56,13 +56,16 @@ func (opts UnmarshalOptions) UnmarshalVuPlaceDailyWorkPeriodRecordG2(data []byte
 	return record, nil
 }
 
-// MarshalVuPlaceDailyWorkPeriodRecordG2 marshals a VuPlaceDailyWorkPeriodRecordG2 (41 bytes) to bytes.
+// MarshalVuPlaceDailyWorkPeriodRecordG2 marshals a VuPlaceDailyWorkPeriodRecordG2 (40 bytes) to bytes.
 func (opts MarshalOptions) MarshalVuPlaceDailyWorkPeriodRecordG2(record *ddv1.VuPlaceDailyWorkPeriodRecordG2) ([]byte, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record cannot be nil")
 	}
 
-	const lenVuPlaceDailyWorkPeriodRecordG2 = 41
+	const (
+		lenVuPlaceDailyWorkPeriodRecordG2 = 40
+		lenFullCardNumberAndGeneration    = 19
+	)
 
 	// Use raw data painting strategy if available
 	var canvas [lenVuPlaceDailyWorkPeriodRecordG2]byte
@@ -76,13 +79,13 @@ func (opts MarshalOptions) MarshalVuPlaceDailyWorkPeriodRecordG2(record *ddv1.Vu
 
 	offset := 0
 
-	// fullCardNumberAndGeneration (20 bytes)
+	// fullCardNumberAndGeneration (19 bytes)
 	fullCardNumberBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetFullCardNumber())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal full card number and generation: %w", err)
 	}
-	copy(canvas[offset:offset+20], fullCardNumberBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], fullCardNumberBytes)
+	offset += lenFullCardNumberAndGeneration
 
 	// placeRecord (21 bytes)
 	placeRecordBytes, err := opts.MarshalPlaceRecordG2(record.GetPlaceRecord())
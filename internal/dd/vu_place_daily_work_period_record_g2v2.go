@@ -6,7 +6,7 @@ import (
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 )
 
-// UnmarshalVuPlaceDailyWorkPeriodRecordG2V2 parses a Generation 2 version 2 VuPlaceDailyWorkPeriodRecord (42 bytes).
+// UnmarshalVuPlaceDailyWorkPeriodRecordG2V2 parses a Generation 2 version 2 VuPlaceDailyWorkPeriodRecord (41 bytes).
 //
 // The data type `VuPlaceDailyWorkPeriodRecord` is specified in the Data Dictionary, Section 2.219.
 //
@@ -17,16 +17,16 @@ import (
 //	    placeAuthRecord             PlaceAuthRecord
 //	}
 //
-// Binary Layout (fixed length, 42 bytes):
-//   - Bytes 0-19: fullCardNumberAndGeneration (FullCardNumberAndGeneration)
-//   - Bytes 20-41: placeAuthRecord (PlaceAuthRecord)
+// Binary Layout (fixed length, 41 bytes):
+//   - Bytes 0-18: fullCardNumberAndGeneration (FullCardNumberAndGeneration)
+//   - Bytes 19-40: placeAuthRecord (PlaceAuthRecord)
 func (opts UnmarshalOptions) UnmarshalVuPlaceDailyWorkPeriodRecordG2V2(data []byte) (*ddv1.VuPlaceDailyWorkPeriodRecordG2V2, error) {
 	const (
 		idxFullCardNumber                   = 0
-		idxPlaceAuthRecord                  = 20
-		lenVuPlaceDailyWorkPeriodRecordG2V2 = 42
+		idxPlaceAuthRecord                  = 19
+		lenVuPlaceDailyWorkPeriodRecordG2V2 = 41
 
-		lenFullCardNumberAndGeneration = 20
+		lenFullCardNumberAndGeneration = 19
 		lenPlaceAuthRecord             = 22
 	)
 
@@ -39,7 +39,7 @@ func (opts UnmarshalOptions) UnmarshalVuPlaceDailyWorkPeriodRecordG2V2(data []by
 		record.SetRawData(data)
 	}
 
-	// fullCardNumberAndGeneration (20 bytes)
+	// fullCardNumberAndGeneration (19 bytes)
 	fullCardNumber, err := opts.UnmarshalFullCardNumberAndGeneration(data[idxFullCardNumber : idxFullCardNumber+lenFullCardNumberAndGeneration])
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal full card number and generation: %w", err)
@@ -56,13 +56,16 @@ func (opts UnmarshalOptions) UnmarshalVuPlaceDailyWorkPeriodRecordG2V2(data []by
 	return record, nil
 }
 
-// MarshalVuPlaceDailyWorkPeriodRecordG2V2 marshals a VuPlaceDailyWorkPeriodRecordG2V2 (42 bytes) to bytes.
+// MarshalVuPlaceDailyWorkPeriodRecordG2V2 marshals a VuPlaceDailyWorkPeriodRecordG2V2 (41 bytes) to bytes.
 func (opts MarshalOptions) MarshalVuPlaceDailyWorkPeriodRecordG2V2(record *ddv1.VuPlaceDailyWorkPeriodRecordG2V2) ([]byte, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record cannot be nil")
 	}
 
-	const lenVuPlaceDailyWorkPeriodRecordG2V2 = 42
+	const (
+		lenVuPlaceDailyWorkPeriodRecordG2V2 = 41
+		lenFullCardNumberAndGeneration      = 19
+	)
 
 	// Use raw data painting strategy if available
 	var canvas [lenVuPlaceDailyWorkPeriodRecordG2V2]byte
@@ -76,13 +79,13 @@ func (opts MarshalOptions) MarshalVuPlaceDailyWorkPeriodRecordG2V2(record *ddv1.
 
 	offset := 0
 
-	// fullCardNumberAndGeneration (20 bytes)
+	// fullCardNumberAndGeneration (19 bytes)
 	fullCardNumberBytes, err := opts.MarshalFullCardNumberAndGeneration(record.GetFullCardNumber())
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal full card number and generation: %w", err)
 	}
-	copy(canvas[offset:offset+20], fullCardNumberBytes)
-	offset += 20
+	copy(canvas[offset:offset+lenFullCardNumberAndGeneration], fullCardNumberBytes)
+	offset += lenFullCardNumberAndGeneration
 
 	// placeAuthRecord (22 bytes)
 	placeAuthRecordBytes, err := opts.MarshalPlaceAuthRecord(record.GetPlaceAuthRecord())
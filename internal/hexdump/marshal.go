@@ -2,9 +2,25 @@ package hexdump
 
 import (
 	"bytes"
-	"encoding/hex"
+	"fmt"
 )
 
+// MarshalOptions configures the hexdump format written by Marshal.
+//
+// The zero value matches the output of `hexdump -C`.
+type MarshalOptions struct {
+	// BytesPerLine is the number of data bytes on each line, such as 8, 16
+	// or 32. The hex bytes are grouped by 8, with an extra space between
+	// groups. Zero means 16.
+	BytesPerLine int
+
+	// OmitASCII omits the ASCII column at the end of each line.
+	OmitASCII bool
+
+	// Uppercase writes the offsets and hex bytes with uppercase digits.
+	Uppercase bool
+}
+
 // Marshal converts binary data into hexdump format matching `hexdump -C`.
 // The output format is:
 //
@@ -17,48 +33,73 @@ import (
 //   - Hex bytes (lowercase, space-separated, double space after byte 8)
 //   - ASCII representation (printable chars or '.' for non-printable)
 func Marshal(data []byte) ([]byte, error) {
+	return MarshalOptions{}.Marshal(data)
+}
+
+// Marshal converts binary data into hexdump format, as configured by the
+// options. Lines have the layout described for the package-level Marshal.
+//
+// The output can be read back with Unmarshal, whatever the options.
+func (o MarshalOptions) Marshal(data []byte) ([]byte, error) {
+	bytesPerLine := o.BytesPerLine
+	if bytesPerLine == 0 {
+		bytesPerLine = 16
+	}
+	if bytesPerLine < 0 {
+		return nil, fmt.Errorf("invalid bytes per line: %d", bytesPerLine)
+	}
 	if len(data) == 0 {
 		return []byte{}, nil
 	}
 
-	var buf bytes.Buffer
-	const bytesPerLine = 16
+	const groupSize = 8
+	digits := "0123456789abcdef"
+	if o.Uppercase {
+		digits = "0123456789ABCDEF"
+	}
+	appendHex := func(buf *bytes.Buffer, b byte) {
+		buf.WriteByte(digits[b>>4])
+		buf.WriteByte(digits[b&0x0f])
+	}
+
+	// Each byte takes 3 chars (2 hex + 1 space), plus 1 extra space between
+	// groups, plus 1 space before the ASCII column: 50 chars for 16 bytes,
+	// matching hexdump -C.
+	hexWidth := func(n int) int {
+		return n*3 + (n-1)/groupSize
+	}
+	fullWidth := hexWidth(bytesPerLine) + 1
 
+	var buf bytes.Buffer
 	for offset := 0; offset < len(data); offset += bytesPerLine {
 		// Write offset
-		buf.WriteString(hex.EncodeToString([]byte{
-			byte(offset >> 24),
-			byte(offset >> 16),
-			byte(offset >> 8),
-			byte(offset),
-		}))
+		for shift := 24; shift >= 0; shift -= 8 {
+			appendHex(&buf, byte(offset>>shift))
+		}
 		buf.WriteString("  ")
 
-		// Get the chunk for this line (up to 16 bytes)
-		end := offset + bytesPerLine
-		if end > len(data) {
-			end = len(data)
-		}
+		// Get the chunk for this line
+		end := min(offset+bytesPerLine, len(data))
 		chunk := data[offset:end]
 
 		// Write hex bytes
 		for i, b := range chunk {
-			if i == 8 {
-				buf.WriteString(" ") // Extra space after 8th byte
+			if i > 0 && i%groupSize == 0 {
+				buf.WriteString(" ") // Extra space between groups
+			}
+			appendHex(&buf, b)
+			if i < len(chunk)-1 || !o.OmitASCII {
+				buf.WriteString(" ")
 			}
-			buf.WriteString(hex.EncodeToString([]byte{b}))
-			buf.WriteString(" ")
 		}
 
-		// Pad hex section to fixed width (50 chars for full line, matching hexdump -C)
-		// Each byte takes 3 chars (2 hex + 1 space), plus 1 extra space at position 8
-		// Full line: 16*3 + 1 = 49 chars, plus 1 space before | = 50 chars total
-		hexChars := len(chunk)*3 + 1 // +1 for extra space at byte 8
-		if len(chunk) <= 8 {
-			hexChars = len(chunk) * 3
+		if o.OmitASCII {
+			buf.WriteByte('\n')
+			continue
 		}
-		padding := 50 - hexChars // 50 = 16*3 + 1 (extra space) + 1 (space before |)
-		for i := 0; i < padding; i++ {
+
+		// Pad hex section to fixed width
+		for range fullWidth - hexWidth(len(chunk)) {
 			buf.WriteByte(' ')
 		}
 
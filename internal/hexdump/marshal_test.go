@@ -68,3 +68,57 @@ func TestMarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalOptions_Marshal(t *testing.T) {
+	input := []byte("Hello World! \x00\xff")
+	tests := []struct {
+		name string
+		opts MarshalOptions
+		want string
+	}{
+		{
+			name: "8 bytes per line",
+			opts: MarshalOptions{BytesPerLine: 8},
+			want: "00000000  48 65 6c 6c 6f 20 57 6f  |Hello Wo|\n" +
+				"00000008  72 6c 64 21 20 00 ff     |rld! ..|\n",
+		},
+		{
+			name: "32 bytes per line",
+			opts: MarshalOptions{BytesPerLine: 32},
+			want: "00000000  48 65 6c 6c 6f 20 57 6f  72 6c 64 21 20 00 ff                                                       |Hello World! ..|\n",
+		},
+		{
+			name: "without ASCII column",
+			opts: MarshalOptions{OmitASCII: true},
+			want: "00000000  48 65 6c 6c 6f 20 57 6f  72 6c 64 21 20 00 ff\n",
+		},
+		{
+			name: "uppercase",
+			opts: MarshalOptions{BytesPerLine: 8, Uppercase: true, OmitASCII: true},
+			want: "00000000  48 65 6C 6C 6F 20 57 6F\n" +
+				"00000008  72 6C 64 21 20 00 FF\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.opts.Marshal(input)
+			if err != nil {
+				t.Fatalf("Marshal() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("Marshal() mismatch (-want +got):\n%s", diff)
+			}
+			roundTrip, err := Unmarshal(got)
+			if err != nil {
+				t.Fatalf("Unmarshal() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(input, roundTrip); diff != "" {
+				t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := (MarshalOptions{BytesPerLine: -1}).Marshal(input); err == nil {
+		t.Error("Marshal() with negative BytesPerLine succeeded, want error")
+	}
+}
@@ -4,16 +4,29 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/hex"
+	"fmt"
+	"strconv"
 )
 
+// maxSqueezedOffset is the largest offset that a squeezed ('*') line may be
+// expanded to, so that a single malformed offset cannot exhaust memory.
+const maxSqueezedOffset = 64 << 20
+
 // Unmarshal converts hexdump format back to binary data.
 // It accepts any hexdump format with offsets and hex bytes, ignoring:
 //   - Offset values (not validated)
 //   - ASCII columns (anything after the hex data)
-//   - Empty lines
+//   - Empty lines and trailing whitespace
 //   - Arbitrary spacing between hex bytes
+//   - The trailing offset-only line emitted by `hexdump -C`
+//
+// A line consisting of a single '*' is interpreted as in `hexdump -C`: the
+// previous line is repeated until the offset of the next line is reached,
+// which may be at most 64 MiB.
 //
-// This makes it forgiving and able to parse dumps from various sources.
+// This makes it forgiving and able to parse dumps from various sources, while
+// still returning an error for lines with malformed hex data rather than
+// silently dropping bytes.
 func Unmarshal(data []byte) ([]byte, error) {
 	result := []byte{}
 	scanner := bufio.NewScanner(bytes.NewReader(data))
@@ -21,7 +34,13 @@ func Unmarshal(data []byte) ([]byte, error) {
 	// Buffer for collecting hex characters (reused across lines)
 	hexBuf := make([]byte, 0, 32)
 
+	// State for expanding squeezed ('*') lines
+	var previous []byte
+	squeezed := false
+
+	lineNumber := 0
 	for scanner.Scan() {
+		lineNumber++
 		line := scanner.Bytes()
 
 		// Trim leading and trailing whitespace
@@ -32,10 +51,40 @@ func Unmarshal(data []byte) ([]byte, error) {
 			continue
 		}
 
+		if bytes.Equal(line, []byte("*")) {
+			squeezed = true
+			continue
+		}
+
 		// Find the separator between offset and hex data (two spaces)
 		sepIdx := bytes.Index(line, []byte("  "))
+		offsetPart := line
+		if sepIdx != -1 {
+			offsetPart = line[:sepIdx]
+		}
+
+		if squeezed {
+			offset, err := strconv.ParseUint(string(offsetPart), 16, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid offset after '*': %w", lineNumber, err)
+			}
+			if len(previous) == 0 {
+				return nil, fmt.Errorf("line %d: '*' without a preceding data line", lineNumber)
+			}
+			if offset > maxSqueezedOffset {
+				return nil, fmt.Errorf("line %d: offset %#x after '*' exceeds %#x", lineNumber, offset, maxSqueezedOffset)
+			}
+			for uint64(len(result)) < offset {
+				result = append(result, previous...)
+			}
+			if uint64(len(result)) != offset {
+				return nil, fmt.Errorf("line %d: offset %#x is not aligned to repeated line", lineNumber, offset)
+			}
+			squeezed = false
+		}
+
 		if sepIdx == -1 {
-			// No separator found, skip this line
+			// No separator found (e.g. trailing offset-only line), skip this line
 			continue
 		}
 
@@ -64,16 +113,20 @@ func Unmarshal(data []byte) ([]byte, error) {
 		decoded := make([]byte, hex.DecodedLen(len(hexBuf)))
 		n, err := hex.Decode(decoded, hexBuf)
 		if err != nil {
-			// Skip lines that can't be decoded as hex
-			continue
+			return nil, fmt.Errorf("line %d: invalid hex data: %w", lineNumber, err)
 		}
 
-		result = append(result, decoded[:n]...)
+		previous = decoded[:n]
+		result = append(result, previous...)
 	}
 
 	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
 
+	if squeezed {
+		return nil, fmt.Errorf("line %d: '*' without a following offset line", lineNumber)
+	}
+
 	return result, nil
 }
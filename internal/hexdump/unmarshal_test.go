@@ -1,6 +1,7 @@
 package hexdump
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -74,6 +75,49 @@ func TestUnmarshal(t *testing.T) {
 				0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
 			},
 		},
+		{
+			name:  "trailing whitespace and blank lines",
+			input: "00000000  48 65 6c 6c 6f   \t\n\n   \n",
+			want:  []byte("Hello"),
+		},
+		{
+			name:  "ASCII gutter containing hex characters",
+			input: "00000000  ab cd                                             |ab cd|\n",
+			want:  []byte{0xab, 0xcd},
+		},
+		{
+			name:  "trailing offset line from hexdump -C",
+			input: "00000000  48 65 6c 6c 6f                                    |Hello|\n00000005\n",
+			want:  []byte("Hello"),
+		},
+		{
+			name: "squeezed repeated lines",
+			input: "00000000  ff ff ff ff ff ff ff ff  ff ff ff ff ff ff ff ff  |................|\n" +
+				"*\n" +
+				"00000030  01                                                |.|\n" +
+				"00000031\n",
+			want: append(bytes.Repeat([]byte{0xff}, 48), 0x01),
+		},
+		{
+			name:    "squeezed line without following offset",
+			input:   "00000000  ff ff\n*\n",
+			wantErr: true,
+		},
+		{
+			name:    "squeezed line with huge offset",
+			input:   "00000000  ff ff\n*\nffffffffffffffff\n",
+			wantErr: true,
+		},
+		{
+			name:    "malformed hex",
+			input:   "00000000  48 6x\n",
+			wantErr: true,
+		},
+		{
+			name:    "odd number of hex digits",
+			input:   "00000000  48 6\n",
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,186 @@
+package security
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// ChainCertificate is a certificate of the chain of a card or VU file.
+//
+// Exactly one of Rsa (Generation 1) and Ecc (Generation 2) is set.
+type ChainCertificate struct {
+	// Name is the data element holding the certificate, such as
+	// "CardCertificate", "CaCertificate" or "VuCertificate".
+	Name string
+
+	// Generation is the generation of the application holding the certificate.
+	Generation ddv1.Generation
+
+	// Rsa is the certificate of a Generation 1 application.
+	Rsa *securityv1.RsaCertificate
+
+	// Ecc is the certificate of a Generation 2 application.
+	Ecc *securityv1.EccCertificate
+}
+
+// HolderReference returns the Certificate Holder Reference (CHR) of the
+// certificate.
+//
+// The CHR of an RSA certificate is only known once the certificate has been
+// verified, see UnmarshalRsaCertificate; it is empty otherwise.
+func (c ChainCertificate) HolderReference() string {
+	if c.Rsa != nil {
+		return c.Rsa.GetCertificateHolderReference()
+	}
+	return c.Ecc.GetCertificateHolderReference()
+}
+
+// AuthorityReference returns the Certificate Authority Reference (CAR) of
+// the certificate, i.e. the CHR of the certificate it is signed by.
+func (c ChainCertificate) AuthorityReference() string {
+	if c.Rsa != nil {
+		return c.Rsa.GetCertificateAuthorityReference()
+	}
+	return c.Ecc.GetCertificateAuthorityReference()
+}
+
+// ExtractCertificateChain returns the certificates of a parsed driver card
+// (*cardv1.DriverCardFile) or vehicle unit (*vuv1.VehicleUnitFile) file, for
+// validation against an external root store.
+//
+// The certificates of each generation are ordered from the equipment up to
+// the European root: for cards, the card certificates followed by the Member
+// State CA (MSCA) certificate and, for Generation 2, the link certificate;
+// for vehicle units, the VU certificate followed by the MSCA certificate.
+// Generation 1 certificates precede Generation 2 certificates. Certificates
+// missing from the file are omitted.
+//
+// Generation 2 VU certificates are read from the raw data of the overview,
+// which is only kept when the file was parsed with PreserveRawData.
+func ExtractCertificateChain(file proto.Message) ([]ChainCertificate, error) {
+	switch file := file.(type) {
+	case *cardv1.DriverCardFile:
+		return extractCardCertificateChain(file), nil
+	case *vuv1.VehicleUnitFile:
+		return extractVehicleUnitCertificateChain(file)
+	default:
+		return nil, fmt.Errorf("unsupported message for certificate chain: %T", file)
+	}
+}
+
+// extractCardCertificateChain returns the certificates of a driver card file.
+func extractCardCertificateChain(file *cardv1.DriverCardFile) []ChainCertificate {
+	var chain []ChainCertificate
+	appendRsa := func(name string, cert *securityv1.RsaCertificate) {
+		if cert != nil {
+			chain = append(chain, ChainCertificate{Name: name, Generation: ddv1.Generation_GENERATION_1, Rsa: cert})
+		}
+	}
+	appendEcc := func(name string, cert *securityv1.EccCertificate) {
+		if cert != nil {
+			chain = append(chain, ChainCertificate{Name: name, Generation: ddv1.Generation_GENERATION_2, Ecc: cert})
+		}
+	}
+	if df := file.GetTachograph(); df != nil {
+		appendRsa("CardCertificate", df.GetCardCertificate().GetRsaCertificate())
+		appendRsa("CaCertificate", df.GetCaCertificate().GetRsaCertificate())
+	}
+	if df := file.GetTachographG2(); df != nil {
+		appendEcc("CardSignCertificate", df.GetCardSignCertificate().GetEccCertificate())
+		appendEcc("CardMaCertificate", df.GetCardMaCertificate().GetEccCertificate())
+		appendEcc("CaCertificate", df.GetCaCertificate().GetEccCertificate())
+		appendEcc("LinkCertificate", df.GetLinkCertificate().GetEccCertificate())
+	}
+	return chain
+}
+
+// extractVehicleUnitCertificateChain returns the certificates of a vehicle
+// unit file.
+func extractVehicleUnitCertificateChain(file *vuv1.VehicleUnitFile) ([]ChainCertificate, error) {
+	var chain []ChainCertificate
+	if overview := file.GetGen1().GetOverview(); overview != nil {
+		for _, c := range []struct {
+			name string
+			data []byte
+		}{
+			{"VuCertificate", overview.GetVuCertificate()},
+			{"MemberStateCertificate", overview.GetMemberStateCertificate()},
+		} {
+			if len(c.data) == 0 {
+				continue
+			}
+			cert, err := UnmarshalRsaCertificate(c.data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal %s: %w", c.name, err)
+			}
+			chain = append(chain, ChainCertificate{Name: c.name, Generation: ddv1.Generation_GENERATION_1, Rsa: cert})
+		}
+	}
+	var gen2Overview []byte
+	switch {
+	case file.GetGen2V1().HasOverview():
+		gen2Overview = file.GetGen2V1().GetOverview().GetRawData()
+	case file.GetGen2V2().HasOverview():
+		gen2Overview = file.GetGen2V2().GetOverview().GetRawData()
+	}
+	if len(gen2Overview) > 0 {
+		// The overview starts with the MemberStateCertificateRecordArray and
+		// the VuCertificateRecordArray.
+		certs, err := firstRecordArrays(gen2Overview, 2)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read overview certificates: %w", err)
+		}
+		for _, c := range []struct {
+			name  string
+			index int
+		}{
+			{"VuCertificate", 1},
+			{"MemberStateCertificate", 0},
+		} {
+			if certs[c.index] == nil {
+				continue
+			}
+			cert, err := UnmarshalEccCertificate(certs[c.index])
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal %s: %w", c.name, err)
+			}
+			chain = append(chain, ChainCertificate{Name: c.name, Generation: ddv1.Generation_GENERATION_2, Ecc: cert})
+		}
+	}
+	return chain, nil
+}
+
+// firstRecordArrays returns the first record of each of the first n record
+// arrays of data, or nil for an array without records.
+//
+// Each record array starts with a 5-byte header: the record type (1 byte),
+// the record size (2 bytes) and the number of records (2 bytes).
+func firstRecordArrays(data []byte, n int) ([][]byte, error) {
+	const lenRecordArrayHeader = 5
+	records := make([][]byte, n)
+	offset := 0
+	for i := range records {
+		if offset+lenRecordArrayHeader > len(data) {
+			return nil, fmt.Errorf("insufficient data for record array header %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		recordSize := int(binary.BigEndian.Uint16(data[offset+1 : offset+3]))
+		noOfRecords := int(binary.BigEndian.Uint16(data[offset+3 : offset+5]))
+		offset += lenRecordArrayHeader
+		if offset+recordSize*noOfRecords > len(data) {
+			return nil, fmt.Errorf("insufficient data for record array %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		if noOfRecords > 0 {
+			records[i] = data[offset : offset+recordSize]
+		}
+		offset += recordSize * noOfRecords
+	}
+	return records, nil
+}
@@ -0,0 +1,136 @@
+package security
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// chainSummary is the name, generation and CAR of each certificate of a chain.
+type chainSummary struct {
+	Name       string
+	Generation ddv1.Generation
+	CAR        string
+}
+
+func summarizeChain(chain []ChainCertificate) []chainSummary {
+	var summary []chainSummary
+	for _, cert := range chain {
+		summary = append(summary, chainSummary{
+			Name:       cert.Name,
+			Generation: cert.Generation,
+			CAR:        cert.AuthorityReference(),
+		})
+	}
+	return summary
+}
+
+func TestExtractCertificateChain(t *testing.T) {
+	g1Cert, err := os.ReadFile("testdata/certs/g1/finland_tcc37.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g2Cert, err := os.ReadFile("testdata/certs/g2/finland_msca_card42.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaCert, err := UnmarshalRsaCertificate(g1Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const (
+		g1CAR = "18250066869723594497"
+		g2CAR = "18250066869740371713"
+	)
+
+	t.Run("driver card", func(t *testing.T) {
+		cardCertificate := &cardv1.CardCertificate{}
+		cardCertificate.SetRsaCertificate(rsaCert)
+		caCertificate := &cardv1.CaCertificate{}
+		caCertificate.SetRsaCertificate(rsaCert)
+		df := &cardv1.DriverCardFile_Tachograph{}
+		df.SetCardCertificate(cardCertificate)
+		df.SetCaCertificate(caCertificate)
+		file := &cardv1.DriverCardFile{}
+		file.SetTachograph(df)
+
+		chain, err := ExtractCertificateChain(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []chainSummary{
+			{Name: "CardCertificate", Generation: ddv1.Generation_GENERATION_1, CAR: g1CAR},
+			{Name: "CaCertificate", Generation: ddv1.Generation_GENERATION_1, CAR: g1CAR},
+		}
+		if diff := cmp.Diff(want, summarizeChain(chain)); diff != "" {
+			t.Errorf("ExtractCertificateChain() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("vehicle unit gen1", func(t *testing.T) {
+		overview := &vuv1.OverviewGen1{}
+		overview.SetMemberStateCertificate(g1Cert)
+		overview.SetVuCertificate(g1Cert)
+		gen1 := &vuv1.VehicleUnitFileGen1{}
+		gen1.SetOverview(overview)
+		file := &vuv1.VehicleUnitFile{}
+		file.SetGen1(gen1)
+
+		chain, err := ExtractCertificateChain(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []chainSummary{
+			{Name: "VuCertificate", Generation: ddv1.Generation_GENERATION_1, CAR: g1CAR},
+			{Name: "MemberStateCertificate", Generation: ddv1.Generation_GENERATION_1, CAR: g1CAR},
+		}
+		if diff := cmp.Diff(want, summarizeChain(chain)); diff != "" {
+			t.Errorf("ExtractCertificateChain() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("vehicle unit gen2", func(t *testing.T) {
+		// MemberStateCertificateRecordArray with one record, followed by an
+		// empty VuCertificateRecordArray.
+		var rawData []byte
+		rawData = append(rawData, 0x01, byte(len(g2Cert)>>8), byte(len(g2Cert)), 0x00, 0x01)
+		rawData = append(rawData, g2Cert...)
+		rawData = append(rawData, 0x02, byte(len(g2Cert)>>8), byte(len(g2Cert)), 0x00, 0x00)
+		overview := &vuv1.OverviewGen2V1{}
+		overview.SetRawData(rawData)
+		gen2 := &vuv1.VehicleUnitFileGen2V1{}
+		gen2.SetOverview(overview)
+		file := &vuv1.VehicleUnitFile{}
+		file.SetGen2V1(gen2)
+
+		chain, err := ExtractCertificateChain(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []chainSummary{
+			{Name: "MemberStateCertificate", Generation: ddv1.Generation_GENERATION_2, CAR: g2CAR},
+		}
+		if diff := cmp.Diff(want, summarizeChain(chain)); diff != "" {
+			t.Errorf("ExtractCertificateChain() mismatch (-want +got):\n%s", diff)
+		}
+		if got, want := chain[0].HolderReference(), "1316820541130145537"; got != want {
+			t.Errorf("HolderReference() = %q, want %q", got, want)
+		}
+
+		overview.SetRawData(rawData[:10])
+		if _, err := ExtractCertificateChain(file); err == nil {
+			t.Error("ExtractCertificateChain() with truncated overview succeeded, want error")
+		}
+	})
+
+	t.Run("unsupported message", func(t *testing.T) {
+		if _, err := ExtractCertificateChain(&vuv1.OverviewGen1{}); err == nil {
+			t.Error("ExtractCertificateChain() succeeded, want error")
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+)
+
+// maxChainLength is the maximum number of certificates walked from a
+// certificate up to the root, guarding against cycles of CAR references.
+const maxChainLength = 8
+
+// RsaChainResolver resolves the certificates of a Generation 1 chain.
+type RsaChainResolver interface {
+	// GetRootCertificate retrieves the European Root CA certificate.
+	GetRootCertificate(ctx context.Context) (*securityv1.RootCertificate, error)
+
+	// GetRsaCertificate retrieves an RSA certificate by its CHR.
+	GetRsaCertificate(ctx context.Context, chr string) (*securityv1.RsaCertificate, error)
+}
+
+// EccChainResolver resolves the certificates of a Generation 2 chain.
+type EccChainResolver interface {
+	// GetEccRootCertificate retrieves the Gen2 European Root CA certificate.
+	GetEccRootCertificate(ctx context.Context) (*securityv1.EccCertificate, error)
+
+	// GetEccCertificate retrieves an ECC certificate by its CHR.
+	GetEccCertificate(ctx context.Context, chr string) (*securityv1.EccCertificate, error)
+}
+
+// VerifyRsaCertificateChain verifies an RSA certificate by walking its
+// Certificate Authority Reference (CAR) up to the European root.
+//
+// The issuer of each certificate is looked up among intermediates, such as
+// the CA certificate of a card, and then with the resolver. Since the CHR of
+// an RSA certificate is only known once it has been verified, intermediates
+// that have not been verified yet are tried last; a wrong candidate is
+// reported as a CAR mismatch when the chain is verified.
+//
+// The certificates are verified from the root down, populating their public
+// keys and CHRs. The verified chain is returned starting with cert and ending
+// with the certificate signed by the root.
+//
+// See Appendix 11, Section 3.3 for the certificate verification.
+func VerifyRsaCertificateChain(ctx context.Context, cert *securityv1.RsaCertificate, intermediates []*securityv1.RsaCertificate, resolver RsaChainResolver) ([]*securityv1.RsaCertificate, error) {
+	if cert == nil {
+		return nil, fmt.Errorf("certificate cannot be nil")
+	}
+	root, err := resolver.GetRootCertificate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root certificate: %w", err)
+	}
+	chain := []*securityv1.RsaCertificate{cert}
+	for {
+		current := chain[len(chain)-1]
+		car := current.GetCertificateAuthorityReference()
+		if car == root.GetKeyId() {
+			break
+		}
+		if len(chain) == maxChainLength {
+			return nil, fmt.Errorf("certificate chain exceeds %d certificates at CAR %s", maxChainLength, car)
+		}
+		issuer, err := findRsaIssuer(ctx, car, chain, intermediates, resolver)
+		if err != nil {
+			return nil, fmt.Errorf("certificate chain broken at CAR %s: %w", car, err)
+		}
+		chain = append(chain, issuer)
+	}
+	top := chain[len(chain)-1]
+	if err := VerifyRsaCertificateWithRoot(top, root); err != nil {
+		return nil, fmt.Errorf("certificate with CAR %s: verification against root failed: %w", top.GetCertificateAuthorityReference(), err)
+	}
+	for i := len(chain) - 2; i >= 0; i-- {
+		if err := VerifyRsaCertificateWithCA(chain[i], chain[i+1]); err != nil {
+			return nil, fmt.Errorf("certificate with CAR %s: verification failed: %w", chain[i].GetCertificateAuthorityReference(), err)
+		}
+	}
+	return chain, nil
+}
+
+// findRsaIssuer returns the certificate with the given CHR that is not
+// already part of chain.
+func findRsaIssuer(ctx context.Context, chr string, chain, intermediates []*securityv1.RsaCertificate, resolver RsaChainResolver) (*securityv1.RsaCertificate, error) {
+	for _, candidate := range intermediates {
+		if candidate.GetCertificateHolderReference() == chr && !slices.Contains(chain, candidate) {
+			return candidate, nil
+		}
+	}
+	issuer, err := resolver.GetRsaCertificate(ctx, chr)
+	if err == nil {
+		return issuer, nil
+	}
+	for _, candidate := range intermediates {
+		if !candidate.HasCertificateHolderReference() && !slices.Contains(chain, candidate) {
+			return candidate, nil
+		}
+	}
+	return nil, fmt.Errorf("issuer not found: %w", err)
+}
+
+// VerifyEccCertificateChain verifies an ECC certificate by walking its
+// Certificate Authority Reference (CAR) up to the European root.
+//
+// The issuer of each certificate is looked up by CHR among intermediates,
+// such as the CA and link certificates of a card, and then with the resolver.
+// Link certificates, which are signed by a previous root and carry the key of
+// a new root, are walked like any other issuer, so that certificates issued
+// under the new root can be verified against the previous one.
+//
+// The verified chain is returned starting with cert and ending with the
+// certificate signed by the root.
+func VerifyEccCertificateChain(ctx context.Context, cert *securityv1.EccCertificate, intermediates []*securityv1.EccCertificate, resolver EccChainResolver) ([]*securityv1.EccCertificate, error) {
+	if cert == nil {
+		return nil, fmt.Errorf("certificate cannot be nil")
+	}
+	root, err := resolver.GetEccRootCertificate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Gen2 root certificate: %w", err)
+	}
+	chain := []*securityv1.EccCertificate{cert}
+	for {
+		current := chain[len(chain)-1]
+		car := current.GetCertificateAuthorityReference()
+		if car == root.GetCertificateHolderReference() {
+			if err := VerifyEccCertificateWithEccRoot(current, root); err != nil {
+				return nil, fmt.Errorf("certificate %s: verification against root %s failed: %w", current.GetCertificateHolderReference(), car, err)
+			}
+			return chain, nil
+		}
+		if car == current.GetCertificateHolderReference() {
+			return nil, fmt.Errorf("certificate chain ends at untrusted root %s", car)
+		}
+		if len(chain) == maxChainLength {
+			return nil, fmt.Errorf("certificate chain exceeds %d certificates at CAR %s", maxChainLength, car)
+		}
+		issuer, err := findEccIssuer(ctx, car, chain, intermediates, resolver)
+		if err != nil {
+			return nil, fmt.Errorf("certificate chain broken at CAR %s: %w", car, err)
+		}
+		if err := VerifyEccCertificateWithCA(current, issuer); err != nil {
+			return nil, fmt.Errorf("certificate %s: verification against %s failed: %w", current.GetCertificateHolderReference(), car, err)
+		}
+		chain = append(chain, issuer)
+	}
+}
+
+// findEccIssuer returns the certificate with the given CHR that is not
+// already part of chain.
+func findEccIssuer(ctx context.Context, chr string, chain, intermediates []*securityv1.EccCertificate, resolver EccChainResolver) (*securityv1.EccCertificate, error) {
+	for _, candidate := range intermediates {
+		if candidate.GetCertificateHolderReference() == chr && !slices.Contains(chain, candidate) {
+			return candidate, nil
+		}
+	}
+	issuer, err := resolver.GetEccCertificate(ctx, chr)
+	if err != nil {
+		return nil, fmt.Errorf("issuer not found: %w", err)
+	}
+	if slices.ContainsFunc(chain, func(c *securityv1.EccCertificate) bool {
+		return c.GetCertificateHolderReference() == chr
+	}) {
+		return nil, fmt.Errorf("certificate %s already in chain", chr)
+	}
+	return issuer, nil
+}
@@ -0,0 +1,234 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/way-platform/tachograph-go/internal/cert/certcache"
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+)
+
+func TestVerifyRsaCertificateChain(t *testing.T) {
+	const (
+		rootKeyID  = 0x0101010101010101
+		mscaCHR    = 0x0202020202020202
+		subCaCHR   = 0x0303030303030303
+		cardCHR    = 0x0404040404040404
+		unknownCAR = 0x0505050505050505
+	)
+	rootKey := generateRsaKey(t)
+	mscaKey := generateRsaKey(t)
+	subCaKey := generateRsaKey(t)
+	cardKey := generateRsaKey(t)
+
+	root := &securityv1.RootCertificate{}
+	root.SetKeyId(strconv.FormatUint(rootKeyID, 10))
+	root.SetRsaModulus(rootKey.N.Bytes())
+	root.SetRsaExponent(big.NewInt(int64(rootKey.E)).FillBytes(make([]byte, 8)))
+
+	mscaData := signRsaCertificate(rootKey, rootKeyID, mscaCHR, &mscaKey.PublicKey)
+	subCaData := signRsaCertificate(mscaKey, mscaCHR, subCaCHR, &subCaKey.PublicKey)
+	cardData := signRsaCertificate(subCaKey, subCaCHR, cardCHR, &cardKey.PublicKey)
+	orphanData := signRsaCertificate(subCaKey, unknownCAR, cardCHR, &cardKey.PublicKey)
+
+	mustUnmarshal := func(data []byte) *securityv1.RsaCertificate {
+		t.Helper()
+		cert, err := UnmarshalRsaCertificate(data)
+		if err != nil {
+			t.Fatalf("UnmarshalRsaCertificate() failed: %v", err)
+		}
+		return cert
+	}
+
+	t.Run("intermediate and resolved CA", func(t *testing.T) {
+		resolver := &testChainResolver{
+			root: root,
+			rsa:  map[string][]byte{strconv.FormatUint(mscaCHR, 10): mscaData},
+		}
+		cardCert := mustUnmarshal(cardData)
+		intermediates := []*securityv1.RsaCertificate{mustUnmarshal(subCaData)}
+		chain, err := VerifyRsaCertificateChain(context.Background(), cardCert, intermediates, resolver)
+		if err != nil {
+			t.Fatalf("VerifyRsaCertificateChain() failed: %v", err)
+		}
+		var got []string
+		for _, cert := range chain {
+			got = append(got, cert.GetCertificateHolderReference())
+		}
+		want := []string{
+			strconv.FormatUint(cardCHR, 10),
+			strconv.FormatUint(subCaCHR, 10),
+			strconv.FormatUint(mscaCHR, 10),
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("chain CHRs mismatch (-want +got):\n%s", diff)
+		}
+		if !cardCert.GetSignatureValid() {
+			t.Error("card certificate signature_valid = false, want true")
+		}
+	})
+
+	t.Run("missing CA", func(t *testing.T) {
+		resolver := &testChainResolver{root: root}
+		_, err := VerifyRsaCertificateChain(context.Background(), mustUnmarshal(orphanData), nil, resolver)
+		if err == nil {
+			t.Fatal("VerifyRsaCertificateChain() succeeded, want error")
+		}
+		wantCAR := strconv.FormatUint(unknownCAR, 10)
+		if got := err.Error(); !strings.Contains(got, wantCAR) {
+			t.Errorf("error %q does not name the CAR %s", got, wantCAR)
+		}
+	})
+
+	t.Run("wrong intermediate", func(t *testing.T) {
+		resolver := &testChainResolver{
+			root: root,
+			rsa:  map[string][]byte{strconv.FormatUint(mscaCHR, 10): mscaData},
+		}
+		// The MSCA certificate is offered as the issuer of the card certificate.
+		intermediates := []*securityv1.RsaCertificate{mustUnmarshal(mscaData)}
+		if _, err := VerifyRsaCertificateChain(context.Background(), mustUnmarshal(cardData), intermediates, resolver); err == nil {
+			t.Fatal("VerifyRsaCertificateChain() succeeded, want error")
+		}
+	})
+
+	t.Run("embedded root", func(t *testing.T) {
+		embeddedRoot, err := UnmarshalRootCertificate(certcache.Root())
+		if err != nil {
+			t.Fatalf("UnmarshalRootCertificate() failed: %v", err)
+		}
+		data, err := os.ReadFile("testdata/certs/g1/finland_tcc37.bin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain, err := VerifyRsaCertificateChain(context.Background(), mustUnmarshal(data), nil, &testChainResolver{root: embeddedRoot})
+		if err != nil {
+			t.Fatalf("VerifyRsaCertificateChain() failed: %v", err)
+		}
+		if len(chain) != 1 {
+			t.Errorf("len(chain) = %d, want 1", len(chain))
+		}
+	})
+}
+
+func TestVerifyEccCertificateChain(t *testing.T) {
+	const rootCHR = "18250066869740371713"
+	rootData := certcache.RootG2()
+	mscaData, err := os.ReadFile("testdata/certs/g2/finland_msca_card42.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := UnmarshalEccCertificate(rootData)
+	if err != nil {
+		t.Fatalf("UnmarshalEccCertificate() failed: %v", err)
+	}
+
+	t.Run("root", func(t *testing.T) {
+		msca, err := UnmarshalEccCertificate(mscaData)
+		if err != nil {
+			t.Fatalf("UnmarshalEccCertificate() failed: %v", err)
+		}
+		chain, err := VerifyEccCertificateChain(context.Background(), msca, nil, &testChainResolver{eccRoot: root})
+		if err != nil {
+			t.Fatalf("VerifyEccCertificateChain() failed: %v", err)
+		}
+		if len(chain) != 1 || chain[0] != msca {
+			t.Errorf("chain = %v, want [msca]", chain)
+		}
+	})
+
+	t.Run("untrusted root", func(t *testing.T) {
+		// A root certificate whose CHR differs from the MSCA's CAR, standing in
+		// for a root the MSCA cannot be linked to.
+		otherRoot, err := UnmarshalEccCertificate(rootData)
+		if err != nil {
+			t.Fatalf("UnmarshalEccCertificate() failed: %v", err)
+		}
+		otherRoot.SetCertificateHolderReference("1")
+		msca, err := UnmarshalEccCertificate(mscaData)
+		if err != nil {
+			t.Fatalf("UnmarshalEccCertificate() failed: %v", err)
+		}
+		// The resolver only knows the self-signed root, which is not trusted.
+		resolver := &testChainResolver{eccRoot: otherRoot, ecc: map[string][]byte{rootCHR: rootData}}
+		if _, err := VerifyEccCertificateChain(context.Background(), msca, nil, resolver); err == nil {
+			t.Fatal("VerifyEccCertificateChain() succeeded, want error")
+		}
+	})
+}
+
+// testChainResolver resolves certificates from fixed maps keyed by CHR.
+type testChainResolver struct {
+	root    *securityv1.RootCertificate
+	eccRoot *securityv1.EccCertificate
+	rsa     map[string][]byte
+	ecc     map[string][]byte
+}
+
+func (r *testChainResolver) GetRootCertificate(context.Context) (*securityv1.RootCertificate, error) {
+	return r.root, nil
+}
+
+func (r *testChainResolver) GetEccRootCertificate(context.Context) (*securityv1.EccCertificate, error) {
+	return r.eccRoot, nil
+}
+
+func (r *testChainResolver) GetRsaCertificate(_ context.Context, chr string) (*securityv1.RsaCertificate, error) {
+	data, ok := r.rsa[chr]
+	if !ok {
+		return nil, fmt.Errorf("certificate not found: CHR %s", chr)
+	}
+	return UnmarshalRsaCertificate(data)
+}
+
+func (r *testChainResolver) GetEccCertificate(_ context.Context, chr string) (*securityv1.EccCertificate, error) {
+	data, ok := r.ecc[chr]
+	if !ok {
+		return nil, fmt.Errorf("certificate not found: CHR %s", chr)
+	}
+	return UnmarshalEccCertificate(data)
+}
+
+func generateRsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	return key
+}
+
+// signRsaCertificate issues a 194-byte Gen1 certificate with ISO/IEC 9796-2
+// message recovery, as specified in Appendix 11, Section 3.3.
+func signRsaCertificate(caKey *rsa.PrivateKey, car, chr uint64, pub *rsa.PublicKey) []byte {
+	// C = CPI || CAR || CHA || EOV || CHR || n || e
+	content := []byte{0x01}
+	content = binary.BigEndian.AppendUint64(content, car)
+	content = append(content, make([]byte, 7)...)     // CHA
+	content = append(content, 0xFF, 0xFF, 0xFF, 0xFF) // EOV
+	content = binary.BigEndian.AppendUint64(content, chr)
+	content = append(content, pub.N.FillBytes(make([]byte, 128))...)
+	content = append(content, big.NewInt(int64(pub.E)).FillBytes(make([]byte, 8))...)
+
+	hash := sha1.Sum(content)
+	message := []byte{0x6A}
+	message = append(message, content[:106]...)
+	message = append(message, hash[:]...)
+	message = append(message, 0xBC)
+	sr := new(big.Int).Exp(new(big.Int).SetBytes(message), caKey.D, caKey.N)
+
+	certificate := sr.FillBytes(make([]byte, 128))
+	certificate = append(certificate, content[106:]...)
+	return binary.BigEndian.AppendUint64(certificate, car)
+}
@@ -92,4 +92,3 @@ func VerifyEccDataSignature(data, signature []byte, cardCert *securityv1.EccCert
 
 	return nil
 }
-
@@ -3,6 +3,7 @@ package vu
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
@@ -49,22 +50,18 @@ func sizeOfActivitiesGen1(data []byte) (totalSize, signatureSize int, err error)
 	offset += 3 // OdometerValueMidnight
 
 	// VuCardIWData: 2 bytes count + variable records
-	if len(data[offset:]) < 2 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfIWRecords")
+	if len(data) < offset+2 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfIWRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfIWRecords := binary.BigEndian.Uint16(data[offset:])
 	offset += 2
 
 	// Each VuCardIWRecordFirstGen: 129 bytes
-	// (cardHolderName 72, fullCardNumber 18, cardExpiryDate 4, cardInsertionTime 4,
-	//  vehicleOdometerValueAtInsertion 3, cardSlotNumber 1, cardWithdrawalTime 4,
-	//  vehicleOdometerValueAtWithdrawal 3, previousVehicleInfo 19, manualInputFlag 1)
-	const vuCardIWRecordSize = 129
-	offset += int(noOfIWRecords) * vuCardIWRecordSize
+	offset += int(noOfIWRecords) * lenVuCardIWRecord
 
 	// VuActivityDailyData: 2 bytes count + variable activity changes
-	if len(data[offset:]) < 2 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfActivityChanges")
+	if len(data) < offset+2 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfActivityChanges: %w", io.ErrUnexpectedEOF)
 	}
 	noOfActivityChanges := binary.BigEndian.Uint16(data[offset:])
 	offset += 2
@@ -74,8 +71,8 @@ func sizeOfActivitiesGen1(data []byte) (totalSize, signatureSize int, err error)
 	offset += int(noOfActivityChanges) * activityChangeInfoSize
 
 	// VuPlaceDailyWorkPeriodData: 1 byte count + variable place records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfPlaceRecords")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfPlaceRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfPlaceRecords := data[offset]
 	offset += 1
@@ -85,8 +82,8 @@ func sizeOfActivitiesGen1(data []byte) (totalSize, signatureSize int, err error)
 	offset += int(noOfPlaceRecords) * vuPlaceDailyWorkPeriodRecordSize
 
 	// VuSpecificConditionData: 2 bytes count + variable condition records
-	if len(data[offset:]) < 2 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfSpecificConditionRecords")
+	if len(data) < offset+2 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfSpecificConditionRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfSpecificConditionRecords := binary.BigEndian.Uint16(data[offset:])
 	offset += 2
@@ -102,145 +99,43 @@ func sizeOfActivitiesGen1(data []byte) (totalSize, signatureSize int, err error)
 	return offset, gen1SignatureSize, nil
 }
 
+// activitiesGen2V1RecordArrays names the RecordArrays of Gen2 V1 Activities preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.3 (TREP 22 Hex).
+var activitiesGen2V1RecordArrays = []string{
+	"DateOfDayDownloadedRecordArray",
+	"OdometerValueMidnightRecordArray",
+	"VuCardIWRecordArray",
+	"VuActivityDailyRecordArray",
+	"VuPlaceDailyWorkPeriodRecordArray",
+	"VuGNSSADRecordArray",
+	"VuSpecificConditionRecordArray",
+}
+
 // sizeOfActivitiesGen2V1 calculates size by parsing all Gen2 V1 RecordArrays.
 func sizeOfActivitiesGen2V1(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// DateOfDayDownloadedRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("DateOfDayDownloadedRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// OdometerValueMidnightRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("OdometerValueMidnightRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuCardIWRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuCardIWRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuActivityDailyRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuActivityDailyRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuPlaceDailyWorkPeriodRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuPlaceDailyWorkPeriodRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuSpecificConditionRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuSpecificConditionRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuGNSSADRecordArray (Gen2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuGNSSADRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
-	}
-	signatureSizeGen2 := size
-	offset += size
+	return sizeOfRecordArrays(data, activitiesGen2V1RecordArrays)
+}
 
-	return offset, signatureSizeGen2, nil
+// activitiesGen2V2RecordArrays names the RecordArrays of Gen2 V2 Activities preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.3 (TREP 32 Hex).
+var activitiesGen2V2RecordArrays = []string{
+	"DateOfDayDownloadedRecordArray",
+	"OdometerValueMidnightRecordArray",
+	"VuCardIWRecordArray",
+	"VuActivityDailyRecordArray",
+	"VuPlaceDailyWorkPeriodRecordArray",
+	"VuGNSSADRecordArray",
+	"VuSpecificConditionRecordArray",
+	"VuBorderCrossingRecordArray",
+	"VuLoadUnloadRecordArray",
 }
 
 // sizeOfActivitiesGen2V2 calculates size by parsing all Gen2 V2 RecordArrays.
 // Must handle VuBorderCrossingRecordArray and VuLoadUnloadRecordArray.
 func sizeOfActivitiesGen2V2(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// DateOfDayDownloadedRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("DateOfDayDownloadedRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// OdometerValueMidnightRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("OdometerValueMidnightRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuCardIWRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuCardIWRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuActivityDailyRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuActivityDailyRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuPlaceDailyWorkPeriodRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuPlaceDailyWorkPeriodRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuSpecificConditionRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuSpecificConditionRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuGNSSADRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuGNSSADRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuBorderCrossingRecordArray (Gen2 V2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuBorderCrossingRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuLoadUnloadRecordArray (Gen2 V2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuLoadUnloadRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
-	}
-	signatureSizeGen2 := size
-	offset += size
-
-	return offset, signatureSizeGen2, nil
+	return sizeOfRecordArrays(data, activitiesGen2V2RecordArrays)
 }
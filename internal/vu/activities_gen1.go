@@ -3,6 +3,7 @@ package vu
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -10,6 +11,13 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// lenVuCardIWRecord is the size of a Gen1 VuCardIWRecord: cardHolderName 72,
+// fullCardNumber 18, cardExpiryDate 4, cardInsertionTime 4,
+// vehicleOdometerValueAtInsertion 3, cardSlotNumber 1, cardWithdrawalTime 4,
+// vehicleOdometerValueAtWithdrawal 3, previousVehicleInfo 19 and
+// manualInputFlag 1 bytes.
+const lenVuCardIWRecord = 129
+
 // unmarshalActivitiesGen1 parses Gen1 Activities data from the complete transfer value.
 //
 // This function accepts the complete transfer value including the signature appended
@@ -34,10 +42,16 @@ import (
 // - OdometerValueMidnight: 3 bytes (OdometerShort)
 // - VuCardIWData: 2 bytes (noOfIWRecords) + (noOfIWRecords * 129 bytes)
 //   - Each VuCardIWRecordFirstGen: 129 bytes
+//   - CardHolderName: 72 bytes
 //   - FullCardNumber: 18 bytes
-//   - ManufacturerCode: 1 byte
-//   - DownloadTime: 4 bytes
-//   - ... (rest of record)
+//   - CardExpiryDate: 4 bytes
+//   - CardInsertionTime: 4 bytes
+//   - VehicleOdometerValueAtInsertion: 3 bytes
+//   - CardSlotNumber: 1 byte
+//   - CardWithdrawalTime: 4 bytes
+//   - VehicleOdometerValueAtWithdrawal: 3 bytes
+//   - PreviousVehicleInfo: 19 bytes
+//   - ManualInputFlag: 1 byte
 //
 // - VuActivityDailyData: 2 bytes (noOfActivityChanges) + (noOfActivityChanges * 2 bytes)
 //   - Each ActivityChangeInfo: 2 bytes
@@ -57,11 +71,17 @@ import (
 // Note: This is a minimal implementation that validates the binary structure and stores raw_data.
 // Full semantic parsing of all nested records is TODO.
 func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
+	return parseActivitiesGen1(dd.UnmarshalOptions{PreserveRawData: true}, value)
+}
+
+// parseActivitiesGen1 is like unmarshalActivitiesGen1, but parses the records of the
+// transfer with opts.
+func parseActivitiesGen1(opts dd.UnmarshalOptions, value []byte) (*vuv1.ActivitiesGen1, error) {
 	// Split transfer value into data and signature
 	// Gen1 uses fixed 128-byte RSA-1024 signatures
 	const signatureSize = 128
 	if len(value) < signatureSize {
-		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d", signatureSize, len(value))
+		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d: %w", signatureSize, len(value), io.ErrUnexpectedEOF)
 	}
 
 	dataSize := len(value) - signatureSize
@@ -72,11 +92,10 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 	activities.SetRawData(value) // Store complete transfer value for painting
 
 	offset := 0
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	// TimeReal (4 bytes) - date of day downloaded
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for TimeReal")
+		return nil, fmt.Errorf("insufficient data for TimeReal: %w", io.ErrUnexpectedEOF)
 	}
 	timeReal, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -87,7 +106,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 
 	// OdometerValueMidnight (3 bytes - OdometerShort)
 	if offset+3 > len(data) {
-		return nil, fmt.Errorf("insufficient data for OdometerValueMidnight")
+		return nil, fmt.Errorf("insufficient data for OdometerValueMidnight: %w", io.ErrUnexpectedEOF)
 	}
 	odometer, err := opts.UnmarshalOdometer(data[offset : offset+3])
 	if err != nil {
@@ -98,7 +117,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 
 	// VuCardIWData: 2 bytes (noOfIWRecords) + (noOfIWRecords * 129 bytes)
 	if offset+2 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfIWRecords")
+		return nil, fmt.Errorf("insufficient data for noOfIWRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfIWRecords := binary.BigEndian.Uint16(data[offset : offset+2])
 	offset += 2
@@ -106,24 +125,23 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 	// Parse each CardIWRecord (129 bytes each for Gen1)
 	cardIWRecords := make([]*ddv1.VuCardIWRecord, noOfIWRecords)
 	for i := uint16(0); i < noOfIWRecords; i++ {
-		const cardIWRecordSize = 129
-		if offset+cardIWRecordSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for CardIWRecord %d", i)
+		if offset+lenVuCardIWRecord > len(data) {
+			return nil, fmt.Errorf("insufficient data for CardIWRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuCardIWRecord(data[offset : offset+cardIWRecordSize])
+		record, err := opts.UnmarshalVuCardIWRecord(data[offset : offset+lenVuCardIWRecord])
 		if err != nil {
 			return nil, fmt.Errorf("unmarshal CardIWRecord %d: %w", i, err)
 		}
 
 		cardIWRecords[i] = record
-		offset += cardIWRecordSize
+		offset += lenVuCardIWRecord
 	}
 	activities.SetCardIwData(cardIWRecords)
 
 	// VuActivityDailyData: 2 bytes (noOfActivityChanges) + (noOfActivityChanges * 2 bytes)
 	if offset+2 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfActivityChanges")
+		return nil, fmt.Errorf("insufficient data for noOfActivityChanges: %w", io.ErrUnexpectedEOF)
 	}
 	noOfActivityChanges := binary.BigEndian.Uint16(data[offset : offset+2])
 	offset += 2
@@ -133,7 +151,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 	for i := uint16(0); i < noOfActivityChanges; i++ {
 		const activityChangeSize = 2
 		if offset+activityChangeSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for ActivityChangeInfo %d", i)
+			return nil, fmt.Errorf("insufficient data for ActivityChangeInfo %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
 		activityChange, err := opts.UnmarshalActivityChangeInfo(data[offset : offset+activityChangeSize])
@@ -147,7 +165,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 
 	// VuPlaceDailyWorkPeriodData: 1 byte (noOfPlaceRecords) + (noOfPlaceRecords * 28 bytes)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfPlaceRecords")
+		return nil, fmt.Errorf("insufficient data for noOfPlaceRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfPlaceRecords := data[offset]
 	offset += 1
@@ -157,7 +175,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 	for i := uint8(0); i < noOfPlaceRecords; i++ {
 		const placeRecordSize = 28 // 18 bytes FullCardNumber + 10 bytes PlaceRecord
 		if offset+placeRecordSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for VuPlaceDailyWorkPeriodRecord %d", i)
+			return nil, fmt.Errorf("insufficient data for VuPlaceDailyWorkPeriodRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
 		vuPlaceRecord, err := opts.UnmarshalVuPlaceDailyWorkPeriodRecord(data[offset : offset+placeRecordSize])
@@ -172,7 +190,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 
 	// VuSpecificConditionData: 2 bytes (noOfSpecificConditionRecords) + (noOfSpecificConditionRecords * 5 bytes)
 	if offset+2 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfSpecificConditionRecords")
+		return nil, fmt.Errorf("insufficient data for noOfSpecificConditionRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfSpecificConditionRecords := binary.BigEndian.Uint16(data[offset : offset+2])
 	offset += 2
@@ -182,7 +200,7 @@ func unmarshalActivitiesGen1(value []byte) (*vuv1.ActivitiesGen1, error) {
 	for i := uint16(0); i < noOfSpecificConditionRecords; i++ {
 		const specificConditionSize = 5
 		if offset+specificConditionSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for SpecificConditionRecord %d", i)
+			return nil, fmt.Errorf("insufficient data for SpecificConditionRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
 		specificCondition, err := opts.UnmarshalSpecificConditionRecord(data[offset : offset+specificConditionSize])
@@ -221,13 +239,7 @@ func (opts MarshalOptions) MarshalActivitiesGen1(activities *vuv1.ActivitiesGen1
 	noOfPlaceRecords := len(activities.GetPlaceRecords())
 	noOfSpecificConditions := len(activities.GetSpecificConditions())
 
-	// Fixed header: 4 (TimeReal) + 3 (OdometerShort)
-	// VuCardIWData: 2 (count) + N*129 (records)
-	// VuActivityDailyData: 2 (count) + M*2 (records)
-	// VuPlaceDailyWorkPeriodData: 1 (count) + P*28 (records)
-	// VuSpecificConditionData: 2 (count) + Q*5 (records)
-	const headerSize = 4 + 3
-	dataSize := headerSize + 2 + (noOfIWRecords * 129) + 2 + (noOfActivityChanges * 2) + 1 + (noOfPlaceRecords * 28) + 2 + (noOfSpecificConditions * 5)
+	dataSize := activitiesGen1DataSize(activities)
 
 	// Use raw_data as canvas if available
 	var canvas []byte
@@ -272,11 +284,11 @@ func (opts MarshalOptions) MarshalActivitiesGen1(activities *vuv1.ActivitiesGen1
 		if err != nil {
 			return nil, fmt.Errorf("marshal CardIWRecord %d: %w", i, err)
 		}
-		if len(recordBytes) != 129 {
-			return nil, fmt.Errorf("CardIWRecord %d has invalid length: got %d, want 129", i, len(recordBytes))
+		if len(recordBytes) != lenVuCardIWRecord {
+			return nil, fmt.Errorf("CardIWRecord %d has invalid length: got %d, want %d", i, len(recordBytes), lenVuCardIWRecord)
 		}
-		copy(canvas[offset:offset+129], recordBytes)
-		offset += 129
+		copy(canvas[offset:offset+lenVuCardIWRecord], recordBytes)
+		offset += lenVuCardIWRecord
 	}
 
 	// VuActivityDailyData: 2 bytes (count) + records
@@ -347,6 +359,22 @@ func (opts MarshalOptions) MarshalActivitiesGen1(activities *vuv1.ActivitiesGen1
 	return transferValue, nil
 }
 
+// activitiesGen1DataSize returns the length of the data of a Gen1 Activities
+// transfer, without its signature.
+func activitiesGen1DataSize(activities *vuv1.ActivitiesGen1) int {
+	// Fixed header: 4 (TimeReal) + 3 (OdometerShort)
+	// VuCardIWData: 2 (count) + N*129 (records)
+	// VuActivityDailyData: 2 (count) + M*2 (records)
+	// VuPlaceDailyWorkPeriodData: 1 (count) + P*28 (records)
+	// VuSpecificConditionData: 2 (count) + Q*5 (records)
+	const headerSize = 4 + 3
+	return headerSize +
+		2 + (len(activities.GetCardIwData()) * lenVuCardIWRecord) +
+		2 + (len(activities.GetActivityChanges()) * 2) +
+		1 + (len(activities.GetPlaceRecords()) * 28) +
+		2 + (len(activities.GetSpecificConditions()) * 5)
+}
+
 // anonymizeActivitiesGen1 anonymizes Gen1 Activities data.
 // TODO: Implement full semantic anonymization (anonymize card numbers, timestamps, etc.).
 func (opts AnonymizeOptions) anonymizeActivitiesGen1(activities *vuv1.ActivitiesGen1) *vuv1.ActivitiesGen1 {
@@ -356,10 +384,7 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen1(activities *vuv1.Activities
 	result := proto.Clone(activities).(*vuv1.ActivitiesGen1)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize card IW data (cards inserted/withdrawn)
 	var anonymizedCardIWRecords []*ddv1.VuCardIWRecord
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
@@ -32,6 +35,16 @@ func TestActivities_Gen1(t *testing.T) {
 				t.Fatalf("Failed to read hexdump: %v", err)
 			}
 
+			// The size used to split the transfer from a VU file must agree
+			// with the size consumed when parsing it.
+			size, signatureSize, err := sizeOfActivitiesGen1(data)
+			if err != nil {
+				t.Fatalf("sizeOfActivitiesGen1 failed: %v", err)
+			}
+			if size != len(data) || signatureSize != 128 {
+				t.Errorf("sizeOfActivitiesGen1() = %d, %d, want %d, 128", size, signatureSize, len(data))
+			}
+
 			// Unmarshal
 			activities, err := unmarshalActivitiesGen1(data)
 			if err != nil {
@@ -58,3 +71,50 @@ func TestActivities_Gen1(t *testing.T) {
 		})
 	}
 }
+
+func TestActivities_Gen1_cardIWRecords(t *testing.T) {
+	hexdumpFiles, err := findHexdumpFiles(vuv1.TransferType_ACTIVITIES_GEN1)
+	if err != nil {
+		t.Fatalf("Failed to discover hexdump files: %v", err)
+	}
+	if len(hexdumpFiles) == 0 {
+		t.Skip("No hexdump files found for ACTIVITIES_GEN1")
+	}
+
+	for _, hexdumpPath := range hexdumpFiles {
+		relPath := strings.TrimPrefix(hexdumpPath, "testdata/records/")
+		t.Run(strings.TrimSuffix(relPath, ".hexdump"), func(t *testing.T) {
+			data, err := readHexdump(hexdumpPath)
+			if err != nil {
+				t.Fatalf("Failed to read hexdump: %v", err)
+			}
+			activities, err := unmarshalActivitiesGen1(data)
+			if err != nil {
+				t.Fatalf("Unmarshal failed: %v", err)
+			}
+
+			// The VuCardIWRecords (129 bytes each) follow timeReal (4 bytes),
+			// odometerValueMidnight (3 bytes) and noOfIWRecords (2 bytes).
+			const idxFirstRecord = 4 + 3 + 2
+			for i, record := range activities.GetCardIwData() {
+				idx := idxFirstRecord + i*lenVuCardIWRecord
+				want := data[idx : idx+lenVuCardIWRecord]
+				if got := record.GetManualInputFlag(); got != (want[lenVuCardIWRecord-1] != 0) {
+					t.Errorf("record %d: manualInputFlag = %v, want last byte of record (%#x)", i, got, want[lenVuCardIWRecord-1])
+				}
+
+				// Marshal the record from its fields alone, so that the field
+				// offsets are checked against the downloaded bytes.
+				record = proto.Clone(record).(*ddv1.VuCardIWRecord)
+				record.ClearRawData()
+				got, err := dd.MarshalOptions{}.MarshalVuCardIWRecord(record)
+				if err != nil {
+					t.Fatalf("record %d: MarshalVuCardIWRecord failed: %v", i, err)
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("record %d: round-trip mismatch (-want +got):\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
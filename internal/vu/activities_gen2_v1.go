@@ -3,6 +3,7 @@ package vu
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
@@ -35,6 +36,13 @@ import (
 //
 //	recordType (1 byte) + recordSize (2 bytes, big-endian) + noOfRecords (2 bytes, big-endian)
 func unmarshalActivitiesGen2V1(value []byte) (*vuv1.ActivitiesGen2V1, error) {
+	return parseActivitiesGen2V1(dd.UnmarshalOptions{PreserveRawData: true}, value, nil)
+}
+
+// parseActivitiesGen2V1 is like unmarshalActivitiesGen2V1, but parses the
+// records of the transfer with opts, and checks the record sizes declared by
+// the RecordArray headers with sizes.
+func parseActivitiesGen2V1(opts dd.UnmarshalOptions, value []byte, sizes *recordSizes) (*vuv1.ActivitiesGen2V1, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -49,6 +57,9 @@ func unmarshalActivitiesGen2V1(value []byte) (*vuv1.ActivitiesGen2V1, error) {
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	activities := &vuv1.ActivitiesGen2V1{}
 	activities.SetRawData(value) // Store complete transfer value for painting
@@ -71,8 +82,8 @@ func unmarshalActivitiesGen2V1(value []byte) (*vuv1.ActivitiesGen2V1, error) {
 	activities.SetOdometerMidnightKm(odometerMidnightKm)
 	offset += bytesRead
 
-	// VuCardIWRecordArray (Gen2 - 132 bytes per record)
-	cardIWRecords, bytesRead, err := parseVuCardIWRecordArrayG2(data, offset)
+	// VuCardIWRecordArray (Gen2 - 131 bytes per record)
+	cardIWRecords, bytesRead, err := parseVuCardIWRecordArrayG2(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuCardIWRecordArray: %w", err)
 	}
@@ -80,15 +91,15 @@ func unmarshalActivitiesGen2V1(value []byte) (*vuv1.ActivitiesGen2V1, error) {
 	offset += bytesRead
 
 	// VuActivityDailyRecordArray
-	activityChanges, bytesRead, err := parseVuActivityDailyRecordArray(data, offset)
+	activityChanges, bytesRead, err := parseVuActivityDailyRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuActivityDailyRecordArray: %w", err)
 	}
 	activities.SetActivityChanges(activityChanges)
 	offset += bytesRead
 
-	// VuPlaceDailyWorkPeriodRecordArray (Gen2v1 - 41 bytes per record)
-	vuPlaceRecords, bytesRead, err := parseVuPlaceDailyWorkPeriodRecordArrayG2(data, offset)
+	// VuPlaceDailyWorkPeriodRecordArray (Gen2v1 - 40 bytes per record)
+	vuPlaceRecords, bytesRead, err := parseVuPlaceDailyWorkPeriodRecordArrayG2(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuPlaceDailyWorkPeriodRecordArray: %w", err)
 	}
@@ -100,8 +111,8 @@ func unmarshalActivitiesGen2V1(value []byte) (*vuv1.ActivitiesGen2V1, error) {
 	activities.SetPlaces(placeRecords)
 	offset += bytesRead
 
-	// VuGNSSADRecordArray (Gen2v1 - 58 bytes per record)
-	gnssADRecords, bytesRead, err := parseVuGNSSADRecordArray(data, offset)
+	// VuGNSSADRecordArray (Gen2v1 - 56 bytes per record)
+	gnssADRecords, bytesRead, err := parseVuGNSSADRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuGNSSADRecordArray: %w", err)
 	}
@@ -109,7 +120,7 @@ func unmarshalActivitiesGen2V1(value []byte) (*vuv1.ActivitiesGen2V1, error) {
 	offset += bytesRead
 
 	// VuSpecificConditionRecordArray
-	specificConditions, bytesRead, err := parseVuSpecificConditionRecordArray(data, offset)
+	specificConditions, bytesRead, err := parseVuSpecificConditionRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuSpecificConditionRecordArray: %w", err)
 	}
@@ -138,8 +149,13 @@ func (opts MarshalOptions) MarshalActivitiesGen2V1(activities *vuv1.ActivitiesGe
 
 	// For Gen2 structures with RecordArrays, raw data painting is straightforward
 	raw := activities.GetRawData()
-	if len(raw) > 0 {
+	if len(raw) > 0 && opts.UseRawData {
 		// raw_data contains complete transfer value (data + signature)
+		if opts.VerifyPaint {
+			if err := verifyPaint(activities, raw, unmarshalActivitiesGen2V1); err != nil {
+				return nil, err
+			}
+		}
 		return raw, nil
 	}
 
@@ -163,12 +179,12 @@ func (opts MarshalOptions) MarshalActivitiesGen2V1(activities *vuv1.ActivitiesGe
 	result = appendRecordArrayHeader(result, 0x02, 3, 1)
 	result = append(result, odometerData...)
 
-	// VuCardIWRecordArray (Gen2 - 132 bytes per record)
+	// VuCardIWRecordArray (Gen2 - 131 bytes per record)
 	cardIWData, err := marshalCardIWRecordsG2(activities.GetCardIwData())
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuCardIWRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x03, 132, uint16(len(activities.GetCardIwData())))
+	result = appendRecordArrayHeader(result, 0x03, 131, uint16(len(activities.GetCardIwData())))
 	result = append(result, cardIWData...)
 
 	// VuActivityDailyRecordArray (2 bytes per record)
@@ -179,20 +195,20 @@ func (opts MarshalOptions) MarshalActivitiesGen2V1(activities *vuv1.ActivitiesGe
 	result = appendRecordArrayHeader(result, 0x04, 2, uint16(len(activities.GetActivityChanges())))
 	result = append(result, activityData...)
 
-	// VuPlaceDailyWorkPeriodRecordArray (Gen2v1 - 41 bytes per record)
-	placeData, err := marshalPlaceRecordsG2V1(activities.GetPlaces())
+	// VuPlaceDailyWorkPeriodRecordArray (Gen2v1 - 40 bytes per record)
+	placeData, err := marshalPlaceRecordsG2V1(activities.GetPlaces(), recordArrayRecords(raw, 4))
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuPlaceDailyWorkPeriodRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x05, 41, uint16(len(activities.GetPlaces())))
+	result = appendRecordArrayHeader(result, 0x05, 40, uint16(len(activities.GetPlaces())))
 	result = append(result, placeData...)
 
-	// VuGNSSADRecordArray (Gen2v1 - 58 bytes per record)
+	// VuGNSSADRecordArray (Gen2v1 - 56 bytes per record)
 	gnssData, err := marshalGnssAccumulatedDrivingRecordsV1(activities.GetGnssAccumulatedDriving())
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuGNSSADRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x06, 58, uint16(len(activities.GetGnssAccumulatedDriving())))
+	result = appendRecordArrayHeader(result, 0x06, 56, uint16(len(activities.GetGnssAccumulatedDriving())))
 	result = append(result, gnssData...)
 
 	// VuSpecificConditionRecordArray (5 bytes per record)
@@ -207,26 +223,114 @@ func (opts MarshalOptions) MarshalActivitiesGen2V1(activities *vuv1.ActivitiesGe
 	// Gen2 uses variable-length ECDSA signatures
 	result = append(result, activities.GetSignature()...)
 
+	if opts.VerifyPaint && len(raw) > 0 {
+		if err := verifyPaint(activities, result, unmarshalActivitiesGen2V1); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
+// activitiesGen2V1Size returns the length of a Gen2 V1 Activities transfer
+// encoded from its semantic fields, including its signature.
+func activitiesGen2V1Size(activities *vuv1.ActivitiesGen2V1) int {
+	// Each record array has a 5-byte header: recordType (1) + recordSize (2) +
+	// noOfRecords (2)
+	const lenHeader = 5
+	return lenHeader + 4 + // TimeRealRecordArray
+		lenHeader + 3 + // OdometerValueMidnightRecordArray
+		lenHeader + len(activities.GetCardIwData())*131 +
+		lenHeader + len(activities.GetActivityChanges())*2 +
+		lenHeader + len(activities.GetPlaces())*40 +
+		lenHeader + len(activities.GetGnssAccumulatedDriving())*56 +
+		lenHeader + len(activities.GetSpecificConditions())*5 +
+		len(activities.GetSignature())
+}
+
 // Helper functions for parsing Gen2 V1 RecordArrays
 
 // parseRecordArrayHeader parses the 5-byte RecordArray header.
 // Returns: recordType, recordSize, noOfRecords, bytesConsumed, error
+//
+// An error is returned if the declared records do not fit in data.
 func parseRecordArrayHeader(data []byte, offset int) (byte, uint16, uint16, int, error) {
 	const headerSize = 5
 	if offset+headerSize > len(data) {
-		return 0, 0, 0, 0, fmt.Errorf("insufficient data for RecordArray header at offset %d", offset)
+		return 0, 0, 0, 0, fmt.Errorf("insufficient data for RecordArray header at offset %d: %w", offset, io.ErrUnexpectedEOF)
 	}
 
 	recordType := data[offset]
 	recordSize := binary.BigEndian.Uint16(data[offset+1 : offset+3])
 	noOfRecords := binary.BigEndian.Uint16(data[offset+3 : offset+5])
 
+	// Reject arrays overrunning the data before callers allocate for their records
+	if arraySize := int(recordSize) * int(noOfRecords); offset+headerSize+arraySize > len(data) {
+		return 0, 0, 0, 0, fmt.Errorf(
+			"RecordArray at offset %d declares %d records of %d bytes, but only %d bytes are available: %w",
+			offset, noOfRecords, recordSize, len(data)-offset-headerSize, io.ErrUnexpectedEOF,
+		)
+	}
+
 	return recordType, recordSize, noOfRecords, headerSize, nil
 }
 
+// recordSizes checks the record sizes declared by RecordArray headers against
+// the sizes specified in the Data Dictionary. A nil *recordSizes rejects any
+// mismatch.
+type recordSizes struct {
+	// lenient tolerates mismatched record sizes, see
+	// ParseOptions.LenientRecordSizes.
+	lenient bool
+
+	// maxRecords limits the number of records per array, see
+	// ParseOptions.MaxRecordsPerArray. Zero means no limit.
+	maxRecords int
+
+	// warnings collects the tolerated mismatches.
+	warnings []string
+}
+
+// checkCount returns an error if an array declares more records than allowed.
+func (s *recordSizes) checkCount(name string, noOfRecords uint16) error {
+	if s == nil || s.maxRecords <= 0 || int(noOfRecords) <= s.maxRecords {
+		return nil
+	}
+	return fmt.Errorf("%s array declares %d records, more than the maximum of %d", name, noOfRecords, s.maxRecords)
+}
+
+// checkArrayCount is like checkCount, for the RecordArray at offset in data.
+// Arrays whose header is truncated are left to the caller to report.
+func (s *recordSizes) checkArrayCount(name string, data []byte, offset int) error {
+	const headerSize = 5
+	if offset+headerSize > len(data) {
+		return nil
+	}
+	return s.checkCount(name, binary.BigEndian.Uint16(data[offset+3:offset+5]))
+}
+
+// check reports whether the records of an array with the declared record size
+// can be decoded as records of the expected size.
+//
+// A mismatch is an error, unless the sizes are lenient. Then the mismatch is
+// recorded as a warning, and records that are larger than expected are decoded
+// from their leading bytes, while arrays of smaller records are skipped.
+func (s *recordSizes) check(name string, recordSize, expectedRecordSize uint16) (bool, error) {
+	if recordSize == expectedRecordSize {
+		return true, nil
+	}
+	if s == nil || !s.lenient {
+		return false, fmt.Errorf("expected %s size %d, got %d", name, expectedRecordSize, recordSize)
+	}
+	decode := recordSize > expectedRecordSize
+	action := "skipped"
+	if decode {
+		action = "decoded from leading bytes"
+	}
+	s.warnings = append(s.warnings, fmt.Sprintf("%s size %d, expected %d: records %s", name, recordSize, expectedRecordSize, action))
+	return decode, nil
+}
+
 // parseTimeRealRecordArray parses a TimeRealRecordArray (should have 1 record of 4 bytes).
 func parseTimeRealRecordArray(data []byte, offset int) (*timestamppb.Timestamp, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
@@ -279,20 +383,24 @@ func parseOdometerValueMidnightRecordArray(data []byte, offset int) (int32, int,
 	return int32(odometer), totalSize, nil
 }
 
-// parseVuCardIWRecordArrayG2 parses a VuCardIWRecordArray (Gen2 - 132 bytes per record).
-func parseVuCardIWRecordArrayG2(data []byte, offset int) ([]*ddv1.VuCardIWRecordG2, int, error) {
+// parseVuCardIWRecordArrayG2 parses a VuCardIWRecordArray (Gen2 - 131 bytes per record).
+func parseVuCardIWRecordArrayG2(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.VuCardIWRecordG2, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	const expectedRecordSize = 132 // Gen2
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected VuCardIWRecord size %d, got %d", expectedRecordSize, recordSize)
+	const expectedRecordSize = 131 // Gen2
+	decode, err := sizes.check("VuCardIWRecord", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("VuCardIWRecord", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	var opts dd.UnmarshalOptions
-	opts.PreserveRawData = true
 
 	records := make([]*ddv1.VuCardIWRecordG2, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -300,10 +408,10 @@ func parseVuCardIWRecordArrayG2(data []byte, offset int) ([]*ddv1.VuCardIWRecord
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for VuCardIWRecord %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for VuCardIWRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuCardIWRecordG2(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalVuCardIWRecordG2(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal VuCardIWRecord %d: %w", i, err)
 		}
@@ -317,19 +425,23 @@ func parseVuCardIWRecordArrayG2(data []byte, offset int) ([]*ddv1.VuCardIWRecord
 }
 
 // parseVuActivityDailyRecordArray parses a VuActivityDailyRecordArray (2 bytes per record).
-func parseVuActivityDailyRecordArray(data []byte, offset int) ([]*ddv1.ActivityChangeInfo, int, error) {
+func parseVuActivityDailyRecordArray(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.ActivityChangeInfo, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	const expectedRecordSize = 2
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected ActivityChangeInfo size %d, got %d", expectedRecordSize, recordSize)
+	decode, err := sizes.check("ActivityChangeInfo", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("ActivityChangeInfo", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	var opts dd.UnmarshalOptions
-	opts.PreserveRawData = true
 
 	records := make([]*ddv1.ActivityChangeInfo, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -337,10 +449,10 @@ func parseVuActivityDailyRecordArray(data []byte, offset int) ([]*ddv1.ActivityC
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for ActivityChangeInfo %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for ActivityChangeInfo %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalActivityChangeInfo(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalActivityChangeInfo(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal ActivityChangeInfo %d: %w", i, err)
 		}
@@ -353,20 +465,24 @@ func parseVuActivityDailyRecordArray(data []byte, offset int) ([]*ddv1.ActivityC
 	return records, totalSize, nil
 }
 
-// parseVuPlaceDailyWorkPeriodRecordArrayG2 parses a VuPlaceDailyWorkPeriodRecordArray (Gen2v1 - 41 bytes per record).
-func parseVuPlaceDailyWorkPeriodRecordArrayG2(data []byte, offset int) ([]*ddv1.VuPlaceDailyWorkPeriodRecordG2, int, error) {
+// parseVuPlaceDailyWorkPeriodRecordArrayG2 parses a VuPlaceDailyWorkPeriodRecordArray (Gen2v1 - 40 bytes per record).
+func parseVuPlaceDailyWorkPeriodRecordArrayG2(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.VuPlaceDailyWorkPeriodRecordG2, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	const expectedRecordSize = 41 // Gen2v1
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected VuPlaceDailyWorkPeriodRecord size %d, got %d", expectedRecordSize, recordSize)
+	const expectedRecordSize = 40 // Gen2v1
+	decode, err := sizes.check("VuPlaceDailyWorkPeriodRecord", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("VuPlaceDailyWorkPeriodRecord", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	var opts dd.UnmarshalOptions
-	opts.PreserveRawData = true
 
 	records := make([]*ddv1.VuPlaceDailyWorkPeriodRecordG2, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -374,10 +490,10 @@ func parseVuPlaceDailyWorkPeriodRecordArrayG2(data []byte, offset int) ([]*ddv1.
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for VuPlaceDailyWorkPeriodRecord %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for VuPlaceDailyWorkPeriodRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuPlaceDailyWorkPeriodRecordG2(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalVuPlaceDailyWorkPeriodRecordG2(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal VuPlaceDailyWorkPeriodRecord %d: %w", i, err)
 		}
@@ -390,19 +506,24 @@ func parseVuPlaceDailyWorkPeriodRecordArrayG2(data []byte, offset int) ([]*ddv1.
 	return records, totalSize, nil
 }
 
-// parseVuGNSSADRecordArray parses a VuGNSSADRecordArray (Gen2v1 - 58 bytes per record).
-func parseVuGNSSADRecordArray(data []byte, offset int) ([]*ddv1.VuGNSSADRecord, int, error) {
+// parseVuGNSSADRecordArray parses a VuGNSSADRecordArray (Gen2v1 - 56 bytes per record).
+func parseVuGNSSADRecordArray(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.VuGNSSADRecord, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	const expectedRecordSize = 58 // Gen2v1
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected VuGNSSADRecord size %d, got %d", expectedRecordSize, recordSize)
+	const expectedRecordSize = 56 // Gen2v1
+	decode, err := sizes.check("VuGNSSADRecord", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("VuGNSSADRecord", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	records := make([]*ddv1.VuGNSSADRecord, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -410,10 +531,10 @@ func parseVuGNSSADRecordArray(data []byte, offset int) ([]*ddv1.VuGNSSADRecord,
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for VuGNSSADRecord %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for VuGNSSADRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuGNSSADRecord(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalVuGNSSADRecord(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal VuGNSSADRecord %d: %w", i, err)
 		}
@@ -427,19 +548,23 @@ func parseVuGNSSADRecordArray(data []byte, offset int) ([]*ddv1.VuGNSSADRecord,
 }
 
 // parseVuSpecificConditionRecordArray parses a VuSpecificConditionRecordArray (5 bytes per record).
-func parseVuSpecificConditionRecordArray(data []byte, offset int) ([]*ddv1.SpecificConditionRecord, int, error) {
+func parseVuSpecificConditionRecordArray(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.SpecificConditionRecord, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
 	const expectedRecordSize = 5
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected SpecificConditionRecord size %d, got %d", expectedRecordSize, recordSize)
+	decode, err := sizes.check("SpecificConditionRecord", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("SpecificConditionRecord", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	var opts dd.UnmarshalOptions
-	opts.PreserveRawData = true
 
 	records := make([]*ddv1.SpecificConditionRecord, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -447,10 +572,10 @@ func parseVuSpecificConditionRecordArray(data []byte, offset int) ([]*ddv1.Speci
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for SpecificConditionRecord %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for SpecificConditionRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalSpecificConditionRecord(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalSpecificConditionRecord(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal SpecificConditionRecord %d: %w", i, err)
 		}
@@ -505,23 +630,34 @@ func marshalActivityChangeInfos(records []*ddv1.ActivityChangeInfo) ([]byte, err
 }
 
 // marshalPlaceRecordsG2V1 marshals PlaceRecords for Gen2v1.
-func marshalPlaceRecordsG2V1(records []*ddv1.PlaceRecordG2) ([]byte, error) {
+//
+// Each VuPlaceDailyWorkPeriodRecord is 40 bytes: 19 bytes FullCardNumberAndGeneration
+// followed by 21 bytes PlaceRecordG2. The proto does not expose the card number,
+// so it is taken from the canvas (the records of the original
+// VuPlaceDailyWorkPeriodRecordArray) when it has one record per place record,
+// and zero-filled otherwise.
+func marshalPlaceRecordsG2V1(records []*ddv1.PlaceRecordG2, canvas []byte) ([]byte, error) {
+	const (
+		lenFullCardNumberAndGeneration  = 19
+		lenVuPlaceDailyWorkPeriodRecord = 40
+	)
 	var result []byte
 	var opts dd.MarshalOptions
 
+	hasCanvas := len(canvas) == len(records)*lenVuPlaceDailyWorkPeriodRecord
 	for i, placeRec := range records {
-		// Wrap in VuPlaceDailyWorkPeriodRecordG2 (41 bytes = 20 bytes FullCardNumberAndGeneration + 21 bytes PlaceRecordG2)
-		ddRecord := &ddv1.VuPlaceDailyWorkPeriodRecordG2{}
-		// Note: VU place records include a card number, but Gen2v1 proto doesn't expose it
-		// Use empty/zero card number for now
-		ddRecord.SetFullCardNumber(&ddv1.FullCardNumberAndGeneration{})
-		ddRecord.SetPlaceRecord(placeRec)
-
-		recordData, err := opts.MarshalVuPlaceDailyWorkPeriodRecordG2(ddRecord)
+		cardNumber := make([]byte, lenFullCardNumberAndGeneration)
+		if hasCanvas {
+			start := i * lenVuPlaceDailyWorkPeriodRecord
+			copy(cardNumber, canvas[start:start+lenFullCardNumberAndGeneration])
+		}
+		result = append(result, cardNumber...)
+
+		placeData, err := opts.MarshalPlaceRecordG2(placeRec)
 		if err != nil {
 			return nil, fmt.Errorf("marshal PlaceRecord %d: %w", i, err)
 		}
-		result = append(result, recordData...)
+		result = append(result, placeData...)
 	}
 	return result, nil
 }
@@ -561,7 +697,7 @@ func marshalSpecificConditionRecords(records []*ddv1.SpecificConditionRecord) ([
 // Anonymization strategy:
 // - Replaces timestamps with deterministic sequential values
 // - Replaces card numbers and holder names with generic test data
-// - Normalizes locations to generic values (Finland/Helsinki)
+// - Normalizes locations to the anonymized base location and nation
 // - Rounds odometer values to nearest 100km
 // - Clears signatures and raw_data
 func (opts AnonymizeOptions) anonymizeActivitiesGen2V1(activities *vuv1.ActivitiesGen2V1) *vuv1.ActivitiesGen2V1 {
@@ -572,10 +708,7 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V1(activities *vuv1.Activiti
 	result := &vuv1.ActivitiesGen2V1{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize date_of_day - use a fixed date (2024-01-01 00:00:00 UTC)
 	baseTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -587,46 +720,7 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V1(activities *vuv1.Activiti
 	result.SetOdometerMidnightKm(roundedOdometer)
 
 	// Anonymize card_iw_data
-	anonCardIW := make([]*ddv1.VuCardIWRecordG2, len(activities.GetCardIwData()))
-	for i, rec := range activities.GetCardIwData() {
-		anonCardIW[i] = &ddv1.VuCardIWRecordG2{}
-
-		// Generic test holder name
-		testSurname := &ddv1.StringValue{}
-		testSurname.SetValue("TEST")
-		testFirstName := &ddv1.StringValue{}
-		testFirstName.SetValue("DRIVER")
-		testName := &ddv1.HolderName{}
-		testName.SetHolderSurname(testSurname)
-		testName.SetHolderFirstNames(testFirstName)
-		anonCardIW[i].SetCardHolderName(testName)
-
-		// Generic test card number - use empty FullCardNumberAndGeneration
-		anonCardIW[i].SetFullCardNumber(&ddv1.FullCardNumberAndGeneration{})
-
-		// Use fixed dates
-		testDate := &ddv1.Date{}
-		testDate.SetYear(2030)
-		testDate.SetMonth(12)
-		testDate.SetDay(31)
-		anonCardIW[i].SetCardExpiryDate(testDate)
-		anonCardIW[i].SetCardInsertionTime(timestamppb.New(baseTime.Add(time.Duration(i*2) * time.Hour)))
-		anonCardIW[i].SetCardWithdrawalTime(timestamppb.New(baseTime.Add(time.Duration(i*2+1) * time.Hour)))
-
-		// Round odometer values
-		anonCardIW[i].SetOdometerAtInsertionKm((rec.GetOdometerAtInsertionKm() / 100) * 100)
-		anonCardIW[i].SetOdometerAtWithdrawalKm((rec.GetOdometerAtWithdrawalKm() / 100) * 100)
-
-		// Preserve slot and manual input flag
-		anonCardIW[i].SetCardSlotNumber(rec.GetCardSlotNumber())
-		anonCardIW[i].SetManualInputFlag(rec.GetManualInputFlag())
-
-		// Anonymize previous vehicle info
-		if prevVehicle := rec.GetPreviousVehicleInfo(); prevVehicle != nil {
-			anonCardIW[i].SetPreviousVehicleInfo(ddOpts.AnonymizePreviousVehicleInfoG2(prevVehicle))
-		}
-	}
-	result.SetCardIwData(anonCardIW)
+	result.SetCardIwData(opts.anonymizeCardIWRecordsG2(activities.GetCardIwData(), baseTime))
 
 	// Anonymize activity_changes
 	anonActivityChanges := make([]*ddv1.ActivityChangeInfo, len(activities.GetActivityChanges()))
@@ -647,17 +741,13 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V1(activities *vuv1.Activiti
 	for i, gnss := range activities.GetGnssAccumulatedDriving() {
 		anonGnss[i] = &ddv1.VuGNSSADRecord{}
 		anonGnss[i].SetTimeStamp(timestamppb.New(baseTime.Add(time.Duration(i*3) * time.Hour)))
-		anonGnss[i].SetCardNumberDriverSlot(&ddv1.FullCardNumberAndGeneration{})
-		anonGnss[i].SetCardNumberCodriverSlot(&ddv1.FullCardNumberAndGeneration{})
+		anonGnss[i].SetCardNumberDriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(gnss.GetCardNumberDriverSlot()))
+		anonGnss[i].SetCardNumberCodriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(gnss.GetCardNumberCodriverSlot()))
 		// Create anonymized GNSS place record
 		gnssPlace := &ddv1.GNSSPlaceRecord{}
 		gnssPlace.SetTimestamp(timestamppb.New(baseTime.Add(time.Duration(i*3) * time.Hour)))
 		gnssPlace.SetGnssAccuracy(gnss.GetGnssPlaceRecord().GetGnssAccuracy())
-		// Use generic Finland/Helsinki coordinates
-		testCoordsV1 := &ddv1.GeoCoordinates{}
-		testCoordsV1.SetLatitude(60170000)  // 60.17°N (Helsinki)
-		testCoordsV1.SetLongitude(24940000) // 24.94°E (Helsinki)
-		gnssPlace.SetGeoCoordinates(testCoordsV1)
+		gnssPlace.SetGeoCoordinates(ddOpts.AnonymizedGeoCoordinates())
 		anonGnss[i].SetGnssPlaceRecord(gnssPlace)
 		anonGnss[i].SetVehicleOdometerKm((gnss.GetVehicleOdometerKm() / 100) * 100)
 	}
@@ -673,3 +763,52 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V1(activities *vuv1.Activiti
 
 	return result
 }
+
+// anonymizeCardIWRecordsG2 anonymizes the Gen2 card insertion/withdrawal
+// records of an activities transfer, shared by Gen2 V1 and V2.
+//
+// Insertion and withdrawal times are replaced by alternating hours starting
+// at baseTime.
+func (opts AnonymizeOptions) anonymizeCardIWRecordsG2(records []*ddv1.VuCardIWRecordG2, baseTime time.Time) []*ddv1.VuCardIWRecordG2 {
+	ddOpts := opts.ddAnonymizeOptions()
+	anonCardIW := make([]*ddv1.VuCardIWRecordG2, len(records))
+	for i, rec := range records {
+		anonCardIW[i] = &ddv1.VuCardIWRecordG2{}
+
+		// Generic test holder name
+		testSurname := &ddv1.StringValue{}
+		testSurname.SetValue("TEST")
+		testFirstName := &ddv1.StringValue{}
+		testFirstName.SetValue("DRIVER")
+		testName := &ddv1.HolderName{}
+		testName.SetHolderSurname(testSurname)
+		testName.SetHolderFirstNames(testFirstName)
+		anonCardIW[i].SetCardHolderName(testName)
+
+		// Synthetic card number, unique per original card
+		anonCardIW[i].SetFullCardNumber(ddOpts.AnonymizeFullCardNumberAndGeneration(rec.GetFullCardNumber()))
+
+		// Use fixed dates
+		testDate := &ddv1.Date{}
+		testDate.SetYear(2030)
+		testDate.SetMonth(12)
+		testDate.SetDay(31)
+		anonCardIW[i].SetCardExpiryDate(testDate)
+		anonCardIW[i].SetCardInsertionTime(timestamppb.New(baseTime.Add(time.Duration(i*2) * time.Hour)))
+		anonCardIW[i].SetCardWithdrawalTime(timestamppb.New(baseTime.Add(time.Duration(i*2+1) * time.Hour)))
+
+		// Round odometer values
+		anonCardIW[i].SetOdometerAtInsertionKm((rec.GetOdometerAtInsertionKm() / 100) * 100)
+		anonCardIW[i].SetOdometerAtWithdrawalKm((rec.GetOdometerAtWithdrawalKm() / 100) * 100)
+
+		// Preserve slot and manual input flag
+		anonCardIW[i].SetCardSlotNumber(rec.GetCardSlotNumber())
+		anonCardIW[i].SetManualInputFlag(rec.GetManualInputFlag())
+
+		// Anonymize previous vehicle info
+		if prevVehicle := rec.GetPreviousVehicleInfo(); prevVehicle != nil {
+			anonCardIW[i].SetPreviousVehicleInfo(ddOpts.AnonymizePreviousVehicleInfoG2(prevVehicle))
+		}
+	}
+	return anonCardIW
+}
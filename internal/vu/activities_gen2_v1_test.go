@@ -3,9 +3,13 @@ package vu
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
@@ -58,3 +62,363 @@ func TestActivities_Gen2V1(t *testing.T) {
 		})
 	}
 }
+
+func TestParseVuSpecificConditionRecordArray(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []byte
+		lenient   bool
+		wantTypes []ddv1.SpecificConditionType
+		wantSize  int
+		wantErr   bool
+	}{
+		{
+			name: "two 5-byte records",
+			data: []byte{
+				0x07, 0x00, 0x05, 0x00, 0x02, // header: type, size 5, 2 records
+				0x65, 0x00, 0x00, 0x00, 0x01, // out of scope begin
+				0x65, 0x00, 0x10, 0x00, 0x02, // out of scope end
+			},
+			wantTypes: []ddv1.SpecificConditionType{
+				ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN,
+				ddv1.SpecificConditionType_OUT_OF_SCOPE_END,
+			},
+			wantSize: 15,
+		},
+		{
+			name:     "empty array",
+			data:     []byte{0x07, 0x00, 0x05, 0x00, 0x00},
+			wantSize: 5,
+		},
+		{
+			name: "unexpected record size",
+			data: []byte{
+				0x07, 0x00, 0x06, 0x00, 0x01,
+				0x65, 0x00, 0x00, 0x00, 0x01, 0x00,
+			},
+			wantErr: true,
+		},
+		{
+			name: "lenient larger record size",
+			data: []byte{
+				0x07, 0x00, 0x06, 0x00, 0x01,
+				0x65, 0x00, 0x00, 0x00, 0x01, 0x00,
+			},
+			lenient:   true,
+			wantTypes: []ddv1.SpecificConditionType{ddv1.SpecificConditionType_OUT_OF_SCOPE_BEGIN},
+			wantSize:  11,
+		},
+		{
+			name: "lenient smaller record size",
+			data: []byte{
+				0x07, 0x00, 0x04, 0x00, 0x01,
+				0x65, 0x00, 0x00, 0x00,
+			},
+			lenient:  true,
+			wantSize: 9,
+		},
+		{
+			name:    "truncated record",
+			data:    []byte{0x07, 0x00, 0x05, 0x00, 0x01, 0x65, 0x00},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sizes := &recordSizes{lenient: tt.lenient}
+			records, size, err := parseVuSpecificConditionRecordArray(dd.UnmarshalOptions{PreserveRawData: true}, tt.data, 0, sizes)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if size != tt.wantSize {
+				t.Errorf("size = %d, want %d", size, tt.wantSize)
+			}
+			if wantWarning := tt.lenient && tt.data[2] != 5; (len(sizes.warnings) > 0) != wantWarning {
+				t.Errorf("warnings = %q, want warning: %v", sizes.warnings, wantWarning)
+			}
+			var gotTypes []ddv1.SpecificConditionType
+			for _, record := range records {
+				gotTypes = append(gotTypes, record.GetSpecificConditionType())
+			}
+			if diff := cmp.Diff(tt.wantTypes, gotTypes); diff != "" {
+				t.Errorf("types mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRawVehicleUnitFile_LenientRecordSizes(t *testing.T) {
+	activities := &vuv1.ActivitiesGen2V1{}
+	activities.SetDateOfDay(timestamppb.New(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	activities.SetOdometerMidnightKm(123456)
+	change := &ddv1.ActivityChangeInfo{}
+	change.SetSlot(ddv1.CardSlotNumber_DRIVER_SLOT)
+	change.SetInserted(true)
+	change.SetActivity(ddv1.DriverActivityValue_DRIVING)
+	change.SetTimeOfChangeMinutes(480)
+	activities.SetActivityChanges([]*ddv1.ActivityChangeInfo{change})
+	activities.SetSignature([]byte{0x08, 0x00, 0x40, 0x00, 0x00}) // empty SignatureRecordArray
+	data, err := MarshalOptions{}.MarshalActivitiesGen2V1(activities)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V1() failed: %v", err)
+	}
+
+	// Declare 3-byte records in the VuActivityDailyRecordArray, which follows
+	// the TimeReal, OdometerValueMidnight and empty VuCardIW arrays, and pad
+	// the record accordingly.
+	const idxActivityDailyRecordArray = 9 + 8 + 5
+	if data[idxActivityDailyRecordArray+2] != 2 {
+		t.Fatalf("unexpected VuActivityDailyRecordArray header: % X", data[idxActivityDailyRecordArray:idxActivityDailyRecordArray+5])
+	}
+	damaged := append([]byte{}, data[:idxActivityDailyRecordArray+7]...)
+	damaged[idxActivityDailyRecordArray+2] = 3
+	damaged = append(damaged, 0x00)
+	damaged = append(damaged, data[idxActivityDailyRecordArray+7:]...)
+
+	record := &vuv1.RawVehicleUnitFile_Record{}
+	record.SetType(vuv1.TransferType_ACTIVITIES_GEN2_V1)
+	record.SetGeneration(ddv1.Generation_GENERATION_2)
+	record.SetValue(damaged)
+	rawFile := &vuv1.RawVehicleUnitFile{}
+	rawFile.SetRecords([]*vuv1.RawVehicleUnitFile_Record{record})
+
+	if _, err := (ParseOptions{}).ParseRawVehicleUnitFile(rawFile); err == nil {
+		t.Fatal("ParseRawVehicleUnitFile() succeeded, want record size error")
+	}
+	file, err := ParseOptions{LenientRecordSizes: true}.ParseRawVehicleUnitFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawVehicleUnitFile(LenientRecordSizes) failed: %v", err)
+	}
+	if got := len(file.GetParseWarnings()); got != 1 {
+		t.Errorf("ParseWarnings = %q, want 1 warning", file.GetParseWarnings())
+	}
+	parsed := file.GetGen2V1().GetActivities()
+	if len(parsed) != 1 {
+		t.Fatalf("got %d activities, want 1", len(parsed))
+	}
+	if got := parsed[0].GetActivityChanges(); len(got) != 1 || got[0].GetTimeOfChangeMinutes() != 480 {
+		t.Errorf("ActivityChanges = %v, want the recovered change at 480 minutes", got)
+	}
+	if got := parsed[0].GetOdometerMidnightKm(); got != 123456 {
+		t.Errorf("OdometerMidnightKm = %d, want 123456", got)
+	}
+}
+
+func TestParseRawVehicleUnitFile_MaxRecordsPerArray(t *testing.T) {
+	activities := &vuv1.ActivitiesGen2V1{}
+	activities.SetDateOfDay(timestamppb.New(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	var changes []*ddv1.ActivityChangeInfo
+	for minutes := int32(480); minutes < 490; minutes++ {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetSlot(ddv1.CardSlotNumber_DRIVER_SLOT)
+		change.SetInserted(true)
+		change.SetActivity(ddv1.DriverActivityValue_DRIVING)
+		change.SetTimeOfChangeMinutes(minutes)
+		changes = append(changes, change)
+	}
+	activities.SetActivityChanges(changes)
+	activities.SetSignature([]byte{0x08, 0x00, 0x40, 0x00, 0x00}) // empty SignatureRecordArray
+	data, err := MarshalOptions{}.MarshalActivitiesGen2V1(activities)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V1() failed: %v", err)
+	}
+	rawFile := func(value []byte) *vuv1.RawVehicleUnitFile {
+		record := &vuv1.RawVehicleUnitFile_Record{}
+		record.SetType(vuv1.TransferType_ACTIVITIES_GEN2_V1)
+		record.SetGeneration(ddv1.Generation_GENERATION_2)
+		record.SetValue(value)
+		f := &vuv1.RawVehicleUnitFile{}
+		f.SetRecords([]*vuv1.RawVehicleUnitFile_Record{record})
+		return f
+	}
+
+	if _, err := (ParseOptions{MaxRecordsPerArray: 10}).ParseRawVehicleUnitFile(rawFile(data)); err != nil {
+		t.Errorf("ParseRawVehicleUnitFile(MaxRecordsPerArray: 10) failed: %v", err)
+	}
+	if _, err := (ParseOptions{MaxRecordsPerArray: 9}).ParseRawVehicleUnitFile(rawFile(data)); err == nil {
+		t.Error("ParseRawVehicleUnitFile(MaxRecordsPerArray: 9) succeeded, want error")
+	}
+
+	// Declare the maximum number of records in the VuActivityDailyRecordArray,
+	// which follows the TimeReal, OdometerValueMidnight and empty VuCardIW arrays.
+	const idxActivityDailyRecordArray = 9 + 8 + 5
+	bomb := append([]byte{}, data...)
+	bomb[idxActivityDailyRecordArray+3] = 0xff
+	bomb[idxActivityDailyRecordArray+4] = 0xff
+	if _, err := (ParseOptions{}).ParseRawVehicleUnitFile(rawFile(bomb)); err == nil {
+		t.Error("ParseRawVehicleUnitFile() of overrunning record array succeeded, want error")
+	}
+}
+
+func TestMarshalActivitiesGen2V1_UseRawData(t *testing.T) {
+	activities := &vuv1.ActivitiesGen2V1{}
+	activities.SetDateOfDay(timestamppb.New(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	activities.SetOdometerMidnightKm(123456)
+	activities.SetSignature([]byte{0x08, 0x00, 0x40, 0x00, 0x00}) // empty SignatureRecordArray
+	data, err := MarshalOptions{}.MarshalActivitiesGen2V1(activities)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V1() failed: %v", err)
+	}
+
+	parsed, err := unmarshalActivitiesGen2V1(data)
+	if err != nil {
+		t.Fatalf("unmarshalActivitiesGen2V1() failed: %v", err)
+	}
+	parsed.SetOdometerMidnightKm(654321)
+
+	// With UseRawData, raw_data is emitted verbatim and the edit has no effect.
+	useRawData := MarshalOptions{MarshalOptions: dd.MarshalOptions{UseRawData: true}}
+	unchanged, err := useRawData.MarshalActivitiesGen2V1(parsed)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V1() failed: %v", err)
+	}
+	if diff := cmp.Diff(data, unchanged); diff != "" {
+		t.Errorf("MarshalActivitiesGen2V1() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Without UseRawData, the edit is re-encoded.
+	changed, err := MarshalOptions{}.MarshalActivitiesGen2V1(parsed)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V1() failed: %v", err)
+	}
+	reparsed, err := unmarshalActivitiesGen2V1(changed)
+	if err != nil {
+		t.Fatalf("unmarshalActivitiesGen2V1() failed: %v", err)
+	}
+	if got := reparsed.GetOdometerMidnightKm(); got != 654321 {
+		t.Errorf("OdometerMidnightKm = %d, want 654321", got)
+	}
+}
+
+func TestMarshalActivitiesGen2V1_VerifyPaint(t *testing.T) {
+	activities := &vuv1.ActivitiesGen2V1{}
+	activities.SetDateOfDay(timestamppb.New(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	activities.SetOdometerMidnightKm(123456)
+	activities.SetSignature([]byte{0x08, 0x00, 0x40, 0x00, 0x00}) // empty SignatureRecordArray
+	data, err := MarshalOptions{}.MarshalActivitiesGen2V1(activities)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V1() failed: %v", err)
+	}
+	parsed, err := unmarshalActivitiesGen2V1(data)
+	if err != nil {
+		t.Fatalf("unmarshalActivitiesGen2V1() failed: %v", err)
+	}
+
+	verifyPaint := MarshalOptions{MarshalOptions: dd.MarshalOptions{UseRawData: true}, VerifyPaint: true}
+	if _, err := verifyPaint.MarshalActivitiesGen2V1(parsed); err != nil {
+		t.Errorf("MarshalActivitiesGen2V1(VerifyPaint) of unmodified activities failed: %v", err)
+	}
+
+	// The edit is dropped when raw_data is emitted verbatim.
+	parsed.SetOdometerMidnightKm(654321)
+	if _, err := verifyPaint.MarshalActivitiesGen2V1(parsed); err == nil {
+		t.Error("MarshalActivitiesGen2V1(VerifyPaint) of edited activities succeeded, want error")
+	}
+	if _, err := (MarshalOptions{VerifyPaint: true}).MarshalActivitiesGen2V1(parsed); err != nil {
+		t.Errorf("MarshalActivitiesGen2V1(VerifyPaint) without UseRawData failed: %v", err)
+	}
+}
+
+func TestMarshalPlaceRecordsG2V1_Canvas(t *testing.T) {
+	place := &ddv1.PlaceRecordG2{}
+	place.SetEntryTime(timestamppb.New(time.Date(2024, 5, 6, 8, 0, 0, 0, time.UTC)))
+	canvas := make([]byte, 40)
+	copy(canvas, "CARD-NUMBER-BYTES!!")
+
+	got, err := marshalPlaceRecordsG2V1([]*ddv1.PlaceRecordG2{place}, canvas)
+	if err != nil {
+		t.Fatalf("marshalPlaceRecordsG2V1() failed: %v", err)
+	}
+	if diff := cmp.Diff(canvas[:19], got[:19]); diff != "" {
+		t.Errorf("card number mismatch (-want +got):\n%s", diff)
+	}
+	if got[19] == 0 && got[20] == 0 && got[21] == 0 && got[22] == 0 {
+		t.Error("entry time was not encoded")
+	}
+}
+
+func TestParseVuCardIWRecordArrayG2(t *testing.T) {
+	inserted := time.Date(2024, 5, 6, 6, 30, 0, 0, time.UTC)
+	withdrawn := time.Date(2024, 5, 6, 17, 45, 0, 0, time.UTC)
+	previous := time.Date(2024, 5, 5, 18, 0, 0, 0, time.UTC)
+
+	// A Gen2 VuCardIWRecord, laid out as in Data Dictionary, Section 2.177.
+	padded := func(s string, n int) []byte {
+		return []byte(s + strings.Repeat(" ", n-len(s)))
+	}
+	var record []byte
+	record = append(record, 0x01)                        // codePage
+	record = append(record, padded("MUSTERMANN", 35)...) // holderSurname
+	record = append(record, 0x01)                        // codePage
+	record = append(record, padded("ERIKA", 35)...)      // holderFirstNames
+	record = append(record, 0x01, 0x0D)                  // cardType, cardIssuingMemberState
+	record = append(record, "DF00001234567801"...)       // cardNumber
+	record = append(record, 0x02)                        // generation
+	record = append(record, 0x20, 0x29, 0x12, 0x31)      // cardExpiryDate
+	record = append(record, timeReal(inserted)...)       // cardInsertionTime
+	record = append(record, 0x01, 0xE2, 0x40)            // vehicleOdometerValueAtInsertion
+	record = append(record, 0x00)                        // cardSlotNumber: driver
+	record = append(record, timeReal(withdrawn)...)      // cardWithdrawalTime
+	record = append(record, 0x01, 0xE3, 0x9B)            // vehicleOdometerValueAtWithdrawal
+	record = append(record, 0x0D, 0x01)                  // vehicleRegistrationNation, codePage
+	record = append(record, padded("B-AB 1234", 13)...)  // vehicleRegistrationNumber
+	record = append(record, timeReal(previous)...)       // cardWithdrawalTime
+	record = append(record, 0x02)                        // vuGeneration
+	record = append(record, 0x01)                        // manualInputFlag
+	if len(record) != 131 {
+		t.Fatalf("test record is %d bytes, want 131", len(record))
+	}
+	data := appendRecordArrayHeader(nil, 0x03, 131, 1)
+	data = append(data, record...)
+
+	records, n, err := parseVuCardIWRecordArrayG2(dd.UnmarshalOptions{}, data, 0, nil)
+	if err != nil {
+		t.Fatalf("parseVuCardIWRecordArrayG2() failed: %v", err)
+	}
+	if n != len(data) {
+		t.Errorf("parseVuCardIWRecordArrayG2() read %d bytes, want %d", n, len(data))
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	got := records[0]
+	if name := got.GetCardHolderName().GetHolderSurname().GetValue(); name != "MUSTERMANN" {
+		t.Errorf("holder surname = %q, want %q", name, "MUSTERMANN")
+	}
+	cardNumber := got.GetFullCardNumber()
+	if id := cardNumber.GetFullCardNumber().GetDriverIdentification().GetDriverIdentificationNumber().GetValue(); id != "DF000012345678" {
+		t.Errorf("driver identification = %q, want %q", id, "DF000012345678")
+	}
+	if gen := cardNumber.GetGeneration(); gen != ddv1.Generation_GENERATION_2 {
+		t.Errorf("card generation = %v, want %v", gen, ddv1.Generation_GENERATION_2)
+	}
+	if ts := got.GetCardInsertionTime().AsTime(); !ts.Equal(inserted) {
+		t.Errorf("card insertion time = %v, want %v", ts, inserted)
+	}
+	if ts := got.GetCardWithdrawalTime().AsTime(); !ts.Equal(withdrawn) {
+		t.Errorf("card withdrawal time = %v, want %v", ts, withdrawn)
+	}
+	if km := got.GetOdometerAtWithdrawalKm(); km != 123803 {
+		t.Errorf("odometer at withdrawal = %d, want 123803", km)
+	}
+	if gen := got.GetPreviousVehicleInfo().GetVuGeneration(); gen != ddv1.Generation_GENERATION_2 {
+		t.Errorf("previous VU generation = %v, want %v", gen, ddv1.Generation_GENERATION_2)
+	}
+	if !got.GetManualInputFlag() {
+		t.Error("manual input flag = false, want true")
+	}
+
+	marshalled, err := marshalCardIWRecordsG2(records)
+	if err != nil {
+		t.Fatalf("marshalCardIWRecordsG2() failed: %v", err)
+	}
+	if diff := cmp.Diff(record, marshalled); diff != "" {
+		t.Errorf("round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -2,6 +2,7 @@ package vu
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
@@ -33,6 +34,13 @@ import (
 //
 //	recordType (1 byte) + recordSize (2 bytes, big-endian) + noOfRecords (2 bytes, big-endian)
 func unmarshalActivitiesGen2V2(value []byte) (*vuv1.ActivitiesGen2V2, error) {
+	return parseActivitiesGen2V2(dd.UnmarshalOptions{PreserveRawData: true}, value, nil)
+}
+
+// parseActivitiesGen2V2 is like unmarshalActivitiesGen2V2, but parses the
+// records of the transfer with opts, and checks the record sizes declared by
+// the RecordArray headers with sizes.
+func parseActivitiesGen2V2(opts dd.UnmarshalOptions, value []byte, sizes *recordSizes) (*vuv1.ActivitiesGen2V2, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -47,6 +55,9 @@ func unmarshalActivitiesGen2V2(value []byte) (*vuv1.ActivitiesGen2V2, error) {
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	activities := &vuv1.ActivitiesGen2V2{}
 	activities.SetRawData(value) // Store complete transfer value for painting
@@ -69,8 +80,8 @@ func unmarshalActivitiesGen2V2(value []byte) (*vuv1.ActivitiesGen2V2, error) {
 	activities.SetOdometerMidnightKm(odometerMidnightKm)
 	offset += bytesRead
 
-	// VuCardIWRecordArray (Gen2 - 132 bytes per record, same as V1)
-	cardIWRecords, bytesRead, err := parseVuCardIWRecordArrayG2(data, offset)
+	// VuCardIWRecordArray (Gen2 - 131 bytes per record, same as V1)
+	cardIWRecords, bytesRead, err := parseVuCardIWRecordArrayG2(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuCardIWRecordArray: %w", err)
 	}
@@ -78,16 +89,16 @@ func unmarshalActivitiesGen2V2(value []byte) (*vuv1.ActivitiesGen2V2, error) {
 	offset += bytesRead
 
 	// VuActivityDailyRecordArray
-	activityChanges, bytesRead, err := parseVuActivityDailyRecordArray(data, offset)
+	activityChanges, bytesRead, err := parseVuActivityDailyRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuActivityDailyRecordArray: %w", err)
 	}
 	activities.SetActivityChanges(activityChanges)
 	offset += bytesRead
 
-	// VuPlaceDailyWorkPeriodRecordArray (Gen2v1 format - 41 bytes per record)
-	// Note: Gen2v2 may eventually use PlaceAuthRecord (42 bytes), but currently using Gen2v1 format
-	vuPlaceRecords, bytesRead, err := parseVuPlaceDailyWorkPeriodRecordArrayG2(data, offset)
+	// VuPlaceDailyWorkPeriodRecordArray (Gen2v1 format - 40 bytes per record)
+	// Note: Gen2v2 may eventually use PlaceAuthRecord (41 bytes), but currently using Gen2v1 format
+	vuPlaceRecords, bytesRead, err := parseVuPlaceDailyWorkPeriodRecordArrayG2(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuPlaceDailyWorkPeriodRecordArray: %w", err)
 	}
@@ -99,8 +110,8 @@ func unmarshalActivitiesGen2V2(value []byte) (*vuv1.ActivitiesGen2V2, error) {
 	activities.SetPlaces(placeRecords)
 	offset += bytesRead
 
-	// VuGNSSADRecordArray (Gen2v2 - 59 bytes per record with authentication)
-	gnssADRecords, bytesRead, err := parseVuGNSSADRecordArrayG2(data, offset)
+	// VuGNSSADRecordArray (Gen2v2 - 57 bytes per record with authentication)
+	gnssADRecords, bytesRead, err := parseVuGNSSADRecordArrayG2(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuGNSSADRecordArray: %w", err)
 	}
@@ -108,23 +119,23 @@ func unmarshalActivitiesGen2V2(value []byte) (*vuv1.ActivitiesGen2V2, error) {
 	offset += bytesRead
 
 	// VuSpecificConditionRecordArray
-	specificConditions, bytesRead, err := parseVuSpecificConditionRecordArray(data, offset)
+	specificConditions, bytesRead, err := parseVuSpecificConditionRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuSpecificConditionRecordArray: %w", err)
 	}
 	activities.SetSpecificConditions(specificConditions)
 	offset += bytesRead
 
-	// VuBorderCrossingRecordArray (Gen2v2 - 57 bytes per record)
-	borderCrossings, bytesRead, err := parseVuBorderCrossingRecordArray(data, offset)
+	// VuBorderCrossingRecordArray (Gen2v2 - 55 bytes per record)
+	borderCrossings, bytesRead, err := parseVuBorderCrossingRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuBorderCrossingRecordArray: %w", err)
 	}
 	activities.SetBorderCrossings(borderCrossings)
 	offset += bytesRead
 
-	// VuLoadUnloadRecordArray (Gen2v2 - 60 bytes per record)
-	loadUnloadRecs, bytesRead, err := parseVuLoadUnloadRecordArray(data, offset)
+	// VuLoadUnloadRecordArray (Gen2v2 - 58 bytes per record)
+	loadUnloadRecs, bytesRead, err := parseVuLoadUnloadRecordArray(opts, data, offset, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("parse VuLoadUnloadRecordArray: %w", err)
 	}
@@ -153,8 +164,13 @@ func (opts MarshalOptions) MarshalActivitiesGen2V2(activities *vuv1.ActivitiesGe
 
 	// For Gen2 structures with RecordArrays, raw data painting is straightforward
 	raw := activities.GetRawData()
-	if len(raw) > 0 {
+	if len(raw) > 0 && opts.UseRawData {
 		// raw_data contains complete transfer value (data + signature)
+		if opts.VerifyPaint {
+			if err := verifyPaint(activities, raw, unmarshalActivitiesGen2V2); err != nil {
+				return nil, err
+			}
+		}
 		return raw, nil
 	}
 
@@ -178,12 +194,12 @@ func (opts MarshalOptions) MarshalActivitiesGen2V2(activities *vuv1.ActivitiesGe
 	result = appendRecordArrayHeader(result, 0x02, 3, 1)
 	result = append(result, odometerData...)
 
-	// VuCardIWRecordArray (Gen2 - 132 bytes per record)
-	cardIWData, err := marshalCardIWRecordsG2V2(activities.GetCardIwData())
+	// VuCardIWRecordArray (Gen2 - 131 bytes per record, same as V1)
+	cardIWData, err := marshalCardIWRecordsG2(activities.GetCardIwData())
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuCardIWRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x03, 132, uint16(len(activities.GetCardIwData())))
+	result = appendRecordArrayHeader(result, 0x03, 131, uint16(len(activities.GetCardIwData())))
 	result = append(result, cardIWData...)
 
 	// VuActivityDailyRecordArray (2 bytes per record)
@@ -194,20 +210,20 @@ func (opts MarshalOptions) MarshalActivitiesGen2V2(activities *vuv1.ActivitiesGe
 	result = appendRecordArrayHeader(result, 0x04, 2, uint16(len(activities.GetActivityChanges())))
 	result = append(result, activityData...)
 
-	// VuPlaceDailyWorkPeriodRecordArray (Gen2v2 - 41 bytes per record)
-	placeData, err := marshalPlaceRecordsG2V2(activities.GetPlaces())
+	// VuPlaceDailyWorkPeriodRecordArray (Gen2v2 - 40 bytes per record)
+	placeData, err := marshalPlaceRecordsG2V2(activities.GetPlaces(), recordArrayRecords(raw, 4))
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuPlaceDailyWorkPeriodRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x05, 41, uint16(len(activities.GetPlaces())))
+	result = appendRecordArrayHeader(result, 0x05, 40, uint16(len(activities.GetPlaces())))
 	result = append(result, placeData...)
 
-	// VuGNSSADRecordArray (Gen2v2 - 59 bytes per record with authentication)
+	// VuGNSSADRecordArray (Gen2v2 - 57 bytes per record with authentication)
 	gnssData, err := marshalGnssAccumulatedDrivingRecordsV2(activities.GetGnssAccumulatedDriving())
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuGNSSADRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x06, 59, uint16(len(activities.GetGnssAccumulatedDriving())))
+	result = appendRecordArrayHeader(result, 0x06, 57, uint16(len(activities.GetGnssAccumulatedDriving())))
 	result = append(result, gnssData...)
 
 	// VuSpecificConditionRecordArray (5 bytes per record)
@@ -218,44 +234,73 @@ func (opts MarshalOptions) MarshalActivitiesGen2V2(activities *vuv1.ActivitiesGe
 	result = appendRecordArrayHeader(result, 0x07, 5, uint16(len(activities.GetSpecificConditions())))
 	result = append(result, specificCondData...)
 
-	// VuBorderCrossingRecordArray (Gen2v2 - 57 bytes per record)
+	// VuBorderCrossingRecordArray (Gen2v2 - 55 bytes per record)
 	borderCrossingData, err := marshalBorderCrossingRecords(activities.GetBorderCrossings())
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuBorderCrossingRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x08, 57, uint16(len(activities.GetBorderCrossings())))
+	result = appendRecordArrayHeader(result, 0x08, 55, uint16(len(activities.GetBorderCrossings())))
 	result = append(result, borderCrossingData...)
 
-	// VuLoadUnloadRecordArray (Gen2v2 - 60 bytes per record)
+	// VuLoadUnloadRecordArray (Gen2v2 - 58 bytes per record)
 	loadUnloadData, err := marshalLoadUnloadRecords(activities.GetLoadUnloadOperations())
 	if err != nil {
 		return nil, fmt.Errorf("marshal VuLoadUnloadRecordArray: %w", err)
 	}
-	result = appendRecordArrayHeader(result, 0x09, 60, uint16(len(activities.GetLoadUnloadOperations())))
+	result = appendRecordArrayHeader(result, 0x09, 58, uint16(len(activities.GetLoadUnloadOperations())))
 	result = append(result, loadUnloadData...)
 
 	// Append signature at the end (TV format: maintains structure)
 	// Gen2 uses variable-length ECDSA signatures
 	result = append(result, activities.GetSignature()...)
 
+	if opts.VerifyPaint && len(raw) > 0 {
+		if err := verifyPaint(activities, result, unmarshalActivitiesGen2V2); err != nil {
+			return nil, err
+		}
+	}
+
 	return result, nil
 }
 
+// activitiesGen2V2Size returns the length of a Gen2 V2 Activities transfer
+// encoded from its semantic fields, including its signature.
+func activitiesGen2V2Size(activities *vuv1.ActivitiesGen2V2) int {
+	// Each record array has a 5-byte header: recordType (1) + recordSize (2) +
+	// noOfRecords (2)
+	const lenHeader = 5
+	return lenHeader + 4 + // TimeRealRecordArray
+		lenHeader + 3 + // OdometerValueMidnightRecordArray
+		lenHeader + len(activities.GetCardIwData())*131 +
+		lenHeader + len(activities.GetActivityChanges())*2 +
+		lenHeader + len(activities.GetPlaces())*40 +
+		lenHeader + len(activities.GetGnssAccumulatedDriving())*57 +
+		lenHeader + len(activities.GetSpecificConditions())*5 +
+		lenHeader + len(activities.GetBorderCrossings())*55 +
+		lenHeader + len(activities.GetLoadUnloadOperations())*58 +
+		len(activities.GetSignature())
+}
+
 // Helper functions for parsing Gen2 V2 RecordArrays
 
-// parseVuGNSSADRecordArrayG2 parses a VuGNSSADRecordArray (Gen2v2 - 59 bytes per record with authentication).
-func parseVuGNSSADRecordArrayG2(data []byte, offset int) ([]*ddv1.VuGNSSADRecordG2, int, error) {
+// parseVuGNSSADRecordArrayG2 parses a VuGNSSADRecordArray (Gen2v2 - 57 bytes per record with authentication).
+func parseVuGNSSADRecordArrayG2(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.VuGNSSADRecordG2, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	const expectedRecordSize = 59 // Gen2v2
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected VuGNSSADRecordG2 size %d, got %d", expectedRecordSize, recordSize)
+	const expectedRecordSize = 57 // Gen2v2
+	decode, err := sizes.check("VuGNSSADRecordG2", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("VuGNSSADRecordG2", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	records := make([]*ddv1.VuGNSSADRecordG2, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -263,10 +308,10 @@ func parseVuGNSSADRecordArrayG2(data []byte, offset int) ([]*ddv1.VuGNSSADRecord
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for VuGNSSADRecordG2 %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for VuGNSSADRecordG2 %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuGNSSADRecordG2(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalVuGNSSADRecordG2(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal VuGNSSADRecordG2 %d: %w", i, err)
 		}
@@ -279,19 +324,24 @@ func parseVuGNSSADRecordArrayG2(data []byte, offset int) ([]*ddv1.VuGNSSADRecord
 	return records, totalSize, nil
 }
 
-// parseVuBorderCrossingRecordArray parses a VuBorderCrossingRecordArray (Gen2v2 - 57 bytes per record).
-func parseVuBorderCrossingRecordArray(data []byte, offset int) ([]*ddv1.VuBorderCrossingRecord, int, error) {
+// parseVuBorderCrossingRecordArray parses a VuBorderCrossingRecordArray (Gen2v2 - 55 bytes per record).
+func parseVuBorderCrossingRecordArray(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.VuBorderCrossingRecord, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	const expectedRecordSize = 57
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected VuBorderCrossingRecord size %d, got %d", expectedRecordSize, recordSize)
+	const expectedRecordSize = 55
+	decode, err := sizes.check("VuBorderCrossingRecord", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("VuBorderCrossingRecord", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	records := make([]*ddv1.VuBorderCrossingRecord, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -299,10 +349,10 @@ func parseVuBorderCrossingRecordArray(data []byte, offset int) ([]*ddv1.VuBorder
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for VuBorderCrossingRecord %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for VuBorderCrossingRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuBorderCrossingRecord(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalVuBorderCrossingRecord(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal VuBorderCrossingRecord %d: %w", i, err)
 		}
@@ -315,19 +365,24 @@ func parseVuBorderCrossingRecordArray(data []byte, offset int) ([]*ddv1.VuBorder
 	return records, totalSize, nil
 }
 
-// parseVuLoadUnloadRecordArray parses a VuLoadUnloadRecordArray (Gen2v2 - 60 bytes per record).
-func parseVuLoadUnloadRecordArray(data []byte, offset int) ([]*ddv1.VuLoadUnloadRecord, int, error) {
+// parseVuLoadUnloadRecordArray parses a VuLoadUnloadRecordArray (Gen2v2 - 58 bytes per record).
+func parseVuLoadUnloadRecordArray(opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]*ddv1.VuLoadUnloadRecord, int, error) {
 	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	const expectedRecordSize = 60
-	if recordSize != expectedRecordSize {
-		return nil, 0, fmt.Errorf("expected VuLoadUnloadRecord size %d, got %d", expectedRecordSize, recordSize)
+	const expectedRecordSize = 58
+	decode, err := sizes.check("VuLoadUnloadRecord", recordSize, expectedRecordSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("VuLoadUnloadRecord", noOfRecords); err != nil {
+		return nil, 0, err
+	}
+	if !decode {
+		return nil, headerSize + int(recordSize)*int(noOfRecords), nil
 	}
-
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	records := make([]*ddv1.VuLoadUnloadRecord, 0, noOfRecords)
 	recordStart := offset + headerSize
@@ -335,10 +390,10 @@ func parseVuLoadUnloadRecordArray(data []byte, offset int) ([]*ddv1.VuLoadUnload
 	for i := uint16(0); i < noOfRecords; i++ {
 		recordEnd := recordStart + int(recordSize)
 		if recordEnd > len(data) {
-			return nil, 0, fmt.Errorf("insufficient data for VuLoadUnloadRecord %d", i)
+			return nil, 0, fmt.Errorf("insufficient data for VuLoadUnloadRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
-		record, err := opts.UnmarshalVuLoadUnloadRecord(data[recordStart:recordEnd])
+		record, err := opts.UnmarshalVuLoadUnloadRecord(data[recordStart : recordStart+expectedRecordSize])
 		if err != nil {
 			return nil, 0, fmt.Errorf("unmarshal VuLoadUnloadRecord %d: %w", i, err)
 		}
@@ -353,16 +408,10 @@ func parseVuLoadUnloadRecordArray(data []byte, offset int) ([]*ddv1.VuLoadUnload
 
 // Helper functions for marshalling Gen2 V2 RecordArrays
 
-// marshalCardIWRecordsG2V2 marshals CardIWRecords for Gen2v2 (same as V1).
-func marshalCardIWRecordsG2V2(records []*ddv1.VuCardIWRecordG2) ([]byte, error) {
-	// Gen2v2 uses same format as V1
-	return marshalCardIWRecordsG2(records)
-}
-
 // marshalPlaceRecordsG2V2 marshals PlaceRecords for Gen2v2 (same format as V1).
-func marshalPlaceRecordsG2V2(records []*ddv1.PlaceRecordG2) ([]byte, error) {
+func marshalPlaceRecordsG2V2(records []*ddv1.PlaceRecordG2, canvas []byte) ([]byte, error) {
 	// Gen2v2 uses same format as V1
-	return marshalPlaceRecordsG2V1(records)
+	return marshalPlaceRecordsG2V1(records, canvas)
 }
 
 // marshalGnssAccumulatedDrivingRecordsV2 marshals GnssAccumulatedDrivingRecords for Gen2v2.
@@ -415,7 +464,7 @@ func marshalLoadUnloadRecords(records []*ddv1.VuLoadUnloadRecord) ([]byte, error
 // Anonymization strategy (same as V1 plus border crossings and load/unload):
 // - Replaces timestamps with deterministic sequential values
 // - Replaces card numbers and holder names with generic test data
-// - Normalizes locations to generic values (Finland/Helsinki)
+// - Normalizes locations to the anonymized base location and nations
 // - Rounds odometer values to nearest 100km
 // - Clears signatures and raw_data
 func (opts AnonymizeOptions) anonymizeActivitiesGen2V2(activities *vuv1.ActivitiesGen2V2) *vuv1.ActivitiesGen2V2 {
@@ -426,10 +475,7 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V2(activities *vuv1.Activiti
 	result := &vuv1.ActivitiesGen2V2{}
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize date_of_day - use a fixed date (2024-01-01 00:00:00 UTC)
 	baseTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -441,46 +487,7 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V2(activities *vuv1.Activiti
 	result.SetOdometerMidnightKm(roundedOdometer)
 
 	// Anonymize card_iw_data (same as V1)
-	anonCardIW := make([]*ddv1.VuCardIWRecordG2, len(activities.GetCardIwData()))
-	for i, rec := range activities.GetCardIwData() {
-		anonCardIW[i] = &ddv1.VuCardIWRecordG2{}
-
-		// Generic test holder name
-		testSurname := &ddv1.StringValue{}
-		testSurname.SetValue("TEST")
-		testFirstName := &ddv1.StringValue{}
-		testFirstName.SetValue("DRIVER")
-		testName := &ddv1.HolderName{}
-		testName.SetHolderSurname(testSurname)
-		testName.SetHolderFirstNames(testFirstName)
-		anonCardIW[i].SetCardHolderName(testName)
-
-		// Generic test card number - use empty FullCardNumberAndGeneration
-		anonCardIW[i].SetFullCardNumber(&ddv1.FullCardNumberAndGeneration{})
-
-		// Use fixed dates
-		testDate := &ddv1.Date{}
-		testDate.SetYear(2030)
-		testDate.SetMonth(12)
-		testDate.SetDay(31)
-		anonCardIW[i].SetCardExpiryDate(testDate)
-		anonCardIW[i].SetCardInsertionTime(timestamppb.New(baseTime.Add(time.Duration(i*2) * time.Hour)))
-		anonCardIW[i].SetCardWithdrawalTime(timestamppb.New(baseTime.Add(time.Duration(i*2+1) * time.Hour)))
-
-		// Round odometer values
-		anonCardIW[i].SetOdometerAtInsertionKm((rec.GetOdometerAtInsertionKm() / 100) * 100)
-		anonCardIW[i].SetOdometerAtWithdrawalKm((rec.GetOdometerAtWithdrawalKm() / 100) * 100)
-
-		// Preserve slot and manual input flag
-		anonCardIW[i].SetCardSlotNumber(rec.GetCardSlotNumber())
-		anonCardIW[i].SetManualInputFlag(rec.GetManualInputFlag())
-
-		// Anonymize previous vehicle info
-		if prevVehicle := rec.GetPreviousVehicleInfo(); prevVehicle != nil {
-			anonCardIW[i].SetPreviousVehicleInfo(ddOpts.AnonymizePreviousVehicleInfoG2(prevVehicle))
-		}
-	}
-	result.SetCardIwData(anonCardIW)
+	result.SetCardIwData(opts.anonymizeCardIWRecordsG2(activities.GetCardIwData(), baseTime))
 
 	// Anonymize activity_changes
 	anonActivityChanges := make([]*ddv1.ActivityChangeInfo, len(activities.GetActivityChanges()))
@@ -501,17 +508,14 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V2(activities *vuv1.Activiti
 	for i, gnss := range activities.GetGnssAccumulatedDriving() {
 		anonGnss[i] = &ddv1.VuGNSSADRecordG2{}
 		anonGnss[i].SetTimeStamp(timestamppb.New(baseTime.Add(time.Duration(i*3) * time.Hour)))
-		anonGnss[i].SetCardNumberDriverSlot(&ddv1.FullCardNumberAndGeneration{})
-		anonGnss[i].SetCardNumberCodriverSlot(&ddv1.FullCardNumberAndGeneration{})
+		anonGnss[i].SetCardNumberDriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(gnss.GetCardNumberDriverSlot()))
+		anonGnss[i].SetCardNumberCodriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(gnss.GetCardNumberCodriverSlot()))
 
 		// Create anonymized GNSS place auth record
 		gnssAuthRec := &ddv1.GNSSPlaceAuthRecord{}
 		gnssAuthRec.SetTimestamp(timestamppb.New(baseTime.Add(time.Duration(i*3) * time.Hour)))
 		gnssAuthRec.SetGnssAccuracy(gnss.GetGnssPlaceAuthRecord().GetGnssAccuracy())
-		testCoords := &ddv1.GeoCoordinates{}
-		testCoords.SetLatitude(60170000)  // 60.17°N (Helsinki)
-		testCoords.SetLongitude(24940000) // 24.94°E (Helsinki)
-		gnssAuthRec.SetGeoCoordinates(testCoords)
+		gnssAuthRec.SetGeoCoordinates(ddOpts.AnonymizedGeoCoordinates())
 		gnssAuthRec.SetAuthenticationStatus(ddv1.PositionAuthenticationStatus_AUTHENTICATED)
 		anonGnss[i].SetGnssPlaceAuthRecord(gnssAuthRec)
 		anonGnss[i].SetVehicleOdometerKm((gnss.GetVehicleOdometerKm() / 100) * 100)
@@ -525,20 +529,28 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V2(activities *vuv1.Activiti
 	anonBorderCrossings := make([]*ddv1.VuBorderCrossingRecord, len(activities.GetBorderCrossings()))
 	for i, bc := range activities.GetBorderCrossings() {
 		anonBorderCrossings[i] = &ddv1.VuBorderCrossingRecord{}
-		anonBorderCrossings[i].SetCardNumberDriverSlot(&ddv1.FullCardNumberAndGeneration{})
-		anonBorderCrossings[i].SetCardNumberCodriverSlot(&ddv1.FullCardNumberAndGeneration{})
-		anonBorderCrossings[i].SetCountryLeft(ddv1.NationNumeric_FINLAND)
-		anonBorderCrossings[i].SetCountryEntered(ddv1.NationNumeric_SWEDEN)
+		anonBorderCrossings[i].SetCardNumberDriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(bc.GetCardNumberDriverSlot()))
+		anonBorderCrossings[i].SetCardNumberCodriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(bc.GetCardNumberCodriverSlot()))
+		if ddOpts.PreserveNations {
+			anonBorderCrossings[i].SetCountryLeft(bc.GetCountryLeft())
+			anonBorderCrossings[i].SetCountryEntered(bc.GetCountryEntered())
+			if bc.HasUnrecognizedCountryLeft() {
+				anonBorderCrossings[i].SetUnrecognizedCountryLeft(bc.GetUnrecognizedCountryLeft())
+			}
+			if bc.HasUnrecognizedCountryEntered() {
+				anonBorderCrossings[i].SetUnrecognizedCountryEntered(bc.GetUnrecognizedCountryEntered())
+			}
+		} else {
+			anonBorderCrossings[i].SetCountryLeft(ddOpts.AnonymizedHomeNation())
+			anonBorderCrossings[i].SetCountryEntered(ddOpts.AnonymizedNeighborNation())
+		}
 		anonBorderCrossings[i].SetVehicleOdometerKm((bc.GetVehicleOdometerKm() / 100) * 100)
 
 		// Anonymize GNSS auth record
 		anonGnssAuth := &ddv1.GNSSPlaceAuthRecord{}
 		anonGnssAuth.SetTimestamp(timestamppb.New(baseTime.Add(time.Duration(i*4) * time.Hour)))
 		anonGnssAuth.SetGnssAccuracy(10)
-		testCoordsBc := &ddv1.GeoCoordinates{}
-		testCoordsBc.SetLatitude(60170000)
-		testCoordsBc.SetLongitude(24940000)
-		anonGnssAuth.SetGeoCoordinates(testCoordsBc)
+		anonGnssAuth.SetGeoCoordinates(ddOpts.AnonymizedGeoCoordinates())
 		anonGnssAuth.SetAuthenticationStatus(ddv1.PositionAuthenticationStatus_AUTHENTICATED)
 		anonBorderCrossings[i].SetGnssPlaceAuthRecord(anonGnssAuth)
 	}
@@ -550,18 +562,15 @@ func (opts AnonymizeOptions) anonymizeActivitiesGen2V2(activities *vuv1.Activiti
 		anonLoadUnload[i] = &ddv1.VuLoadUnloadRecord{}
 		anonLoadUnload[i].SetTimeStamp(timestamppb.New(baseTime.Add(time.Duration(i*5) * time.Hour)))
 		anonLoadUnload[i].SetOperationType(lu.GetOperationType())
-		anonLoadUnload[i].SetCardNumberDriverSlot(&ddv1.FullCardNumberAndGeneration{})
-		anonLoadUnload[i].SetCardNumberCodriverSlot(&ddv1.FullCardNumberAndGeneration{})
+		anonLoadUnload[i].SetCardNumberDriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(lu.GetCardNumberDriverSlot()))
+		anonLoadUnload[i].SetCardNumberCodriverSlot(ddOpts.AnonymizeFullCardNumberAndGeneration(lu.GetCardNumberCodriverSlot()))
 		anonLoadUnload[i].SetVehicleOdometerKm((lu.GetVehicleOdometerKm() / 100) * 100)
 
 		// Anonymize GNSS auth record
 		anonGnssAuthLu := &ddv1.GNSSPlaceAuthRecord{}
 		anonGnssAuthLu.SetTimestamp(timestamppb.New(baseTime.Add(time.Duration(i*5) * time.Hour)))
 		anonGnssAuthLu.SetGnssAccuracy(10)
-		testCoordsLu := &ddv1.GeoCoordinates{}
-		testCoordsLu.SetLatitude(60170000)
-		testCoordsLu.SetLongitude(24940000)
-		anonGnssAuthLu.SetGeoCoordinates(testCoordsLu)
+		anonGnssAuthLu.SetGeoCoordinates(ddOpts.AnonymizedGeoCoordinates())
 		anonGnssAuthLu.SetAuthenticationStatus(ddv1.PositionAuthenticationStatus_AUTHENTICATED)
 		anonLoadUnload[i].SetGnssPlaceAuthRecord(anonGnssAuthLu)
 	}
@@ -0,0 +1,225 @@
+package vu
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// FieldSpan is a byte range of a transfer value holding one field, such as
+// "VuCertificate" or "VuCardIWRecordArray.records[2]".
+type FieldSpan struct {
+	// Offset is the offset of the field from the start of the transfer value.
+	Offset int
+
+	// Length is the size of the field in bytes.
+	Length int
+
+	// Name is the name of the field, as in the Data Dictionary. Records of
+	// a counted set or RecordArray are indexed from zero.
+	Name string
+}
+
+// String returns the span as "offset+length: name".
+func (s FieldSpan) String() string {
+	return fmt.Sprintf("%d+%d: %s", s.Offset, s.Length, s.Name)
+}
+
+// AnnotateTransfer labels the byte ranges of a transfer value, which follows
+// the 2-byte tag of a transfer response (TREP) of the given type, with the
+// fields they hold.
+//
+// The spans are contiguous and follow the layout used by TransferSize: fields
+// of Generation 1 transfers have fixed sizes or are sets of fixed-size records
+// preceded by a count, and Generation 2 transfers are sequences of
+// RecordArrays, each annotated as its header and its records. Records are not
+// broken down further. CARD_DOWNLOAD transfers are annotated as their TLV
+// records.
+//
+// If data is too short for the layout, the spans up to the missing field are
+// returned together with an error, so that a truncated or misaligned transfer
+// can still be inspected. Bytes after the transfer are not annotated.
+func AnnotateTransfer(data []byte, transferType vuv1.TransferType) ([]FieldSpan, error) {
+	switch transferType {
+	case vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION:
+		return annotateTransferFields(data, downloadInterfaceVersionFields)
+	case vuv1.TransferType_OVERVIEW_GEN1:
+		return annotateTransferFields(data, overviewGen1Fields)
+	case vuv1.TransferType_ACTIVITIES_GEN1:
+		return annotateTransferFields(data, activitiesGen1Fields)
+	case vuv1.TransferType_EVENTS_AND_FAULTS_GEN1:
+		return annotateTransferFields(data, eventsAndFaultsGen1Fields)
+	case vuv1.TransferType_DETAILED_SPEED_GEN1:
+		return annotateTransferFields(data, detailedSpeedGen1Fields)
+	case vuv1.TransferType_TECHNICAL_DATA_GEN1:
+		return annotateTransferFields(data, technicalDataGen1Fields)
+	case vuv1.TransferType_OVERVIEW_GEN2_V1:
+		return annotateRecordArrays(data, overviewGen2V1RecordArrays)
+	case vuv1.TransferType_OVERVIEW_GEN2_V2:
+		return annotateRecordArrays(data, overviewGen2V2RecordArrays)
+	case vuv1.TransferType_ACTIVITIES_GEN2_V1:
+		return annotateRecordArrays(data, activitiesGen2V1RecordArrays)
+	case vuv1.TransferType_ACTIVITIES_GEN2_V2:
+		return annotateRecordArrays(data, activitiesGen2V2RecordArrays)
+	case vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V1:
+		return annotateRecordArrays(data, eventsAndFaultsGen2V1RecordArrays)
+	case vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V2:
+		return annotateRecordArrays(data, eventsAndFaultsGen2V2RecordArrays)
+	case vuv1.TransferType_DETAILED_SPEED_GEN2:
+		return annotateRecordArrays(data, detailedSpeedGen2RecordArrays)
+	case vuv1.TransferType_TECHNICAL_DATA_GEN2_V1, vuv1.TransferType_TECHNICAL_DATA_GEN2_V2:
+		return annotateRecordArrays(data, technicalDataGen2RecordArrays)
+	case vuv1.TransferType_CARD_DOWNLOAD:
+		return annotateCardDownload(data)
+	default:
+		return nil, fmt.Errorf("unsupported transfer type: %v", transferType)
+	}
+}
+
+// transferField is a field of a Generation 1 transfer value.
+type transferField struct {
+	// name is the name of the field.
+	name string
+
+	// size is the size of the field, or of each of its records if the field
+	// is a counted set of records.
+	size int
+
+	// countName and countSize are the name and size of the count preceding
+	// the records of a counted set. countSize is zero for fixed-size fields.
+	countName string
+	countSize int
+
+	// recordsName is the name of the records of a counted set.
+	recordsName string
+}
+
+// The layouts of the Generation 1 transfer values, see Appendix 7, Section 2.2.6.
+// They match the sizes used by the sizeOf functions of each transfer.
+var (
+	downloadInterfaceVersionFields = []transferField{
+		{name: "DownloadInterfaceVersion", size: 2},
+	}
+
+	overviewGen1Fields = []transferField{
+		{name: "MemberStateCertificate", size: 194},
+		{name: "VuCertificate", size: 194},
+		{name: "VehicleIdentificationNumber", size: 17},
+		{name: "VehicleRegistrationIdentification", size: 15},
+		{name: "CurrentDateTime", size: 4},
+		{name: "VuDownloadablePeriod", size: 8},
+		{name: "CardSlotsStatus", size: 1},
+		{name: "VuDownloadActivityData", size: 58},
+		{name: "VuCompanyLocksData", countName: "noOfLocks", countSize: 1, recordsName: "vuCompanyLocksRecords", size: 98},
+		{name: "VuControlActivityData", countName: "noOfControls", countSize: 1, recordsName: "vuControlActivityRecords", size: 31},
+		{name: "Signature", size: 128},
+	}
+
+	activitiesGen1Fields = []transferField{
+		{name: "TimeReal", size: 4},
+		{name: "OdometerValueMidnight", size: 3},
+		{name: "VuCardIWData", countName: "noOfIWRecords", countSize: 2, recordsName: "vuCardIWRecords", size: 129},
+		{name: "VuActivityDailyData", countName: "noOfActivityChanges", countSize: 2, recordsName: "activityChangeInfos", size: 2},
+		{name: "VuPlaceDailyWorkPeriodData", countName: "noOfPlaceRecords", countSize: 1, recordsName: "vuPlaceDailyWorkPeriodRecords", size: 28},
+		{name: "VuSpecificConditionData", countName: "noOfSpecificConditionRecords", countSize: 2, recordsName: "specificConditionRecords", size: 5},
+		{name: "Signature", size: 128},
+	}
+
+	eventsAndFaultsGen1Fields = []transferField{
+		{name: "VuFaultData", countName: "noOfVuFaults", countSize: 1, recordsName: "vuFaultRecords", size: 82},
+		{name: "VuEventData", countName: "noOfVuEvents", countSize: 1, recordsName: "vuEventRecords", size: 83},
+		{name: "VuOverSpeedingControlData", size: 9},
+		{name: "VuOverSpeedingEventData", countName: "noOfVuOverSpeedingEvents", countSize: 1, recordsName: "vuOverSpeedingEventRecords", size: 31},
+		{name: "VuTimeAdjustmentData", countName: "noOfVuTimeAdjRecords", countSize: 1, recordsName: "vuTimeAdjustmentRecords", size: 98},
+		{name: "Signature", size: 128},
+	}
+
+	detailedSpeedGen1Fields = []transferField{
+		{name: "VuDetailedSpeedData", countName: "noOfSpeedBlocks", countSize: 2, recordsName: "vuDetailedSpeedBlocks", size: 64},
+		{name: "Signature", size: 128},
+	}
+
+	technicalDataGen1Fields = []transferField{
+		{name: "VuIdentification", size: 116},
+		{name: "SensorPaired", size: 20},
+		{name: "VuCalibrationData", countName: "noOfVuCalibrationRecords", countSize: 1, recordsName: "vuCalibrationRecords", size: 167},
+		{name: "Signature", size: 128},
+	}
+)
+
+// annotateTransferFields annotates a transfer value with the given layout.
+func annotateTransferFields(data []byte, fields []transferField) ([]FieldSpan, error) {
+	var spans []FieldSpan
+	offset := 0
+	appendSpan := func(name string, length int) error {
+		if offset+length > len(data) {
+			return fmt.Errorf("insufficient data for %s at offset %d: need %d, have %d: %w", name, offset, length, len(data)-offset, io.ErrUnexpectedEOF)
+		}
+		spans = append(spans, FieldSpan{Offset: offset, Length: length, Name: name})
+		offset += length
+		return nil
+	}
+	for _, field := range fields {
+		if field.countSize == 0 {
+			if err := appendSpan(field.name, field.size); err != nil {
+				return spans, err
+			}
+			continue
+		}
+		countOffset := offset
+		if err := appendSpan(field.name+"."+field.countName, field.countSize); err != nil {
+			return spans, err
+		}
+		var count int
+		if field.countSize == 2 {
+			count = int(binary.BigEndian.Uint16(data[countOffset:]))
+		} else {
+			count = int(data[countOffset])
+		}
+		for i := range count {
+			if err := appendSpan(fmt.Sprintf("%s.%s[%d]", field.name, field.recordsName, i), field.size); err != nil {
+				return spans, err
+			}
+		}
+	}
+	return spans, nil
+}
+
+// annotateRecordArrays annotates a Gen2 transfer value consisting of the
+// named RecordArrays followed by a SignatureRecordArray.
+func annotateRecordArrays(data []byte, names []string) ([]FieldSpan, error) {
+	var spans []FieldSpan
+	offset := 0
+	for _, name := range append(names[:len(names):len(names)], "SignatureRecordArray") {
+		_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
+		if err != nil {
+			return spans, fmt.Errorf("%s: %w", name, err)
+		}
+		spans = append(spans, FieldSpan{Offset: offset, Length: headerSize, Name: name + ".header"})
+		offset += headerSize
+		for i := range int(noOfRecords) {
+			spans = append(spans, FieldSpan{Offset: offset, Length: int(recordSize), Name: fmt.Sprintf("%s.records[%d]", name, i)})
+			offset += int(recordSize)
+		}
+	}
+	return spans, nil
+}
+
+// annotateCardDownload annotates the TLV records of a card download transfer
+// value, see sizeOfCardDownload.
+func annotateCardDownload(data []byte) ([]FieldSpan, error) {
+	const tlvHeaderSize = 5
+	var spans []FieldSpan
+	offset := 0
+	for i := 0; offset+tlvHeaderSize <= len(data); i++ {
+		recordSize := tlvHeaderSize + int(binary.BigEndian.Uint16(data[offset+3:]))
+		if offset+recordSize > len(data) {
+			return spans, fmt.Errorf("incomplete TLV record at offset %d: need %d bytes, have %d", offset, recordSize, len(data)-offset)
+		}
+		spans = append(spans, FieldSpan{Offset: offset, Length: recordSize, Name: fmt.Sprintf("records[%d]", i)})
+		offset += recordSize
+	}
+	return spans, nil
+}
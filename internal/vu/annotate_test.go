@@ -0,0 +1,83 @@
+package vu
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestAnnotateTransfer_testdata(t *testing.T) {
+	hexdumpFiles, err := filepath.Glob("testdata/records/*/*.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to glob hexdump files: %v", err)
+	}
+	for _, hexdumpPath := range hexdumpFiles {
+		name := strings.TrimSuffix(filepath.Base(hexdumpPath), ".hexdump")
+		transferType := vuv1.TransferType(vuv1.TransferType_value[name[strings.IndexByte(name, '-')+1:]])
+		t.Run(filepath.Base(filepath.Dir(hexdumpPath))+"/"+name, func(t *testing.T) {
+			data, err := readHexdump(hexdumpPath)
+			if err != nil {
+				t.Fatalf("Failed to read hexdump: %v", err)
+			}
+			spans, err := AnnotateTransfer(data, transferType)
+			if err != nil {
+				t.Fatalf("AnnotateTransfer() error = %v", err)
+			}
+			wantSize, _, err := TransferSize(data, transferType)
+			if err != nil {
+				t.Fatalf("TransferSize() error = %v", err)
+			}
+			offset := 0
+			for _, span := range spans {
+				if span.Offset != offset {
+					t.Fatalf("span %v starts at %d, want %d", span, span.Offset, offset)
+				}
+				offset += span.Length
+			}
+			if offset != wantSize {
+				t.Errorf("spans cover %d bytes, want %d", offset, wantSize)
+			}
+		})
+	}
+}
+
+func TestAnnotateTransfer(t *testing.T) {
+	// A Gen2 detailed speed transfer with one speed block of 64 bytes and a
+	// 64-byte signature.
+	data := []byte{0x0B, 0x00, 0x40, 0x00, 0x01}
+	data = append(data, make([]byte, 64)...)
+	data = append(data, 0x08, 0x00, 0x40, 0x00, 0x01)
+	data = append(data, make([]byte, 64)...)
+	spans, err := AnnotateTransfer(data, vuv1.TransferType_DETAILED_SPEED_GEN2)
+	if err != nil {
+		t.Fatalf("AnnotateTransfer() error = %v", err)
+	}
+	want := []FieldSpan{
+		{Offset: 0, Length: 5, Name: "VuDetailedSpeedBlockRecordArray.header"},
+		{Offset: 5, Length: 64, Name: "VuDetailedSpeedBlockRecordArray.records[0]"},
+		{Offset: 69, Length: 5, Name: "SignatureRecordArray.header"},
+		{Offset: 74, Length: 64, Name: "SignatureRecordArray.records[0]"},
+	}
+	if diff := cmp.Diff(want, spans); diff != "" {
+		t.Errorf("AnnotateTransfer() mismatch (-want +got):\n%s", diff)
+	}
+
+	// A Gen1 detailed speed transfer declaring two speed blocks, truncated
+	// in the second one.
+	data = append([]byte{0x00, 0x02}, make([]byte, 100)...)
+	spans, err = AnnotateTransfer(data, vuv1.TransferType_DETAILED_SPEED_GEN1)
+	if err == nil || !strings.Contains(err.Error(), "VuDetailedSpeedData.vuDetailedSpeedBlocks[1] at offset 66") {
+		t.Errorf("AnnotateTransfer() error = %v, want error for the second speed block", err)
+	}
+	want = []FieldSpan{
+		{Offset: 0, Length: 2, Name: "VuDetailedSpeedData.noOfSpeedBlocks"},
+		{Offset: 2, Length: 64, Name: "VuDetailedSpeedData.vuDetailedSpeedBlocks[0]"},
+	}
+	if diff := cmp.Diff(want, spans); diff != "" {
+		t.Errorf("AnnotateTransfer() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -1,6 +1,7 @@
 package vu
 
 import (
+	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 	"google.golang.org/protobuf/proto"
@@ -13,6 +14,25 @@ type AnonymizeOptions struct {
 
 	// PreserveTimestamps controls whether timestamps are preserved.
 	PreserveTimestamps bool
+
+	// BaseLatitude and BaseLongitude are the coordinates replacing anonymized
+	// positions, encoded as GeoCoordinates (±DDMM.M * 10).
+	// If both are zero, Helsinki (60°10.0'N 24°56.0'E) is used.
+	BaseLatitude, BaseLongitude int32
+
+	// HomeNation replaces anonymized nations. If unspecified, Finland is used.
+	HomeNation ddv1.NationNumeric
+
+	// NeighborNation is the country entered by anonymized border crossings.
+	// If unspecified, Sweden is used.
+	NeighborNation ddv1.NationNumeric
+
+	// PreserveNations keeps the original nations and regions.
+	PreserveNations bool
+
+	// Key is the secret key deriving anonymized card numbers.
+	// If empty, dd.DefaultAnonymizationKey is used.
+	Key []byte
 }
 
 // AnonymizeVehicleUnitFile creates an anonymized copy of a vehicle unit file.
@@ -150,3 +170,17 @@ func (opts AnonymizeOptions) AnonymizeVehicleUnitFile(file *vuv1.VehicleUnitFile
 
 	return result, nil
 }
+
+// ddAnonymizeOptions returns the data dictionary anonymize options matching opts.
+func (opts AnonymizeOptions) ddAnonymizeOptions() dd.AnonymizeOptions {
+	return dd.AnonymizeOptions{
+		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
+		PreserveTimestamps:       opts.PreserveTimestamps,
+		BaseLatitude:             opts.BaseLatitude,
+		BaseLongitude:            opts.BaseLongitude,
+		HomeNation:               opts.HomeNation,
+		NeighborNation:           opts.NeighborNation,
+		PreserveNations:          opts.PreserveNations,
+		Key:                      opts.Key,
+	}
+}
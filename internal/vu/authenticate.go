@@ -5,8 +5,10 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/cert"
+	"github.com/way-platform/tachograph-go/internal/dd"
 	"github.com/way-platform/tachograph-go/internal/security"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
@@ -73,7 +75,7 @@ func (opts AuthenticateOptions) authenticateRecord(ctx context.Context, record *
 		return opts.authenticateGen2Record(ctx, record, allRecords, auth)
 	default:
 		auth.SetStatus(securityv1.Authentication_CERTIFICATE_VERIFICATION_FAILED)
-		return fmt.Errorf("unsupported generation for %v: %v", transferType, generation)
+		return fmt.Errorf("%w for %v: %v", dd.ErrUnsupportedGeneration, transferType, generation)
 	}
 }
 
@@ -188,7 +190,7 @@ func (opts AuthenticateOptions) extractGen2Certificates(overviewRecord *vuv1.Raw
 
 	// Parse MSCA certificate RecordArray
 	if len(data) < 5 {
-		return nil, nil, fmt.Errorf("insufficient data for MSCA RecordArray header")
+		return nil, nil, fmt.Errorf("insufficient data for MSCA RecordArray header: %w", io.ErrUnexpectedEOF)
 	}
 
 	mscaRecordSize := int(binary.BigEndian.Uint16(data[1:3]))
@@ -200,7 +202,7 @@ func (opts AuthenticateOptions) extractGen2Certificates(overviewRecord *vuv1.Raw
 
 	mscaArraySize := 5 + mscaRecordSize // header + data
 	if len(data) < mscaArraySize {
-		return nil, nil, fmt.Errorf("insufficient data for MSCA certificate: need %d, have %d", mscaArraySize, len(data))
+		return nil, nil, fmt.Errorf("insufficient data for MSCA certificate: need %d, have %d: %w", mscaArraySize, len(data), io.ErrUnexpectedEOF)
 	}
 
 	mscaCertData := data[5:mscaArraySize]
@@ -208,7 +210,7 @@ func (opts AuthenticateOptions) extractGen2Certificates(overviewRecord *vuv1.Raw
 	// Parse VU certificate RecordArray
 	vuArrayStart := mscaArraySize
 	if len(data) < vuArrayStart+5 {
-		return nil, nil, fmt.Errorf("insufficient data for VU RecordArray header")
+		return nil, nil, fmt.Errorf("insufficient data for VU RecordArray header: %w", io.ErrUnexpectedEOF)
 	}
 
 	vuRecordSize := int(binary.BigEndian.Uint16(data[vuArrayStart+1 : vuArrayStart+3]))
@@ -220,7 +222,7 @@ func (opts AuthenticateOptions) extractGen2Certificates(overviewRecord *vuv1.Raw
 
 	vuArraySize := 5 + vuRecordSize
 	if len(data) < vuArrayStart+vuArraySize {
-		return nil, nil, fmt.Errorf("insufficient data for VU certificate: need %d, have %d", vuArrayStart+vuArraySize, len(data))
+		return nil, nil, fmt.Errorf("insufficient data for VU certificate: need %d, have %d: %w", vuArrayStart+vuArraySize, len(data), io.ErrUnexpectedEOF)
 	}
 
 	vuCertData := data[vuArrayStart+5 : vuArrayStart+vuArraySize]
@@ -239,27 +241,14 @@ func (opts AuthenticateOptions) extractGen2Certificates(overviewRecord *vuv1.Raw
 	return vuCert, mscaCert, nil
 }
 
-// verifyGen2CertificateChain verifies the Gen2 certificate chain: EUR Root (ECC) -> MSCA (ECC) -> VU (ECC)
+// verifyGen2CertificateChain verifies the Gen2 certificate chain: EUR Root (ECC) -> MSCA (ECC) -> VU (ECC).
+// Link certificates between the MSCA and the EUR root are fetched from the resolver.
 func (opts AuthenticateOptions) verifyGen2CertificateChain(ctx context.Context, vuCert *securityv1.EccCertificate, mscaCert *securityv1.EccCertificate, auth *securityv1.Authentication) error {
-	// Get Gen2 ECC root certificate
-	rootCert, err := opts.CertificateResolver.GetEccRootCertificate(ctx)
-	if err != nil {
-		auth.SetStatus(securityv1.Authentication_CERTIFICATE_VERIFICATION_FAILED)
-		return fmt.Errorf("failed to get Gen2 root certificate: %w", err)
-	}
-
-	// Verify MSCA certificate against EUR Gen2 root (both ECC)
-	if err := security.VerifyEccCertificateWithEccRoot(mscaCert, rootCert); err != nil {
-		auth.SetStatus(securityv1.Authentication_CERTIFICATE_VERIFICATION_FAILED)
-		return fmt.Errorf("MSCA certificate verification failed: %w", err)
-	}
-
-	// Verify VU certificate against MSCA
-	if err := security.VerifyEccCertificateWithCA(vuCert, mscaCert); err != nil {
+	intermediates := []*securityv1.EccCertificate{mscaCert}
+	if _, err := security.VerifyEccCertificateChain(ctx, vuCert, intermediates, opts.CertificateResolver); err != nil {
 		auth.SetStatus(securityv1.Authentication_CERTIFICATE_VERIFICATION_FAILED)
 		return fmt.Errorf("VU certificate verification failed: %w", err)
 	}
-
 	return nil
 }
 
@@ -273,14 +262,27 @@ func (opts AuthenticateOptions) verifyGen2DataSignature(record *vuv1.RawVehicleU
 
 	if len(signature) == 0 {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("no signature present in Gen2 record")
+		return fmt.Errorf("%w: no signature present in Gen2 record", dd.ErrInvalidSignature)
 	}
 
-	// For Gen2, the signature is over all the data in the transfer
-	// The signature format is plain ECDSA (R || S)
-	if err := security.VerifyEccDataSignature(data, signature, vuCert); err != nil {
+	signatures, err := parseSignatureRecordArray(signature)
+	if err != nil {
+		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
+		return fmt.Errorf("failed to parse signature: %w", err)
+	}
+	data, err = SignedBytes(record)
+	if err != nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("data signature verification failed: %w", err)
+		return err
+	}
+
+	// For Gen2, each signature is over all the data in the transfer
+	// The signature format is plain ECDSA (R || S)
+	for i, signature := range signatures {
+		if err := security.VerifyEccDataSignature(data, signature, vuCert); err != nil {
+			auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
+			return fmt.Errorf("data signature %d verification failed: %w: %w", i, dd.ErrInvalidSignature, err)
+		}
 	}
 
 	return nil
@@ -316,7 +318,7 @@ func (opts AuthenticateOptions) extractGen1Certificates(overviewRecord *vuv1.Raw
 	const minDataLen = 388 // Two certificates
 
 	if len(data) < minDataLen {
-		return nil, nil, fmt.Errorf("insufficient data for certificates: got %d, need at least %d", len(data), minDataLen)
+		return nil, nil, fmt.Errorf("insufficient data for certificates: got %d, need at least %d: %w", len(data), minDataLen, io.ErrUnexpectedEOF)
 	}
 
 	// Extract MSCA certificate
@@ -363,7 +365,7 @@ func (opts AuthenticateOptions) verifyGen1CertificateChain(ctx context.Context,
 
 // verifyGen1DataSignature verifies the RSA signature on the data portion of a Gen1 record.
 func (opts AuthenticateOptions) verifyGen1DataSignature(record *vuv1.RawVehicleUnitFile_Record, vuCert *securityv1.RsaCertificate, auth *securityv1.Authentication) error {
-	allData, signature, err := splitTransferValue(record)
+	_, signature, err := splitTransferValue(record)
 	if err != nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
 		return fmt.Errorf("failed to split transfer value: %w", err)
@@ -376,47 +378,17 @@ func (opts AuthenticateOptions) verifyGen1DataSignature(record *vuv1.RawVehicleU
 		return fmt.Errorf("invalid signature length for Gen1: got %d, want %d", len(signature), lenRsaSignature)
 	}
 
-	// For Overview (TREP 01), Technical Data (TREP 05), and other Gen1 records,
-	// the signature is over the data AFTER the certificates.
-	// Each RSA certificate is 194 bytes: MSCA cert + VU cert = 388 bytes total.
-	var signedData []byte
-	transferType := record.GetType()
-
-	switch transferType {
-	case vuv1.TransferType_OVERVIEW_GEN1:
-		// Overview: Signature covers data from VehicleIdentificationNumber onwards (after 2 certificates)
-		const lenCertificates = 388 // 194 bytes MSCA + 194 bytes VU
-		if len(allData) <= lenCertificates {
-			auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-			return fmt.Errorf("insufficient data for Overview signature verification: got %d, need > %d", len(allData), lenCertificates)
-		}
-		signedData = allData[lenCertificates:]
-
-	case vuv1.TransferType_TECHNICAL_DATA_GEN1:
-		// Technical Data: Signature covers all data (no certificates in this record)
-		signedData = allData
-
-	case vuv1.TransferType_ACTIVITIES_GEN1:
-		// Activities: Signature covers all data (no certificates in this record)
-		signedData = allData
-
-	case vuv1.TransferType_EVENTS_AND_FAULTS_GEN1:
-		// Events/Faults: Signature covers all data (no certificates in this record)
-		signedData = allData
-
-	case vuv1.TransferType_DETAILED_SPEED_GEN1:
-		// Detailed Speed: Signature covers all data (no certificates in this record)
-		signedData = allData
-
-	default:
-		// For unknown transfer types, assume signature covers all data
-		signedData = allData
+	// The signature of the Overview starts after the certificates.
+	signedData, err := SignedBytes(record)
+	if err != nil {
+		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
+		return err
 	}
 
 	// Verify the signature using PKCS#1 v1.5
 	if err := security.VerifyRsaDataSignature(signedData, signature, vuCert); err != nil {
 		auth.SetStatus(securityv1.Authentication_DATA_SIGNATURE_INVALID)
-		return fmt.Errorf("data signature verification failed: %w", err)
+		return fmt.Errorf("data signature verification failed: %w: %w", dd.ErrInvalidSignature, err)
 	}
 
 	return nil
@@ -26,7 +26,7 @@ func sizeOfCardDownload(data []byte, transferType vuv1.TransferType) (totalSize,
 	for offset < len(data) {
 		// Need at least 5 bytes for TLV header (3-byte tag + 2-byte length)
 		const tlvHeaderSize = 5
-		if len(data[offset:]) < tlvHeaderSize {
+		if len(data) < offset+tlvHeaderSize {
 			// If we have less than a full header, we've reached the end
 			break
 		}
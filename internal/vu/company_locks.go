@@ -0,0 +1,150 @@
+package vu
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LockIssueKind identifies a finding about the company locks of a VU.
+type LockIssueKind int
+
+const (
+	// LockIssueActive is a lock without a lock-out time, i.e. a lock that
+	// is still active.
+	LockIssueActive LockIssueKind = iota + 1
+
+	// LockIssueOverlap is a pair of locks by the same company whose periods
+	// overlap.
+	LockIssueOverlap
+
+	// LockIssueConflict is a pair of locks by different companies whose
+	// periods overlap. A VU ends the lock of a company when another company
+	// locks in, so this indicates inconsistent lock records.
+	LockIssueConflict
+)
+
+// String returns the name of the lock issue kind.
+func (k LockIssueKind) String() string {
+	switch k {
+	case LockIssueActive:
+		return "ACTIVE"
+	case LockIssueOverlap:
+		return "OVERLAP"
+	case LockIssueConflict:
+		return "CONFLICT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// LockIssue is a finding about one or two company locks.
+type LockIssue struct {
+	// Kind identifies the finding.
+	Kind LockIssueKind
+
+	// Locks are the indices of the locks concerned in the analyzed slice:
+	// one lock for LockIssueActive, and two for overlapping locks.
+	Locks []int
+}
+
+// AnalyzeCompanyLocks reports the active and overlapping company locks of a
+// Gen1 overview.
+//
+// The records of VuCompanyLocksData are specified in the Data Dictionary,
+// Section 2.184. A lock without a lock-out time lasts indefinitely, so it
+// overlaps all locks starting after it. Companies are identified by the
+// issuing member state and owner identification of their company card, or by
+// their name if the card number is missing. Records without a lock-in time
+// are ignored.
+//
+// Issues are ordered by the index of their first lock; an active lock is
+// reported before its overlaps.
+func AnalyzeCompanyLocks(locks []*vuv1.OverviewGen1_CompanyLock) []LockIssue {
+	periods := make([]companyLockPeriod, len(locks))
+	for i, lock := range locks {
+		periods[i] = newCompanyLockPeriod(lock.GetLockInTime(), lock.GetLockOutTime(), lock.GetCompanyCardNumber(), lock.GetCompanyName())
+	}
+	return analyzeCompanyLockPeriods(periods)
+}
+
+// AnalyzeCompanyLocksGen2V1 reports the active and overlapping company locks
+// of a Gen2 V1 overview, see AnalyzeCompanyLocks.
+func AnalyzeCompanyLocksGen2V1(locks []*vuv1.OverviewGen2V1_CompanyLock) []LockIssue {
+	periods := make([]companyLockPeriod, len(locks))
+	for i, lock := range locks {
+		periods[i] = newCompanyLockPeriod(lock.GetLockInTime(), lock.GetLockOutTime(), lock.GetCompanyCardNumberAndGeneration().GetFullCardNumber(), lock.GetCompanyName())
+	}
+	return analyzeCompanyLockPeriods(periods)
+}
+
+// AnalyzeCompanyLocksGen2V2 reports the active and overlapping company locks
+// of a Gen2 V2 overview, see AnalyzeCompanyLocks.
+func AnalyzeCompanyLocksGen2V2(locks []*vuv1.OverviewGen2V2_CompanyLock) []LockIssue {
+	periods := make([]companyLockPeriod, len(locks))
+	for i, lock := range locks {
+		periods[i] = newCompanyLockPeriod(lock.GetLockInTime(), lock.GetLockOutTime(), lock.GetCompanyCardNumberAndGeneration().GetFullCardNumber(), lock.GetCompanyName())
+	}
+	return analyzeCompanyLockPeriods(periods)
+}
+
+// companyLockPeriod is a company lock of either generation.
+type companyLockPeriod struct {
+	// lockIn and lockOut bound the lock. lockOut is zero while the lock is
+	// active, and lockIn is zero for records without a lock-in time.
+	lockIn, lockOut time.Time
+
+	// company identifies the company that locked in.
+	company string
+}
+
+// newCompanyLockPeriod returns the period of a company lock.
+func newCompanyLockPeriod(lockIn, lockOut *timestamppb.Timestamp, card *ddv1.FullCardNumber, name *ddv1.StringValue) companyLockPeriod {
+	var period companyLockPeriod
+	if !dd.IsUnsetTime(lockIn) {
+		period.lockIn = lockIn.AsTime().UTC()
+	}
+	if !dd.IsUnsetTime(lockOut) {
+		period.lockOut = lockOut.AsTime().UTC()
+	}
+	if owner := card.GetOwnerIdentification().GetOwnerIdentification().GetValue(); owner != "" {
+		period.company = fmt.Sprintf("%v/%s", card.GetCardIssuingMemberState(), owner)
+	} else {
+		period.company = name.GetValue()
+	}
+	return period
+}
+
+// overlaps reports whether the periods of two locks overlap.
+func (p companyLockPeriod) overlaps(other companyLockPeriod) bool {
+	return (p.lockOut.IsZero() || other.lockIn.Before(p.lockOut)) &&
+		(other.lockOut.IsZero() || p.lockIn.Before(other.lockOut))
+}
+
+func analyzeCompanyLockPeriods(periods []companyLockPeriod) []LockIssue {
+	var issues []LockIssue
+	for i, period := range periods {
+		if period.lockIn.IsZero() {
+			continue
+		}
+		if period.lockOut.IsZero() {
+			issues = append(issues, LockIssue{Kind: LockIssueActive, Locks: []int{i}})
+		}
+		for j := i + 1; j < len(periods); j++ {
+			other := periods[j]
+			if other.lockIn.IsZero() || !period.overlaps(other) {
+				continue
+			}
+			kind := LockIssueOverlap
+			if period.company != other.company {
+				kind = LockIssueConflict
+			}
+			issues = append(issues, LockIssue{Kind: kind, Locks: []int{i, j}})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,105 @@
+package vu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestAnalyzeCompanyLocks(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newLock := func(lockIn, lockOut time.Duration, company string) *vuv1.OverviewGen1_CompanyLock {
+		owner := &ddv1.Ia5StringValue{}
+		owner.SetValue(company)
+		ownerIdentification := &ddv1.OwnerIdentification{}
+		ownerIdentification.SetOwnerIdentification(owner)
+		card := &ddv1.FullCardNumber{}
+		card.SetCardIssuingMemberState(ddv1.NationNumeric_GERMANY)
+		card.SetOwnerIdentification(ownerIdentification)
+		lock := &vuv1.OverviewGen1_CompanyLock{}
+		lock.SetLockInTime(timestamppb.New(day.Add(lockIn)))
+		if lockOut != 0 {
+			lock.SetLockOutTime(timestamppb.New(day.Add(lockOut)))
+		}
+		lock.SetCompanyCardNumber(card)
+		return lock
+	}
+	for _, tt := range []struct {
+		name  string
+		locks []*vuv1.OverviewGen1_CompanyLock
+		want  []LockIssue
+	}{
+		{
+			name: "consecutive locks",
+			locks: []*vuv1.OverviewGen1_CompanyLock{
+				newLock(1*time.Hour, 2*time.Hour, "C000000000001"),
+				newLock(2*time.Hour, 3*time.Hour, "C000000000002"),
+			},
+		},
+		{
+			name: "active lock",
+			locks: []*vuv1.OverviewGen1_CompanyLock{
+				newLock(1*time.Hour, 2*time.Hour, "C000000000001"),
+				newLock(3*time.Hour, 0, "C000000000002"),
+			},
+			want: []LockIssue{
+				{Kind: LockIssueActive, Locks: []int{1}},
+			},
+		},
+		{
+			name: "overlap by same company",
+			locks: []*vuv1.OverviewGen1_CompanyLock{
+				newLock(1*time.Hour, 3*time.Hour, "C000000000001"),
+				newLock(2*time.Hour, 4*time.Hour, "C000000000001"),
+			},
+			want: []LockIssue{
+				{Kind: LockIssueOverlap, Locks: []int{0, 1}},
+			},
+		},
+		{
+			name: "active lock overlapped by other company",
+			locks: []*vuv1.OverviewGen1_CompanyLock{
+				newLock(1*time.Hour, 0, "C000000000001"),
+				newLock(5*time.Hour, 6*time.Hour, "C000000000002"),
+				newLock(7*time.Hour, 8*time.Hour, "C000000000001"),
+			},
+			want: []LockIssue{
+				{Kind: LockIssueActive, Locks: []int{0}},
+				{Kind: LockIssueConflict, Locks: []int{0, 1}},
+				{Kind: LockIssueOverlap, Locks: []int{0, 2}},
+			},
+		},
+		{
+			name: "active lock with all-ones lock-out time",
+			locks: func() []*vuv1.OverviewGen1_CompanyLock {
+				lock := newLock(1*time.Hour, 0, "C000000000001")
+				lock.SetLockOutTime(&timestamppb.Timestamp{Seconds: 0xFFFFFFFF})
+				return []*vuv1.OverviewGen1_CompanyLock{lock}
+			}(),
+			want: []LockIssue{
+				{Kind: LockIssueActive, Locks: []int{0}},
+			},
+		},
+		{
+			name: "empty record",
+			locks: []*vuv1.OverviewGen1_CompanyLock{
+				newLock(1*time.Hour, 0, "C000000000001"),
+				{},
+			},
+			want: []LockIssue{
+				{Kind: LockIssueActive, Locks: []int{0}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, AnalyzeCompanyLocks(tt.locks)); diff != "" {
+				t.Errorf("AnalyzeCompanyLocks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package vu
+
+import (
+	"time"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ControlActivity is a control performed with the vehicle unit, as recorded in
+// the overview transfer of any generation.
+type ControlActivity struct {
+	// ControlType is the type of the control.
+	ControlType *ddv1.ControlType
+
+	// ControlTime is the date and time of the control.
+	ControlTime time.Time
+
+	// ControlCardNumber is the card number of the control card used.
+	ControlCardNumber *ddv1.FullCardNumber
+
+	// ControlCardGeneration is the generation of the control card used.
+	// It is unspecified for Gen1 VUs, which do not record it.
+	ControlCardGeneration ddv1.Generation
+
+	// DownloadPeriodBegin and DownloadPeriodEnd are the bounds of the period
+	// downloaded during the control, if any.
+	DownloadPeriodBegin, DownloadPeriodEnd time.Time
+}
+
+// ControlActivities returns the control activities recorded in the overview of
+// a VU download, regardless of its generation.
+//
+// The data type `VuControlActivityRecord` is specified in the Data Dictionary, Section 2.187.
+func ControlActivities(file *vuv1.VehicleUnitFile) []*ControlActivity {
+	var result []*ControlActivity
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		for _, record := range file.GetGen1().GetOverview().GetControlActivities() {
+			result = append(result, &ControlActivity{
+				ControlType:         record.GetControlType(),
+				ControlTime:         controlActivityTime(record.GetControlTime()),
+				ControlCardNumber:   record.GetControlCardNumber(),
+				DownloadPeriodBegin: controlActivityTime(record.GetDownloadPeriodBeginTime()),
+				DownloadPeriodEnd:   controlActivityTime(record.GetDownloadPeriodEndTime()),
+			})
+		}
+	case ddv1.Generation_GENERATION_2:
+		if file.GetVersion() == ddv1.Version_VERSION_2 {
+			for _, record := range file.GetGen2V2().GetOverview().GetControlActivities() {
+				result = append(result, &ControlActivity{
+					ControlType:           record.GetControlType(),
+					ControlTime:           controlActivityTime(record.GetControlTime()),
+					ControlCardNumber:     record.GetControlCardNumberAndGeneration().GetFullCardNumber(),
+					ControlCardGeneration: record.GetControlCardNumberAndGeneration().GetGeneration(),
+					DownloadPeriodBegin:   controlActivityTime(record.GetDownloadPeriodBeginTime()),
+					DownloadPeriodEnd:     controlActivityTime(record.GetDownloadPeriodEndTime()),
+				})
+			}
+		} else {
+			for _, record := range file.GetGen2V1().GetOverview().GetControlActivities() {
+				result = append(result, &ControlActivity{
+					ControlType:           record.GetControlType(),
+					ControlTime:           controlActivityTime(record.GetControlTime()),
+					ControlCardNumber:     record.GetControlCardNumberAndGeneration().GetFullCardNumber(),
+					ControlCardGeneration: record.GetControlCardNumberAndGeneration().GetGeneration(),
+					DownloadPeriodBegin:   controlActivityTime(record.GetDownloadPeriodBeginTime()),
+					DownloadPeriodEnd:     controlActivityTime(record.GetDownloadPeriodEndTime()),
+				})
+			}
+		}
+	}
+	return result
+}
+
+// controlActivityTime converts a TimeReal to a time, mapping unset and zero
+// values to the zero time.
+func controlActivityTime(t *timestamppb.Timestamp) time.Time {
+	if !hasRouteTime(t) {
+		return time.Time{}
+	}
+	return t.AsTime().UTC()
+}
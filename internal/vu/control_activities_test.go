@@ -0,0 +1,115 @@
+package vu
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestControlActivities(t *testing.T) {
+	controlTime := time.Date(2024, 5, 6, 10, 30, 0, 0, time.UTC)
+	begin := time.Date(2024, 4, 6, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+
+	// A VuControlActivityRecordArray with a single Gen2 record.
+	data := []byte{
+		0x0A,       // recordType
+		0x00, 0x20, // recordSize
+		0x00, 0x01, // noOfRecords
+		0xC0, // controlType: card and VU downloading
+	}
+	data = append(data, timeReal(controlTime)...)
+	data = append(data, 0x03, 0x11) // cardType: control card, cardIssuingMemberState
+	data = append(data, []byte("CONTROL-00000001")...)
+	data = append(data, 0x02) // generation
+	data = append(data, timeReal(begin)...)
+	data = append(data, timeReal(end)...)
+
+	gen2v1Records, size, err := parseVuControlActivityRecordArray[vuv1.OverviewGen2V1_ControlActivity](dd.UnmarshalOptions{}, data, 0, nil)
+	if err != nil {
+		t.Fatalf("parseVuControlActivityRecordArray() failed: %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("parseVuControlActivityRecordArray() size = %d, want %d", size, len(data))
+	}
+	gen2v2Records, _, err := parseVuControlActivityRecordArray[vuv1.OverviewGen2V2_ControlActivity](dd.UnmarshalOptions{}, data, 0, nil)
+	if err != nil {
+		t.Fatalf("parseVuControlActivityRecordArray() failed: %v", err)
+	}
+
+	gen1Record := &vuv1.OverviewGen1_ControlActivity{}
+	gen1Record.SetControlTime(timestamppb.New(controlTime))
+	gen1Record.SetControlCardNumber(gen2v1Records[0].GetControlCardNumberAndGeneration().GetFullCardNumber())
+	gen1Record.SetDownloadPeriodBeginTime(timestamppb.New(begin))
+	gen1Record.SetDownloadPeriodEndTime(timestamppb.New(end))
+
+	gen1Overview := &vuv1.OverviewGen1{}
+	gen1Overview.SetControlActivities([]*vuv1.OverviewGen1_ControlActivity{gen1Record})
+	gen1 := &vuv1.VehicleUnitFileGen1{}
+	gen1.SetOverview(gen1Overview)
+	gen1File := &vuv1.VehicleUnitFile{}
+	gen1File.SetGeneration(ddv1.Generation_GENERATION_1)
+	gen1File.SetGen1(gen1)
+
+	gen2v1Overview := &vuv1.OverviewGen2V1{}
+	gen2v1Overview.SetControlActivities(gen2v1Records)
+	gen2v1 := &vuv1.VehicleUnitFileGen2V1{}
+	gen2v1.SetOverview(gen2v1Overview)
+	gen2v1File := &vuv1.VehicleUnitFile{}
+	gen2v1File.SetGeneration(ddv1.Generation_GENERATION_2)
+	gen2v1File.SetVersion(ddv1.Version_VERSION_1)
+	gen2v1File.SetGen2V1(gen2v1)
+
+	gen2v2Overview := &vuv1.OverviewGen2V2{}
+	gen2v2Overview.SetControlActivities(gen2v2Records)
+	gen2v2 := &vuv1.VehicleUnitFileGen2V2{}
+	gen2v2.SetOverview(gen2v2Overview)
+	gen2v2File := &vuv1.VehicleUnitFile{}
+	gen2v2File.SetGeneration(ddv1.Generation_GENERATION_2)
+	gen2v2File.SetVersion(ddv1.Version_VERSION_2)
+	gen2v2File.SetGen2V2(gen2v2)
+
+	for _, tt := range []struct {
+		name           string
+		file           *vuv1.VehicleUnitFile
+		wantGeneration ddv1.Generation
+	}{
+		{name: "gen1", file: gen1File},
+		{name: "gen2v1", file: gen2v1File, wantGeneration: ddv1.Generation_GENERATION_2},
+		{name: "gen2v2", file: gen2v2File, wantGeneration: ddv1.Generation_GENERATION_2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controls := ControlActivities(tt.file)
+			if len(controls) != 1 {
+				t.Fatalf("ControlActivities() returned %d records, want 1", len(controls))
+			}
+			control := controls[0]
+			if !control.ControlTime.Equal(controlTime) {
+				t.Errorf("ControlTime = %v, want %v", control.ControlTime, controlTime)
+			}
+			if !control.DownloadPeriodBegin.Equal(begin) {
+				t.Errorf("DownloadPeriodBegin = %v, want %v", control.DownloadPeriodBegin, begin)
+			}
+			if !control.DownloadPeriodEnd.Equal(end) {
+				t.Errorf("DownloadPeriodEnd = %v, want %v", control.DownloadPeriodEnd, end)
+			}
+			if got := control.ControlCardNumber.GetCardType(); got != ddv1.EquipmentType_CONTROL_CARD {
+				t.Errorf("ControlCardNumber.CardType = %v, want CONTROL_CARD", got)
+			}
+			if control.ControlCardGeneration != tt.wantGeneration {
+				t.Errorf("ControlCardGeneration = %v, want %v", control.ControlCardGeneration, tt.wantGeneration)
+			}
+		})
+	}
+}
+
+// timeReal encodes t as a 4-byte TimeReal.
+func timeReal(t time.Time) []byte {
+	seconds := uint32(t.Unix())
+	return []byte{byte(seconds >> 24), byte(seconds >> 16), byte(seconds >> 8), byte(seconds)}
+}
@@ -3,6 +3,7 @@ package vu
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
@@ -36,8 +37,8 @@ func sizeOfDetailedSpeedGen1(data []byte) (totalSize, signatureSize int, err err
 	offset := 0
 
 	// VuDetailedSpeedData: 2 bytes count + variable speed blocks
-	if len(data[offset:]) < 2 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfSpeedBlocks")
+	if len(data) < offset+2 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfSpeedBlocks: %w", io.ErrUnexpectedEOF)
 	}
 	noOfSpeedBlocks := binary.BigEndian.Uint16(data[offset:])
 	offset += 2
@@ -54,26 +55,17 @@ func sizeOfDetailedSpeedGen1(data []byte) (totalSize, signatureSize int, err err
 	return offset, gen1SignatureSize, nil
 }
 
+// detailedSpeedGen2RecordArrays names the RecordArrays of Gen2 Detailed Speed preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.5 (TREP 24 Hex).
+var detailedSpeedGen2RecordArrays = []string{
+	"VuDetailedSpeedBlockRecordArray",
+}
+
 // sizeOfDetailedSpeedGen2 calculates size by parsing Gen2 RecordArrays.
 func sizeOfDetailedSpeedGen2(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// VuDetailedSpeedBlockRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuDetailedSpeedBlockRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
-	}
-	signatureSizeGen2 := size
-	offset += size
-
-	return offset, signatureSizeGen2, nil
+	return sizeOfRecordArrays(data, detailedSpeedGen2RecordArrays)
 }
 
 // AppendVuDetailedSpeed appends VU detailed speed data to a buffer.
@@ -3,6 +3,7 @@ package vu
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
 	dd "github.com/way-platform/tachograph-go/internal/dd"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
@@ -28,11 +29,17 @@ import (
 //   - N x 64 bytes: VuDetailedSpeedBlock records
 //   - 128 bytes: RSA-1024 signature
 func unmarshalDetailedSpeedGen1(value []byte) (*vuv1.DetailedSpeedGen1, error) {
+	return parseDetailedSpeedGen1(dd.UnmarshalOptions{PreserveRawData: true}, value)
+}
+
+// parseDetailedSpeedGen1 is like unmarshalDetailedSpeedGen1, but parses the records of the
+// transfer with opts.
+func parseDetailedSpeedGen1(opts dd.UnmarshalOptions, value []byte) (*vuv1.DetailedSpeedGen1, error) {
 	// Split transfer value into data and signature
 	// Gen1 uses fixed 128-byte RSA-1024 signatures
 	const signatureSize = 128
 	if len(value) < signatureSize {
-		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d", signatureSize, len(value))
+		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d: %w", signatureSize, len(value), io.ErrUnexpectedEOF)
 	}
 
 	dataSize := len(value) - signatureSize
@@ -43,11 +50,10 @@ func unmarshalDetailedSpeedGen1(value []byte) (*vuv1.DetailedSpeedGen1, error) {
 	detailedSpeed.SetRawData(value) // Store complete transfer value for painting
 
 	offset := 0
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	// VuDetailedSpeedData: 2 bytes (noOfSpeedBlocks) + (noOfSpeedBlocks * 64 bytes)
 	if offset+2 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfSpeedBlocks")
+		return nil, fmt.Errorf("insufficient data for noOfSpeedBlocks: %w", io.ErrUnexpectedEOF)
 	}
 	noOfSpeedBlocks := int(data[offset])<<8 | int(data[offset+1])
 	offset += 2
@@ -57,7 +63,7 @@ func unmarshalDetailedSpeedGen1(value []byte) (*vuv1.DetailedSpeedGen1, error) {
 	for i := 0; i < noOfSpeedBlocks; i++ {
 		const speedBlockSize = 64 // 4 bytes TimeReal + 60 bytes Speed
 		if offset+speedBlockSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for VuDetailedSpeedBlock %d", i)
+			return nil, fmt.Errorf("insufficient data for VuDetailedSpeedBlock %d: %w", i, io.ErrUnexpectedEOF)
 		}
 
 		speedBlock, err := unmarshalDetailedSpeedBlock(opts, data[offset:offset+speedBlockSize])
@@ -130,8 +136,7 @@ func (opts MarshalOptions) MarshalDetailedSpeedGen1(detailedSpeed *vuv1.Detailed
 	// Calculate expected size (signature is stored separately and appended at the end)
 	noOfSpeedBlocks := len(detailedSpeed.GetSpeedBlocks())
 
-	// Data portion: 2 (count) + N*64 (blocks)
-	dataSize := 2 + (noOfSpeedBlocks * 64)
+	dataSize := detailedSpeedGen1DataSize(detailedSpeed)
 
 	// Use raw_data as canvas if available
 	var canvas []byte
@@ -187,6 +192,13 @@ func (opts MarshalOptions) MarshalDetailedSpeedGen1(detailedSpeed *vuv1.Detailed
 	return transferValue, nil
 }
 
+// detailedSpeedGen1DataSize returns the length of the data of a Gen1 Detailed
+// Speed transfer, without its signature.
+func detailedSpeedGen1DataSize(detailedSpeed *vuv1.DetailedSpeedGen1) int {
+	// Data portion: 2 (count) + N*64 (blocks)
+	return 2 + (len(detailedSpeed.GetSpeedBlocks()) * 64)
+}
+
 // marshalDetailedSpeedBlock marshals a single VuDetailedSpeedBlock (64 bytes).
 func (opts MarshalOptions) marshalDetailedSpeedBlock(block *vuv1.DetailedSpeedGen1_DetailedSpeedBlock) ([]byte, error) {
 	if block == nil {
@@ -235,10 +247,7 @@ func (opts AnonymizeOptions) anonymizeDetailedSpeedGen1(ds *vuv1.DetailedSpeedGe
 	result := proto.Clone(ds).(*vuv1.DetailedSpeedGen1)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize blocks (timestamp only - speed values are not PII)
 	var anonymizedBlocks []*vuv1.DetailedSpeedGen1_DetailedSpeedBlock
@@ -17,6 +17,12 @@ import (
 // Note: This is a minimal implementation that stores raw_data for round-trip fidelity.
 // Gen2 has no V2 variant - both V1 and V2 use the same structure.
 func unmarshalDetailedSpeedGen2(value []byte) (*vuv1.DetailedSpeedGen2, error) {
+	return parseDetailedSpeedGen2(value, nil)
+}
+
+// parseDetailedSpeedGen2 is like unmarshalDetailedSpeedGen2, but limits the
+// number of records of the RecordArrays of the transfer with sizes.
+func parseDetailedSpeedGen2(value []byte, sizes *recordSizes) (*vuv1.DetailedSpeedGen2, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -31,6 +37,9 @@ func unmarshalDetailedSpeedGen2(value []byte) (*vuv1.DetailedSpeedGen2, error) {
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	detailedSpeed := &vuv1.DetailedSpeedGen2{}
 	detailedSpeed.SetRawData(value) // Store complete transfer value for painting
@@ -38,6 +47,9 @@ func unmarshalDetailedSpeedGen2(value []byte) (*vuv1.DetailedSpeedGen2, error) {
 	// Validate structure by skipping through all record arrays
 	offset := 0
 	skipRecordArray := func(name string) error {
+		if err := sizes.checkArrayCount(name, data, offset); err != nil {
+			return err
+		}
 		size, err := sizeOfRecordArray(data, offset)
 		if err != nil {
 			return fmt.Errorf("%s: %w", name, err)
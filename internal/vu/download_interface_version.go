@@ -2,7 +2,9 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
@@ -19,7 +21,7 @@ import (
 func sizeOfDownloadInterfaceVersion(data []byte, transferType vuv1.TransferType) (totalSize, signatureSize int, err error) {
 	const lenDownloadInterfaceVersion = 2
 	if len(data) < lenDownloadInterfaceVersion {
-		return 0, 0, fmt.Errorf("insufficient data for DownloadInterfaceVersion: need %d, have %d", lenDownloadInterfaceVersion, len(data))
+		return 0, 0, fmt.Errorf("insufficient data for DownloadInterfaceVersion: need %d, have %d: %w", lenDownloadInterfaceVersion, len(data), io.ErrUnexpectedEOF)
 	}
 	// No signature for DownloadInterfaceVersion
 	return lenDownloadInterfaceVersion, 0, nil
@@ -39,3 +41,56 @@ func sizeOfDownloadInterfaceVersion(data []byte, transferType vuv1.TransferType)
 // ASN.1 Definition:
 //
 //	DownloadInterfaceVersion ::= OCTET STRING (SIZE (2))
+//
+// Value Assignment ('aabb'H):
+//   - 'aa': Generation of the VU ('01'H: Generation 2)
+//   - 'bb': Version of the download interface ('01'H: Version 2 of Gen2 VU)
+//
+// Unassigned values are left unspecified; raw_data preserves them.
+func unmarshalDownloadInterfaceVersion(data []byte) (*vuv1.DownloadInterfaceVersion, error) {
+	const (
+		lenDownloadInterfaceVersion = 2
+		idxGeneration               = 0
+		idxVersion                  = 1
+	)
+	if len(data) != lenDownloadInterfaceVersion {
+		return nil, fmt.Errorf("invalid data length for DownloadInterfaceVersion: got %d, want %d", len(data), lenDownloadInterfaceVersion)
+	}
+	version := &vuv1.DownloadInterfaceVersion{}
+	version.SetRawData(data)
+	if data[idxGeneration] == 0x01 {
+		version.SetGeneration(ddv1.Generation_GENERATION_2)
+	}
+	if data[idxVersion] == 0x01 {
+		version.SetVersion(ddv1.Version_VERSION_2)
+	}
+	return version, nil
+}
+
+// ===== Marshal Functions =====
+
+// MarshalDownloadInterfaceVersion marshals the download interface version using raw data painting.
+//
+// If raw_data is available, it is used as a canvas and the assigned generation and
+// version values are painted over it; other values keep their canvas bytes.
+func (opts MarshalOptions) MarshalDownloadInterfaceVersion(version *vuv1.DownloadInterfaceVersion) ([]byte, error) {
+	const (
+		lenDownloadInterfaceVersion = 2
+		idxGeneration               = 0
+		idxVersion                  = 1
+	)
+	if version == nil {
+		return nil, fmt.Errorf("download interface version cannot be nil")
+	}
+	canvas := make([]byte, lenDownloadInterfaceVersion)
+	if raw := version.GetRawData(); len(raw) == lenDownloadInterfaceVersion {
+		copy(canvas, raw)
+	}
+	if version.GetGeneration() == ddv1.Generation_GENERATION_2 {
+		canvas[idxGeneration] = 0x01
+	}
+	if version.GetVersion() == ddv1.Version_VERSION_2 {
+		canvas[idxVersion] = 0x01
+	}
+	return canvas, nil
+}
@@ -0,0 +1,66 @@
+package vu
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestDownloadInterfaceVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           []byte
+		wantGeneration ddv1.Generation
+		wantVersion    ddv1.Version
+	}{
+		{name: "gen2 version 2", data: []byte{0x01, 0x01}, wantGeneration: ddv1.Generation_GENERATION_2, wantVersion: ddv1.Version_VERSION_2},
+		{name: "unassigned version", data: []byte{0x01, 0x02}, wantGeneration: ddv1.Generation_GENERATION_2},
+		{name: "unassigned generation", data: []byte{0x02, 0x01}, wantVersion: ddv1.Version_VERSION_2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := unmarshalDownloadInterfaceVersion(tt.data)
+			if err != nil {
+				t.Fatalf("unmarshalDownloadInterfaceVersion failed: %v", err)
+			}
+			if got := version.GetGeneration(); got != tt.wantGeneration {
+				t.Errorf("Generation = %v, want %v", got, tt.wantGeneration)
+			}
+			if got := version.GetVersion(); got != tt.wantVersion {
+				t.Errorf("Version = %v, want %v", got, tt.wantVersion)
+			}
+			marshaled, err := MarshalOptions{}.MarshalDownloadInterfaceVersion(version)
+			if err != nil {
+				t.Fatalf("MarshalDownloadInterfaceVersion failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.data, marshaled); diff != "" {
+				t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDownloadInterfaceVersion_Gen2V2File(t *testing.T) {
+	data := []byte{0x76, 0x00, 0x01, 0x01}
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+	}
+	file, err := ParseOptions{}.ParseRawVehicleUnitFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawVehicleUnitFile failed: %v", err)
+	}
+	version := file.GetGen2V2().GetDownloadInterfaceVersion()
+	if version.GetGeneration() != ddv1.Generation_GENERATION_2 || version.GetVersion() != ddv1.Version_VERSION_2 {
+		t.Errorf("DownloadInterfaceVersion = %v, want GENERATION_2 VERSION_2", version)
+	}
+	marshaled, err := MarshalOptions{}.MarshalVehicleUnitFile(file)
+	if err != nil {
+		t.Fatalf("MarshalVehicleUnitFile failed: %v", err)
+	}
+	if diff := cmp.Diff(data, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
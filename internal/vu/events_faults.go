@@ -2,6 +2,7 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
@@ -77,8 +78,8 @@ func sizeOfEventsAndFaultsGen1(data []byte) (totalSize, signatureSize int, err e
 	offset := 0
 
 	// VuFaultData: 1 byte count + variable fault records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfVuFaults")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfVuFaults: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuFaults := data[offset]
 	offset += 1
@@ -88,8 +89,8 @@ func sizeOfEventsAndFaultsGen1(data []byte) (totalSize, signatureSize int, err e
 	offset += int(noOfVuFaults) * vuFaultRecordSize
 
 	// VuEventData: 1 byte count + variable event records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfVuEvents")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfVuEvents: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuEvents := data[offset]
 	offset += 1
@@ -102,8 +103,8 @@ func sizeOfEventsAndFaultsGen1(data []byte) (totalSize, signatureSize int, err e
 	offset += 9
 
 	// VuOverSpeedingEventData: 1 byte count + variable overspeed records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfVuOverSpeedingEvents")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfVuOverSpeedingEvents: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuOverSpeedingEvents := data[offset]
 	offset += 1
@@ -113,8 +114,8 @@ func sizeOfEventsAndFaultsGen1(data []byte) (totalSize, signatureSize int, err e
 	offset += int(noOfVuOverSpeedingEvents) * vuOverSpeedingEventRecordSize
 
 	// VuTimeAdjustmentData: 1 byte count + variable time adjustment records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfVuTimeAdjRecords")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfVuTimeAdjRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuTimeAdjRecords := data[offset]
 	offset += 1
@@ -130,112 +131,40 @@ func sizeOfEventsAndFaultsGen1(data []byte) (totalSize, signatureSize int, err e
 	return offset, gen1SignatureSize, nil
 }
 
+// eventsAndFaultsGen2V1RecordArrays names the RecordArrays of Gen2 V1 Events and Faults preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.4 (TREP 23 Hex).
+var eventsAndFaultsGen2V1RecordArrays = []string{
+	"VuFaultRecordArray",
+	"VuEventRecordArray",
+	"VuOverSpeedingControlDataRecordArray",
+	"VuOverSpeedingEventRecordArray",
+	"VuTimeAdjustmentRecordArray",
+}
+
 // sizeOfEventsAndFaultsGen2V1 calculates size by parsing all Gen2 V1 RecordArrays.
 func sizeOfEventsAndFaultsGen2V1(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// VuFaultRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuFaultRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuEventRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuEventRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuOverSpeedingControlDataRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuOverSpeedingControlDataRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuOverSpeedingEventRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuOverSpeedingEventRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuTimeAdjustmentRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuTimeAdjustmentRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
-	}
-	signatureSizeGen2 := size
-	offset += size
+	return sizeOfRecordArrays(data, eventsAndFaultsGen2V1RecordArrays)
+}
 
-	return offset, signatureSizeGen2, nil
+// eventsAndFaultsGen2V2RecordArrays names the RecordArrays of Gen2 V2 Events and Faults preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.4 (TREP 33 Hex).
+var eventsAndFaultsGen2V2RecordArrays = []string{
+	"VuFaultRecordArray",
+	"VuEventRecordArray",
+	"VuOverSpeedingControlDataRecordArray",
+	"VuOverSpeedingEventRecordArray",
+	"VuTimeAdjustmentRecordArray",
+	"VuTimeAdjustmentGNSSRecordArray",
 }
 
 // sizeOfEventsAndFaultsGen2V2 calculates size by parsing all Gen2 V2 RecordArrays.
 // Gen2 V2 has an additional VuTimeAdjustmentGNSSRecordArray.
 func sizeOfEventsAndFaultsGen2V2(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// VuFaultRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuFaultRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuEventRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuEventRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuOverSpeedingControlDataRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuOverSpeedingControlDataRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuOverSpeedingEventRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuOverSpeedingEventRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuTimeAdjustmentRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuTimeAdjustmentRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VuTimeAdjustmentGNSSRecordArray (Gen2 V2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuTimeAdjustmentGNSSRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
-	}
-	signatureSizeGen2 := size
-	offset += size
-
-	return offset, signatureSizeGen2, nil
+	return sizeOfRecordArrays(data, eventsAndFaultsGen2V2RecordArrays)
 }
 
 // ===== Unmarshal Functions =====
@@ -2,6 +2,7 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -27,11 +28,17 @@ import (
 //	    signature                     SignatureFirstGen
 //	}
 func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, error) {
+	return parseEventsAndFaultsGen1(dd.UnmarshalOptions{PreserveRawData: true}, value)
+}
+
+// parseEventsAndFaultsGen1 is like unmarshalEventsAndFaultsGen1, but parses the records of the
+// transfer with opts.
+func parseEventsAndFaultsGen1(opts dd.UnmarshalOptions, value []byte) (*vuv1.EventsAndFaultsGen1, error) {
 	// Split transfer value into data and signature
 	// Gen1 uses fixed 128-byte RSA-1024 signatures
 	const signatureSize = 128
 	if len(value) < signatureSize {
-		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d", signatureSize, len(value))
+		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d: %w", signatureSize, len(value), io.ErrUnexpectedEOF)
 	}
 
 	dataSize := len(value) - signatureSize
@@ -41,11 +48,10 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 	eventsAndFaults := &vuv1.EventsAndFaultsGen1{}
 	eventsAndFaults.SetRawData(value) // Store complete transfer value for painting
 	offset := 0
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	// Parse VuFaultData (1 byte count + fault records)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfVuFaults")
+		return nil, fmt.Errorf("insufficient data for noOfVuFaults: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuFaults := data[offset]
 	offset += 1
@@ -54,7 +60,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 	for i := 0; i < int(noOfVuFaults); i++ {
 		const faultRecordSize = 82
 		if offset+faultRecordSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for VuFaultRecord %d", i)
+			return nil, fmt.Errorf("insufficient data for VuFaultRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 		faultRecord, err := opts.UnmarshalVuFaultRecord(data[offset : offset+faultRecordSize])
 		if err != nil {
@@ -67,7 +73,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 
 	// Parse VuEventData (1 byte count + event records)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfVuEvents")
+		return nil, fmt.Errorf("insufficient data for noOfVuEvents: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuEvents := data[offset]
 	offset += 1
@@ -76,7 +82,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 	for i := 0; i < int(noOfVuEvents); i++ {
 		const eventRecordSize = 83
 		if offset+eventRecordSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for VuEventRecord %d", i)
+			return nil, fmt.Errorf("insufficient data for VuEventRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 		eventRecord, err := opts.UnmarshalVuEventRecord(data[offset : offset+eventRecordSize])
 		if err != nil {
@@ -90,7 +96,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 	// Parse VuOverSpeedingControlData (9 bytes, no count byte)
 	const overspeedControlSize = 9
 	if offset+overspeedControlSize > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuOverSpeedingControlData")
+		return nil, fmt.Errorf("insufficient data for VuOverSpeedingControlData: %w", io.ErrUnexpectedEOF)
 	}
 	overspeedControl, err := opts.UnmarshalVuOverspeedControlData(data[offset : offset+overspeedControlSize])
 	if err != nil {
@@ -101,7 +107,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 
 	// Parse VuOverSpeedingEventData (1 byte count + overspeed event records)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfVuOverSpeedingEvents")
+		return nil, fmt.Errorf("insufficient data for noOfVuOverSpeedingEvents: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuOverSpeedingEvents := data[offset]
 	offset += 1
@@ -110,7 +116,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 	for i := 0; i < int(noOfVuOverSpeedingEvents); i++ {
 		const overspeedEventRecordSize = 31
 		if offset+overspeedEventRecordSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for VuOverSpeedingEventRecord %d", i)
+			return nil, fmt.Errorf("insufficient data for VuOverSpeedingEventRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 		overspeedEventRecord, err := opts.UnmarshalVuOverspeedEventRecord(data[offset : offset+overspeedEventRecordSize])
 		if err != nil {
@@ -123,7 +129,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 
 	// Parse VuTimeAdjustmentData (1 byte count + time adjustment records)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfVuTimeAdjustments")
+		return nil, fmt.Errorf("insufficient data for noOfVuTimeAdjustments: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuTimeAdjustments := data[offset]
 	offset += 1
@@ -132,7 +138,7 @@ func unmarshalEventsAndFaultsGen1(value []byte) (*vuv1.EventsAndFaultsGen1, erro
 	for i := 0; i < int(noOfVuTimeAdjustments); i++ {
 		const timeAdjustmentRecordSize = 98
 		if offset+timeAdjustmentRecordSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for VuTimeAdjustmentRecord %d", i)
+			return nil, fmt.Errorf("insufficient data for VuTimeAdjustmentRecord %d: %w", i, io.ErrUnexpectedEOF)
 		}
 		timeAdjustmentRecord, err := opts.UnmarshalVuTimeAdjustmentRecord(data[offset : offset+timeAdjustmentRecordSize])
 		if err != nil {
@@ -166,11 +172,7 @@ func (opts MarshalOptions) MarshalEventsAndFaultsGen1(eventsAndFaults *vuv1.Even
 	noOfOverspeedEvents := len(eventsAndFaults.GetOverspeedingEvents())
 	noOfTimeAdjustments := len(eventsAndFaults.GetTimeAdjustments())
 
-	dataSize := 1 + (noOfFaults * 82) + // VuFaultData: 1 byte count + records
-		1 + (noOfEvents * 83) + // VuEventData: 1 byte count + records
-		9 + // VuOverSpeedingControlData: fixed 9 bytes
-		1 + (noOfOverspeedEvents * 31) + // VuOverSpeedingEventData: 1 byte count + records
-		1 + (noOfTimeAdjustments * 98) // VuTimeAdjustmentData: 1 byte count + records
+	dataSize := eventsAndFaultsGen1DataSize(eventsAndFaults)
 
 	// Use raw data as canvas if available
 	var canvas []byte
@@ -277,6 +279,16 @@ func (opts MarshalOptions) MarshalEventsAndFaultsGen1(eventsAndFaults *vuv1.Even
 	return transferValue, nil
 }
 
+// eventsAndFaultsGen1DataSize returns the length of the data of a Gen1 Events
+// and Faults transfer, without its signature.
+func eventsAndFaultsGen1DataSize(eventsAndFaults *vuv1.EventsAndFaultsGen1) int {
+	return 1 + (len(eventsAndFaults.GetFaults()) * 82) + // VuFaultData: 1 byte count + records
+		1 + (len(eventsAndFaults.GetEvents()) * 83) + // VuEventData: 1 byte count + records
+		9 + // VuOverSpeedingControlData: fixed 9 bytes
+		1 + (len(eventsAndFaults.GetOverspeedingEvents()) * 31) + // VuOverSpeedingEventData: 1 byte count + records
+		1 + (len(eventsAndFaults.GetTimeAdjustments()) * 98) // VuTimeAdjustmentData: 1 byte count + records
+}
+
 // anonymizeEventsAndFaultsGen1 anonymizes Gen1 Events and Faults data.
 // TODO: Implement full semantic anonymization (anonymize event/fault records, timestamps, etc.).
 func (opts AnonymizeOptions) anonymizeEventsAndFaultsGen1(ef *vuv1.EventsAndFaultsGen1) *vuv1.EventsAndFaultsGen1 {
@@ -286,10 +298,7 @@ func (opts AnonymizeOptions) anonymizeEventsAndFaultsGen1(ef *vuv1.EventsAndFaul
 	result := proto.Clone(ef).(*vuv1.EventsAndFaultsGen1)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize fault records
 	var anonymizedFaults []*ddv1.VuFaultRecord
@@ -16,6 +16,12 @@ import (
 //
 // Note: This is a minimal implementation that stores raw_data for round-trip fidelity.
 func unmarshalEventsAndFaultsGen2V2(value []byte) (*vuv1.EventsAndFaultsGen2V2, error) {
+	return parseEventsAndFaultsGen2V2(value, nil)
+}
+
+// parseEventsAndFaultsGen2V2 is like unmarshalEventsAndFaultsGen2V2, but limits the
+// number of records of the RecordArrays of the transfer with sizes.
+func parseEventsAndFaultsGen2V2(value []byte, sizes *recordSizes) (*vuv1.EventsAndFaultsGen2V2, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -30,6 +36,9 @@ func unmarshalEventsAndFaultsGen2V2(value []byte) (*vuv1.EventsAndFaultsGen2V2,
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	eventsAndFaults := &vuv1.EventsAndFaultsGen2V2{}
 	eventsAndFaults.SetRawData(value) // Store complete transfer value for painting
@@ -37,6 +46,9 @@ func unmarshalEventsAndFaultsGen2V2(value []byte) (*vuv1.EventsAndFaultsGen2V2,
 	// Validate structure by skipping through all record arrays
 	offset := 0
 	skipRecordArray := func(name string) error {
+		if err := sizes.checkArrayCount(name, data, offset); err != nil {
+			return err
+		}
 		size, err := sizeOfRecordArray(data, offset)
 		if err != nil {
 			return fmt.Errorf("%s: %w", name, err)
@@ -46,21 +58,10 @@ func unmarshalEventsAndFaultsGen2V2(value []byte) (*vuv1.EventsAndFaultsGen2V2,
 	}
 
 	// Skip all record arrays
-	// VuFaultRecordArray
-	if err := skipRecordArray("VuFault"); err != nil {
-		return nil, err
-	}
-	// VuEventRecordArray
-	if err := skipRecordArray("VuEvent"); err != nil {
-		return nil, err
-	}
-	// VuOverSpeedingControlRecordArray
-	if err := skipRecordArray("VuOverSpeedingControl"); err != nil {
-		return nil, err
-	}
-	// VuTimeAdjustmentRecordArray
-	if err := skipRecordArray("VuTimeAdjustment"); err != nil {
-		return nil, err
+	for _, name := range eventsAndFaultsGen2V2RecordArrays {
+		if err := skipRecordArray(name); err != nil {
+			return nil, err
+		}
 	}
 
 	// Store signature (extracted at the beginning)
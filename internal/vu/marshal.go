@@ -1,11 +1,64 @@
 package vu
 
 import (
+	"encoding/binary"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
 	"github.com/way-platform/tachograph-go/internal/dd"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
 // MarshalOptions configures the marshaling of VU files into binary format.
 type MarshalOptions struct {
 	// Embed dd.MarshalOptions to inherit marshaling configuration.
+	//
+	// With UseRawData, transfers that cannot be painted field by field (such
+	// as Gen2 activities) are emitted verbatim from raw_data, so edits to their
+	// semantic fields have no effect. Without it, they are re-encoded from
+	// their semantic fields, and no longer match their original signatures.
+	// Other transfers always paint their semantic fields over raw_data.
 	dd.MarshalOptions
+
+	// VerifyPaint re-parses every transfer that was painted over a raw_data
+	// canvas and returns an error if it does not decode to the semantic fields
+	// of the input message.
+	//
+	// This is a self-check for the raw data painting pattern: it catches field
+	// offset regressions, as well as semantic edits that the painted output
+	// silently drops.
+	VerifyPaint bool
+}
+
+// MarshalRawVehicleUnitFile serializes a RawVehicleUnitFile into binary format.
+//
+// Each record is written in TV format: its 2-byte tag followed by its value,
+// which includes the transfer signature.
+func (opts MarshalOptions) MarshalRawVehicleUnitFile(file *vuv1.RawVehicleUnitFile) ([]byte, error) {
+	var result []byte
+	for _, record := range file.GetRecords() {
+		result = binary.BigEndian.AppendUint16(result, uint16(record.GetTag()))
+		result = append(result, record.GetValue()...)
+	}
+	return result, nil
+}
+
+// verifyPaint re-parses a transfer value that was painted over the raw_data
+// canvas of want, and checks that it decodes to the same semantic fields.
+func verifyPaint[T interface {
+	proto.Message
+	ClearRawData()
+}](want T, value []byte, unmarshal func([]byte) (T, error)) error {
+	got, err := unmarshal(value)
+	if err != nil {
+		return fmt.Errorf("verify paint: %w", err)
+	}
+	want = proto.Clone(want).(T)
+	want.ClearRawData()
+	got.ClearRawData()
+	if !proto.Equal(want, got) {
+		return fmt.Errorf("verify paint: painted %s does not match its semantic fields", want.ProtoReflect().Descriptor().Name())
+	}
+	return nil
 }
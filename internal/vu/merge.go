@@ -0,0 +1,218 @@
+package vu
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MergeVehicleUnitFiles combines the transfers of downloads from the same
+// vehicle unit into a single file, such as an overview, activities and
+// technical data pulled as separate downloads.
+//
+// All files must have the same generation and version. Repeated transfers,
+// such as the activities of each downloaded day, are kept in the order of the
+// files, and transfers identical to one already merged are dropped. The
+// download interface version transfer occurs at most once per file, so an
+// error is returned if the files hold different ones.
+//
+// The overview also occurs at most once per file, but differs between
+// downloads of the same vehicle unit, such as in its current time and card
+// slots. The overviews must identify the same vehicle and vehicle unit: the
+// same VIN, registration and VU certificate, since the overview holds no VU
+// serial number. Of these, the overview with the latest current time is kept.
+//
+// Nil files are ignored. The input files are not modified.
+func MergeVehicleUnitFiles(files ...*vuv1.VehicleUnitFile) (*vuv1.VehicleUnitFile, error) {
+	var result *vuv1.VehicleUnitFile
+	for i, file := range files {
+		if file == nil {
+			continue
+		}
+		if result == nil {
+			result = &vuv1.VehicleUnitFile{}
+			result.SetGeneration(file.GetGeneration())
+			if file.HasVersion() {
+				result.SetVersion(file.GetVersion())
+			}
+		} else if file.GetGeneration() != result.GetGeneration() || file.GetVersion() != result.GetVersion() {
+			return nil, fmt.Errorf(
+				"file %d: generation %v version %v does not match generation %v version %v",
+				i, file.GetGeneration(), file.GetVersion(), result.GetGeneration(), result.GetVersion(),
+			)
+		}
+		var err error
+		switch file.GetGeneration() {
+		case ddv1.Generation_GENERATION_1:
+			err = mergeVehicleUnitFileGen1(result, file.GetGen1())
+		case ddv1.Generation_GENERATION_2:
+			if file.GetVersion() == ddv1.Version_VERSION_2 {
+				err = mergeVehicleUnitFileGen2V2(result, file.GetGen2V2())
+			} else {
+				err = mergeVehicleUnitFileGen2V1(result, file.GetGen2V1())
+			}
+		default:
+			err = fmt.Errorf("%w %v", dd.ErrUnsupportedGeneration, file.GetGeneration())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("file %d: %w", i, err)
+		}
+		result.SetParseWarnings(append(result.GetParseWarnings(), file.GetParseWarnings()...))
+	}
+	if result == nil {
+		return nil, errors.New("no vehicle unit files to merge")
+	}
+	return result, nil
+}
+
+func mergeVehicleUnitFileGen1(dst *vuv1.VehicleUnitFile, src *vuv1.VehicleUnitFileGen1) error {
+	if src == nil {
+		return nil
+	}
+	gen1 := dst.GetGen1()
+	if gen1 == nil {
+		gen1 = &vuv1.VehicleUnitFileGen1{}
+		dst.SetGen1(gen1)
+	}
+	if src.HasOverview() {
+		overview := src.GetOverview()
+		if gen1.HasOverview() {
+			var err error
+			overview, err = latestOverview(
+				gen1.GetOverview(), overview,
+				registrationKey(gen1.GetOverview().GetVehicleRegistrationWithNation()),
+				registrationKey(overview.GetVehicleRegistrationWithNation()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		gen1.SetOverview(proto.CloneOf(overview))
+	}
+	gen1.SetActivities(appendUniqueTransfers(gen1.GetActivities(), src.GetActivities()))
+	gen1.SetEventsAndFaults(appendUniqueTransfers(gen1.GetEventsAndFaults(), src.GetEventsAndFaults()))
+	gen1.SetDetailedSpeed(appendUniqueTransfers(gen1.GetDetailedSpeed(), src.GetDetailedSpeed()))
+	gen1.SetTechnicalData(appendUniqueTransfers(gen1.GetTechnicalData(), src.GetTechnicalData()))
+	return nil
+}
+
+func mergeVehicleUnitFileGen2V1(dst *vuv1.VehicleUnitFile, src *vuv1.VehicleUnitFileGen2V1) error {
+	if src == nil {
+		return nil
+	}
+	gen2v1 := dst.GetGen2V1()
+	if gen2v1 == nil {
+		gen2v1 = &vuv1.VehicleUnitFileGen2V1{}
+		dst.SetGen2V1(gen2v1)
+	}
+	if src.HasOverview() {
+		overview := src.GetOverview()
+		if gen2v1.HasOverview() {
+			var err error
+			overview, err = latestOverview(
+				gen2v1.GetOverview(), overview,
+				registrationKey(gen2v1.GetOverview().GetVehicleRegistrationWithNation()),
+				registrationKey(overview.GetVehicleRegistrationWithNation()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		gen2v1.SetOverview(proto.CloneOf(overview))
+	}
+	gen2v1.SetActivities(appendUniqueTransfers(gen2v1.GetActivities(), src.GetActivities()))
+	gen2v1.SetEventsAndFaults(appendUniqueTransfers(gen2v1.GetEventsAndFaults(), src.GetEventsAndFaults()))
+	gen2v1.SetDetailedSpeed(appendUniqueTransfers(gen2v1.GetDetailedSpeed(), src.GetDetailedSpeed()))
+	gen2v1.SetTechnicalData(appendUniqueTransfers(gen2v1.GetTechnicalData(), src.GetTechnicalData()))
+	return nil
+}
+
+func mergeVehicleUnitFileGen2V2(dst *vuv1.VehicleUnitFile, src *vuv1.VehicleUnitFileGen2V2) error {
+	if src == nil {
+		return nil
+	}
+	gen2v2 := dst.GetGen2V2()
+	if gen2v2 == nil {
+		gen2v2 = &vuv1.VehicleUnitFileGen2V2{}
+		dst.SetGen2V2(gen2v2)
+	}
+	if src.HasDownloadInterfaceVersion() {
+		if gen2v2.HasDownloadInterfaceVersion() && !proto.Equal(gen2v2.GetDownloadInterfaceVersion(), src.GetDownloadInterfaceVersion()) {
+			return errors.New("conflicting download interface version transfers")
+		}
+		gen2v2.SetDownloadInterfaceVersion(proto.CloneOf(src.GetDownloadInterfaceVersion()))
+	}
+	if src.HasOverview() {
+		overview := src.GetOverview()
+		if gen2v2.HasOverview() {
+			var err error
+			overview, err = latestOverview(
+				gen2v2.GetOverview(), overview,
+				gen2v2.GetOverview().GetVehicleRegistrationNumber().GetValue(),
+				overview.GetVehicleRegistrationNumber().GetValue(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		gen2v2.SetOverview(proto.CloneOf(overview))
+	}
+	gen2v2.SetActivities(appendUniqueTransfers(gen2v2.GetActivities(), src.GetActivities()))
+	gen2v2.SetEventsAndFaults(appendUniqueTransfers(gen2v2.GetEventsAndFaults(), src.GetEventsAndFaults()))
+	gen2v2.SetDetailedSpeed(appendUniqueTransfers(gen2v2.GetDetailedSpeed(), src.GetDetailedSpeed()))
+	gen2v2.SetTechnicalData(appendUniqueTransfers(gen2v2.GetTechnicalData(), src.GetTechnicalData()))
+	return nil
+}
+
+// overview is implemented by the overview transfers of all generations.
+type overview interface {
+	proto.Message
+	GetVehicleIdentificationNumber() *ddv1.Ia5StringValue
+	GetVuCertificate() []byte
+	GetCurrentDateTime() *timestamppb.Timestamp
+}
+
+// latestOverview returns the overview with the later current time, or an
+// error if the overviews are of different vehicles or vehicle units.
+//
+// The registrations are compared as given, since their type depends on the
+// generation.
+func latestOverview[T overview](dst, src T, dstRegistration, srcRegistration string) (T, error) {
+	if got, want := src.GetVehicleIdentificationNumber().GetValue(), dst.GetVehicleIdentificationNumber().GetValue(); got != want {
+		return dst, fmt.Errorf("overview of VIN %q does not match VIN %q", got, want)
+	}
+	if srcRegistration != dstRegistration {
+		return dst, fmt.Errorf("overview of registration %q does not match registration %q", srcRegistration, dstRegistration)
+	}
+	if !bytes.Equal(src.GetVuCertificate(), dst.GetVuCertificate()) {
+		return dst, errors.New("overview of a different vehicle unit certificate")
+	}
+	if src.GetCurrentDateTime().AsTime().After(dst.GetCurrentDateTime().AsTime()) {
+		return src, nil
+	}
+	return dst, nil
+}
+
+// registrationKey returns the nation and number of a vehicle registration.
+func registrationKey(registration *ddv1.VehicleRegistrationIdentification) string {
+	return registration.GetNation().String() + " " + registration.GetNumber().GetValue()
+}
+
+// appendUniqueTransfers appends copies of the transfers in src to dst,
+// skipping transfers equal to one already in dst.
+func appendUniqueTransfers[T proto.Message](dst, src []T) []T {
+	for _, transfer := range src {
+		if slices.ContainsFunc(dst, func(existing T) bool { return proto.Equal(existing, transfer) }) {
+			continue
+		}
+		dst = append(dst, proto.CloneOf(transfer))
+	}
+	return dst
+}
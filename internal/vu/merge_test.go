@@ -0,0 +1,97 @@
+package vu
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestMergeVehicleUnitFiles(t *testing.T) {
+	_, file := readGen1TestDownload(t)
+
+	// Split the download into separate downloads sharing the overview.
+	activities := RemoveTransfers(file,
+		vuv1.TransferType_EVENTS_AND_FAULTS_GEN1,
+		vuv1.TransferType_DETAILED_SPEED_GEN1,
+		vuv1.TransferType_TECHNICAL_DATA_GEN1,
+	)
+	rest := RemoveTransfers(file, vuv1.TransferType_ACTIVITIES_GEN1)
+
+	merged, err := MergeVehicleUnitFiles(activities, rest, activities)
+	if err != nil {
+		t.Fatalf("MergeVehicleUnitFiles failed: %v", err)
+	}
+	if diff := cmp.Diff(file, merged, protocmp.Transform()); diff != "" {
+		t.Errorf("merged file mismatch (-want +got):\n%s", diff)
+	}
+	if got := len(activities.GetGen1().GetEventsAndFaults()); got != 0 {
+		t.Errorf("MergeVehicleUnitFiles modified its input: %d events and faults transfers", got)
+	}
+}
+
+func TestMergeVehicleUnitFiles_overviews(t *testing.T) {
+	// Two overviews of the same vehicle unit, downloaded at different times.
+	readOverview := func(path string) *vuv1.VehicleUnitFile {
+		t.Helper()
+		value, err := readHexdump(path)
+		if err != nil {
+			t.Fatalf("Failed to read hexdump: %v", err)
+		}
+		data := appendTransfer(nil, vuv1.TransferType_OVERVIEW_GEN1, value)
+		rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+		if err != nil {
+			t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+		}
+		file, err := ParseOptions{PreserveRawData: true}.ParseRawVehicleUnitFile(rawFile)
+		if err != nil {
+			t.Fatalf("ParseRawVehicleUnitFile failed: %v", err)
+		}
+		return file
+	}
+	later := readOverview("testdata/records/001-anonymized/000-OVERVIEW_GEN1.hexdump")
+	earlier := readOverview("testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if !later.GetGen1().GetOverview().GetCurrentDateTime().AsTime().After(earlier.GetGen1().GetOverview().GetCurrentDateTime().AsTime()) {
+		t.Fatal("test overviews are not in the expected order")
+	}
+
+	for _, files := range [][]*vuv1.VehicleUnitFile{
+		{earlier, later},
+		{later, earlier},
+	} {
+		merged, err := MergeVehicleUnitFiles(files...)
+		if err != nil {
+			t.Fatalf("MergeVehicleUnitFiles failed: %v", err)
+		}
+		if diff := cmp.Diff(later.GetGen1().GetOverview(), merged.GetGen1().GetOverview(), protocmp.Transform()); diff != "" {
+			t.Errorf("merged overview is not the latest (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestMergeVehicleUnitFiles_errors(t *testing.T) {
+	_, file := readGen1TestDownload(t)
+
+	gen2 := &vuv1.VehicleUnitFile{}
+	gen2.SetGeneration(ddv1.Generation_GENERATION_2)
+	gen2.SetVersion(ddv1.Version_VERSION_1)
+	gen2.SetGen2V1(&vuv1.VehicleUnitFileGen2V1{})
+	if _, err := MergeVehicleUnitFiles(file, gen2); err == nil {
+		t.Error("MergeVehicleUnitFiles of different generations succeeded, want error")
+	}
+
+	conflicting := RemoveTransfers(file)
+	vin := &ddv1.Ia5StringValue{}
+	vin.SetValue("WDB9634031L000001")
+	conflicting.GetGen1().GetOverview().SetVehicleIdentificationNumber(vin)
+	if _, err := MergeVehicleUnitFiles(file, conflicting); err == nil {
+		t.Error("MergeVehicleUnitFiles of overviews of different vehicles succeeded, want error")
+	}
+
+	if _, err := MergeVehicleUnitFiles(); err == nil {
+		t.Error("MergeVehicleUnitFiles of no files succeeded, want error")
+	}
+}
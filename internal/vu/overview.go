@@ -2,8 +2,12 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ===== sizeOf Functions =====
@@ -58,8 +62,8 @@ func sizeOfOverviewGen1(data []byte) (totalSize, signatureSize int, err error) {
 	offset += 58  // VuDownloadActivityData (4 + 18 + 36)
 
 	// VuCompanyLocksData: 1 byte count + variable records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfLocks")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfLocks: %w", io.ErrUnexpectedEOF)
 	}
 	noOfLocks := data[offset]
 	offset += 1
@@ -70,8 +74,8 @@ func sizeOfOverviewGen1(data []byte) (totalSize, signatureSize int, err error) {
 	offset += int(noOfLocks) * vuCompanyLocksRecordSize
 
 	// VuControlActivityData: 1 byte count + variable records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfControls")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfControls: %w", io.ErrUnexpectedEOF)
 	}
 	noOfControls := data[offset]
 	offset += 1
@@ -88,178 +92,297 @@ func sizeOfOverviewGen1(data []byte) (totalSize, signatureSize int, err error) {
 	return offset, gen1SignatureSize, nil
 }
 
+// overviewGen2V1RecordArrays names the RecordArrays of Gen2 V1 Overview preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.2 (TREP 21 Hex).
+var overviewGen2V1RecordArrays = []string{
+	"MemberStateCertificateRecordArray",
+	"VUCertificateRecordArray",
+	"VehicleIdentificationNumberRecordArray",
+	"VehicleRegistrationIdentificationRecordArray",
+	"CurrentDateTimeRecordArray",
+	"VuDownloadablePeriodRecordArray",
+	"CardSlotsStatusRecordArray",
+	"VuDownloadActivityDataRecordArray",
+	"VuCompanyLocksRecordArray",
+	"VuControlActivityRecordArray",
+}
+
 // sizeOfOverviewGen2V1 calculates size by parsing all Gen2 V1 RecordArrays.
 //
 // Gen2 uses RecordArray structures with 5-byte headers that include the size.
 // We parse each RecordArray header sequentially to determine the total size.
 func sizeOfOverviewGen2V1(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// MemberStateCertificateRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("MemberStateCertificateRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VUCertificateRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VUCertificateRecordArray: %w", sizeErr)
-	}
-	offset += size
+	return sizeOfRecordArrays(data, overviewGen2V1RecordArrays)
+}
 
-	// VehicleIdentificationNumberRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VehicleIdentificationNumberRecordArray: %w", sizeErr)
-	}
-	offset += size
+// overviewGen2V2RecordArrays names the RecordArrays of Gen2 V2 Overview preceding the
+// SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.2 (TREP 31 Hex).
+var overviewGen2V2RecordArrays = []string{
+	"MemberStateCertificateRecordArray",
+	"VUCertificateRecordArray",
+	"VehicleIdentificationNumberRecordArray",
+	"VehicleRegistrationNumberRecordArray",
+	"CurrentDateTimeRecordArray",
+	"VuDownloadablePeriodRecordArray",
+	"CardSlotsStatusRecordArray",
+	"VuDownloadActivityDataRecordArray",
+	"VuCompanyLocksRecordArray",
+	"VuControlActivityRecordArray",
+}
 
-	// VehicleRegistrationIdentificationRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VehicleRegistrationIdentificationRecordArray: %w", sizeErr)
-	}
-	offset += size
+// sizeOfOverviewGen2V2 calculates size by parsing all Gen2 V2 RecordArrays.
+//
+// Gen2 V2 has an additional VehicleRegistrationNumberRecordArray between
+// VehicleIdentificationNumberRecordArray and CurrentDateTimeRecordArray.
+func sizeOfOverviewGen2V2(data []byte) (totalSize, signatureSize int, err error) {
+	return sizeOfRecordArrays(data, overviewGen2V2RecordArrays)
+}
 
-	// CurrentDateTimeRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("CurrentDateTimeRecordArray: %w", sizeErr)
-	}
-	offset += size
+// vuDownloadActivityRecordG2 is implemented by the Gen2 V1 and Gen2 V2 overview
+// download activity messages, which share the same record layout.
+type vuDownloadActivityRecordG2[T any] interface {
+	*T
+	SetDownloadingTime(*timestamppb.Timestamp)
+	SetFullCardNumberAndGeneration(*ddv1.FullCardNumberAndGeneration)
+	SetCompanyOrWorkshopName(*ddv1.StringValue)
+}
 
-	// VuDownloadablePeriodRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuDownloadablePeriodRecordArray: %w", sizeErr)
+// parseVuDownloadActivityDataRecordArray parses a Gen2 VuDownloadActivityDataRecordArray.
+//
+// The data type `VuDownloadActivityData` is specified in the Data Dictionary, Section 2.195.
+//
+// ASN.1 Definition:
+//
+//	VuDownloadActivityDataSecondGen ::= SEQUENCE {
+//	    downloadingTime                  TimeReal,                       -- 4 bytes
+//	    fullCardNumberAndGeneration      FullCardNumberAndGeneration,    -- 19 bytes
+//	    companyOrWorkshopName            Name                            -- 36 bytes
+//	}
+func parseVuDownloadActivityDataRecordArray[T any, PT vuDownloadActivityRecordG2[T]](opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]PT, int, error) {
+	const (
+		idxDownloadingTime             = 0
+		idxFullCardNumberAndGeneration = 4
+		idxCompanyOrWorkshopName       = 23
+		lenVuDownloadActivityData      = 59
+	)
+
+	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
+	if err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// CardSlotsStatusRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("CardSlotsStatusRecordArray: %w", sizeErr)
+	if err := sizes.checkCount("VuDownloadActivityData", noOfRecords); err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// VuDownloadActivityDataRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuDownloadActivityDataRecordArray: %w", sizeErr)
+	if noOfRecords > 0 && recordSize != lenVuDownloadActivityData {
+		return nil, 0, fmt.Errorf("expected VuDownloadActivityData size %d, got %d", lenVuDownloadActivityData, recordSize)
 	}
-	offset += size
 
-	// VuCompanyLocksRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuCompanyLocksRecordArray: %w", sizeErr)
+	records := make([]PT, 0, noOfRecords)
+	recordStart := offset + headerSize
+	for i := uint16(0); i < noOfRecords; i++ {
+		recordEnd := recordStart + int(recordSize)
+		recordData := data[recordStart:recordEnd]
+
+		record := PT(new(T))
+		downloadingTime, err := opts.UnmarshalTimeReal(recordData[idxDownloadingTime : idxDownloadingTime+4])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal downloading time: %w", err)
+		}
+		record.SetDownloadingTime(downloadingTime)
+		fullCard, err := opts.UnmarshalFullCardNumberAndGeneration(recordData[idxFullCardNumberAndGeneration:idxCompanyOrWorkshopName])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal full card number and generation: %w", err)
+		}
+		record.SetFullCardNumberAndGeneration(fullCard)
+		companyName, err := opts.UnmarshalStringValue(recordData[idxCompanyOrWorkshopName:lenVuDownloadActivityData])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal company or workshop name: %w", err)
+		}
+		record.SetCompanyOrWorkshopName(companyName)
+
+		records = append(records, record)
+		recordStart = recordEnd
 	}
-	offset += size
 
-	// VuControlActivityRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuControlActivityRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
-	}
-	signatureSizeGen2 := size
-	offset += size
+	totalSize := headerSize + int(recordSize)*int(noOfRecords)
+	return records, totalSize, nil
+}
 
-	return offset, signatureSizeGen2, nil
+// vuCompanyLocksRecordG2 is implemented by the Gen2 V1 and Gen2 V2 overview
+// company lock messages, which share the same record layout.
+type vuCompanyLocksRecordG2[T any] interface {
+	*T
+	SetLockInTime(*timestamppb.Timestamp)
+	SetLockOutTime(*timestamppb.Timestamp)
+	SetCompanyName(*ddv1.StringValue)
+	SetCompanyAddress(*ddv1.StringValue)
+	SetCompanyCardNumberAndGeneration(*ddv1.FullCardNumberAndGeneration)
 }
 
-// sizeOfOverviewGen2V2 calculates size by parsing all Gen2 V2 RecordArrays.
+// parseVuCompanyLocksRecordArray parses a Gen2 VuCompanyLocksRecordArray.
 //
-// Gen2 V2 has an additional VehicleRegistrationNumberRecordArray between
-// VehicleIdentificationNumberRecordArray and CurrentDateTimeRecordArray.
-func sizeOfOverviewGen2V2(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
-
-	// MemberStateCertificateRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("MemberStateCertificateRecordArray: %w", sizeErr)
-	}
-	offset += size
-
-	// VUCertificateRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VUCertificateRecordArray: %w", sizeErr)
+// The data type `VuCompanyLocksRecord` is specified in the Data Dictionary, Section 2.184.
+//
+// ASN.1 Definition:
+//
+//	VuCompanyLocksRecordSecondGen ::= SEQUENCE {
+//	    lockInTime                       TimeReal,                       -- 4 bytes
+//	    lockOutTime                      TimeReal,                       -- 4 bytes
+//	    companyName                      Name,                           -- 36 bytes
+//	    companyAddress                   Address,                        -- 36 bytes
+//	    companyCardNumberAndGeneration   FullCardNumberAndGeneration     -- 19 bytes
+//	}
+func parseVuCompanyLocksRecordArray[T any, PT vuCompanyLocksRecordG2[T]](opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]PT, int, error) {
+	const (
+		idxLockInTime                     = 0
+		idxLockOutTime                    = 4
+		idxCompanyName                    = 8
+		idxCompanyAddress                 = 44
+		idxCompanyCardNumberAndGeneration = 80
+		lenVuCompanyLocksRecord           = 99
+	)
+
+	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
+	if err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// VehicleIdentificationNumberRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VehicleIdentificationNumberRecordArray: %w", sizeErr)
+	if err := sizes.checkCount("VuCompanyLocksRecord", noOfRecords); err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// VehicleRegistrationNumberRecordArray (Gen2 V2 addition)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VehicleRegistrationNumberRecordArray: %w", sizeErr)
+	if noOfRecords > 0 && recordSize != lenVuCompanyLocksRecord {
+		return nil, 0, fmt.Errorf("expected VuCompanyLocksRecord size %d, got %d", lenVuCompanyLocksRecord, recordSize)
 	}
-	offset += size
 
-	// CurrentDateTimeRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("CurrentDateTimeRecordArray: %w", sizeErr)
+	records := make([]PT, 0, noOfRecords)
+	recordStart := offset + headerSize
+	for i := uint16(0); i < noOfRecords; i++ {
+		recordEnd := recordStart + int(recordSize)
+		recordData := data[recordStart:recordEnd]
+
+		record := PT(new(T))
+		lockInTime, err := opts.UnmarshalTimeReal(recordData[idxLockInTime : idxLockInTime+4])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal lock in time: %w", err)
+		}
+		record.SetLockInTime(lockInTime)
+		lockOutTime, err := opts.UnmarshalTimeReal(recordData[idxLockOutTime : idxLockOutTime+4])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal lock out time: %w", err)
+		}
+		record.SetLockOutTime(lockOutTime)
+		companyName, err := opts.UnmarshalStringValue(recordData[idxCompanyName:idxCompanyAddress])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal company name: %w", err)
+		}
+		record.SetCompanyName(companyName)
+		companyAddress, err := opts.UnmarshalStringValue(recordData[idxCompanyAddress:idxCompanyCardNumberAndGeneration])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal company address: %w", err)
+		}
+		record.SetCompanyAddress(companyAddress)
+		companyCard, err := opts.UnmarshalFullCardNumberAndGeneration(recordData[idxCompanyCardNumberAndGeneration:lenVuCompanyLocksRecord])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal company card number and generation: %w", err)
+		}
+		record.SetCompanyCardNumberAndGeneration(companyCard)
+
+		records = append(records, record)
+		recordStart = recordEnd
 	}
-	offset += size
 
-	// VuDownloadablePeriodRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuDownloadablePeriodRecordArray: %w", sizeErr)
-	}
-	offset += size
+	totalSize := headerSize + int(recordSize)*int(noOfRecords)
+	return records, totalSize, nil
+}
 
-	// CardSlotsStatusRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("CardSlotsStatusRecordArray: %w", sizeErr)
-	}
-	offset += size
+// vuControlActivityRecordG2 is implemented by the Gen2 V1 and Gen2 V2 overview
+// control activity messages, which share the same record layout.
+type vuControlActivityRecordG2[T any] interface {
+	*T
+	SetControlType(*ddv1.ControlType)
+	SetControlTime(*timestamppb.Timestamp)
+	SetControlCardNumberAndGeneration(*ddv1.FullCardNumberAndGeneration)
+	SetDownloadPeriodBeginTime(*timestamppb.Timestamp)
+	SetDownloadPeriodEndTime(*timestamppb.Timestamp)
+}
 
-	// VuDownloadActivityDataRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuDownloadActivityDataRecordArray: %w", sizeErr)
+// parseVuControlActivityRecordArray parses a Gen2 VuControlActivityRecordArray.
+//
+// The data type `VuControlActivityRecord` is specified in the Data Dictionary, Section 2.187.
+//
+// ASN.1 Definition:
+//
+//	VuControlActivityRecord ::= SEQUENCE {
+//	    controlType                      ControlType,                    -- 1 byte
+//	    controlTime                      TimeReal,                       -- 4 bytes
+//	    controlCardNumberAndGeneration   FullCardNumberAndGeneration,    -- 19 bytes
+//	    downloadPeriodBeginTime          TimeReal,                       -- 4 bytes
+//	    downloadPeriodEndTime            TimeReal                        -- 4 bytes
+//	}
+func parseVuControlActivityRecordArray[T any, PT vuControlActivityRecordG2[T]](opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]PT, int, error) {
+	const (
+		idxControlType                    = 0
+		idxControlTime                    = 1
+		idxControlCardNumberAndGeneration = 5
+		idxDownloadPeriodBeginTime        = 24
+		idxDownloadPeriodEndTime          = 28
+		lenVuControlActivityRecord        = 32
+	)
+
+	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
+	if err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// VuCompanyLocksRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuCompanyLocksRecordArray: %w", sizeErr)
+	if err := sizes.checkCount("VuControlActivityRecord", noOfRecords); err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// VuControlActivityRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuControlActivityRecordArray: %w", sizeErr)
+	if noOfRecords > 0 && recordSize != lenVuControlActivityRecord {
+		return nil, 0, fmt.Errorf("expected VuControlActivityRecord size %d, got %d", lenVuControlActivityRecord, recordSize)
 	}
-	offset += size
 
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
+	records := make([]PT, 0, noOfRecords)
+	recordStart := offset + headerSize
+	for i := uint16(0); i < noOfRecords; i++ {
+		recordEnd := recordStart + int(recordSize)
+		if recordEnd > len(data) {
+			return nil, 0, fmt.Errorf("insufficient data for VuControlActivityRecord %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		recordData := data[recordStart:recordEnd]
+
+		record := PT(new(T))
+		controlType, err := opts.UnmarshalControlType(recordData[idxControlType : idxControlType+1])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal control type: %w", err)
+		}
+		record.SetControlType(controlType)
+		controlTime, err := opts.UnmarshalTimeReal(recordData[idxControlTime : idxControlTime+4])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal control time: %w", err)
+		}
+		record.SetControlTime(controlTime)
+		controlCard, err := opts.UnmarshalFullCardNumberAndGeneration(recordData[idxControlCardNumberAndGeneration:idxDownloadPeriodBeginTime])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal control card number and generation: %w", err)
+		}
+		record.SetControlCardNumberAndGeneration(controlCard)
+		downloadPeriodBeginTime, err := opts.UnmarshalTimeReal(recordData[idxDownloadPeriodBeginTime : idxDownloadPeriodBeginTime+4])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal download period begin time: %w", err)
+		}
+		record.SetDownloadPeriodBeginTime(downloadPeriodBeginTime)
+		downloadPeriodEndTime, err := opts.UnmarshalTimeReal(recordData[idxDownloadPeriodEndTime : idxDownloadPeriodEndTime+4])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal download period end time: %w", err)
+		}
+		record.SetDownloadPeriodEndTime(downloadPeriodEndTime)
+
+		records = append(records, record)
+		recordStart = recordEnd
 	}
-	signatureSizeGen2 := size
-	offset += size
 
-	return offset, signatureSizeGen2, nil
+	totalSize := headerSize + int(recordSize)*int(noOfRecords)
+	return records, totalSize, nil
 }
@@ -2,6 +2,7 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -63,11 +64,17 @@ import (
 //
 // - Signature: 128 bytes (RSA-1024)
 func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
+	return parseOverviewGen1(dd.UnmarshalOptions{PreserveRawData: true}, value)
+}
+
+// parseOverviewGen1 is like unmarshalOverviewGen1, but parses the records of the
+// transfer with opts.
+func parseOverviewGen1(opts dd.UnmarshalOptions, value []byte) (*vuv1.OverviewGen1, error) {
 	// Split transfer value into data and signature
 	// Gen1 uses fixed 128-byte RSA-1024 signatures
 	const signatureSize = 128
 	if len(value) < signatureSize {
-		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d", signatureSize, len(value))
+		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d: %w", signatureSize, len(value), io.ErrUnexpectedEOF)
 	}
 
 	dataSize := len(value) - signatureSize
@@ -78,25 +85,24 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 	overview.SetRawData(value) // Store complete transfer value for painting
 
 	offset := 0
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	// MemberStateCertificate (194 bytes)
 	if offset+194 > len(data) {
-		return nil, fmt.Errorf("insufficient data for MemberStateCertificate")
+		return nil, fmt.Errorf("insufficient data for MemberStateCertificate: %w", io.ErrUnexpectedEOF)
 	}
 	overview.SetMemberStateCertificate(data[offset : offset+194])
 	offset += 194
 
 	// VuCertificate (194 bytes)
 	if offset+194 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuCertificate")
+		return nil, fmt.Errorf("insufficient data for VuCertificate: %w", io.ErrUnexpectedEOF)
 	}
 	overview.SetVuCertificate(data[offset : offset+194])
 	offset += 194
 
 	// VehicleIdentificationNumber (17 bytes)
 	if offset+17 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VehicleIdentificationNumber")
+		return nil, fmt.Errorf("insufficient data for VehicleIdentificationNumber: %w", io.ErrUnexpectedEOF)
 	}
 	vin, err := opts.UnmarshalIa5StringValue(data[offset : offset+17])
 	if err != nil {
@@ -107,7 +113,7 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 
 	// VehicleRegistrationIdentification (15 bytes)
 	if offset+15 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VehicleRegistrationIdentification")
+		return nil, fmt.Errorf("insufficient data for VehicleRegistrationIdentification: %w", io.ErrUnexpectedEOF)
 	}
 	vrn, err := opts.UnmarshalVehicleRegistration(data[offset : offset+15])
 	if err != nil {
@@ -118,7 +124,7 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 
 	// CurrentDateTime (4 bytes)
 	if offset+4 > len(data) {
-		return nil, fmt.Errorf("insufficient data for CurrentDateTime")
+		return nil, fmt.Errorf("insufficient data for CurrentDateTime: %w", io.ErrUnexpectedEOF)
 	}
 	currentTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -129,7 +135,7 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 
 	// VuDownloadablePeriod (8 bytes: 2 x TimeReal)
 	if offset+8 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuDownloadablePeriod")
+		return nil, fmt.Errorf("insufficient data for VuDownloadablePeriod: %w", io.ErrUnexpectedEOF)
 	}
 	minTime, err := opts.UnmarshalTimeReal(data[offset : offset+4])
 	if err != nil {
@@ -149,19 +155,19 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 	// Lower 4 bits (0-3): driver slot
 	// Upper 4 bits (4-7): co-driver slot
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for CardSlotsStatus")
+		return nil, fmt.Errorf("insufficient data for CardSlotsStatus: %w", io.ErrUnexpectedEOF)
 	}
 	cardSlotsStatus := data[offset]
 	driverSlotRaw := byte(cardSlotsStatus & 0x0F)
 	coDriverSlotRaw := byte((cardSlotsStatus >> 4) & 0x0F)
 
 	// Use UnmarshalEnum to properly map protocol values to enum values
-	driverSlot, err := dd.UnmarshalEnum[ddv1.SlotCardType](driverSlotRaw)
+	driverSlot, err := dd.UnmarshalEnumOrReport[ddv1.SlotCardType](opts, "CardSlotsStatus.driver", driverSlotRaw)
 	if err != nil {
 		// Unrecognized value - set to UNRECOGNIZED
 		driverSlot = ddv1.SlotCardType_SLOT_CARD_TYPE_UNRECOGNIZED
 	}
-	coDriverSlot, err := dd.UnmarshalEnum[ddv1.SlotCardType](coDriverSlotRaw)
+	coDriverSlot, err := dd.UnmarshalEnumOrReport[ddv1.SlotCardType](opts, "CardSlotsStatus.coDriver", coDriverSlotRaw)
 	if err != nil {
 		// Unrecognized value - set to UNRECOGNIZED
 		coDriverSlot = ddv1.SlotCardType_SLOT_CARD_TYPE_UNRECOGNIZED
@@ -173,7 +179,7 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 
 	// VuDownloadActivityData (58 bytes: 4 + 18 + 36)
 	if offset+58 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuDownloadActivityData")
+		return nil, fmt.Errorf("insufficient data for VuDownloadActivityData: %w", io.ErrUnexpectedEOF)
 	}
 
 	downloadActivity := &vuv1.OverviewGen1_DownloadActivity{}
@@ -206,14 +212,14 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 
 	// VuCompanyLocksData: 1 byte (noOfLocks) + (noOfLocks * 98 bytes per record)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuCompanyLocksData noOfLocks")
+		return nil, fmt.Errorf("insufficient data for VuCompanyLocksData noOfLocks: %w", io.ErrUnexpectedEOF)
 	}
 	noOfLocks := data[offset]
 	offset += 1
 
 	const companyLockRecordSize = 98 // 4 + 4 + 36 + 36 + 18
 	if offset+int(noOfLocks)*companyLockRecordSize > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuCompanyLocksData records")
+		return nil, fmt.Errorf("insufficient data for VuCompanyLocksData records: %w", io.ErrUnexpectedEOF)
 	}
 
 	companyLocks := make([]*vuv1.OverviewGen1_CompanyLock, noOfLocks)
@@ -266,14 +272,14 @@ func unmarshalOverviewGen1(value []byte) (*vuv1.OverviewGen1, error) {
 
 	// VuControlActivityData: 1 byte (noOfControls) + (noOfControls * 31 bytes per record)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuControlActivityData noOfControls")
+		return nil, fmt.Errorf("insufficient data for VuControlActivityData noOfControls: %w", io.ErrUnexpectedEOF)
 	}
 	noOfControls := data[offset]
 	offset += 1
 
 	const controlActivityRecordSize = 31 // 1 + 4 + 18 + 4 + 4
 	if offset+int(noOfControls)*controlActivityRecordSize > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuControlActivityData records")
+		return nil, fmt.Errorf("insufficient data for VuControlActivityData records: %w", io.ErrUnexpectedEOF)
 	}
 
 	controlActivities := make([]*vuv1.OverviewGen1_ControlActivity, noOfControls)
@@ -348,13 +354,14 @@ func (opts MarshalOptions) MarshalOverviewGen1(overview *vuv1.OverviewGen1) ([]b
 	// Calculate expected size (signature is stored separately in RawVehicleUnitFile_Record)
 	noOfLocks := len(overview.GetCompanyLocks())
 	noOfControls := len(overview.GetControlActivities())
-	expectedSize := 491 + 1 + (noOfLocks * 98) + 1 + (noOfControls * 31)
-	// 491 = 194 + 194 + 17 + 15 + 4 + 8 + 1 + 58
+	expectedSize := overviewGen1DataSize(overview)
 
-	// Use raw_data as canvas if available
+	// Use raw_data as canvas if available (raw_data includes the 128-byte signature)
 	var canvas []byte
-	if raw := overview.GetRawData(); len(raw) == expectedSize {
-		canvas = make([]byte, len(raw))
+	raw := overview.GetRawData()
+	hasCanvas := len(raw) == expectedSize+128
+	if hasCanvas {
+		canvas = make([]byte, expectedSize)
 		copy(canvas, raw)
 	} else {
 		canvas = make([]byte, expectedSize)
@@ -567,9 +574,22 @@ func (opts MarshalOptions) MarshalOverviewGen1(overview *vuv1.OverviewGen1) ([]b
 	}
 	transferValue := append(canvas, signature...)
 
+	if opts.VerifyPaint && hasCanvas {
+		if err := verifyPaint(overview, transferValue, unmarshalOverviewGen1); err != nil {
+			return nil, err
+		}
+	}
+
 	return transferValue, nil
 }
 
+// overviewGen1DataSize returns the length of the data of a Gen1 Overview
+// transfer, without its signature.
+func overviewGen1DataSize(overview *vuv1.OverviewGen1) int {
+	// 491 = 194 + 194 + 17 + 15 + 4 + 8 + 1 + 58
+	return 491 + 1 + (len(overview.GetCompanyLocks()) * 98) + 1 + (len(overview.GetControlActivities()) * 31)
+}
+
 // anonymizeOverviewGen1 anonymizes Gen1 Overview data.
 func (opts AnonymizeOptions) anonymizeOverviewGen1(overview *vuv1.OverviewGen1) *vuv1.OverviewGen1 {
 	if overview == nil {
@@ -579,10 +599,7 @@ func (opts AnonymizeOptions) anonymizeOverviewGen1(overview *vuv1.OverviewGen1)
 	result := proto.Clone(overview).(*vuv1.OverviewGen1)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize VIN
 	if vin := result.GetVehicleIdentificationNumber(); vin != nil {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
@@ -46,7 +47,7 @@ func TestOverview_Gen1(t *testing.T) {
 			loadOrCreateGolden(t, overview, goldenPath)
 
 			// Round-trip test - marshal
-			marshalOpts := MarshalOptions{}
+			marshalOpts := MarshalOptions{VerifyPaint: true}
 			marshaled, err := marshalOpts.MarshalOverviewGen1(overview)
 			if err != nil {
 				t.Fatalf("Marshal failed: %v", err)
@@ -58,3 +59,72 @@ func TestOverview_Gen1(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalOverviewGen1_VerifyPaint(t *testing.T) {
+	hexdumpFiles, err := findHexdumpFiles(vuv1.TransferType_OVERVIEW_GEN1)
+	if err != nil {
+		t.Fatalf("Failed to discover hexdump files: %v", err)
+	}
+	if len(hexdumpFiles) == 0 {
+		t.Skip("No hexdump files found for OVERVIEW_GEN1")
+	}
+	data, err := readHexdump(hexdumpFiles[0])
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	overview, err := unmarshalOverviewGen1(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	// An unrecognized slot card type cannot be painted, so the canvas value is
+	// kept and the output no longer matches the semantic fields.
+	overview.SetDriverSlotCard(ddv1.SlotCardType(99))
+	if _, err := (MarshalOptions{}).MarshalOverviewGen1(overview); err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if _, err := (MarshalOptions{VerifyPaint: true}).MarshalOverviewGen1(overview); err == nil {
+		t.Error("Marshal with VerifyPaint succeeded, want error")
+	}
+}
+
+func TestOverviewGen1_onUnrecognized(t *testing.T) {
+	hexdumpFiles, err := findHexdumpFiles(vuv1.TransferType_OVERVIEW_GEN1)
+	if err != nil {
+		t.Fatalf("Failed to discover hexdump files: %v", err)
+	}
+	if len(hexdumpFiles) == 0 {
+		t.Skip("No hexdump files found for OVERVIEW_GEN1")
+	}
+	data, err := readHexdump(hexdumpFiles[0])
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+
+	// CardSlotsStatus follows the certificates (2 x 194 bytes), the VIN
+	// (17 bytes), the VRN (15 bytes) and the current and downloadable period
+	// times (4 + 8 bytes). Set the driver slot to a value not in SlotCardType.
+	const idxCardSlotsStatus = 2*194 + 17 + 15 + 4 + 8
+	data[idxCardSlotsStatus] = data[idxCardSlotsStatus]&0xF0 | 0x07
+
+	type report struct {
+		Context  string
+		RawValue uint64
+	}
+	var got []report
+	opts := ParseOptions{
+		OnUnrecognized: func(context string, rawValue uint64) {
+			got = append(got, report{Context: context, RawValue: rawValue})
+		},
+	}
+	overview, err := parseOverviewGen1(opts.unmarshal(), data)
+	if err != nil {
+		t.Fatalf("parseOverviewGen1() failed: %v", err)
+	}
+	if diff := cmp.Diff([]report{{Context: "CardSlotsStatus.driver", RawValue: 7}}, got); diff != "" {
+		t.Errorf("OnUnrecognized reports mismatch (-want +got):\n%s", diff)
+	}
+	if got := overview.GetDriverSlotCard(); got != ddv1.SlotCardType_SLOT_CARD_TYPE_UNRECOGNIZED {
+		t.Errorf("DriverSlotCard = %v, want SLOT_CARD_TYPE_UNRECOGNIZED", got)
+	}
+}
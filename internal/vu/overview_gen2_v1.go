@@ -38,6 +38,13 @@ import (
 // Note: This is a minimal implementation that stores raw_data for round-trip fidelity.
 // Full semantic parsing of all RecordArrays is TODO.
 func unmarshalOverviewGen2V1(value []byte) (*vuv1.OverviewGen2V1, error) {
+	return parseOverviewGen2V1(dd.UnmarshalOptions{PreserveRawData: true}, value, nil)
+}
+
+// parseOverviewGen2V1 is like unmarshalOverviewGen2V1, but parses the records
+// of the transfer with opts, and limits the number of records of its
+// RecordArrays with sizes.
+func parseOverviewGen2V1(opts dd.UnmarshalOptions, value []byte, sizes *recordSizes) (*vuv1.OverviewGen2V1, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -52,6 +59,9 @@ func unmarshalOverviewGen2V1(value []byte) (*vuv1.OverviewGen2V1, error) {
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	overview := &vuv1.OverviewGen2V1{}
 	overview.SetRawData(value) // Store complete transfer value for painting
@@ -61,6 +71,9 @@ func unmarshalOverviewGen2V1(value []byte) (*vuv1.OverviewGen2V1, error) {
 
 	// Helper to skip a RecordArray
 	skipRecordArray := func(name string) error {
+		if err := sizes.checkArrayCount(name, data, offset); err != nil {
+			return err
+		}
 		size, err := sizeOfRecordArray(data, offset)
 		if err != nil {
 			return fmt.Errorf("%s: %w", name, err)
@@ -105,19 +118,28 @@ func unmarshalOverviewGen2V1(value []byte) (*vuv1.OverviewGen2V1, error) {
 	}
 
 	// VuDownloadActivityDataRecordArray
-	if err := skipRecordArray("VuDownloadActivityData"); err != nil {
-		return nil, err
+	downloadActivities, size, err := parseVuDownloadActivityDataRecordArray[vuv1.OverviewGen2V1_DownloadActivity](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuDownloadActivityData: %w", err)
 	}
+	overview.SetDownloadActivities(downloadActivities)
+	offset += size
 
 	// VuCompanyLocksRecordArray
-	if err := skipRecordArray("VuCompanyLocks"); err != nil {
-		return nil, err
+	companyLocks, size, err := parseVuCompanyLocksRecordArray[vuv1.OverviewGen2V1_CompanyLock](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuCompanyLocks: %w", err)
 	}
+	overview.SetCompanyLocks(companyLocks)
+	offset += size
 
 	// VuControlActivityRecordArray
-	if err := skipRecordArray("VuControlActivity"); err != nil {
-		return nil, err
+	controlActivities, size, err := parseVuControlActivityRecordArray[vuv1.OverviewGen2V1_ControlActivity](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuControlActivity: %w", err)
 	}
+	overview.SetControlActivities(controlActivities)
+	offset += size
 
 	// Store signature (extracted at the beginning)
 	overview.SetSignature(signature)
@@ -165,10 +187,7 @@ func (opts AnonymizeOptions) anonymizeOverviewGen2V1(overview *vuv1.OverviewGen2
 	result := proto.Clone(overview).(*vuv1.OverviewGen2V1)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize VIN
 	if vin := result.GetVehicleIdentificationNumber(); vin != nil {
@@ -1,6 +1,8 @@
 package vu
 
 import (
+	"encoding/binary"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -58,3 +60,122 @@ func TestOverview_Gen2V1(t *testing.T) {
 		})
 	}
 }
+
+func TestOverview_Gen2V1_downloadActivities(t *testing.T) {
+	newDownloadRecord := func(downloadingTime uint32, cardNumber, name string) []byte {
+		record := binary.BigEndian.AppendUint32(nil, downloadingTime)
+		record = append(record, 0x03, 0x11)                          // cardType (company card), cardIssuingMemberState
+		record = append(record, fmt.Sprintf("%-16s", cardNumber)...) // cardNumber
+		record = append(record, 0x02)                                // generation
+		record = append(record, 0x01)                                // codePage
+		record = append(record, fmt.Sprintf("%-35s", name)...)       // name
+		return record
+	}
+	records := [][]byte{
+		newDownloadRecord(0x60000000, "C000000000001000", "FIRST TRANSPORT"),
+		newDownloadRecord(0x61000000, "C000000000002000", "SECOND TRANSPORT"),
+	}
+
+	var value []byte
+	for _, name := range overviewGen2V1RecordArrays {
+		if name != "VuDownloadActivityDataRecordArray" {
+			value = appendRecordArrayHeader(value, 0x00, 0, 0)
+			continue
+		}
+		value = appendRecordArrayHeader(value, 0x00, uint16(len(records[0])), uint16(len(records)))
+		for _, record := range records {
+			value = append(value, record...)
+		}
+	}
+	value = appendRecordArrayHeader(value, 0x08, 4, 1) // SignatureRecordArray
+	value = append(value, 0xde, 0xad, 0xbe, 0xef)
+
+	overview, err := unmarshalOverviewGen2V1(value)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	type downloadActivity struct {
+		DownloadingTime int64
+		CardNumber      string
+		Name            string
+	}
+	var got []downloadActivity
+	for _, activity := range overview.GetDownloadActivities() {
+		got = append(got, downloadActivity{
+			DownloadingTime: activity.GetDownloadingTime().GetSeconds(),
+			CardNumber:      activity.GetFullCardNumberAndGeneration().GetFullCardNumber().GetOwnerIdentification().GetOwnerIdentification().GetValue(),
+			Name:            activity.GetCompanyOrWorkshopName().GetValue(),
+		})
+	}
+	want := []downloadActivity{
+		{DownloadingTime: 0x60000000, CardNumber: "C000000000001", Name: "FIRST TRANSPORT"},
+		{DownloadingTime: 0x61000000, CardNumber: "C000000000002", Name: "SECOND TRANSPORT"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("download activities mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOverview_Gen2V1_companyLocks(t *testing.T) {
+	newLockRecord := func(lockIn, lockOut uint32, name, cardNumber string) []byte {
+		record := binary.BigEndian.AppendUint32(nil, lockIn)
+		record = binary.BigEndian.AppendUint32(record, lockOut)
+		record = append(record, 0x01)                                // codePage
+		record = append(record, fmt.Sprintf("%-35s", name)...)       // companyName
+		record = append(record, 0x01)                                // codePage
+		record = append(record, fmt.Sprintf("%-35s", "MAIN ST")...)  // companyAddress
+		record = append(record, 0x03, 0x11)                          // cardType (company card), cardIssuingMemberState
+		record = append(record, fmt.Sprintf("%-16s", cardNumber)...) // cardNumber
+		record = append(record, 0x02)                                // generation
+		return record
+	}
+	records := [][]byte{
+		newLockRecord(0x60000000, 0x61000000, "FIRST TRANSPORT", "C000000000001000"),
+		newLockRecord(0x61000000, 0, "SECOND TRANSPORT", "C000000000002000"),
+	}
+
+	var value []byte
+	for _, name := range overviewGen2V1RecordArrays {
+		if name != "VuCompanyLocksRecordArray" {
+			value = appendRecordArrayHeader(value, 0x00, 0, 0)
+			continue
+		}
+		value = appendRecordArrayHeader(value, 0x00, uint16(len(records[0])), uint16(len(records)))
+		for _, record := range records {
+			value = append(value, record...)
+		}
+	}
+	value = appendRecordArrayHeader(value, 0x08, 4, 1) // SignatureRecordArray
+	value = append(value, 0xde, 0xad, 0xbe, 0xef)
+
+	overview, err := unmarshalOverviewGen2V1(value)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	type companyLock struct {
+		LockInTime  int64
+		LockOutTime int64
+		Name        string
+		CardNumber  string
+	}
+	var got []companyLock
+	for _, lock := range overview.GetCompanyLocks() {
+		got = append(got, companyLock{
+			LockInTime:  lock.GetLockInTime().GetSeconds(),
+			LockOutTime: lock.GetLockOutTime().GetSeconds(),
+			Name:        lock.GetCompanyName().GetValue(),
+			CardNumber:  lock.GetCompanyCardNumberAndGeneration().GetFullCardNumber().GetOwnerIdentification().GetOwnerIdentification().GetValue(),
+		})
+	}
+	want := []companyLock{
+		{LockInTime: 0x60000000, LockOutTime: 0x61000000, Name: "FIRST TRANSPORT", CardNumber: "C000000000001"},
+		{LockInTime: 0x61000000, Name: "SECOND TRANSPORT", CardNumber: "C000000000002"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("company locks mismatch (-want +got):\n%s", diff)
+	}
+	wantIssues := []LockIssue{{Kind: LockIssueActive, Locks: []int{1}}}
+	if diff := cmp.Diff(wantIssues, AnalyzeCompanyLocksGen2V1(overview.GetCompanyLocks())); diff != "" {
+		t.Errorf("AnalyzeCompanyLocksGen2V1() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -40,6 +40,13 @@ import (
 // Note: This is a minimal implementation that stores raw_data for round-trip fidelity.
 // Full semantic parsing of all RecordArrays is TODO.
 func unmarshalOverviewGen2V2(value []byte) (*vuv1.OverviewGen2V2, error) {
+	return parseOverviewGen2V2(dd.UnmarshalOptions{PreserveRawData: true}, value, nil)
+}
+
+// parseOverviewGen2V2 is like unmarshalOverviewGen2V2, but parses the records
+// of the transfer with opts, and limits the number of records of its
+// RecordArrays with sizes.
+func parseOverviewGen2V2(opts dd.UnmarshalOptions, value []byte, sizes *recordSizes) (*vuv1.OverviewGen2V2, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -54,6 +61,9 @@ func unmarshalOverviewGen2V2(value []byte) (*vuv1.OverviewGen2V2, error) {
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	overview := &vuv1.OverviewGen2V2{}
 	overview.SetRawData(value) // Store complete transfer value for painting
@@ -63,6 +73,9 @@ func unmarshalOverviewGen2V2(value []byte) (*vuv1.OverviewGen2V2, error) {
 
 	// Helper to skip a RecordArray
 	skipRecordArray := func(name string) error {
+		if err := sizes.checkArrayCount(name, data, offset); err != nil {
+			return err
+		}
 		size, err := sizeOfRecordArray(data, offset)
 		if err != nil {
 			return fmt.Errorf("%s: %w", name, err)
@@ -107,19 +120,28 @@ func unmarshalOverviewGen2V2(value []byte) (*vuv1.OverviewGen2V2, error) {
 	}
 
 	// VuDownloadActivityDataRecordArray
-	if err := skipRecordArray("VuDownloadActivityData"); err != nil {
-		return nil, err
+	downloadActivities, size, err := parseVuDownloadActivityDataRecordArray[vuv1.OverviewGen2V2_DownloadActivity](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuDownloadActivityData: %w", err)
 	}
+	overview.SetDownloadActivities(downloadActivities)
+	offset += size
 
 	// VuCompanyLocksRecordArray
-	if err := skipRecordArray("VuCompanyLocks"); err != nil {
-		return nil, err
+	companyLocks, size, err := parseVuCompanyLocksRecordArray[vuv1.OverviewGen2V2_CompanyLock](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuCompanyLocks: %w", err)
 	}
+	overview.SetCompanyLocks(companyLocks)
+	offset += size
 
 	// VuControlActivityRecordArray
-	if err := skipRecordArray("VuControlActivity"); err != nil {
-		return nil, err
+	controlActivities, size, err := parseVuControlActivityRecordArray[vuv1.OverviewGen2V2_ControlActivity](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuControlActivity: %w", err)
 	}
+	overview.SetControlActivities(controlActivities)
+	offset += size
 
 	// Store signature (extracted at the beginning)
 	overview.SetSignature(signature)
@@ -167,10 +189,7 @@ func (opts AnonymizeOptions) anonymizeOverviewGen2V2(overview *vuv1.OverviewGen2
 	result := proto.Clone(overview).(*vuv1.OverviewGen2V2)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize VIN
 	if vin := result.GetVehicleIdentificationNumber(); vin != nil {
@@ -1,11 +1,16 @@
 package vu
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
@@ -58,3 +63,76 @@ func TestOverview_Gen2V2(t *testing.T) {
 		})
 	}
 }
+
+func TestOverview_Gen2V2_companyLocksAndControlActivities(t *testing.T) {
+	lock := binary.BigEndian.AppendUint32(nil, 0x60000000)                 // lockInTime
+	lock = binary.BigEndian.AppendUint32(lock, 0)                          // lockOutTime
+	lock = append(lock, 0x01)                                              // codePage
+	lock = append(lock, fmt.Sprintf("%-35s", "FIRST TRANSPORT")...)        // companyName
+	lock = append(lock, 0x01)                                              // codePage
+	lock = append(lock, fmt.Sprintf("%-35s", "MAIN ST")...)                // companyAddress
+	lock = append(lock, 0x03, 0x11)                                        // cardType (company card), cardIssuingMemberState
+	lock = append(lock, fmt.Sprintf("%-16s", "C000000000001000")...)       // cardNumber
+	lock = append(lock, 0x02)                                              // generation
+	control := []byte{0x80}                                                // controlType (card downloading)
+	control = binary.BigEndian.AppendUint32(control, 0x61000000)           // controlTime
+	control = append(control, 0x04, 0x11)                                  // cardType (control card), cardIssuingMemberState
+	control = append(control, fmt.Sprintf("%-16s", "K000000000001000")...) // cardNumber
+	control = append(control, 0x02)                                        // generation
+	control = binary.BigEndian.AppendUint32(control, 0x5F000000)           // downloadPeriodBeginTime
+	control = binary.BigEndian.AppendUint32(control, 0x60000000)           // downloadPeriodEndTime
+
+	var value []byte
+	for _, name := range overviewGen2V2RecordArrays {
+		switch name {
+		case "VuCompanyLocksRecordArray":
+			value = appendRecordArrayHeader(value, 0x00, uint16(len(lock)), 1)
+			value = append(value, lock...)
+		case "VuControlActivityRecordArray":
+			value = appendRecordArrayHeader(value, 0x00, uint16(len(control)), 1)
+			value = append(value, control...)
+		default:
+			value = appendRecordArrayHeader(value, 0x00, 0, 0)
+		}
+	}
+	value = appendRecordArrayHeader(value, 0x08, 4, 1) // SignatureRecordArray
+	value = append(value, 0xde, 0xad, 0xbe, 0xef)
+
+	overview, err := unmarshalOverviewGen2V2(value)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if got := len(overview.GetCompanyLocks()); got != 1 {
+		t.Fatalf("got %d company locks, want 1", got)
+	}
+	gotLock := overview.GetCompanyLocks()[0]
+	if got := gotLock.GetLockInTime().GetSeconds(); got != 0x60000000 {
+		t.Errorf("lock in time = %#x, want 0x60000000", got)
+	}
+	if got := gotLock.GetCompanyName().GetValue(); got != "FIRST TRANSPORT" {
+		t.Errorf("company name = %q, want %q", got, "FIRST TRANSPORT")
+	}
+	if got := len(overview.GetControlActivities()); got != 1 {
+		t.Fatalf("got %d control activities, want 1", got)
+	}
+	gotControl := overview.GetControlActivities()[0]
+	if got := gotControl.GetControlType().GetCardDownloading(); !got {
+		t.Error("control type card downloading = false, want true")
+	}
+	if got := gotControl.GetControlTime().GetSeconds(); got != 0x61000000 {
+		t.Errorf("control time = %#x, want 0x61000000", got)
+	}
+	if got := gotControl.GetControlCardNumberAndGeneration().GetFullCardNumber().GetOwnerIdentification().GetOwnerIdentification().GetValue(); got != "K000000000001" {
+		t.Errorf("control card number = %q, want %q", got, "K000000000001")
+	}
+	if got := gotControl.GetDownloadPeriodEndTime().GetSeconds(); got != 0x60000000 {
+		t.Errorf("download period end time = %#x, want 0x60000000", got)
+	}
+
+	// A lock record cut short by the end of the transfer is truncated data.
+	truncated := appendRecordArrayHeader(nil, 0x00, uint16(len(lock)), 1)
+	truncated = append(truncated, lock[:50]...)
+	if _, _, err := parseVuCompanyLocksRecordArray[vuv1.OverviewGen2V2_CompanyLock](dd.UnmarshalOptions{}, truncated, 0, nil); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("parseVuCompanyLocksRecordArray(truncated) error = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
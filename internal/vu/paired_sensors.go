@@ -0,0 +1,76 @@
+package vu
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// PairedSensor is a motion sensor paired with the vehicle unit, as recorded
+// in the technical data transfer of any generation.
+type PairedSensor struct {
+	// SerialNumber is the extended serial number of the motion sensor.
+	SerialNumber *ddv1.ExtendedSerialNumber
+
+	// ApprovalNumber is the type approval number of the motion sensor,
+	// trimmed of padding.
+	ApprovalNumber string
+
+	// PairingDate is the date the motion sensor was paired with the VU.
+	PairingDate time.Time
+}
+
+// PairedSensors returns the motion sensors paired with the vehicle unit,
+// regardless of its generation.
+//
+// Generation 1 VUs record the last paired sensor only, while Generation 2 VUs
+// record the history of paired sensors. When a download holds several
+// technical data transfers, the sensors of all transfers are returned in
+// order, skipping sensors already returned.
+//
+// The data types `SensorPaired` and `SensorPairedRecord` are specified in the
+// Data Dictionary, Sections 2.144 and 2.145.
+func PairedSensors(file *vuv1.VehicleUnitFile) []*PairedSensor {
+	var result []*PairedSensor
+	add := func(serialNumber *ddv1.ExtendedSerialNumber, approvalNumber *ddv1.Ia5StringValue, pairingDate time.Time) {
+		sensor := &PairedSensor{
+			SerialNumber:   serialNumber,
+			ApprovalNumber: strings.TrimSpace(approvalNumber.GetValue()),
+			PairingDate:    pairingDate,
+		}
+		for _, other := range result {
+			if other.ApprovalNumber == sensor.ApprovalNumber && other.PairingDate.Equal(sensor.PairingDate) &&
+				proto.Equal(other.SerialNumber, sensor.SerialNumber) {
+				return
+			}
+		}
+		result = append(result, sensor)
+	}
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		for _, technicalData := range file.GetGen1().GetTechnicalData() {
+			if sensor := technicalData.GetPairedSensor(); sensor != nil {
+				add(sensor.GetSerialNumber(), sensor.GetApprovalNumber(), controlActivityTime(sensor.GetPairingDate()))
+			}
+		}
+	case ddv1.Generation_GENERATION_2:
+		if file.GetVersion() == ddv1.Version_VERSION_2 {
+			for _, technicalData := range file.GetGen2V2().GetTechnicalData() {
+				for _, sensor := range technicalData.GetPairedSensors() {
+					add(sensor.GetSerialNumber(), sensor.GetApprovalNumber(), controlActivityTime(sensor.GetPairingDate()))
+				}
+			}
+		} else {
+			for _, technicalData := range file.GetGen2V1().GetTechnicalData() {
+				for _, sensor := range technicalData.GetPairedSensors() {
+					add(sensor.GetSerialNumber(), sensor.GetApprovalNumber(), controlActivityTime(sensor.GetPairingDate()))
+				}
+			}
+		}
+	}
+	return result
+}
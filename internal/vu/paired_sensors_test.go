@@ -0,0 +1,97 @@
+package vu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestPairedSensors(t *testing.T) {
+	firstPairing := time.Date(2021, 3, 4, 8, 0, 0, 0, time.UTC)
+	secondPairing := time.Date(2023, 9, 12, 14, 30, 0, 0, time.UTC)
+	serialNumber := []byte{0x00, 0x12, 0x34, 0x56, 0x03, 0x21, 0x07, 0x42} // ExtendedSerialNumber
+
+	// A VuSensorPairedRecordArray with two sensors.
+	data := appendRecordArrayHeader(nil, 0x08, 28, 2)
+	data = append(data, serialNumber...)
+	data = append(data, []byte("e1-0001         ")...)
+	data = append(data, timeReal(firstPairing)...)
+	data = append(data, serialNumber...)
+	data = append(data, []byte("e1-0002         ")...)
+	data = append(data, timeReal(secondPairing)...)
+	records, size, err := parseSensorPairedRecordArray[vuv1.TechnicalDataGen2V1_PairedSensor](dd.UnmarshalOptions{}, data, 0, nil)
+	if err != nil {
+		t.Fatalf("parseSensorPairedRecordArray() failed: %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("parseSensorPairedRecordArray() size = %d, want %d", size, len(data))
+	}
+
+	// Two technical data transfers, the second repeating the first sensor.
+	first := &vuv1.TechnicalDataGen2V1{}
+	first.SetPairedSensors(records[:1])
+	second := &vuv1.TechnicalDataGen2V1{}
+	second.SetPairedSensors(records)
+	gen2v1 := &vuv1.VehicleUnitFileGen2V1{}
+	gen2v1.SetTechnicalData([]*vuv1.TechnicalDataGen2V1{first, second})
+	gen2v1File := &vuv1.VehicleUnitFile{}
+	gen2v1File.SetGeneration(ddv1.Generation_GENERATION_2)
+	gen2v1File.SetVersion(ddv1.Version_VERSION_1)
+	gen2v1File.SetGen2V1(gen2v1)
+
+	// A Gen1 SensorPaired with an 8-byte approval number.
+	gen1Data := append([]byte{}, serialNumber...)
+	gen1Data = append(gen1Data, []byte("e1-0001 ")...)
+	gen1Data = append(gen1Data, timeReal(firstPairing)...)
+	sensorPaired, err := dd.UnmarshalOptions{}.UnmarshalSensorPaired(gen1Data)
+	if err != nil {
+		t.Fatalf("UnmarshalSensorPaired() failed: %v", err)
+	}
+	gen1TechnicalData := &vuv1.TechnicalDataGen1{}
+	gen1TechnicalData.SetPairedSensor(sensorPaired)
+	gen1 := &vuv1.VehicleUnitFileGen1{}
+	gen1.SetTechnicalData([]*vuv1.TechnicalDataGen1{gen1TechnicalData})
+	gen1File := &vuv1.VehicleUnitFile{}
+	gen1File.SetGeneration(ddv1.Generation_GENERATION_1)
+	gen1File.SetGen1(gen1)
+
+	type sensor struct {
+		SerialNumber   int64
+		ApprovalNumber string
+		PairingDate    time.Time
+	}
+	for _, tt := range []struct {
+		name string
+		file *vuv1.VehicleUnitFile
+		want []sensor
+	}{
+		{
+			name: "gen1",
+			file: gen1File,
+			want: []sensor{{0x123456, "e1-0001", firstPairing}},
+		},
+		{
+			name: "gen2 v1",
+			file: gen2v1File,
+			want: []sensor{
+				{0x123456, "e1-0001", firstPairing},
+				{0x123456, "e1-0002", secondPairing},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []sensor
+			for _, s := range PairedSensors(tt.file) {
+				got = append(got, sensor{s.SerialNumber.GetSerialNumber(), s.ApprovalNumber, s.PairingDate})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PairedSensors() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
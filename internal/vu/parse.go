@@ -1,8 +1,46 @@
 package vu
 
+import (
+	"github.com/way-platform/tachograph-go/internal/dd"
+)
+
 // ParseOptions configures the parsing of raw VU files into semantic structures.
 type ParseOptions struct {
 	// PreserveRawData controls whether raw byte slices are stored in
 	// the raw_data field of parsed protobuf messages.
 	PreserveRawData bool
+
+	// LenientRecordSizes controls how the parser handles RecordArrays whose
+	// declared record size differs from the Data Dictionary.
+	//
+	// If false (default), such arrays are rejected with an error.
+	// If true, the parser recovers what it can, using the declared size to
+	// step over the records, and reports the mismatch in the parse warnings
+	// of the parsed file. This is intended for forensic parsing of damaged
+	// downloads.
+	LenientRecordSizes bool
+
+	// MaxRecordsPerArray limits the number of records a RecordArray may
+	// declare. Arrays declaring more records are rejected with an error
+	// before any records are allocated.
+	//
+	// If zero (default), the number of records is only limited by the size
+	// of the transfer: arrays whose declared records overrun the transfer
+	// are always rejected. A limit guards against allocating for large
+	// arrays of small records when parsing untrusted input.
+	MaxRecordsPerArray int
+
+	// OnUnrecognized, if set, is called for each enum value that has no
+	// matching value in the Data Dictionary, see dd.UnmarshalOptions.
+	OnUnrecognized func(context string, rawValue uint64)
+}
+
+// unmarshal returns the dd.UnmarshalOptions for parsing the records of
+// transfers. Raw data is always preserved, as marshalling paints over the raw
+// transfer values.
+func (o ParseOptions) unmarshal() dd.UnmarshalOptions {
+	return dd.UnmarshalOptions{
+		PreserveRawData: true,
+		OnUnrecognized:  o.OnUnrecognized,
+	}
 }
@@ -3,7 +3,9 @@ package vu
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 	"google.golang.org/protobuf/proto"
@@ -22,7 +24,7 @@ func splitTransferValue(record *vuv1.RawVehicleUnitFile_Record) (data, signature
 	sigSize := int(record.GetSignatureSize())
 
 	if len(value) < sigSize {
-		return nil, nil, fmt.Errorf("value too short for signature: got %d bytes, need at least %d", len(value), sigSize)
+		return nil, nil, fmt.Errorf("value too short for signature: got %d bytes, need at least %d: %w", len(value), sigSize, io.ErrUnexpectedEOF)
 	}
 
 	dataSize := len(value) - sigSize
@@ -49,16 +51,17 @@ func (opts UnmarshalOptions) UnmarshalRawVehicleUnitFile(data []byte) (*vuv1.Raw
 	for offset < len(data) {
 		// Read tag (2 bytes)
 		if offset+2 > len(data) {
-			return nil, fmt.Errorf("insufficient data for tag at offset %d: need 2 bytes, have %d", offset, len(data)-offset)
+			return nil, fmt.Errorf("insufficient data for tag at offset %d: need 2 bytes, have %d: %w", offset, len(data)-offset, io.ErrUnexpectedEOF)
 		}
+		tagOffset := offset
 		tag := binary.BigEndian.Uint16(data[offset:])
 		offset += 2
 
 		// Determine transfer type from tag
-		transferType := findTransferTypeByTag(tag)
-		if transferType == vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED {
+		transferType, ok := TransferTypeForTag(tag)
+		if !ok {
 			if opts.Strict {
-				return nil, fmt.Errorf("unknown tag: 0x%04X at offset %d", tag, offset-2)
+				return nil, fmt.Errorf("%w: 0x%04X at offset %d", dd.ErrUnknownTag, tag, offset-2)
 			}
 			// In non-strict mode, skip this tag and try to continue
 			// We can't know the structure without knowing the transfer type,
@@ -68,14 +71,29 @@ func (opts UnmarshalOptions) UnmarshalRawVehicleUnitFile(data []byte) (*vuv1.Raw
 		}
 
 		// Calculate size of value (including embedded signature)
-		totalSize, sigSize, err := sizeOfTransferValue(data[offset:], transferType)
+		totalSize, sigSize, err := TransferSize(data[offset:], transferType)
+		if (err != nil || offset+totalSize > len(data)) && isEmptyTransfer(data[offset:], transferType) {
+			if opts.Strict {
+				return nil, fmt.Errorf("%w: %v at offset %d", dd.ErrEmptyTransfer, transferType, tagOffset)
+			}
+			// In non-strict mode, keep the empty transfer as a record with an
+			// empty value, and continue with the next one.
+			record := &vuv1.RawVehicleUnitFile_Record{}
+			record.SetTag(uint32(tag))
+			record.SetType(transferType)
+			record.SetGeneration(generationFromTransferType(transferType))
+			record.SetValue([]byte{})
+			record.SetFileOffset(int32(tagOffset))
+			rawFile.SetRecords(append(rawFile.GetRecords(), record))
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("sizeOf failed for %v at offset %d: %w", transferType, offset, err)
 		}
 
 		// Extract complete value (includes signature)
 		if offset+totalSize > len(data) {
-			return nil, fmt.Errorf("insufficient data for %v value: need %d bytes, have %d", transferType, totalSize, len(data)-offset)
+			return nil, fmt.Errorf("insufficient data for %v value: need %d bytes, have %d: %w", transferType, totalSize, len(data)-offset, io.ErrUnexpectedEOF)
 		}
 		value := data[offset : offset+totalSize]
 		offset += totalSize
@@ -87,6 +105,7 @@ func (opts UnmarshalOptions) UnmarshalRawVehicleUnitFile(data []byte) (*vuv1.Raw
 		record.SetGeneration(generationFromTransferType(transferType))
 		record.SetValue(value)                  // Store complete value
 		record.SetSignatureSize(int32(sigSize)) // Store signature size for efficient splitting
+		record.SetFileOffset(int32(tagOffset))
 
 		rawFile.SetRecords(append(rawFile.GetRecords(), record))
 	}
@@ -94,10 +113,39 @@ func (opts UnmarshalOptions) UnmarshalRawVehicleUnitFile(data []byte) (*vuv1.Raw
 	return &rawFile, nil
 }
 
-// sizeOfTransferValue dispatches to type-specific sizeOf functions.
-// Returns both the total byte size (including signature) and the signature size.
-// The data portion size can be calculated as: totalSize - signatureSize.
-func sizeOfTransferValue(data []byte, transferType vuv1.TransferType) (totalSize, signatureSize int, err error) {
+// isEmptyTransfer reports whether the transfer of the given type whose value
+// starts at data has an empty value, as emitted by some VUs: data either ends
+// right after the tag, or continues with the tag of another transfer.
+//
+// It is only meaningful once the value failed to size as the given type, since
+// a non-empty value may also happen to start with the bytes of a tag.
+// CARD_DOWNLOAD values may legitimately be empty and are never reported.
+func isEmptyTransfer(data []byte, transferType vuv1.TransferType) bool {
+	if transferType == vuv1.TransferType_CARD_DOWNLOAD {
+		return false
+	}
+	if len(data) == 0 {
+		return true
+	}
+	if len(data) < 2 {
+		return false
+	}
+	_, ok := TransferTypeForTag(binary.BigEndian.Uint16(data))
+	return ok
+}
+
+// TransferSize returns the size of the transfer value at the start of data,
+// which follows the 2-byte tag of a transfer response (TREP) of the given type.
+//
+// It returns both the total byte size (including signature) and the signature
+// size. The data portion size can be calculated as: totalSize - signatureSize.
+//
+// Transfer values are not length-prefixed, so the size is computed from the
+// count fields in the value itself. If data is too short to reach all of them,
+// an error is returned; download clients can read more bytes and retry.
+// CARD_DOWNLOAD transfers have no count fields and span all of data, so their
+// size is zero for empty data. For all other types empty data is an error.
+func TransferSize(data []byte, transferType vuv1.TransferType) (totalSize, signatureSize int, err error) {
 	switch transferType {
 	case vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION:
 		return sizeOfDownloadInterfaceVersion(data, transferType)
@@ -129,8 +177,8 @@ func sizeOfTransferValue(data []byte, transferType vuv1.TransferType) (totalSize
 // Total size = 5 + (recordSize * noOfRecords)
 func sizeOfRecordArray(data []byte, offset int) (int, error) {
 	const headerSize = 5
-	if len(data[offset:]) < headerSize {
-		return 0, fmt.Errorf("insufficient data for RecordArray header: need %d, have %d", headerSize, len(data[offset:]))
+	if len(data) < offset+headerSize {
+		return 0, fmt.Errorf("insufficient data for RecordArray header: need %d, have %d: %w", headerSize, max(len(data)-offset, 0), io.ErrUnexpectedEOF)
 	}
 
 	recordSize := binary.BigEndian.Uint16(data[offset+1:])
@@ -140,6 +188,73 @@ func sizeOfRecordArray(data []byte, offset int) (int, error) {
 	return totalSize, nil
 }
 
+// sizeOfRecordArrays calculates the size of a Gen2 transfer value consisting of
+// the named RecordArrays followed by a SignatureRecordArray, in that order.
+// The names are used in errors only.
+func sizeOfRecordArrays(data []byte, names []string) (totalSize, signatureSize int, err error) {
+	offset := 0
+	for _, name := range names {
+		size, sizeErr := sizeOfRecordArray(data, offset)
+		if sizeErr != nil {
+			return 0, 0, fmt.Errorf("%s: %w", name, sizeErr)
+		}
+		offset += size
+	}
+
+	// SignatureRecordArray (last)
+	size, sizeErr := sizeOfRecordArray(data, offset)
+	if sizeErr != nil {
+		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
+	}
+	offset += size
+
+	return offset, size, nil
+}
+
+// recordArrayRecords returns the records of the n-th RecordArray (counting from
+// zero) in data, or nil if data does not contain that many RecordArrays.
+func recordArrayRecords(data []byte, n int) []byte {
+	const headerSize = 5
+	offset := 0
+	for i := 0; i < n; i++ {
+		size, err := sizeOfRecordArray(data, offset)
+		if err != nil || offset+size > len(data) {
+			return nil
+		}
+		offset += size
+	}
+	size, err := sizeOfRecordArray(data, offset)
+	if err != nil || offset+size > len(data) {
+		return nil
+	}
+	return data[offset+headerSize : offset+size]
+}
+
+// parseSignatureRecordArray returns the signatures of a SignatureRecordArray,
+// in the order they are stored.
+//
+// The noOfRecords field of the header determines the number of signatures, each
+// recordSize bytes long (Appendix 7, Section 2.2.6). An error is returned if
+// the array holds no signature, or does not span data exactly.
+func parseSignatureRecordArray(data []byte) ([][]byte, error) {
+	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if noOfRecords == 0 || recordSize == 0 {
+		return nil, fmt.Errorf("SignatureRecordArray holds no signature")
+	}
+	if size := headerSize + int(recordSize)*int(noOfRecords); size != len(data) {
+		return nil, fmt.Errorf("SignatureRecordArray size mismatch: header declares %d bytes, got %d", size, len(data))
+	}
+	signatures := make([][]byte, 0, noOfRecords)
+	for i := 0; i < int(noOfRecords); i++ {
+		start := headerSize + i*int(recordSize)
+		signatures = append(signatures, data[start:start+int(recordSize)])
+	}
+	return signatures, nil
+}
+
 // generationFromTransferType extracts generation from transfer type using protobuf reflection.
 func generationFromTransferType(transferType vuv1.TransferType) ddv1.Generation {
 	// Use protobuf reflection to get generation from enum options
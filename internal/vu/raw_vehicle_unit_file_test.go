@@ -1,7 +1,9 @@
 package vu
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"flag"
 	"os"
 	"path/filepath"
@@ -10,6 +12,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
 var update = flag.Bool("update", false, "update golden files")
@@ -144,6 +149,9 @@ func TestRawVehicleUnitFileRoundTrip(t *testing.T) {
 			// Reconstruct binary by concatenating tags and values
 			var reconstructed []byte
 			for _, record := range rawFile.GetRecords() {
+				if got, want := int(record.GetFileOffset()), len(reconstructed); got != want {
+					t.Errorf("file offset of %v = %d, want %d", record.GetType(), got, want)
+				}
 				// Append 2-byte tag (big-endian)
 				tag := uint16(record.GetTag())
 				reconstructed = append(reconstructed, byte(tag>>8), byte(tag))
@@ -159,3 +167,183 @@ func TestRawVehicleUnitFileRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferSize(t *testing.T) {
+	for _, transferType := range []vuv1.TransferType{
+		vuv1.TransferType_OVERVIEW_GEN1,
+		vuv1.TransferType_ACTIVITIES_GEN1,
+		vuv1.TransferType_EVENTS_AND_FAULTS_GEN1,
+		vuv1.TransferType_DETAILED_SPEED_GEN1,
+		vuv1.TransferType_TECHNICAL_DATA_GEN1,
+	} {
+		hexdumpFiles, err := findHexdumpFiles(transferType)
+		if err != nil {
+			t.Fatalf("Failed to discover hexdump files: %v", err)
+		}
+		for _, hexdumpPath := range hexdumpFiles {
+			t.Run(strings.TrimPrefix(hexdumpPath, "testdata/records/"), func(t *testing.T) {
+				data, err := readHexdump(hexdumpPath)
+				if err != nil {
+					t.Fatalf("Failed to read hexdump: %v", err)
+				}
+				total, signature, err := TransferSize(data, transferType)
+				if err != nil {
+					t.Fatalf("TransferSize failed: %v", err)
+				}
+				if total != len(data) || signature != 128 {
+					t.Errorf("TransferSize = (%d, %d), want (%d, 128)", total, signature, len(data))
+				}
+				// A partially received transfer either needs more data or
+				// already determines the full size.
+				for n := range len(data) {
+					if total, _, err := TransferSize(data[:n], transferType); err == nil && total != len(data) {
+						t.Fatalf("TransferSize of %d-byte prefix = %d, want %d", n, total, len(data))
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestUnmarshalRawVehicleUnitFile_FileOffset(t *testing.T) {
+	var data []byte
+	var want []int32
+	for _, transferType := range []vuv1.TransferType{
+		vuv1.TransferType_OVERVIEW_GEN1,
+		vuv1.TransferType_ACTIVITIES_GEN1,
+		vuv1.TransferType_TECHNICAL_DATA_GEN1,
+	} {
+		hexdumpFiles, err := findHexdumpFiles(transferType)
+		if err != nil || len(hexdumpFiles) == 0 {
+			t.Fatalf("Failed to find %v hexdump files: %v", transferType, err)
+		}
+		value, err := readHexdump(hexdumpFiles[0])
+		if err != nil {
+			t.Fatalf("Failed to read hexdump: %v", err)
+		}
+		want = append(want, int32(len(data)))
+		data = appendTransfer(data, transferType, value)
+	}
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+	}
+	var got []int32
+	for _, record := range rawFile.GetRecords() {
+		got = append(got, record.GetFileOffset())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("file offsets mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnmarshalRawVehicleUnitFile_EmptyTransfer(t *testing.T) {
+	var overview, technicalData []byte
+	for transferType, value := range map[vuv1.TransferType]*[]byte{
+		vuv1.TransferType_OVERVIEW_GEN1:       &overview,
+		vuv1.TransferType_TECHNICAL_DATA_GEN1: &technicalData,
+	} {
+		hexdumpFiles, err := findHexdumpFiles(transferType)
+		if err != nil || len(hexdumpFiles) == 0 {
+			t.Fatalf("Failed to find %v hexdump files: %v", transferType, err)
+		}
+		if *value, err = readHexdump(hexdumpFiles[0]); err != nil {
+			t.Fatalf("Failed to read hexdump: %v", err)
+		}
+	}
+	withOverview := appendTransfer(nil, vuv1.TransferType_OVERVIEW_GEN1, overview)
+	for _, tt := range []struct {
+		name string
+		data []byte
+		want []vuv1.TransferType
+	}{
+		{
+			name: "between transfers",
+			data: appendTransfer(appendTransfer(withOverview, vuv1.TransferType_DETAILED_SPEED_GEN1, nil), vuv1.TransferType_TECHNICAL_DATA_GEN1, technicalData),
+			want: []vuv1.TransferType{vuv1.TransferType_OVERVIEW_GEN1, vuv1.TransferType_DETAILED_SPEED_GEN1, vuv1.TransferType_TECHNICAL_DATA_GEN1},
+		},
+		{
+			name: "at end",
+			data: appendTransfer(withOverview, vuv1.TransferType_DETAILED_SPEED_GEN1, nil),
+			want: []vuv1.TransferType{vuv1.TransferType_OVERVIEW_GEN1, vuv1.TransferType_DETAILED_SPEED_GEN1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalOptions{Strict: true}.UnmarshalRawVehicleUnitFile(tt.data)
+			if !errors.Is(err, dd.ErrEmptyTransfer) {
+				t.Errorf("strict UnmarshalRawVehicleUnitFile() error = %v, want %v", err, dd.ErrEmptyTransfer)
+			}
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(tt.data)
+			if err != nil {
+				t.Fatalf("UnmarshalRawVehicleUnitFile() failed: %v", err)
+			}
+			var got []vuv1.TransferType
+			for _, record := range rawFile.GetRecords() {
+				got = append(got, record.GetType())
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("transfer types mismatch (-want +got):\n%s", diff)
+			}
+			marshaled, err := MarshalOptions{}.MarshalRawVehicleUnitFile(rawFile)
+			if err != nil {
+				t.Fatalf("MarshalRawVehicleUnitFile() failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.data, marshaled); diff != "" {
+				t.Errorf("binary round-trip mismatch (-want +got):\n%s", diff)
+			}
+			file, err := ParseOptions{}.ParseRawVehicleUnitFile(rawFile)
+			if err != nil {
+				t.Fatalf("ParseRawVehicleUnitFile() failed: %v", err)
+			}
+			if got := len(file.GetGen1().GetDetailedSpeed()); got != 0 {
+				t.Errorf("got %d detailed speed transfers, want none for the empty transfer", got)
+			}
+		})
+	}
+
+	// An empty card download is a valid, explicitly represented transfer.
+	rawFile, err := UnmarshalOptions{Strict: true}.UnmarshalRawVehicleUnitFile(appendTransfer(withOverview, vuv1.TransferType_CARD_DOWNLOAD, nil))
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile() with empty card download failed: %v", err)
+	}
+	if records := rawFile.GetRecords(); len(records) != 2 || len(records[1].GetValue()) != 0 {
+		t.Errorf("got %d records, want overview and empty card download", len(records))
+	}
+}
+
+func TestParseSignatureRecordArray(t *testing.T) {
+	first := []byte{0x01, 0x02, 0x03, 0x04}
+	second := []byte{0x05, 0x06, 0x07, 0x08}
+	data := appendRecordArrayHeader(nil, 0x08, 4, 2)
+	data = append(data, first...)
+	data = append(data, second...)
+	signatures, err := parseSignatureRecordArray(data)
+	if err != nil {
+		t.Fatalf("parseSignatureRecordArray failed: %v", err)
+	}
+	if diff := cmp.Diff([][]byte{first, second}, signatures); diff != "" {
+		t.Errorf("signatures mismatch (-want +got):\n%s", diff)
+	}
+
+	// A signature record whose array exceeds the 64 KiB default token size
+	// of a bufio.Scanner.
+	large := bytes.Repeat([]byte{0x5A}, 0xFFFF)
+	data = append(appendRecordArrayHeader(nil, 0x08, 0xFFFF, 1), large...)
+	signatures, err = parseSignatureRecordArray(data)
+	if err != nil {
+		t.Fatalf("parseSignatureRecordArray of large record failed: %v", err)
+	}
+	if len(signatures) != 1 || !bytes.Equal(signatures[0], large) {
+		t.Errorf("large signature mismatch: got %d signatures", len(signatures))
+	}
+
+	for name, data := range map[string][]byte{
+		"no records":     appendRecordArrayHeader(nil, 0x08, 64, 0),
+		"truncated":      append(appendRecordArrayHeader(nil, 0x08, 4, 2), first...),
+		"trailing bytes": append(append(appendRecordArrayHeader(nil, 0x08, 4, 1), first...), 0x00),
+	} {
+		if _, err := parseSignatureRecordArray(data); err == nil {
+			t.Errorf("%s: parseSignatureRecordArray succeeded, want error", name)
+		}
+	}
+}
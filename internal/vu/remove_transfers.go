@@ -0,0 +1,94 @@
+package vu
+
+import (
+	"slices"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// RemoveTransfers returns a copy of file without the transfers of the given types.
+//
+// The remaining transfers are left untouched, including their signatures, so the
+// result marshals to a valid (if partial) download where each remaining transfer
+// can still be authenticated. A typical use is stripping detailed speed data
+// before sharing a download. Transfer types that do not belong to the file's
+// generation and version are ignored.
+func RemoveTransfers(file *vuv1.VehicleUnitFile, types ...vuv1.TransferType) *vuv1.VehicleUnitFile {
+	if file == nil {
+		return nil
+	}
+	result := proto.Clone(file).(*vuv1.VehicleUnitFile)
+	remove := func(transferType vuv1.TransferType) bool {
+		return slices.Contains(types, transferType)
+	}
+	switch result.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		gen1 := result.GetGen1()
+		if gen1 == nil {
+			break
+		}
+		if remove(vuv1.TransferType_OVERVIEW_GEN1) {
+			gen1.ClearOverview()
+		}
+		if remove(vuv1.TransferType_ACTIVITIES_GEN1) {
+			gen1.SetActivities(nil)
+		}
+		if remove(vuv1.TransferType_EVENTS_AND_FAULTS_GEN1) {
+			gen1.SetEventsAndFaults(nil)
+		}
+		if remove(vuv1.TransferType_DETAILED_SPEED_GEN1) {
+			gen1.SetDetailedSpeed(nil)
+		}
+		if remove(vuv1.TransferType_TECHNICAL_DATA_GEN1) {
+			gen1.SetTechnicalData(nil)
+		}
+	case ddv1.Generation_GENERATION_2:
+		if result.GetVersion() == ddv1.Version_VERSION_2 {
+			gen2v2 := result.GetGen2V2()
+			if gen2v2 == nil {
+				break
+			}
+			if remove(vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION) {
+				gen2v2.ClearDownloadInterfaceVersion()
+			}
+			if remove(vuv1.TransferType_OVERVIEW_GEN2_V2) {
+				gen2v2.ClearOverview()
+			}
+			if remove(vuv1.TransferType_ACTIVITIES_GEN2_V2) {
+				gen2v2.SetActivities(nil)
+			}
+			if remove(vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V2) {
+				gen2v2.SetEventsAndFaults(nil)
+			}
+			if remove(vuv1.TransferType_DETAILED_SPEED_GEN2) {
+				gen2v2.SetDetailedSpeed(nil)
+			}
+			if remove(vuv1.TransferType_TECHNICAL_DATA_GEN2_V2) {
+				gen2v2.SetTechnicalData(nil)
+			}
+		} else {
+			gen2v1 := result.GetGen2V1()
+			if gen2v1 == nil {
+				break
+			}
+			if remove(vuv1.TransferType_OVERVIEW_GEN2_V1) {
+				gen2v1.ClearOverview()
+			}
+			if remove(vuv1.TransferType_ACTIVITIES_GEN2_V1) {
+				gen2v1.SetActivities(nil)
+			}
+			if remove(vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V1) {
+				gen2v1.SetEventsAndFaults(nil)
+			}
+			if remove(vuv1.TransferType_DETAILED_SPEED_GEN2) {
+				gen2v1.SetDetailedSpeed(nil)
+			}
+			if remove(vuv1.TransferType_TECHNICAL_DATA_GEN2_V1) {
+				gen2v1.SetTechnicalData(nil)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package vu
+
+import (
+	"path/filepath"
+	"sort"
+	"testing"
+
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// readGen1TestDownload assembles a complete Gen1 download from the transfers
+// of one VU in testdata, returning its raw data and the parsed file.
+func readGen1TestDownload(t *testing.T) ([]byte, *vuv1.VehicleUnitFile) {
+	t.Helper()
+	hexdumpFiles, err := filepath.Glob("testdata/records/000-anonymized/*.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to glob hexdump files: %v", err)
+	}
+	sort.Strings(hexdumpFiles)
+	var data []byte
+	for _, hexdumpPath := range hexdumpFiles {
+		var transferType vuv1.TransferType
+		for _, candidate := range []vuv1.TransferType{
+			vuv1.TransferType_OVERVIEW_GEN1,
+			vuv1.TransferType_ACTIVITIES_GEN1,
+			vuv1.TransferType_EVENTS_AND_FAULTS_GEN1,
+			vuv1.TransferType_DETAILED_SPEED_GEN1,
+			vuv1.TransferType_TECHNICAL_DATA_GEN1,
+		} {
+			if matched, _ := filepath.Match("*-"+candidate.String()+".hexdump", filepath.Base(hexdumpPath)); matched {
+				transferType = candidate
+			}
+		}
+		if transferType == vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED {
+			t.Fatalf("Unknown transfer type for %s", hexdumpPath)
+		}
+		value, err := readHexdump(hexdumpPath)
+		if err != nil {
+			t.Fatalf("Failed to read hexdump: %v", err)
+		}
+		data = appendTransfer(data, transferType, value)
+	}
+
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+	}
+	file, err := ParseOptions{PreserveRawData: true}.ParseRawVehicleUnitFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawVehicleUnitFile failed: %v", err)
+	}
+	return data, file
+}
+
+func TestRemoveTransfers(t *testing.T) {
+	// Assemble a complete Gen1 download from the transfers of one VU.
+	data, file := readGen1TestDownload(t)
+	if len(file.GetGen1().GetDetailedSpeed()) == 0 {
+		t.Fatal("test file has no detailed speed transfers")
+	}
+
+	stripped := RemoveTransfers(file, vuv1.TransferType_DETAILED_SPEED_GEN1)
+	if len(file.GetGen1().GetDetailedSpeed()) == 0 {
+		t.Error("RemoveTransfers modified its input")
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalVehicleUnitFile(stripped)
+	if err != nil {
+		t.Fatalf("MarshalVehicleUnitFile failed: %v", err)
+	}
+	reRawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(marshaled)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile of stripped file failed: %v", err)
+	}
+	reparsed, err := ParseOptions{}.ParseRawVehicleUnitFile(reRawFile)
+	if err != nil {
+		t.Fatalf("ParseRawVehicleUnitFile of stripped file failed: %v", err)
+	}
+
+	gen1, reGen1 := file.GetGen1(), reparsed.GetGen1()
+	if got := len(reGen1.GetDetailedSpeed()); got != 0 {
+		t.Errorf("stripped file has %d detailed speed transfers, want 0", got)
+	}
+	if !reGen1.HasOverview() {
+		t.Error("stripped file has no overview")
+	}
+	if got, want := len(reGen1.GetActivities()), len(gen1.GetActivities()); got != want {
+		t.Errorf("stripped file has %d activities transfers, want %d", got, want)
+	}
+	if got, want := len(reGen1.GetEventsAndFaults()), len(gen1.GetEventsAndFaults()); got != want {
+		t.Errorf("stripped file has %d events and faults transfers, want %d", got, want)
+	}
+	if got, want := len(reGen1.GetTechnicalData()), len(gen1.GetTechnicalData()); got != want {
+		t.Errorf("stripped file has %d technical data transfers, want %d", got, want)
+	}
+	if got, want := len(marshaled), len(data); got >= want {
+		t.Errorf("stripped file is %d bytes, want less than %d", got, want)
+	}
+}
@@ -0,0 +1,250 @@
+package vu
+
+import (
+	"sort"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RoutePointKind identifies the VU record a route point was derived from.
+type RoutePointKind int
+
+const (
+	// RoutePointGNSSAccumulatedDriving is a position recorded each time the
+	// accumulated driving time reaches a multiple of three hours.
+	RoutePointGNSSAccumulatedDriving RoutePointKind = iota + 1
+
+	// RoutePointBorderCrossing is a position recorded when the vehicle
+	// crossed a border (Gen2v2).
+	RoutePointBorderCrossing
+
+	// RoutePointLoadUnload is a position recorded when a load or unload
+	// operation was entered (Gen2v2).
+	RoutePointLoadUnload
+)
+
+// String returns the name of the route point kind.
+func (k RoutePointKind) String() string {
+	switch k {
+	case RoutePointGNSSAccumulatedDriving:
+		return "GNSS_ACCUMULATED_DRIVING"
+	case RoutePointBorderCrossing:
+		return "BORDER_CROSSING"
+	case RoutePointLoadUnload:
+		return "LOAD_UNLOAD"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// RoutePoint is a position recorded by a vehicle unit.
+type RoutePoint struct {
+	// Kind identifies the record the point was derived from.
+	Kind RoutePointKind
+
+	// Time is the time of the record. For border crossings, which carry no
+	// separate timestamp, this is the time of the GNSS fix.
+	Time time.Time
+
+	// Latitude and Longitude are the position in decimal degrees.
+	// They are only meaningful when HasPosition is true.
+	Latitude, Longitude float64
+
+	// HasPosition is false if the VU recorded the unknown position marker.
+	HasPosition bool
+
+	// OdometerKm is the vehicle odometer value when the point was recorded.
+	OdometerKm int32
+
+	// CountryLeft and CountryEntered are set for border crossings.
+	CountryLeft, CountryEntered ddv1.NationNumeric
+
+	// OperationType is set for load/unload operations.
+	OperationType ddv1.OperationType
+}
+
+// Route reconstructs the route of a vehicle from the positions recorded in
+// the activities transfers of a VU download, ordered chronologically.
+//
+// The route merges the GNSS accumulated driving records (Gen2), and the border
+// crossing and load/unload records (Gen2v2). Points recorded at the same time
+// are ordered by odometer value. Gen1 VUs do not record positions, so the
+// route of a Gen1 download is empty.
+func Route(file *vuv1.VehicleUnitFile) []*RoutePoint {
+	var route []*RoutePoint
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_2:
+		switch file.GetVersion() {
+		case ddv1.Version_VERSION_2:
+			for _, activities := range file.GetGen2V2().GetActivities() {
+				for _, record := range activities.GetGnssAccumulatedDriving() {
+					route = appendAuthRoutePoint(route, RoutePointGNSSAccumulatedDriving, record.GetTimeStamp(), record.GetGnssPlaceAuthRecord(), record.GetVehicleOdometerKm())
+				}
+				for _, record := range activities.GetBorderCrossings() {
+					place := record.GetGnssPlaceAuthRecord()
+					n := len(route)
+					route = appendAuthRoutePoint(route, RoutePointBorderCrossing, place.GetTimestamp(), place, record.GetVehicleOdometerKm())
+					if len(route) > n {
+						route[n].CountryLeft = record.GetCountryLeft()
+						route[n].CountryEntered = record.GetCountryEntered()
+					}
+				}
+				for _, record := range activities.GetLoadUnloadOperations() {
+					n := len(route)
+					route = appendAuthRoutePoint(route, RoutePointLoadUnload, record.GetTimeStamp(), record.GetGnssPlaceAuthRecord(), record.GetVehicleOdometerKm())
+					if len(route) > n {
+						route[n].OperationType = record.GetOperationType()
+					}
+				}
+			}
+		default:
+			for _, activities := range file.GetGen2V1().GetActivities() {
+				for _, record := range activities.GetGnssAccumulatedDriving() {
+					if !hasRouteTime(record.GetTimeStamp()) {
+						continue
+					}
+					point := &RoutePoint{
+						Kind:       RoutePointGNSSAccumulatedDriving,
+						Time:       record.GetTimeStamp().AsTime().UTC(),
+						OdometerKm: record.GetVehicleOdometerKm(),
+					}
+					point.Latitude, point.Longitude, point.HasPosition = dd.GeoCoordinatesToDegrees(record.GetGnssPlaceRecord().GetGeoCoordinates())
+					route = append(route, point)
+				}
+			}
+		}
+	}
+	sort.SliceStable(route, func(i, j int) bool {
+		if !route[i].Time.Equal(route[j].Time) {
+			return route[i].Time.Before(route[j].Time)
+		}
+		return route[i].OdometerKm < route[j].OdometerKm
+	})
+	return route
+}
+
+// appendAuthRoutePoint appends a route point for a GNSSPlaceAuthRecord, unless
+// the record has no timestamp.
+func appendAuthRoutePoint(route []*RoutePoint, kind RoutePointKind, t *timestamppb.Timestamp, place *ddv1.GNSSPlaceAuthRecord, odometerKm int32) []*RoutePoint {
+	if !hasRouteTime(t) {
+		return route
+	}
+	point := &RoutePoint{
+		Kind:       kind,
+		Time:       t.AsTime().UTC(),
+		OdometerKm: odometerKm,
+	}
+	point.Latitude, point.Longitude, point.HasPosition = dd.GeoCoordinatesToDegrees(place.GetGeoCoordinates())
+	return append(route, point)
+}
+
+// hasRouteTime reports whether t is set to a time other than the zero TimeReal.
+func hasRouteTime(t *timestamppb.Timestamp) bool {
+	return !dd.IsUnsetTime(t)
+}
+
+// CountriesVisited returns the countries recorded in the border crossings of
+// a Gen2v2 VU download, in the order they were first visited.
+//
+// Border crossings are ordered by the time of their GNSS fix. The countries
+// left and entered are both included, so a download with a single crossing
+// yields both countries. The special NationNumeric values that do not denote
+// a single country, such as REST_OF_WORLD or NATION_NUMERIC_EMPTY, and
+// unrecognized codes are excluded.
+func CountriesVisited(file *vuv1.VehicleUnitFile) []ddv1.NationNumeric {
+	var crossings []*ddv1.VuBorderCrossingRecord
+	for _, activities := range file.GetGen2V2().GetActivities() {
+		crossings = append(crossings, activities.GetBorderCrossings()...)
+	}
+	sort.SliceStable(crossings, func(i, j int) bool {
+		return crossings[i].GetGnssPlaceAuthRecord().GetTimestamp().AsTime().Before(
+			crossings[j].GetGnssPlaceAuthRecord().GetTimestamp().AsTime())
+	})
+	var countries []ddv1.NationNumeric
+	seen := map[ddv1.NationNumeric]bool{}
+	for _, crossing := range crossings {
+		for _, nation := range []ddv1.NationNumeric{crossing.GetCountryLeft(), crossing.GetCountryEntered()} {
+			if _, _, ok := dd.NationToISO(nation); !ok || seen[nation] {
+				continue
+			}
+			seen[nation] = true
+			countries = append(countries, nation)
+		}
+	}
+	return countries
+}
+
+// RouteLeg is a period spent in one country, bounded by border crossings.
+type RouteLeg struct {
+	// Country is the country of the leg: the country entered by the crossing
+	// starting the leg, or the country left by the first crossing.
+	Country ddv1.NationNumeric
+
+	// EntryTime and ExitTime are the times of the GNSS fixes of the crossings
+	// bounding the leg. EntryTime is zero for the first leg, which started
+	// before the first recorded crossing, and ExitTime is zero for the last.
+	EntryTime, ExitTime time.Time
+
+	// EntryOdometerKm and ExitOdometerKm are the vehicle odometer values at
+	// the crossings bounding the leg, if any.
+	EntryOdometerKm, ExitOdometerKm int32
+
+	// Inconsistent is true if the crossing ending the leg left a country
+	// other than Country, e.g. because a crossing was not recorded.
+	Inconsistent bool
+}
+
+// DistanceKm returns the distance driven during the leg, or zero if the leg
+// is not bounded by crossings at both ends.
+//
+// A rollover of the odometer during the leg is accounted for, see
+// [dd.UnwrapOdometer].
+func (l *RouteLeg) DistanceKm() int32 {
+	if l.EntryTime.IsZero() || l.ExitTime.IsZero() {
+		return 0
+	}
+	return max(0, dd.UnwrapOdometer(l.EntryOdometerKm, l.ExitOdometerKm)-l.EntryOdometerKm)
+}
+
+// ReconstructRoute returns the sequence of countries visited according to the
+// border crossing records of a Gen2v2 VU download, as legs between crossings.
+//
+// Crossings are ordered by the time of their GNSS fix, and crossings without
+// a time are skipped. n crossings yield n+1 legs: the country left by the
+// first crossing, followed by the country entered by each crossing. A leg is
+// marked inconsistent if the next crossing left a different country than the
+// leg's crossing entered. No legs are returned without crossings.
+func ReconstructRoute(crossings []*ddv1.VuBorderCrossingRecord) []*RouteLeg {
+	var sorted []*ddv1.VuBorderCrossingRecord
+	for _, crossing := range crossings {
+		if hasRouteTime(crossing.GetGnssPlaceAuthRecord().GetTimestamp()) {
+			sorted = append(sorted, crossing)
+		}
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetGnssPlaceAuthRecord().GetTimestamp().AsTime().Before(
+			sorted[j].GetGnssPlaceAuthRecord().GetTimestamp().AsTime())
+	})
+	legs := make([]*RouteLeg, 0, len(sorted)+1)
+	leg := &RouteLeg{Country: sorted[0].GetCountryLeft()}
+	for _, crossing := range sorted {
+		crossingTime := crossing.GetGnssPlaceAuthRecord().GetTimestamp().AsTime().UTC()
+		leg.ExitTime = crossingTime
+		leg.ExitOdometerKm = crossing.GetVehicleOdometerKm()
+		leg.Inconsistent = crossing.GetCountryLeft() != leg.Country
+		legs = append(legs, leg)
+		leg = &RouteLeg{
+			Country:         crossing.GetCountryEntered(),
+			EntryTime:       crossingTime,
+			EntryOdometerKm: crossing.GetVehicleOdometerKm(),
+		}
+	}
+	return append(legs, leg)
+}
@@ -0,0 +1,226 @@
+package vu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestRoute_Gen2V2(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newPlace := func(at time.Time, latitude, longitude int32) *ddv1.GNSSPlaceAuthRecord {
+		coords := &ddv1.GeoCoordinates{}
+		coords.SetLatitude(latitude)
+		coords.SetLongitude(longitude)
+		place := &ddv1.GNSSPlaceAuthRecord{}
+		place.SetTimestamp(timestamppb.New(at))
+		place.SetGeoCoordinates(coords)
+		return place
+	}
+	newGNSS := func(at time.Time, odometer int32, latitude, longitude int32) *ddv1.VuGNSSADRecordG2 {
+		record := &ddv1.VuGNSSADRecordG2{}
+		record.SetTimeStamp(timestamppb.New(at))
+		record.SetGnssPlaceAuthRecord(newPlace(at, latitude, longitude))
+		record.SetVehicleOdometerKm(odometer)
+		return record
+	}
+	newBorderCrossing := func(at time.Time, odometer int32, left, entered ddv1.NationNumeric) *ddv1.VuBorderCrossingRecord {
+		record := &ddv1.VuBorderCrossingRecord{}
+		record.SetCountryLeft(left)
+		record.SetCountryEntered(entered)
+		record.SetGnssPlaceAuthRecord(newPlace(at, 54300, 10100))
+		record.SetVehicleOdometerKm(odometer)
+		return record
+	}
+	newLoadUnload := func(at time.Time, odometer int32, operation ddv1.OperationType) *ddv1.VuLoadUnloadRecord {
+		record := &ddv1.VuLoadUnloadRecord{}
+		record.SetTimeStamp(timestamppb.New(at))
+		record.SetOperationType(operation)
+		record.SetGnssPlaceAuthRecord(newPlace(at, 0x7FFFFF, 0x7FFFFF))
+		record.SetVehicleOdometerKm(odometer)
+		return record
+	}
+
+	// Two days of activities, stored newest first to verify ordering.
+	day1 := &vuv1.ActivitiesGen2V2{}
+	day1.SetDateOfDay(timestamppb.New(day))
+	day1.SetLoadUnloadOperations([]*ddv1.VuLoadUnloadRecord{
+		newLoadUnload(day.Add(6*time.Hour), 1000, ddv1.OperationType_LOAD_OPERATION),
+	})
+	day1.SetGnssAccumulatedDriving([]*ddv1.VuGNSSADRecordG2{
+		newGNSS(day.Add(9*time.Hour), 1250, 55300, 9300),
+		// Unused record without timestamp.
+		&ddv1.VuGNSSADRecordG2{},
+	})
+	day1.SetBorderCrossings([]*ddv1.VuBorderCrossingRecord{
+		newBorderCrossing(day.Add(11*time.Hour), 1400, ddv1.NationNumeric_DENMARK, ddv1.NationNumeric_GERMANY),
+	})
+	day2 := &vuv1.ActivitiesGen2V2{}
+	day2.SetDateOfDay(timestamppb.New(day.AddDate(0, 0, 1)))
+	day2.SetGnssAccumulatedDriving([]*ddv1.VuGNSSADRecordG2{
+		newGNSS(day.Add(27*time.Hour), 1600, 53330, 10000),
+	})
+	day2.SetLoadUnloadOperations([]*ddv1.VuLoadUnloadRecord{
+		newLoadUnload(day.Add(27*time.Hour), 1601, ddv1.OperationType_UNLOAD_OPERATION),
+	})
+
+	gen2v2 := &vuv1.VehicleUnitFileGen2V2{}
+	gen2v2.SetActivities([]*vuv1.ActivitiesGen2V2{day2, day1})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_2)
+	file.SetVersion(ddv1.Version_VERSION_2)
+	file.SetGen2V2(gen2v2)
+
+	type summary struct {
+		Kind       RoutePointKind
+		Time       time.Time
+		OdometerKm int32
+		Position   bool
+		Left       ddv1.NationNumeric
+		Entered    ddv1.NationNumeric
+		Operation  ddv1.OperationType
+	}
+	var got []summary
+	for _, point := range Route(file) {
+		got = append(got, summary{
+			Kind:       point.Kind,
+			Time:       point.Time,
+			OdometerKm: point.OdometerKm,
+			Position:   point.HasPosition,
+			Left:       point.CountryLeft,
+			Entered:    point.CountryEntered,
+			Operation:  point.OperationType,
+		})
+	}
+	want := []summary{
+		{Kind: RoutePointLoadUnload, Time: day.Add(6 * time.Hour), OdometerKm: 1000, Operation: ddv1.OperationType_LOAD_OPERATION},
+		{Kind: RoutePointGNSSAccumulatedDriving, Time: day.Add(9 * time.Hour), OdometerKm: 1250, Position: true},
+		{Kind: RoutePointBorderCrossing, Time: day.Add(11 * time.Hour), OdometerKm: 1400, Position: true, Left: ddv1.NationNumeric_DENMARK, Entered: ddv1.NationNumeric_GERMANY},
+		{Kind: RoutePointGNSSAccumulatedDriving, Time: day.Add(27 * time.Hour), OdometerKm: 1600, Position: true},
+		{Kind: RoutePointLoadUnload, Time: day.Add(27 * time.Hour), OdometerKm: 1601, Operation: ddv1.OperationType_UNLOAD_OPERATION},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Route() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRoute_Gen1(t *testing.T) {
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_1)
+	file.SetGen1(&vuv1.VehicleUnitFileGen1{})
+	if route := Route(file); len(route) != 0 {
+		t.Errorf("Route() returned %d points for Gen1 file, want 0", len(route))
+	}
+}
+
+func TestCountriesVisited(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newBorderCrossing := func(at time.Time, left, entered ddv1.NationNumeric) *ddv1.VuBorderCrossingRecord {
+		place := &ddv1.GNSSPlaceAuthRecord{}
+		place.SetTimestamp(timestamppb.New(at))
+		record := &ddv1.VuBorderCrossingRecord{}
+		record.SetCountryLeft(left)
+		record.SetCountryEntered(entered)
+		record.SetGnssPlaceAuthRecord(place)
+		return record
+	}
+	day1 := &vuv1.ActivitiesGen2V2{}
+	day1.SetBorderCrossings([]*ddv1.VuBorderCrossingRecord{
+		newBorderCrossing(day.Add(8*time.Hour), ddv1.NationNumeric_DENMARK, ddv1.NationNumeric_GERMANY),
+		newBorderCrossing(day.Add(15*time.Hour), ddv1.NationNumeric_GERMANY, ddv1.NationNumeric_NATION_NUMERIC_UNRECOGNIZED),
+	})
+	day2 := &vuv1.ActivitiesGen2V2{}
+	day2.SetBorderCrossings([]*ddv1.VuBorderCrossingRecord{
+		newBorderCrossing(day.Add(30*time.Hour), ddv1.NationNumeric_REST_OF_WORLD, ddv1.NationNumeric_NETHERLANDS),
+		newBorderCrossing(day.Add(34*time.Hour), ddv1.NationNumeric_NETHERLANDS, ddv1.NationNumeric_GERMANY),
+		newBorderCrossing(day.Add(40*time.Hour), ddv1.NationNumeric_GERMANY, ddv1.NationNumeric_NATION_NUMERIC_EMPTY),
+	})
+
+	gen2v2 := &vuv1.VehicleUnitFileGen2V2{}
+	gen2v2.SetActivities([]*vuv1.ActivitiesGen2V2{day2, day1})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_2)
+	file.SetVersion(ddv1.Version_VERSION_2)
+	file.SetGen2V2(gen2v2)
+
+	want := []ddv1.NationNumeric{
+		ddv1.NationNumeric_DENMARK,
+		ddv1.NationNumeric_GERMANY,
+		ddv1.NationNumeric_NETHERLANDS,
+	}
+	if diff := cmp.Diff(want, CountriesVisited(file)); diff != "" {
+		t.Errorf("CountriesVisited() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReconstructRoute(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newBorderCrossing := func(at time.Time, odometer int32, left, entered ddv1.NationNumeric) *ddv1.VuBorderCrossingRecord {
+		place := &ddv1.GNSSPlaceAuthRecord{}
+		if !at.IsZero() {
+			place.SetTimestamp(timestamppb.New(at))
+		}
+		record := &ddv1.VuBorderCrossingRecord{}
+		record.SetCountryLeft(left)
+		record.SetCountryEntered(entered)
+		record.SetGnssPlaceAuthRecord(place)
+		record.SetVehicleOdometerKm(odometer)
+		return record
+	}
+	crossings := []*ddv1.VuBorderCrossingRecord{
+		// Missing crossing from Austria into Italy.
+		newBorderCrossing(day.Add(20*time.Hour), 9_999_900, ddv1.NationNumeric_ITALY, ddv1.NationNumeric_FRANCE),
+		newBorderCrossing(day.Add(8*time.Hour), 9_999_000, ddv1.NationNumeric_GERMANY, ddv1.NationNumeric_AUSTRIA),
+		// Unused record without timestamp.
+		newBorderCrossing(time.Time{}, 0, ddv1.NationNumeric_NATION_NUMERIC_EMPTY, ddv1.NationNumeric_NATION_NUMERIC_EMPTY),
+		newBorderCrossing(day.Add(30*time.Hour), 200, ddv1.NationNumeric_FRANCE, ddv1.NationNumeric_GERMANY),
+	}
+
+	got := ReconstructRoute(crossings)
+	want := []*RouteLeg{
+		{
+			Country:        ddv1.NationNumeric_GERMANY,
+			ExitTime:       day.Add(8 * time.Hour),
+			ExitOdometerKm: 9_999_000,
+		},
+		{
+			Country:         ddv1.NationNumeric_AUSTRIA,
+			EntryTime:       day.Add(8 * time.Hour),
+			EntryOdometerKm: 9_999_000,
+			ExitTime:        day.Add(20 * time.Hour),
+			ExitOdometerKm:  9_999_900,
+			Inconsistent:    true,
+		},
+		{
+			Country:         ddv1.NationNumeric_FRANCE,
+			EntryTime:       day.Add(20 * time.Hour),
+			EntryOdometerKm: 9_999_900,
+			ExitTime:        day.Add(30 * time.Hour),
+			ExitOdometerKm:  200,
+		},
+		{
+			Country:         ddv1.NationNumeric_GERMANY,
+			EntryTime:       day.Add(30 * time.Hour),
+			EntryOdometerKm: 200,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReconstructRoute() mismatch (-want +got):\n%s", diff)
+	}
+	var gotDistances []int32
+	for _, leg := range got {
+		gotDistances = append(gotDistances, leg.DistanceKm())
+	}
+	// The French leg rolls over the odometer.
+	if diff := cmp.Diff([]int32{0, 900, 300, 0}, gotDistances); diff != "" {
+		t.Errorf("DistanceKm() mismatch (-want +got):\n%s", diff)
+	}
+	if got := ReconstructRoute(nil); got != nil {
+		t.Errorf("ReconstructRoute(nil) = %v, want nil", got)
+	}
+}
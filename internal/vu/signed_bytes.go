@@ -0,0 +1,40 @@
+package vu
+
+import (
+	"fmt"
+	"io"
+
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// lenGen1OverviewCertificates is the size of the MemberStateCertificate and
+// VuCertificate at the start of a Gen1 Overview transfer, 194 bytes each.
+const lenGen1OverviewCertificates = 2 * 194
+
+// SignedBytes returns the bytes of a transfer over which its signature is
+// computed, as checked by AuthenticateOptions.AuthenticateRawVehicleUnitFile.
+//
+// Gen1 transfers are signed over all data preceding the 128-byte signature,
+// except for the Overview, whose signature starts after the certificates
+// (Appendix 7, Section 2.2.6.2). Gen2 transfers are signed over all record
+// arrays preceding the SignatureRecordArray, including the certificates of
+// the Overview (Appendix 7, Section 2.2.6).
+//
+// The returned slice aliases the value of the record. An error is returned for
+// transfers without a signature, such as the download interface version.
+func SignedBytes(record *vuv1.RawVehicleUnitFile_Record) ([]byte, error) {
+	data, signature, err := splitTransferValue(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) == 0 {
+		return nil, fmt.Errorf("%v transfer is not signed", record.GetType())
+	}
+	if record.GetType() == vuv1.TransferType_OVERVIEW_GEN1 {
+		if len(data) <= lenGen1OverviewCertificates {
+			return nil, fmt.Errorf("insufficient data for Overview signature verification: got %d, need > %d: %w", len(data), lenGen1OverviewCertificates, io.ErrUnexpectedEOF)
+		}
+		return data[lenGen1OverviewCertificates:], nil
+	}
+	return data, nil
+}
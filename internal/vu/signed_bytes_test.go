@@ -0,0 +1,70 @@
+package vu
+
+import (
+	"bytes"
+	"testing"
+
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestSignedBytes(t *testing.T) {
+	newRecord := func(transferType vuv1.TransferType, value []byte, signatureSize int) *vuv1.RawVehicleUnitFile_Record {
+		record := &vuv1.RawVehicleUnitFile_Record{}
+		record.SetType(transferType)
+		record.SetValue(value)
+		record.SetSignatureSize(int32(signatureSize))
+		return record
+	}
+	certificates := bytes.Repeat([]byte{0xCC}, lenGen1OverviewCertificates)
+	overviewData := []byte("overview data")
+	rsaSignature := bytes.Repeat([]byte{0x5A}, 128)
+
+	// A Gen2 transfer of one record array followed by a SignatureRecordArray.
+	recordArray := appendRecordArrayHeader(nil, 0x01, 2, 1)
+	recordArray = append(recordArray, 0x12, 0x34)
+	signatureArray := appendRecordArrayHeader(nil, 0x08, 4, 1)
+	signatureArray = append(signatureArray, 0xAA, 0xBB, 0xCC, 0xDD)
+
+	for _, tt := range []struct {
+		name    string
+		record  *vuv1.RawVehicleUnitFile_Record
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:   "gen1 overview excludes certificates",
+			record: newRecord(vuv1.TransferType_OVERVIEW_GEN1, append(append(append([]byte{}, certificates...), overviewData...), rsaSignature...), 128),
+			want:   overviewData,
+		},
+		{
+			name:   "gen1 activities",
+			record: newRecord(vuv1.TransferType_ACTIVITIES_GEN1, append([]byte("activities"), rsaSignature...), 128),
+			want:   []byte("activities"),
+		},
+		{
+			name:   "gen2 excludes signature record array",
+			record: newRecord(vuv1.TransferType_ACTIVITIES_GEN2_V1, append(append([]byte{}, recordArray...), signatureArray...), len(signatureArray)),
+			want:   recordArray,
+		},
+		{
+			name:    "gen1 overview without data",
+			record:  newRecord(vuv1.TransferType_OVERVIEW_GEN1, append(append([]byte{}, certificates...), rsaSignature...), 128),
+			wantErr: true,
+		},
+		{
+			name:    "unsigned transfer",
+			record:  newRecord(vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION, []byte{0x01, 0x02}, 0),
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SignedBytes(tt.record)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SignedBytes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("SignedBytes() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package vu
+
+import (
+	"sort"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SlotPeriod is a period during which the card status and activity recorded
+// by a vehicle unit for both card slots did not change.
+type SlotPeriod struct {
+	// Start and End bound the period, with minute resolution.
+	Start, End time.Time
+
+	// DriverCardInserted and CoDriverCardInserted report whether a card was
+	// inserted in the driver and co-driver slots.
+	DriverCardInserted, CoDriverCardInserted bool
+
+	// DriverActivity and CoDriverActivity are the activities recorded for the
+	// driver and co-driver slots.
+	DriverActivity, CoDriverActivity ddv1.DriverActivityValue
+}
+
+// UnidentifiedDriving reports whether the vehicle was driven without a card
+// in the driver slot during the period.
+func (p *SlotPeriod) UnidentifiedDriving() bool {
+	return !p.DriverCardInserted && p.DriverActivity == ddv1.DriverActivityValue_DRIVING
+}
+
+// SlotTimeline returns the card status and activity of both slots of a
+// vehicle unit, as recorded in the activities transfers of a VU download,
+// ordered chronologically.
+//
+// The VU records an ActivityChangeInfo (Data Dictionary, Section 2.1) for a
+// slot whenever its activity or card status changes, including while no card
+// is inserted, so the activity of an empty slot reflects the use of the
+// vehicle. A new period starts whenever either slot changes. Minutes of a day
+// before the first change of a slot report the slot as empty, with an
+// unspecified activity. Days transferred more than once are only included
+// once.
+func SlotTimeline(file *vuv1.VehicleUnitFile) []*SlotPeriod {
+	var days []slotDay
+	addDay := func(date *timestamppb.Timestamp, changes []*ddv1.ActivityChangeInfo) {
+		if dd.IsUnsetTime(date) {
+			return
+		}
+		days = append(days, slotDay{date: date.AsTime().UTC().Truncate(24 * time.Hour), changes: changes})
+	}
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		for _, activities := range file.GetGen1().GetActivities() {
+			addDay(activities.GetDateOfDay(), activities.GetActivityChanges())
+		}
+	case ddv1.Generation_GENERATION_2:
+		switch file.GetVersion() {
+		case ddv1.Version_VERSION_2:
+			for _, activities := range file.GetGen2V2().GetActivities() {
+				addDay(activities.GetDateOfDay(), activities.GetActivityChanges())
+			}
+		default:
+			for _, activities := range file.GetGen2V1().GetActivities() {
+				addDay(activities.GetDateOfDay(), activities.GetActivityChanges())
+			}
+		}
+	}
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].date.Before(days[j].date)
+	})
+
+	var timeline []*SlotPeriod
+	for i, day := range days {
+		if i > 0 && day.date.Equal(days[i-1].date) {
+			continue
+		}
+		for _, period := range day.periods() {
+			if n := len(timeline); n > 0 && timeline[n-1].End.Equal(period.Start) && sameSlotState(timeline[n-1], period) {
+				timeline[n-1].End = period.End
+				continue
+			}
+			timeline = append(timeline, period)
+		}
+	}
+	return timeline
+}
+
+// UnidentifiedDriving returns the periods during which a vehicle unit recorded
+// driving without a card in the driver slot, ordered chronologically.
+//
+// Consecutive periods of unidentified driving are merged, regardless of
+// changes in the co-driver slot.
+func UnidentifiedDriving(file *vuv1.VehicleUnitFile) []*SlotPeriod {
+	var periods []*SlotPeriod
+	for _, period := range SlotTimeline(file) {
+		if !period.UnidentifiedDriving() {
+			continue
+		}
+		if n := len(periods); n > 0 && periods[n-1].End.Equal(period.Start) {
+			periods[n-1].End = period.End
+			continue
+		}
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// slotDay holds the activity changes recorded by a VU for one day.
+type slotDay struct {
+	date    time.Time
+	changes []*ddv1.ActivityChangeInfo
+}
+
+// periods splits the day into periods at each activity change of either slot.
+func (d slotDay) periods() []*SlotPeriod {
+	const minutesPerDay = 24 * 60
+	bySlot := map[ddv1.CardSlotNumber][]*ddv1.ActivityChangeInfo{}
+	boundaries := []int32{0, minutesPerDay}
+	for _, change := range d.changes {
+		minute := change.GetTimeOfChangeMinutes()
+		if minute < 0 || minute >= minutesPerDay {
+			continue
+		}
+		bySlot[change.GetSlot()] = append(bySlot[change.GetSlot()], change)
+		boundaries = append(boundaries, minute)
+	}
+	for _, changes := range bySlot {
+		sort.SliceStable(changes, func(i, j int) bool {
+			return changes[i].GetTimeOfChangeMinutes() < changes[j].GetTimeOfChangeMinutes()
+		})
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i] < boundaries[j] })
+
+	var periods []*SlotPeriod
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		if start == end {
+			continue
+		}
+		period := &SlotPeriod{
+			Start: d.date.Add(time.Duration(start) * time.Minute),
+			End:   d.date.Add(time.Duration(end) * time.Minute),
+		}
+		if change := slotChangeAt(bySlot[ddv1.CardSlotNumber_DRIVER_SLOT], start); change != nil {
+			period.DriverCardInserted = change.GetInserted()
+			period.DriverActivity = change.GetActivity()
+		}
+		if change := slotChangeAt(bySlot[ddv1.CardSlotNumber_CO_DRIVER_SLOT], start); change != nil {
+			period.CoDriverCardInserted = change.GetInserted()
+			period.CoDriverActivity = change.GetActivity()
+		}
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+// slotChangeAt returns the last change at or before minute, or nil if there
+// is none.
+func slotChangeAt(changes []*ddv1.ActivityChangeInfo, minute int32) *ddv1.ActivityChangeInfo {
+	var result *ddv1.ActivityChangeInfo
+	for _, change := range changes {
+		if change.GetTimeOfChangeMinutes() > minute {
+			break
+		}
+		result = change
+	}
+	return result
+}
+
+// sameSlotState reports whether a and b record the same state for both slots.
+func sameSlotState(a, b *SlotPeriod) bool {
+	return a.DriverCardInserted == b.DriverCardInserted &&
+		a.CoDriverCardInserted == b.CoDriverCardInserted &&
+		a.DriverActivity == b.DriverActivity &&
+		a.CoDriverActivity == b.CoDriverActivity
+}
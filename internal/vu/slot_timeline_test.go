@@ -0,0 +1,85 @@
+package vu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestUnidentifiedDriving(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	newChange := func(slot ddv1.CardSlotNumber, minutes int32, inserted bool, activity ddv1.DriverActivityValue) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetSlot(slot)
+		change.SetInserted(inserted)
+		change.SetActivity(activity)
+		change.SetTimeOfChangeMinutes(minutes)
+		return change
+	}
+	const (
+		driver   = ddv1.CardSlotNumber_DRIVER_SLOT
+		coDriver = ddv1.CardSlotNumber_CO_DRIVER_SLOT
+		driving  = ddv1.DriverActivityValue_DRIVING
+		rest     = ddv1.DriverActivityValue_BREAK_REST
+		work     = ddv1.DriverActivityValue_WORK
+	)
+
+	day1 := &vuv1.ActivitiesGen1{}
+	day1.SetDateOfDay(timestamppb.New(day))
+	day1.SetActivityChanges([]*ddv1.ActivityChangeInfo{
+		newChange(driver, 0, false, rest),
+		// Vehicle moved without a card from 06:00 to 07:00.
+		newChange(driver, 6*60, false, driving),
+		newChange(driver, 7*60, true, driving),
+		newChange(driver, 12*60, true, rest),
+		// Vehicle moved again without a card from 23:00, across midnight.
+		newChange(driver, 23*60, false, driving),
+		newChange(coDriver, 0, false, rest),
+		// A change in the co-driver slot does not split unidentified driving.
+		newChange(coDriver, 6*60+30, false, work),
+	})
+	day2 := &vuv1.ActivitiesGen1{}
+	day2.SetDateOfDay(timestamppb.New(day.AddDate(0, 0, 1)))
+	day2.SetActivityChanges([]*ddv1.ActivityChangeInfo{
+		newChange(driver, 0, false, driving),
+		newChange(driver, 30, false, rest),
+		newChange(coDriver, 0, false, work),
+	})
+	gen1 := &vuv1.VehicleUnitFileGen1{}
+	// Days are stored newest first, and the second day is transferred twice.
+	gen1.SetActivities([]*vuv1.ActivitiesGen1{day2, day1, day2})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_1)
+	file.SetGen1(gen1)
+
+	type period struct {
+		Start, End time.Time
+	}
+	var got []period
+	for _, p := range UnidentifiedDriving(file) {
+		got = append(got, period{Start: p.Start, End: p.End})
+	}
+	want := []period{
+		{Start: day.Add(6 * time.Hour), End: day.Add(7 * time.Hour)},
+		{Start: day.Add(23 * time.Hour), End: day.Add(24*time.Hour + 30*time.Minute)},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UnidentifiedDriving() mismatch (-want +got):\n%s", diff)
+	}
+
+	timeline := SlotTimeline(file)
+	if n := len(timeline); n != 7 {
+		t.Fatalf("len(SlotTimeline()) = %d, want 7", n)
+	}
+	if p := timeline[2]; !p.Start.Equal(day.Add(6*time.Hour+30*time.Minute)) || p.CoDriverActivity != work || p.DriverCardInserted {
+		t.Errorf("SlotTimeline()[2] = %+v, want unidentified driving with co-driver working from 06:30", p)
+	}
+	if p := timeline[len(timeline)-1]; !p.End.Equal(day.AddDate(0, 0, 2)) {
+		t.Errorf("last period ends at %v, want end of second day", p.End)
+	}
+}
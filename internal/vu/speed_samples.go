@@ -0,0 +1,69 @@
+package vu
+
+import (
+	"sort"
+	"time"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SpeedSample is the speed of a vehicle during one second.
+type SpeedSample struct {
+	// Time is the beginning of the second.
+	Time time.Time
+
+	// SpeedKmh is the instantaneous speed of the vehicle in km/h.
+	SpeedKmh int32
+}
+
+// ExpandDetailedSpeed expands the detailed speed transfers of a VU download
+// into one sample per second, ordered chronologically.
+//
+// Each VuDetailedSpeedBlock (Data Dictionary, Section 2.190) holds the speeds
+// of the 60 seconds of the minute beginning at speedBlockBeginDate: the i-th
+// speed is the sample for speedBlockBeginDate + i seconds. Blocks without a
+// begin date are skipped. Blocks only cover the minutes during which the
+// vehicle was moving, so the timeline has gaps while it was stationary.
+func ExpandDetailedSpeed(file *vuv1.VehicleUnitFile) []SpeedSample {
+	var samples []SpeedSample
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		for _, detailedSpeed := range file.GetGen1().GetDetailedSpeed() {
+			for _, block := range detailedSpeed.GetSpeedBlocks() {
+				samples = appendSpeedSamples(samples, block.GetBeginDate(), block.GetSpeedsKmh())
+			}
+		}
+	case ddv1.Generation_GENERATION_2:
+		detailedSpeeds := file.GetGen2V1().GetDetailedSpeed()
+		if file.GetVersion() == ddv1.Version_VERSION_2 {
+			detailedSpeeds = file.GetGen2V2().GetDetailedSpeed()
+		}
+		for _, detailedSpeed := range detailedSpeeds {
+			for _, block := range detailedSpeed.GetSpeedBlocks() {
+				samples = appendSpeedSamples(samples, block.GetBeginDate(), block.GetSpeedsKmh())
+			}
+		}
+	}
+	sort.SliceStable(samples, func(i, j int) bool {
+		return samples[i].Time.Before(samples[j].Time)
+	})
+	return samples
+}
+
+// appendSpeedSamples appends the per-second samples of a speed block.
+func appendSpeedSamples(samples []SpeedSample, beginDate *timestamppb.Timestamp, speedsKmh []int32) []SpeedSample {
+	if dd.IsUnsetTime(beginDate) {
+		return samples
+	}
+	begin := beginDate.AsTime().UTC()
+	for i, speed := range speedsKmh {
+		samples = append(samples, SpeedSample{
+			Time:     begin.Add(time.Duration(i) * time.Second),
+			SpeedKmh: speed,
+		})
+	}
+	return samples
+}
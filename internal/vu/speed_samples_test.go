@@ -0,0 +1,83 @@
+package vu
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestExpandDetailedSpeed(t *testing.T) {
+	minute := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	newBlock := func(begin time.Time, speeds ...int32) *vuv1.DetailedSpeedGen2_DetailedSpeedBlock {
+		block := &vuv1.DetailedSpeedGen2_DetailedSpeedBlock{}
+		if !begin.IsZero() {
+			block.SetBeginDate(timestamppb.New(begin))
+		}
+		block.SetSpeedsKmh(speeds)
+		return block
+	}
+	detailedSpeed := &vuv1.DetailedSpeedGen2{}
+	detailedSpeed.SetSpeedBlocks([]*vuv1.DetailedSpeedGen2_DetailedSpeedBlock{
+		newBlock(minute.Add(5*time.Minute), 80, 81),
+		newBlock(minute, 0, 10, 20),
+		newBlock(time.Time{}, 99),
+	})
+	gen2V2 := &vuv1.VehicleUnitFileGen2V2{}
+	gen2V2.SetDetailedSpeed([]*vuv1.DetailedSpeedGen2{detailedSpeed})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_2)
+	file.SetVersion(ddv1.Version_VERSION_2)
+	file.SetGen2V2(gen2V2)
+
+	want := []SpeedSample{
+		{Time: minute, SpeedKmh: 0},
+		{Time: minute.Add(1 * time.Second), SpeedKmh: 10},
+		{Time: minute.Add(2 * time.Second), SpeedKmh: 20},
+		{Time: minute.Add(5 * time.Minute), SpeedKmh: 80},
+		{Time: minute.Add(5*time.Minute + time.Second), SpeedKmh: 81},
+	}
+	if diff := cmp.Diff(want, ExpandDetailedSpeed(file)); diff != "" {
+		t.Errorf("ExpandDetailedSpeed() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestExpandDetailedSpeed_Gen1(t *testing.T) {
+	hexdumpFiles, err := findHexdumpFiles(vuv1.TransferType_DETAILED_SPEED_GEN1)
+	if err != nil {
+		t.Fatalf("Failed to discover hexdump files: %v", err)
+	}
+	if len(hexdumpFiles) == 0 {
+		t.Skip("No hexdump files found for DETAILED_SPEED_GEN1")
+	}
+	data, err := readHexdump(hexdumpFiles[0])
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	detailedSpeed, err := unmarshalDetailedSpeedGen1(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	gen1 := &vuv1.VehicleUnitFileGen1{}
+	gen1.SetDetailedSpeed([]*vuv1.DetailedSpeedGen1{detailedSpeed})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_1)
+	file.SetGen1(gen1)
+
+	samples := ExpandDetailedSpeed(file)
+	if got, want := len(samples), 60*len(detailedSpeed.GetSpeedBlocks()); got != want {
+		t.Fatalf("got %d samples, want %d", got, want)
+	}
+	// Every speed of every block is a sample, at its second of the block.
+	block := detailedSpeed.GetSpeedBlocks()[0]
+	second := block.GetBeginDate().AsTime().Add(30 * time.Second)
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].Time.Before(second) })
+	if i == len(samples) || !samples[i].Time.Equal(second) || samples[i].SpeedKmh != block.GetSpeedsKmh()[30] {
+		t.Errorf("no sample of %d km/h at %v", block.GetSpeedsKmh()[30], second)
+	}
+}
@@ -2,8 +2,12 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ===== sizeOf Functions =====
@@ -72,8 +76,8 @@ func sizeOfTechnicalDataGen1(data []byte) (totalSize, signatureSize int, err err
 	offset += 20
 
 	// VuCalibrationData: 1 byte count + variable calibration records
-	if len(data[offset:]) < 1 {
-		return 0, 0, fmt.Errorf("insufficient data for noOfVuCalibrationRecords")
+	if len(data) < offset+1 {
+		return 0, 0, fmt.Errorf("insufficient data for noOfVuCalibrationRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuCalibrationRecords := data[offset]
 	offset += 1
@@ -89,97 +93,190 @@ func sizeOfTechnicalDataGen1(data []byte) (totalSize, signatureSize int, err err
 	return offset, gen1SignatureSize, nil
 }
 
+// technicalDataGen2RecordArrays names the RecordArrays of Gen2 Technical Data
+// preceding the SignatureRecordArray, in download order.
+//
+// See Appendix 7, Section 2.2.6.6 (TREP 25 and 35 Hex).
+var technicalDataGen2RecordArrays = []string{
+	"VuIdentificationRecordArray",
+	"VuSensorPairedRecordArray",
+	"VuSensorExternalGNSSCoupledRecordArray",
+	"VuCalibrationRecordArray",
+	"VuCardRecordArray",
+	"VuITSConsentRecordArray",
+	"VuPowerSupplyInterruptionRecordArray",
+}
+
 // sizeOfTechnicalDataGen2V1 calculates size by parsing all Gen2 V1 RecordArrays.
 func sizeOfTechnicalDataGen2V1(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
+	return sizeOfTechnicalDataGen2(data)
+}
 
-	// VuIdentificationRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuIdentificationRecordArray: %w", sizeErr)
-	}
-	offset += size
+// sizeOfTechnicalDataGen2V2 calculates size by parsing all Gen2 V2 RecordArrays.
+func sizeOfTechnicalDataGen2V2(data []byte) (totalSize, signatureSize int, err error) {
+	return sizeOfTechnicalDataGen2(data)
+}
 
-	// SensorPairedRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SensorPairedRecordArray: %w", sizeErr)
-	}
-	offset += size
+// sizeOfTechnicalDataGen2 calculates size by parsing all Gen2 RecordArrays.
+// Gen2 V1 and V2 Technical Data consist of the same RecordArrays.
+func sizeOfTechnicalDataGen2(data []byte) (totalSize, signatureSize int, err error) {
+	return sizeOfRecordArrays(data, technicalDataGen2RecordArrays)
+}
+
+// sensorPairedRecord is implemented by the PairedSensor messages of Gen2 V1
+// and V2 Technical Data.
+type sensorPairedRecord[T any] interface {
+	*T
+	SetSerialNumber(*ddv1.ExtendedSerialNumber)
+	SetApprovalNumber(*ddv1.Ia5StringValue)
+	SetPairingDate(*timestamppb.Timestamp)
+}
+
+// parseSensorPairedRecordArray parses a VuSensorPairedRecordArray.
+//
+// The data type `SensorPairedRecord` is specified in the Data Dictionary, Section 2.145.
+//
+// ASN.1 Definition:
+//
+//	SensorPairedRecord ::= SEQUENCE {
+//	    sensorSerialNumber SensorSerialNumber,      -- 8 bytes
+//	    sensorApprovalNumber SensorApprovalNumber,  -- 16 bytes
+//	    sensorPairingDate SensorPairingDate         -- 4 bytes
+//	}
+func parseSensorPairedRecordArray[T any, PT sensorPairedRecord[T]](opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]PT, int, error) {
+	const (
+		idxSensorSerialNumber   = 0
+		idxSensorApprovalNumber = 8
+		idxSensorPairingDate    = 24
+		lenSensorPairedRecord   = 28
+	)
 
-	// VuCalibrationRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuCalibrationRecordArray: %w", sizeErr)
+	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := sizes.checkCount("SensorPairedRecord", noOfRecords); err != nil {
+		return nil, 0, err
 	}
-	offset += size
+	if noOfRecords > 0 && recordSize != lenSensorPairedRecord {
+		return nil, 0, fmt.Errorf("expected SensorPairedRecord size %d, got %d", lenSensorPairedRecord, recordSize)
+	}
+
+	records := make([]PT, 0, noOfRecords)
+	recordStart := offset + headerSize
+	for i := uint16(0); i < noOfRecords; i++ {
+		recordEnd := recordStart + int(recordSize)
+		if recordEnd > len(data) {
+			return nil, 0, fmt.Errorf("insufficient data for SensorPairedRecord %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		recordData := data[recordStart:recordEnd]
+
+		record := PT(new(T))
+		serialNumber, err := opts.UnmarshalExtendedSerialNumber(recordData[idxSensorSerialNumber:idxSensorApprovalNumber])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal sensor serial number: %w", err)
+		}
+		record.SetSerialNumber(serialNumber)
+		approvalNumber, err := opts.UnmarshalIa5StringValue(recordData[idxSensorApprovalNumber:idxSensorPairingDate])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal sensor approval number: %w", err)
+		}
+		record.SetApprovalNumber(approvalNumber)
+		pairingDate, err := opts.UnmarshalTimeReal(recordData[idxSensorPairingDate:lenSensorPairedRecord])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal sensor pairing date: %w", err)
+		}
+		record.SetPairingDate(pairingDate)
 
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
+		records = append(records, record)
+		recordStart = recordEnd
 	}
-	signatureSizeGen2 := size
-	offset += size
 
-	return offset, signatureSizeGen2, nil
+	totalSize := headerSize + int(recordSize)*int(noOfRecords)
+	return records, totalSize, nil
 }
 
-// sizeOfTechnicalDataGen2V2 calculates size by parsing all Gen2 V2 RecordArrays.
-func sizeOfTechnicalDataGen2V2(data []byte) (totalSize, signatureSize int, err error) {
-	offset := 0
+// sensorExternalGNSSCoupledRecord is implemented by the CoupledGnss messages
+// of Gen2 V1 and V2 Technical Data.
+type sensorExternalGNSSCoupledRecord[T any] interface {
+	*T
+	SetSerialNumber(*ddv1.ExtendedSerialNumber)
+	SetApprovalNumber(*ddv1.Ia5StringValue)
+	SetCouplingDate(*timestamppb.Timestamp)
+}
 
-	// VuIdentificationRecordArray
-	size, sizeErr := sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuIdentificationRecordArray: %w", sizeErr)
-	}
-	offset += size
+// parseSensorExternalGNSSCoupledRecordArray parses a VuSensorExternalGNSSCoupledRecordArray.
+//
+// The data type `SensorExternalGNSSCoupledRecord` is specified in the Data Dictionary, Section 2.133.
+//
+// ASN.1 Definition:
+//
+//	SensorExternalGNSSCoupledRecord ::= SEQUENCE {
+//	    sensorSerialNumber SensorGNSSSerialNumber,                  -- 8 bytes
+//	    sensorApprovalNumber SensorExternalGNSSApprovalNumber,      -- 16 bytes
+//	    sensorCouplingDate SensorGNSSCouplingDate                   -- 4 bytes
+//	}
+func parseSensorExternalGNSSCoupledRecordArray[T any, PT sensorExternalGNSSCoupledRecord[T]](opts dd.UnmarshalOptions, data []byte, offset int, sizes *recordSizes) ([]PT, int, error) {
+	const (
+		idxSensorSerialNumber              = 0
+		idxSensorApprovalNumber            = 8
+		idxSensorCouplingDate              = 24
+		lenSensorExternalGNSSCoupledRecord = 28
+	)
 
-	// SensorPairedRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SensorPairedRecordArray: %w", sizeErr)
+	_, recordSize, noOfRecords, headerSize, err := parseRecordArrayHeader(data, offset)
+	if err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// SensorExternalGNSSCoupledRecordArray (Gen2 V2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SensorExternalGNSSCoupledRecordArray: %w", sizeErr)
+	if err := sizes.checkCount("SensorExternalGNSSCoupledRecord", noOfRecords); err != nil {
+		return nil, 0, err
 	}
-	offset += size
-
-	// VuCalibrationRecordArray
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuCalibrationRecordArray: %w", sizeErr)
+	if noOfRecords > 0 && recordSize != lenSensorExternalGNSSCoupledRecord {
+		return nil, 0, fmt.Errorf("expected SensorExternalGNSSCoupledRecord size %d, got %d", lenSensorExternalGNSSCoupledRecord, recordSize)
 	}
-	offset += size
 
-	// VuITSConsentRecordArray (Gen2 V2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuITSConsentRecordArray: %w", sizeErr)
-	}
-	offset += size
+	records := make([]PT, 0, noOfRecords)
+	recordStart := offset + headerSize
+	for i := uint16(0); i < noOfRecords; i++ {
+		recordEnd := recordStart + int(recordSize)
+		if recordEnd > len(data) {
+			return nil, 0, fmt.Errorf("insufficient data for SensorExternalGNSSCoupledRecord %d: %w", i, io.ErrUnexpectedEOF)
+		}
+		recordData := data[recordStart:recordEnd]
 
-	// VuPowerSupplyInterruptionRecordArray (Gen2 V2+)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("VuPowerSupplyInterruptionRecordArray: %w", sizeErr)
-	}
-	offset += size
+		record := PT(new(T))
+		serialNumber, err := opts.UnmarshalExtendedSerialNumber(recordData[idxSensorSerialNumber:idxSensorApprovalNumber])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal GNSS serial number: %w", err)
+		}
+		record.SetSerialNumber(serialNumber)
+		approvalNumber, err := opts.UnmarshalIa5StringValue(recordData[idxSensorApprovalNumber:idxSensorCouplingDate])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal GNSS approval number: %w", err)
+		}
+		record.SetApprovalNumber(approvalNumber)
+		couplingDate, err := opts.UnmarshalTimeReal(recordData[idxSensorCouplingDate:lenSensorExternalGNSSCoupledRecord])
+		if err != nil {
+			return nil, 0, fmt.Errorf("unmarshal GNSS coupling date: %w", err)
+		}
+		record.SetCouplingDate(couplingDate)
 
-	// SignatureRecordArray (last)
-	size, sizeErr = sizeOfRecordArray(data, offset)
-	if sizeErr != nil {
-		return 0, 0, fmt.Errorf("SignatureRecordArray: %w", sizeErr)
+		records = append(records, record)
+		recordStart = recordEnd
 	}
-	signatureSizeGen2 := size
-	offset += size
 
-	return offset, signatureSizeGen2, nil
+	totalSize := headerSize + int(recordSize)*int(noOfRecords)
+	return records, totalSize, nil
+}
+
+// anonymizeSensorSerialNumber anonymizes the serial number of a paired sensor
+// or coupled GNSS facility, preserving the equipment type and manufacturer code.
+func anonymizeSensorSerialNumber(esn *ddv1.ExtendedSerialNumber) *ddv1.ExtendedSerialNumber {
+	result := &ddv1.ExtendedSerialNumber{}
+	result.SetType(esn.GetType())
+	result.SetManufacturerCode(esn.GetManufacturerCode())
+	result.SetSerialNumber(0)
+	return result
 }
 
 // AppendVuTechnicalData appends VU technical data to a buffer.
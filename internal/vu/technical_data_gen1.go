@@ -2,6 +2,7 @@ package vu
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
@@ -25,11 +26,17 @@ import (
 //	    signature SignatureFirstGen
 //	}
 func unmarshalTechnicalDataGen1(value []byte) (*vuv1.TechnicalDataGen1, error) {
+	return parseTechnicalDataGen1(dd.UnmarshalOptions{PreserveRawData: true}, value)
+}
+
+// parseTechnicalDataGen1 is like unmarshalTechnicalDataGen1, but parses the records of the
+// transfer with opts.
+func parseTechnicalDataGen1(opts dd.UnmarshalOptions, value []byte) (*vuv1.TechnicalDataGen1, error) {
 	// Split transfer value into data and signature
 	// Gen1 uses fixed 128-byte RSA-1024 signatures
 	const signatureSize = 128
 	if len(value) < signatureSize {
-		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d", signatureSize, len(value))
+		return nil, fmt.Errorf("insufficient data for signature: need at least %d bytes, got %d: %w", signatureSize, len(value), io.ErrUnexpectedEOF)
 	}
 
 	dataSize := len(value) - signatureSize
@@ -39,12 +46,11 @@ func unmarshalTechnicalDataGen1(value []byte) (*vuv1.TechnicalDataGen1, error) {
 	technicalData := &vuv1.TechnicalDataGen1{}
 	technicalData.SetRawData(value) // Store complete transfer value for painting
 	offset := 0
-	opts := dd.UnmarshalOptions{PreserveRawData: true}
 
 	// Parse VuIdentification (116 bytes for Gen1: 36+36+16+8+8+4+8)
 	const vuIdentificationSize = 116
 	if offset+vuIdentificationSize > len(data) {
-		return nil, fmt.Errorf("insufficient data for VuIdentification")
+		return nil, fmt.Errorf("insufficient data for VuIdentification: %w", io.ErrUnexpectedEOF)
 	}
 	vuIdentification, err := opts.UnmarshalVuIdentification(data[offset : offset+vuIdentificationSize])
 	if err != nil {
@@ -56,7 +62,7 @@ func unmarshalTechnicalDataGen1(value []byte) (*vuv1.TechnicalDataGen1, error) {
 	// Parse SensorPaired (20 bytes for Gen1)
 	const sensorPairedSize = 20
 	if offset+sensorPairedSize > len(data) {
-		return nil, fmt.Errorf("insufficient data for SensorPaired")
+		return nil, fmt.Errorf("insufficient data for SensorPaired: %w", io.ErrUnexpectedEOF)
 	}
 	sensorPaired, err := opts.UnmarshalSensorPaired(data[offset : offset+sensorPairedSize])
 	if err != nil {
@@ -67,7 +73,7 @@ func unmarshalTechnicalDataGen1(value []byte) (*vuv1.TechnicalDataGen1, error) {
 
 	// Parse VuCalibrationData (1 byte count + calibration records)
 	if offset+1 > len(data) {
-		return nil, fmt.Errorf("insufficient data for noOfVuCalibrationRecords")
+		return nil, fmt.Errorf("insufficient data for noOfVuCalibrationRecords: %w", io.ErrUnexpectedEOF)
 	}
 	noOfVuCalibrationRecords := data[offset]
 	offset += 1
@@ -107,11 +113,8 @@ func (opts MarshalOptions) MarshalTechnicalDataGen1(technicalData *vuv1.Technica
 	}
 
 	// Calculate data size
-	// VuIdentification: 116 bytes (Gen1: 36+36+16+8+8+4+8)
-	// SensorPaired: 20 bytes (Gen1)
-	// VuCalibrationData: 1 byte count + (n * 167 bytes)
 	noOfCalibrationRecords := len(technicalData.GetCalibrationRecords())
-	dataSize := 116 + 20 + 1 + (noOfCalibrationRecords * 167)
+	dataSize := technicalDataGen1DataSize(technicalData)
 
 	// Use raw data painting with canvas
 	var canvas []byte
@@ -188,6 +191,15 @@ func (opts MarshalOptions) MarshalTechnicalDataGen1(technicalData *vuv1.Technica
 	return transferValue, nil
 }
 
+// technicalDataGen1DataSize returns the length of the data of a Gen1 Technical
+// Data transfer, without its signature.
+func technicalDataGen1DataSize(technicalData *vuv1.TechnicalDataGen1) int {
+	// VuIdentification: 116 bytes (Gen1: 36+36+16+8+8+4+8)
+	// SensorPaired: 20 bytes (Gen1)
+	// VuCalibrationData: 1 byte count + (n * 167 bytes)
+	return 116 + 20 + 1 + (len(technicalData.GetCalibrationRecords()) * 167)
+}
+
 // anonymizeTechnicalDataGen1 anonymizes Gen1 Technical Data.
 // TODO: Implement full semantic anonymization (anonymize VIN, VRN, sensor IDs, etc.).
 func (opts AnonymizeOptions) anonymizeTechnicalDataGen1(td *vuv1.TechnicalDataGen1) *vuv1.TechnicalDataGen1 {
@@ -197,10 +209,7 @@ func (opts AnonymizeOptions) anonymizeTechnicalDataGen1(td *vuv1.TechnicalDataGe
 	result := proto.Clone(td).(*vuv1.TechnicalDataGen1)
 
 	// Create DD anonymize options
-	ddOpts := dd.AnonymizeOptions{
-		PreserveDistanceAndTrips: opts.PreserveDistanceAndTrips,
-		PreserveTimestamps:       opts.PreserveTimestamps,
-	}
+	ddOpts := opts.ddAnonymizeOptions()
 
 	// Anonymize VU identification
 	if vuIdent := result.GetVuIdentification(); vuIdent != nil {
@@ -3,6 +3,7 @@ package vu
 import (
 	"fmt"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 	"google.golang.org/protobuf/proto"
 )
@@ -14,8 +15,16 @@ import (
 //
 // Gen2 V1 Technical Data structure uses RecordArray format.
 //
-// Note: This is a minimal implementation that stores raw_data for round-trip fidelity.
+// The paired motion sensors and coupled external GNSS facilities are parsed;
+// the other record arrays are stored in raw_data for round-trip fidelity.
 func unmarshalTechnicalDataGen2V1(value []byte) (*vuv1.TechnicalDataGen2V1, error) {
+	return parseTechnicalDataGen2V1(dd.UnmarshalOptions{PreserveRawData: true}, value, nil)
+}
+
+// parseTechnicalDataGen2V1 is like unmarshalTechnicalDataGen2V1, but parses the
+// records of the transfer with opts, and limits the number of records of its
+// RecordArrays with sizes.
+func parseTechnicalDataGen2V1(opts dd.UnmarshalOptions, value []byte, sizes *recordSizes) (*vuv1.TechnicalDataGen2V1, error) {
 	// Split transfer value into data and signature
 	// Gen2 uses variable-length ECDSA signatures stored as SignatureRecordArray
 	// We use the sizeOf function to determine where to split
@@ -30,13 +39,19 @@ func unmarshalTechnicalDataGen2V1(value []byte) (*vuv1.TechnicalDataGen2V1, erro
 	dataSize := totalSize - signatureSize
 	data := value[:dataSize]
 	signature := value[dataSize:]
+	if err := sizes.checkArrayCount("Signature", signature, 0); err != nil {
+		return nil, err
+	}
 
 	technicalData := &vuv1.TechnicalDataGen2V1{}
 	technicalData.SetRawData(value) // Store complete transfer value for painting
 
-	// Validate structure by skipping through all record arrays
+	// Walk the record arrays, parsing the sensor pairing and GNSS coupling records
 	offset := 0
 	skipRecordArray := func(name string) error {
+		if err := sizes.checkArrayCount(name, data, offset); err != nil {
+			return err
+		}
 		size, err := sizeOfRecordArray(data, offset)
 		if err != nil {
 			return fmt.Errorf("%s: %w", name, err)
@@ -45,27 +60,25 @@ func unmarshalTechnicalDataGen2V1(value []byte) (*vuv1.TechnicalDataGen2V1, erro
 		return nil
 	}
 
-	// Skip all record arrays
-	if err := skipRecordArray("VuApprovalNumber"); err != nil {
-		return nil, err
-	}
-	if err := skipRecordArray("VuSoftwareIdentification"); err != nil {
-		return nil, err
-	}
-	if err := skipRecordArray("VuManufacturerName"); err != nil {
-		return nil, err
-	}
-	if err := skipRecordArray("VuManufacturerAddress"); err != nil {
+	if err := skipRecordArray("VuIdentificationRecordArray"); err != nil {
 		return nil, err
 	}
-	if err := skipRecordArray("VuPartNumber"); err != nil {
-		return nil, err
+	pairedSensors, size, err := parseSensorPairedRecordArray[vuv1.TechnicalDataGen2V1_PairedSensor](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuSensorPairedRecordArray: %w", err)
 	}
-	if err := skipRecordArray("VuSerialNumber"); err != nil {
-		return nil, err
+	technicalData.SetPairedSensors(pairedSensors)
+	offset += size
+	coupledGnss, size, err := parseSensorExternalGNSSCoupledRecordArray[vuv1.TechnicalDataGen2V1_CoupledGnss](opts, data, offset, sizes)
+	if err != nil {
+		return nil, fmt.Errorf("VuSensorExternalGNSSCoupledRecordArray: %w", err)
 	}
-	if err := skipRecordArray("SensorPaired"); err != nil {
-		return nil, err
+	technicalData.SetCoupledGnssFacilities(coupledGnss)
+	offset += size
+	for _, name := range technicalDataGen2RecordArrays[3:] {
+		if err := skipRecordArray(name); err != nil {
+			return nil, err
+		}
 	}
 
 	// Store signature (extracted at the beginning)
@@ -94,7 +107,7 @@ func (opts MarshalOptions) MarshalTechnicalDataGen2V1(technicalData *vuv1.Techni
 }
 
 // anonymizeTechnicalDataGen2V1 anonymizes Gen2 V1 Technical Data.
-// TODO: Implement full semantic anonymization (anonymize VIN, VRN, sensor IDs, etc.).
+// TODO: Implement full semantic anonymization (anonymize VIN, VRN, etc.).
 func (opts AnonymizeOptions) anonymizeTechnicalDataGen2V1(td *vuv1.TechnicalDataGen2V1) *vuv1.TechnicalDataGen2V1 {
 	if td == nil {
 		return nil
@@ -104,6 +117,14 @@ func (opts AnonymizeOptions) anonymizeTechnicalDataGen2V1(td *vuv1.TechnicalData
 	// Gen2 uses variable-length ECDSA signatures
 	result.SetSignature([]byte{})
 
+	// Anonymize sensor and GNSS facility serial numbers
+	for _, sensor := range result.GetPairedSensors() {
+		sensor.SetSerialNumber(anonymizeSensorSerialNumber(sensor.GetSerialNumber()))
+	}
+	for _, gnss := range result.GetCoupledGnssFacilities() {
+		gnss.SetSerialNumber(anonymizeSensorSerialNumber(gnss.GetSerialNumber()))
+	}
+
 	// Note: We intentionally keep raw_data here because MarshalTechnicalDataGen2V1
 	// currently requires raw_data (semantic marshalling not yet implemented).
 
@@ -58,3 +58,72 @@ func TestTechnicalData_Gen2V1(t *testing.T) {
 		})
 	}
 }
+
+func TestTechnicalData_Gen2V1_sensorPairing(t *testing.T) {
+	sensorRecord := []byte{
+		0x00, 0x03, 0x0d, 0x40, 0x05, 0x19, 0x06, 0x21, // sensorSerialNumber
+	}
+	sensorRecord = append(sensorRecord, "e1-0001         "...)  // sensorApprovalNumber
+	sensorRecord = append(sensorRecord, 0x5f, 0x5e, 0x10, 0x00) // sensorPairingDate
+	gnssRecord := []byte{
+		0x00, 0x00, 0x30, 0x39, 0x0c, 0x20, 0x0a, 0x21, // sensorSerialNumber
+	}
+	gnssRecord = append(gnssRecord, "e1-GNSS-0002    "...)  // sensorApprovalNumber
+	gnssRecord = append(gnssRecord, 0x61, 0x3f, 0x43, 0x80) // sensorCouplingDate
+
+	var value []byte
+	value = appendRecordArrayHeader(value, 0x0f, 0, 0) // VuIdentificationRecordArray
+	value = appendRecordArrayHeader(value, 0x11, uint16(len(sensorRecord)), 1)
+	value = append(value, sensorRecord...)
+	value = appendRecordArrayHeader(value, 0x12, uint16(len(gnssRecord)), 1)
+	value = append(value, gnssRecord...)
+	for range technicalDataGen2RecordArrays[3:] {
+		value = appendRecordArrayHeader(value, 0x00, 0, 0)
+	}
+	value = appendRecordArrayHeader(value, 0x08, 4, 1) // SignatureRecordArray
+	value = append(value, 0xde, 0xad, 0xbe, 0xef)
+
+	technicalData, err := unmarshalTechnicalDataGen2V1(value)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	sensors := technicalData.GetPairedSensors()
+	if len(sensors) != 1 {
+		t.Fatalf("got %d paired sensors, want 1", len(sensors))
+	}
+	if got, want := sensors[0].GetSerialNumber().GetSerialNumber(), int64(0x00030d40); got != want {
+		t.Errorf("sensor serial number = %d, want %d", got, want)
+	}
+	if got, want := sensors[0].GetApprovalNumber().GetValue(), "e1-0001"; got != want {
+		t.Errorf("sensor approval number = %q, want %q", got, want)
+	}
+	if got, want := sensors[0].GetPairingDate().GetSeconds(), int64(0x5f5e1000); got != want {
+		t.Errorf("sensor pairing date = %d, want %d", got, want)
+	}
+
+	gnss := technicalData.GetCoupledGnssFacilities()
+	if len(gnss) != 1 {
+		t.Fatalf("got %d coupled GNSS facilities, want 1", len(gnss))
+	}
+	if got, want := gnss[0].GetSerialNumber().GetSerialNumber(), int64(12345); got != want {
+		t.Errorf("GNSS serial number = %d, want %d", got, want)
+	}
+	if got, want := gnss[0].GetApprovalNumber().GetValue(), "e1-GNSS-0002"; got != want {
+		t.Errorf("GNSS approval number = %q, want %q", got, want)
+	}
+	if got, want := gnss[0].GetCouplingDate().GetSeconds(), int64(0x613f4380); got != want {
+		t.Errorf("GNSS coupling date = %d, want %d", got, want)
+	}
+	if diff := cmp.Diff([]byte{0xde, 0xad, 0xbe, 0xef}, technicalData.GetSignature()[5:]); diff != "" {
+		t.Errorf("signature mismatch (-want +got):\n%s", diff)
+	}
+
+	marshaled, err := MarshalOptions{}.MarshalTechnicalDataGen2V1(technicalData)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if diff := cmp.Diff(value, marshaled); diff != "" {
+		t.Errorf("Binary round-trip mismatch (-want +got):\n%s", diff)
+	}
+}
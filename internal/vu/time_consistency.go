@@ -0,0 +1,212 @@
+package vu
+
+import (
+	"sort"
+	"time"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GNSSClockDivergenceThreshold is the largest difference between the time of
+// a record and the time of its GNSS fix that CheckTimeConsistency tolerates.
+//
+// It matches the threshold of the "time conflict" event of Gen2 vehicle
+// units, which is triggered when the VU clock and the GNSS time differ by
+// more than one minute.
+const GNSSClockDivergenceThreshold = time.Minute
+
+// TimeAnomalyKind identifies an inconsistency between the timestamps of a VU.
+type TimeAnomalyKind int
+
+const (
+	// TimeAnomalyBackwardJump is a timestamp earlier than the timestamp the
+	// VU recorded before it in the same sequence of records.
+	TimeAnomalyBackwardJump TimeAnomalyKind = iota + 1
+
+	// TimeAnomalyFuture is a timestamp later than the current time of the
+	// VU when the file was downloaded.
+	TimeAnomalyFuture
+
+	// TimeAnomalyGap is a range of days missing between two downloaded days
+	// of activities.
+	TimeAnomalyGap
+
+	// TimeAnomalyGNSSDivergence is a record whose VU time differs from the
+	// time of its GNSS fix by more than GNSSClockDivergenceThreshold.
+	TimeAnomalyGNSSDivergence
+)
+
+// String returns the name of the time anomaly kind.
+func (k TimeAnomalyKind) String() string {
+	switch k {
+	case TimeAnomalyBackwardJump:
+		return "BACKWARD_JUMP"
+	case TimeAnomalyFuture:
+		return "FUTURE"
+	case TimeAnomalyGap:
+		return "GAP"
+	case TimeAnomalyGNSSDivergence:
+		return "GNSS_DIVERGENCE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// TimeAnomaly is an inconsistency between two timestamps of a VU.
+type TimeAnomaly struct {
+	// Kind identifies the anomaly.
+	Kind TimeAnomalyKind
+
+	// Time is the anomalous timestamp: the timestamp that jumped backward,
+	// the future timestamp, the day after a gap, or the VU time of a record
+	// diverging from its GNSS fix.
+	Time time.Time
+
+	// Reference is the timestamp Time is inconsistent with: the previous
+	// timestamp of the sequence, the current time of the VU at download,
+	// the day before a gap, or the time of the GNSS fix.
+	Reference time.Time
+}
+
+// CheckTimeConsistency reports inconsistencies between the timestamps of a
+// VU download, which may indicate clock drift or manipulation, ordered by
+// Time.
+//
+// The activities transfers are checked for:
+//   - activity changes (Data Dictionary, Section 2.1) of a slot whose time of
+//     change precedes the previous change of the slot on the same day, and
+//     GNSS accumulated driving records (Gen2) and load/unload records
+//     (Gen2v2) preceding the previous record of the day;
+//   - days and records later than the CurrentDateTime of the overview, i.e.
+//     the time of the VU when the file was downloaded;
+//   - days missing between downloaded days;
+//   - GNSS accumulated driving and load/unload records whose VU time differs
+//     from the time of their GNSS fix by more than
+//     GNSSClockDivergenceThreshold.
+//
+// Records without a timestamp, and GNSS fixes without a time, are ignored.
+// Days transferred more than once are only checked once.
+func CheckTimeConsistency(file *vuv1.VehicleUnitFile) []TimeAnomaly {
+	var currentTime *timestamppb.Timestamp
+	var days []timeDay
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		currentTime = file.GetGen1().GetOverview().GetCurrentDateTime()
+		for _, activities := range file.GetGen1().GetActivities() {
+			days = append(days, timeDay{date: activities.GetDateOfDay(), changes: activities.GetActivityChanges()})
+		}
+	case ddv1.Generation_GENERATION_2:
+		switch file.GetVersion() {
+		case ddv1.Version_VERSION_2:
+			currentTime = file.GetGen2V2().GetOverview().GetCurrentDateTime()
+			for _, activities := range file.GetGen2V2().GetActivities() {
+				day := timeDay{date: activities.GetDateOfDay(), changes: activities.GetActivityChanges()}
+				var accumulatedDriving, loadUnload []gnssFix
+				for _, record := range activities.GetGnssAccumulatedDriving() {
+					accumulatedDriving = append(accumulatedDriving, gnssFix{time: record.GetTimeStamp(), gnssTime: record.GetGnssPlaceAuthRecord().GetTimestamp()})
+				}
+				for _, record := range activities.GetLoadUnloadOperations() {
+					loadUnload = append(loadUnload, gnssFix{time: record.GetTimeStamp(), gnssTime: record.GetGnssPlaceAuthRecord().GetTimestamp()})
+				}
+				day.fixes = [][]gnssFix{accumulatedDriving, loadUnload}
+				days = append(days, day)
+			}
+		default:
+			currentTime = file.GetGen2V1().GetOverview().GetCurrentDateTime()
+			for _, activities := range file.GetGen2V1().GetActivities() {
+				day := timeDay{date: activities.GetDateOfDay(), changes: activities.GetActivityChanges()}
+				var accumulatedDriving []gnssFix
+				for _, record := range activities.GetGnssAccumulatedDriving() {
+					accumulatedDriving = append(accumulatedDriving, gnssFix{time: record.GetTimeStamp(), gnssTime: record.GetGnssPlaceRecord().GetTimestamp()})
+				}
+				day.fixes = [][]gnssFix{accumulatedDriving}
+				days = append(days, day)
+			}
+		}
+	}
+
+	var anomalies []TimeAnomaly
+	var downloadTime time.Time
+	if hasRouteTime(currentTime) {
+		downloadTime = currentTime.AsTime().UTC()
+	}
+	checkFuture := func(t time.Time) {
+		if !downloadTime.IsZero() && t.After(downloadTime) {
+			anomalies = append(anomalies, TimeAnomaly{Kind: TimeAnomalyFuture, Time: t, Reference: downloadTime})
+		}
+	}
+
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].date.GetSeconds() < days[j].date.GetSeconds()
+	})
+	var previousDate time.Time
+	for _, day := range days {
+		if !hasRouteTime(day.date) {
+			continue
+		}
+		date := day.date.AsTime().UTC().Truncate(24 * time.Hour)
+		if !previousDate.IsZero() {
+			if date.Equal(previousDate) {
+				continue
+			}
+			if date.Sub(previousDate) > 24*time.Hour {
+				anomalies = append(anomalies, TimeAnomaly{Kind: TimeAnomalyGap, Time: date, Reference: previousDate})
+			}
+		}
+		previousDate = date
+		checkFuture(date)
+
+		lastChange := map[ddv1.CardSlotNumber]time.Time{}
+		for _, change := range day.changes {
+			t := date.Add(time.Duration(change.GetTimeOfChangeMinutes()) * time.Minute)
+			if last, ok := lastChange[change.GetSlot()]; ok && t.Before(last) {
+				anomalies = append(anomalies, TimeAnomaly{Kind: TimeAnomalyBackwardJump, Time: t, Reference: last})
+			}
+			lastChange[change.GetSlot()] = t
+			checkFuture(t)
+		}
+
+		for _, fixes := range day.fixes {
+			var lastFix time.Time
+			for _, fix := range fixes {
+				if !hasRouteTime(fix.time) {
+					continue
+				}
+				t := fix.time.AsTime().UTC()
+				if !lastFix.IsZero() && t.Before(lastFix) {
+					anomalies = append(anomalies, TimeAnomaly{Kind: TimeAnomalyBackwardJump, Time: t, Reference: lastFix})
+				}
+				lastFix = t
+				checkFuture(t)
+				if !hasRouteTime(fix.gnssTime) {
+					continue
+				}
+				gnssTime := fix.gnssTime.AsTime().UTC()
+				if t.Sub(gnssTime).Abs() > GNSSClockDivergenceThreshold {
+					anomalies = append(anomalies, TimeAnomaly{Kind: TimeAnomalyGNSSDivergence, Time: t, Reference: gnssTime})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		return anomalies[i].Time.Before(anomalies[j].Time)
+	})
+	return anomalies
+}
+
+// timeDay holds the timestamps recorded by a VU for one day.
+type timeDay struct {
+	date    *timestamppb.Timestamp
+	changes []*ddv1.ActivityChangeInfo
+	// fixes are the sequences of records of the day with a GNSS fix, one
+	// per record type.
+	fixes [][]gnssFix
+}
+
+// gnssFix is a record carrying both the VU time and the time of a GNSS fix.
+type gnssFix struct {
+	time, gnssTime *timestamppb.Timestamp
+}
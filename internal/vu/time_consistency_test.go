@@ -0,0 +1,73 @@
+package vu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestCheckTimeConsistency(t *testing.T) {
+	day1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day3 := day1.Add(48 * time.Hour)
+	newChange := func(slot ddv1.CardSlotNumber, minute int32) *ddv1.ActivityChangeInfo {
+		change := &ddv1.ActivityChangeInfo{}
+		change.SetSlot(slot)
+		change.SetTimeOfChangeMinutes(minute)
+		return change
+	}
+	newGNSSRecord := func(vuTime, gnssTime time.Time) *ddv1.VuGNSSADRecord {
+		place := &ddv1.GNSSPlaceRecord{}
+		place.SetTimestamp(timestamppb.New(gnssTime))
+		record := &ddv1.VuGNSSADRecord{}
+		record.SetTimeStamp(timestamppb.New(vuTime))
+		record.SetGnssPlaceRecord(place)
+		return record
+	}
+	newActivities := func(date time.Time, changes []*ddv1.ActivityChangeInfo, gnss []*ddv1.VuGNSSADRecord) *vuv1.ActivitiesGen2V1 {
+		activities := &vuv1.ActivitiesGen2V1{}
+		activities.SetDateOfDay(timestamppb.New(date))
+		activities.SetActivityChanges(changes)
+		activities.SetGnssAccumulatedDriving(gnss)
+		return activities
+	}
+	driver, coDriver := ddv1.CardSlotNumber_DRIVER_SLOT, ddv1.CardSlotNumber_CO_DRIVER_SLOT
+
+	overview := &vuv1.OverviewGen2V1{}
+	overview.SetCurrentDateTime(timestamppb.New(day3.Add(12 * time.Hour)))
+	gen2V1 := &vuv1.VehicleUnitFileGen2V1{}
+	gen2V1.SetOverview(overview)
+	gen2V1.SetActivities([]*vuv1.ActivitiesGen2V1{
+		newActivities(day3, []*ddv1.ActivityChangeInfo{
+			newChange(driver, 0),
+			newChange(driver, 13*60),
+		}, nil),
+		newActivities(day1, []*ddv1.ActivityChangeInfo{
+			newChange(driver, 0),
+			newChange(driver, 60),
+			newChange(coDriver, 0), // each slot is a separate sequence
+			newChange(driver, 30),
+		}, []*ddv1.VuGNSSADRecord{
+			newGNSSRecord(day1.Add(10*time.Hour), day1.Add(10*time.Hour+30*time.Second)),
+			newGNSSRecord(day1.Add(12*time.Hour), day1.Add(12*time.Hour+5*time.Minute)),
+		}),
+	})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_2)
+	file.SetVersion(ddv1.Version_VERSION_1)
+	file.SetGen2V1(gen2V1)
+
+	want := []TimeAnomaly{
+		{Kind: TimeAnomalyBackwardJump, Time: day1.Add(30 * time.Minute), Reference: day1.Add(time.Hour)},
+		{Kind: TimeAnomalyGNSSDivergence, Time: day1.Add(12 * time.Hour), Reference: day1.Add(12*time.Hour + 5*time.Minute)},
+		{Kind: TimeAnomalyGap, Time: day3, Reference: day1},
+		{Kind: TimeAnomalyFuture, Time: day3.Add(13 * time.Hour), Reference: day3.Add(12 * time.Hour)},
+	}
+	if diff := cmp.Diff(want, CheckTimeConsistency(file)); diff != "" {
+		t.Errorf("CheckTimeConsistency() mismatch (-want +got):\n%s", diff)
+	}
+}
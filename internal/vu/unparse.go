@@ -3,6 +3,7 @@ package vu
 import (
 	"fmt"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
@@ -15,7 +16,7 @@ func UnparseVehicleUnitFile(file *vuv1.VehicleUnitFile) (*vuv1.RawVehicleUnitFil
 	}
 
 	var records []*vuv1.RawVehicleUnitFile_Record
-	marshalOpts := MarshalOptions{}
+	marshalOpts := MarshalOptions{MarshalOptions: dd.MarshalOptions{UseRawData: true}}
 
 	// Helper to create a raw record from transfer value
 	appendRecord := func(transferType vuv1.TransferType, transferValue []byte) error {
@@ -24,13 +25,19 @@ func UnparseVehicleUnitFile(file *vuv1.VehicleUnitFile) (*vuv1.RawVehicleUnitFil
 		}
 
 		// Calculate signature size for this transfer type
-		_, sigSize, err := sizeOfTransferValue(transferValue, transferType)
+		_, sigSize, err := TransferSize(transferValue, transferType)
 		if err != nil {
 			return fmt.Errorf("failed to determine signature size: %w", err)
 		}
 
+		tag, ok := TagForTransferType(transferType)
+		if !ok {
+			return fmt.Errorf("no tag for transfer type %v", transferType)
+		}
+
 		// Create record with complete transfer value
 		record := &vuv1.RawVehicleUnitFile_Record{}
+		record.SetTag(uint32(tag))
 		record.SetType(transferType)
 		record.SetGeneration(file.GetGeneration())
 		record.SetValue(transferValue)          // Store complete value directly
@@ -110,6 +117,17 @@ func UnparseVehicleUnitFile(file *vuv1.VehicleUnitFile) (*vuv1.RawVehicleUnitFil
 				return nil, fmt.Errorf("Gen2V2 data is nil")
 			}
 
+			// Unparse Download Interface Version (TREP 00)
+			if downloadInterfaceVersion := gen2v2.GetDownloadInterfaceVersion(); downloadInterfaceVersion != nil {
+				transferValue, err := marshalOpts.MarshalDownloadInterfaceVersion(downloadInterfaceVersion)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal DownloadInterfaceVersion: %w", err)
+				}
+				if err := appendRecord(vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION, transferValue); err != nil {
+					return nil, err
+				}
+			}
+
 			// Unparse Overview (TREP 31)
 			if overview := gen2v2.GetOverview(); overview != nil {
 				transferValue, err := marshalOpts.MarshalOverviewGen2V2(overview)
@@ -229,7 +247,7 @@ func UnparseVehicleUnitFile(file *vuv1.VehicleUnitFile) (*vuv1.RawVehicleUnitFil
 		}
 
 	default:
-		return nil, fmt.Errorf("unsupported generation: %v", file.GetGeneration())
+		return nil, fmt.Errorf("%w: %v", dd.ErrUnsupportedGeneration, file.GetGeneration())
 	}
 
 	rawFile := &vuv1.RawVehicleUnitFile{}
@@ -1,9 +1,11 @@
 package vu
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 
+	"github.com/way-platform/tachograph-go/internal/dd"
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 	"google.golang.org/protobuf/proto"
@@ -81,6 +83,15 @@ func (opts MarshalOptions) MarshalVehicleUnitFile(file *vuv1.VehicleUnitFile) ([
 				return nil, fmt.Errorf("Gen2V2 data is nil")
 			}
 
+			// Marshal Download Interface Version (TREP 00)
+			if downloadInterfaceVersion := gen2v2.GetDownloadInterfaceVersion(); downloadInterfaceVersion != nil {
+				transferData, err := opts.MarshalDownloadInterfaceVersion(downloadInterfaceVersion)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal DownloadInterfaceVersion: %w", err)
+				}
+				dst = appendTransfer(dst, vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION, transferData)
+			}
+
 			// Marshal Overview (TREP 31)
 			if overview := gen2v2.GetOverview(); overview != nil {
 				transferData, err := opts.MarshalOverviewGen2V2(overview)
@@ -180,12 +191,145 @@ func (opts MarshalOptions) MarshalVehicleUnitFile(file *vuv1.VehicleUnitFile) ([
 		}
 
 	default:
-		return nil, fmt.Errorf("unsupported generation: %v", file.GetGeneration())
+		return nil, fmt.Errorf("%w: %v", dd.ErrUnsupportedGeneration, file.GetGeneration())
 	}
 
 	return dst, nil
 }
 
+// EstimateSize returns the length of the output of MarshalVehicleUnitFile for
+// file, so that callers can size their buffers before marshaling.
+//
+// The size is computed from the record counts and fixed record lengths of the
+// transfers, and the lengths of their signatures and of the raw data of the
+// transfers that are written from it, without encoding them. It is exact for
+// files that MarshalVehicleUnitFile can encode.
+func (opts MarshalOptions) EstimateSize(file *vuv1.VehicleUnitFile) (int, error) {
+	const (
+		lenTag                      = 2
+		lenSignatureGen1            = 128
+		lenDownloadInterfaceVersion = 2
+	)
+	if file == nil {
+		return 0, fmt.Errorf("vehicle unit file is nil")
+	}
+
+	size := 0
+	// addGen1 adds a Gen1 transfer, whose signature defaults to zeros.
+	addGen1 := func(dataSize int, signature []byte) {
+		if len(signature) == 0 {
+			size += lenSignatureGen1
+		}
+		size += lenTag + dataSize + len(signature)
+	}
+	// addRaw adds a Gen2 transfer that is only written from its raw data.
+	addRaw := func(name string, i int, raw []byte) error {
+		if len(raw) == 0 {
+			return fmt.Errorf("cannot marshal %s [%d] without raw_data", name, i)
+		}
+		size += lenTag + len(raw)
+		return nil
+	}
+	// activitiesSize returns the size of Gen2 activities, which are written
+	// from their raw data with UseRawData.
+	activitiesSize := func(raw []byte, semanticSize int) int {
+		if len(raw) > 0 && opts.UseRawData {
+			return len(raw)
+		}
+		return semanticSize
+	}
+
+	switch file.GetGeneration() {
+	case ddv1.Generation_GENERATION_1:
+		gen1 := file.GetGen1()
+		if gen1 == nil {
+			return 0, fmt.Errorf("Gen1 data is nil")
+		}
+		if overview := gen1.GetOverview(); overview != nil {
+			addGen1(overviewGen1DataSize(overview), overview.GetSignature())
+		}
+		for _, activities := range gen1.GetActivities() {
+			addGen1(activitiesGen1DataSize(activities), activities.GetSignature())
+		}
+		for _, eventsAndFaults := range gen1.GetEventsAndFaults() {
+			addGen1(eventsAndFaultsGen1DataSize(eventsAndFaults), eventsAndFaults.GetSignature())
+		}
+		for _, detailedSpeed := range gen1.GetDetailedSpeed() {
+			addGen1(detailedSpeedGen1DataSize(detailedSpeed), detailedSpeed.GetSignature())
+		}
+		for _, technicalData := range gen1.GetTechnicalData() {
+			addGen1(technicalDataGen1DataSize(technicalData), technicalData.GetSignature())
+		}
+
+	case ddv1.Generation_GENERATION_2:
+		if file.GetVersion() == ddv1.Version_VERSION_2 {
+			gen2v2 := file.GetGen2V2()
+			if gen2v2 == nil {
+				return 0, fmt.Errorf("Gen2V2 data is nil")
+			}
+			if gen2v2.GetDownloadInterfaceVersion() != nil {
+				size += lenTag + lenDownloadInterfaceVersion
+			}
+			if overview := gen2v2.GetOverview(); overview != nil {
+				if err := addRaw("Overview Gen2V2", 0, overview.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+			for _, activities := range gen2v2.GetActivities() {
+				size += lenTag + activitiesSize(activities.GetRawData(), activitiesGen2V2Size(activities))
+			}
+			for i, eventsAndFaults := range gen2v2.GetEventsAndFaults() {
+				if err := addRaw("EventsAndFaults Gen2V2", i, eventsAndFaults.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+			for i, detailedSpeed := range gen2v2.GetDetailedSpeed() {
+				if err := addRaw("DetailedSpeed Gen2V2", i, detailedSpeed.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+			for i, technicalData := range gen2v2.GetTechnicalData() {
+				if err := addRaw("TechnicalData Gen2V2", i, technicalData.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+		} else {
+			gen2v1 := file.GetGen2V1()
+			if gen2v1 == nil {
+				return 0, fmt.Errorf("Gen2V1 data is nil")
+			}
+			if overview := gen2v1.GetOverview(); overview != nil {
+				if err := addRaw("Overview Gen2V1", 0, overview.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+			for _, activities := range gen2v1.GetActivities() {
+				size += lenTag + activitiesSize(activities.GetRawData(), activitiesGen2V1Size(activities))
+			}
+			for i, eventsAndFaults := range gen2v1.GetEventsAndFaults() {
+				if err := addRaw("EventsAndFaults Gen2V1", i, eventsAndFaults.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+			for i, detailedSpeed := range gen2v1.GetDetailedSpeed() {
+				if err := addRaw("DetailedSpeed Gen2V1", i, detailedSpeed.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+			for i, technicalData := range gen2v1.GetTechnicalData() {
+				if err := addRaw("TechnicalData Gen2V1", i, technicalData.GetRawData()); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+	default:
+		return 0, fmt.Errorf("%w: %v", dd.ErrUnsupportedGeneration, file.GetGeneration())
+	}
+
+	return size, nil
+}
+
 // ParseRawVehicleUnitFile parses a RawVehicleUnitFile into a fully parsed VehicleUnitFile message.
 // Authentication results from the raw file records are propagated to the parsed messages.
 //
@@ -207,6 +351,15 @@ func (opts MarshalOptions) MarshalVehicleUnitFile(file *vuv1.VehicleUnitFile) ([
 //	    }
 //	}
 func (opts ParseOptions) ParseRawVehicleUnitFile(rawFile *vuv1.RawVehicleUnitFile) (*vuv1.VehicleUnitFile, error) {
+	return opts.ParseRawVehicleUnitFileContext(context.Background(), rawFile)
+}
+
+// ParseRawVehicleUnitFileContext is like ParseRawVehicleUnitFile, but stops
+// parsing with the context's error when ctx is done. Cancellation is checked
+// between transfers.
+//
+// Errors decoding a transfer are reported as a *dd.ParseError.
+func (opts ParseOptions) ParseRawVehicleUnitFileContext(ctx context.Context, rawFile *vuv1.RawVehicleUnitFile) (*vuv1.VehicleUnitFile, error) {
 	// Determine generation/version
 	if len(rawFile.GetRecords()) == 0 {
 		return nil, fmt.Errorf("empty VU file")
@@ -219,7 +372,7 @@ func (opts ParseOptions) ParseRawVehicleUnitFile(rawFile *vuv1.RawVehicleUnitFil
 
 	switch firstRecord.GetGeneration() {
 	case ddv1.Generation_GENERATION_1:
-		gen1File, err := opts.unmarshalVehicleUnitFileGen1(rawFile)
+		gen1File, err := opts.unmarshalVehicleUnitFileGen1(ctx, rawFile)
 		if err != nil {
 			return nil, err
 		}
@@ -227,8 +380,9 @@ func (opts ParseOptions) ParseRawVehicleUnitFile(rawFile *vuv1.RawVehicleUnitFil
 		output.SetGen1(gen1File)
 
 	case ddv1.Generation_GENERATION_2:
-		if hasGen2V2Transfers(rawFile) {
-			gen2v2File, err := opts.unmarshalVehicleUnitFileGen2V2(rawFile)
+		sizes := &recordSizes{lenient: opts.LenientRecordSizes, maxRecords: opts.MaxRecordsPerArray}
+		if gen2Version(rawFile) == ddv1.Version_VERSION_2 {
+			gen2v2File, err := opts.unmarshalVehicleUnitFileGen2V2(ctx, rawFile, sizes)
 			if err != nil {
 				return nil, err
 			}
@@ -236,7 +390,7 @@ func (opts ParseOptions) ParseRawVehicleUnitFile(rawFile *vuv1.RawVehicleUnitFil
 			output.SetVersion(ddv1.Version_VERSION_2)
 			output.SetGen2V2(gen2v2File)
 		} else {
-			gen2v1File, err := opts.unmarshalVehicleUnitFileGen2V1(rawFile)
+			gen2v1File, err := opts.unmarshalVehicleUnitFileGen2V1(ctx, rawFile, sizes)
 			if err != nil {
 				return nil, err
 			}
@@ -244,18 +398,41 @@ func (opts ParseOptions) ParseRawVehicleUnitFile(rawFile *vuv1.RawVehicleUnitFil
 			output.SetVersion(ddv1.Version_VERSION_1)
 			output.SetGen2V1(gen2v1File)
 		}
+		output.SetParseWarnings(sizes.warnings)
 
 	default:
-		return nil, fmt.Errorf("unknown generation: %v", firstRecord.GetGeneration())
+		return nil, newParseError(firstRecord, fmt.Errorf("%w: %v", dd.ErrUnsupportedGeneration, firstRecord.GetGeneration()))
 	}
 
 	return output, nil
 }
 
-// hasGen2V2Transfers checks if the raw file contains Gen2 V2 transfers.
-// Gen2 V2 is identified by the presence of TREP 00 (DownloadInterfaceVersion)
-// or TREP 31-35 transfers.
-func hasGen2V2Transfers(rawFile *vuv1.RawVehicleUnitFile) bool {
+// newParseError adds the context of a raw record to an error decoding it.
+func newParseError(record *vuv1.RawVehicleUnitFile_Record, err error) error {
+	return &dd.ParseError{
+		Name:       record.GetType().String(),
+		Generation: record.GetGeneration(),
+		FileOffset: int(record.GetFileOffset()),
+		Err:        err,
+	}
+}
+
+// gen2Version determines the version of a Gen2 VU file.
+//
+// The version byte of the DownloadInterfaceVersion transfer (TREP 00) is
+// consulted first, when present and assigned. Otherwise, the file is Version 2
+// if it contains a DownloadInterfaceVersion transfer, which Version 1 vehicle
+// units do not support, or any TREP 31-35 transfer. Files with only transfers
+// shared by both versions, such as TREP 24, are Version 1.
+func gen2Version(rawFile *vuv1.RawVehicleUnitFile) ddv1.Version {
+	for _, record := range rawFile.GetRecords() {
+		if record.GetType() != vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION {
+			continue
+		}
+		if version, err := unmarshalDownloadInterfaceVersion(record.GetValue()); err == nil && version.HasVersion() {
+			return version.GetVersion()
+		}
+	}
 	for _, record := range rawFile.GetRecords() {
 		switch record.GetType() {
 		case vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION,
@@ -263,26 +440,33 @@ func hasGen2V2Transfers(rawFile *vuv1.RawVehicleUnitFile) bool {
 			vuv1.TransferType_ACTIVITIES_GEN2_V2,
 			vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V2,
 			vuv1.TransferType_TECHNICAL_DATA_GEN2_V2:
-			return true
+			return ddv1.Version_VERSION_2
 		}
 	}
-	return false
+	return ddv1.Version_VERSION_1
 }
 
 // unmarshalVehicleUnitFileGen1 unmarshals a Gen1 VU file from raw records.
-func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUnitFile) (*vuv1.VehicleUnitFileGen1, error) {
+func (opts ParseOptions) unmarshalVehicleUnitFileGen1(ctx context.Context, rawFile *vuv1.RawVehicleUnitFile) (*vuv1.VehicleUnitFileGen1, error) {
 	var output vuv1.VehicleUnitFileGen1
-	// unmarshalOpts := opts.unmarshal()  // Available for future use
+	unmarshalOpts := opts.unmarshal()
 
 	for _, record := range rawFile.GetRecords() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		// Get complete transfer value (already combined)
 		transferValue := record.GetValue()
+		if len(transferValue) == 0 {
+			// Empty transfers, kept by non-strict unmarshalling, hold no data.
+			continue
+		}
 
 		switch record.GetType() {
 		case vuv1.TransferType_OVERVIEW_GEN1:
-			overview, err := unmarshalOverviewGen1(transferValue)
+			overview, err := parseOverviewGen1(unmarshalOpts, transferValue)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Overview Gen1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -291,9 +475,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUn
 			output.SetOverview(overview)
 
 		case vuv1.TransferType_ACTIVITIES_GEN1:
-			activities, err := unmarshalActivitiesGen1(transferValue)
+			activities, err := parseActivitiesGen1(unmarshalOpts, transferValue)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Activities Gen1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -302,9 +486,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUn
 			output.SetActivities(append(output.GetActivities(), activities))
 
 		case vuv1.TransferType_EVENTS_AND_FAULTS_GEN1:
-			eventsAndFaults, err := unmarshalEventsAndFaultsGen1(transferValue)
+			eventsAndFaults, err := parseEventsAndFaultsGen1(unmarshalOpts, transferValue)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Events and Faults Gen1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -313,9 +497,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUn
 			output.SetEventsAndFaults(append(output.GetEventsAndFaults(), eventsAndFaults))
 
 		case vuv1.TransferType_DETAILED_SPEED_GEN1:
-			detailedSpeed, err := unmarshalDetailedSpeedGen1(transferValue)
+			detailedSpeed, err := parseDetailedSpeedGen1(unmarshalOpts, transferValue)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Detailed Speed Gen1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -324,9 +508,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUn
 			output.SetDetailedSpeed(append(output.GetDetailedSpeed(), detailedSpeed))
 
 		case vuv1.TransferType_TECHNICAL_DATA_GEN1:
-			technicalData, err := unmarshalTechnicalDataGen1(transferValue)
+			technicalData, err := parseTechnicalDataGen1(unmarshalOpts, transferValue)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Technical Data Gen1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -335,7 +519,7 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUn
 			output.SetTechnicalData(append(output.GetTechnicalData(), technicalData))
 
 		default:
-			return nil, fmt.Errorf("unexpected transfer type %v in Gen1 file", record.GetType())
+			return nil, newParseError(record, fmt.Errorf("unexpected transfer type %v in Gen1 file", record.GetType()))
 		}
 	}
 
@@ -343,19 +527,26 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen1(rawFile *vuv1.RawVehicleUn
 }
 
 // unmarshalVehicleUnitFileGen2V1 unmarshals a Gen2 V1 VU file from raw records.
-func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicleUnitFile) (*vuv1.VehicleUnitFileGen2V1, error) {
+func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(ctx context.Context, rawFile *vuv1.RawVehicleUnitFile, sizes *recordSizes) (*vuv1.VehicleUnitFileGen2V1, error) {
 	var output vuv1.VehicleUnitFileGen2V1
-	// unmarshalOpts := opts.unmarshal()  // Available for future use
+	unmarshalOpts := opts.unmarshal()
 
 	for _, record := range rawFile.GetRecords() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		// Get complete transfer value (already combined)
 		transferValue := record.GetValue()
+		if len(transferValue) == 0 {
+			// Empty transfers, kept by non-strict unmarshalling, hold no data.
+			continue
+		}
 
 		switch record.GetType() {
 		case vuv1.TransferType_OVERVIEW_GEN2_V1:
-			overview, err := unmarshalOverviewGen2V1(transferValue)
+			overview, err := parseOverviewGen2V1(unmarshalOpts, transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Overview Gen2 V1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -364,9 +555,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicle
 			output.SetOverview(overview)
 
 		case vuv1.TransferType_ACTIVITIES_GEN2_V1:
-			activities, err := unmarshalActivitiesGen2V1(transferValue)
+			activities, err := parseActivitiesGen2V1(unmarshalOpts, transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Activities Gen2 V1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -375,9 +566,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicle
 			output.SetActivities(append(output.GetActivities(), activities))
 
 		case vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V1:
-			eventsAndFaults, err := unmarshalEventsAndFaultsGen2V1(transferValue)
+			eventsAndFaults, err := parseEventsAndFaultsGen2V1(transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Events and Faults Gen2 V1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -386,9 +577,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicle
 			output.SetEventsAndFaults(append(output.GetEventsAndFaults(), eventsAndFaults))
 
 		case vuv1.TransferType_DETAILED_SPEED_GEN2:
-			detailedSpeed, err := unmarshalDetailedSpeedGen2(transferValue)
+			detailedSpeed, err := parseDetailedSpeedGen2(transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Detailed Speed Gen2: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -397,9 +588,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicle
 			output.SetDetailedSpeed(append(output.GetDetailedSpeed(), detailedSpeed))
 
 		case vuv1.TransferType_TECHNICAL_DATA_GEN2_V1:
-			technicalData, err := unmarshalTechnicalDataGen2V1(transferValue)
+			technicalData, err := parseTechnicalDataGen2V1(unmarshalOpts, transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Technical Data Gen2 V1: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -408,7 +599,7 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicle
 			output.SetTechnicalData(append(output.GetTechnicalData(), technicalData))
 
 		default:
-			return nil, fmt.Errorf("unexpected transfer type %v in Gen2 V1 file", record.GetType())
+			return nil, newParseError(record, fmt.Errorf("unexpected transfer type %v in Gen2 V1 file", record.GetType()))
 		}
 	}
 
@@ -416,24 +607,33 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V1(rawFile *vuv1.RawVehicle
 }
 
 // unmarshalVehicleUnitFileGen2V2 unmarshals a Gen2 V2 VU file from raw records.
-func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(rawFile *vuv1.RawVehicleUnitFile) (*vuv1.VehicleUnitFileGen2V2, error) {
+func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(ctx context.Context, rawFile *vuv1.RawVehicleUnitFile, sizes *recordSizes) (*vuv1.VehicleUnitFileGen2V2, error) {
 	var output vuv1.VehicleUnitFileGen2V2
-	// unmarshalOpts := opts.unmarshal()  // Available for future use
+	unmarshalOpts := opts.unmarshal()
 
 	for _, record := range rawFile.GetRecords() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		// Get complete transfer value (already combined)
 		transferValue := record.GetValue()
+		if len(transferValue) == 0 {
+			// Empty transfers, kept by non-strict unmarshalling, hold no data.
+			continue
+		}
 
 		switch record.GetType() {
 		case vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION:
-			// Download interface version can be parsed if needed
-			// For now, skip as it's mainly used for version detection
-			// output.SetDownloadInterfaceVersion(...)
+			downloadInterfaceVersion, err := unmarshalDownloadInterfaceVersion(transferValue)
+			if err != nil {
+				return nil, newParseError(record, err)
+			}
+			output.SetDownloadInterfaceVersion(downloadInterfaceVersion)
 
 		case vuv1.TransferType_OVERVIEW_GEN2_V2:
-			overview, err := unmarshalOverviewGen2V2(transferValue)
+			overview, err := parseOverviewGen2V2(unmarshalOpts, transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Overview Gen2 V2: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -442,9 +642,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(rawFile *vuv1.RawVehicle
 			output.SetOverview(overview)
 
 		case vuv1.TransferType_ACTIVITIES_GEN2_V2:
-			activities, err := unmarshalActivitiesGen2V2(transferValue)
+			activities, err := parseActivitiesGen2V2(unmarshalOpts, transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Activities Gen2 V2: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -453,9 +653,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(rawFile *vuv1.RawVehicle
 			output.SetActivities(append(output.GetActivities(), activities))
 
 		case vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V2:
-			eventsAndFaults, err := unmarshalEventsAndFaultsGen2V2(transferValue)
+			eventsAndFaults, err := parseEventsAndFaultsGen2V2(transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Events and Faults Gen2 V2: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -464,9 +664,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(rawFile *vuv1.RawVehicle
 			output.SetEventsAndFaults(append(output.GetEventsAndFaults(), eventsAndFaults))
 
 		case vuv1.TransferType_DETAILED_SPEED_GEN2:
-			detailedSpeed, err := unmarshalDetailedSpeedGen2(transferValue)
+			detailedSpeed, err := parseDetailedSpeedGen2(transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Detailed Speed Gen2: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -475,9 +675,9 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(rawFile *vuv1.RawVehicle
 			output.SetDetailedSpeed(append(output.GetDetailedSpeed(), detailedSpeed))
 
 		case vuv1.TransferType_TECHNICAL_DATA_GEN2_V2:
-			technicalData, err := unmarshalTechnicalDataGen2V2(transferValue)
+			technicalData, err := parseTechnicalDataGen2V2(unmarshalOpts, transferValue, sizes)
 			if err != nil {
-				return nil, fmt.Errorf("unmarshal Technical Data Gen2 V2: %w", err)
+				return nil, newParseError(record, err)
 			}
 			// Propagate authentication
 			if auth := record.GetAuthentication(); auth != nil {
@@ -486,52 +686,56 @@ func (opts ParseOptions) unmarshalVehicleUnitFileGen2V2(rawFile *vuv1.RawVehicle
 			output.SetTechnicalData(append(output.GetTechnicalData(), technicalData))
 
 		default:
-			return nil, fmt.Errorf("unexpected transfer type %v in Gen2 V2 file", record.GetType())
+			return nil, newParseError(record, fmt.Errorf("unexpected transfer type %v in Gen2 V2 file", record.GetType()))
 		}
 	}
 
 	return &output, nil
 }
 
-// findTransferTypeByTag maps VU transfer tags to TransferType enum values
-func findTransferTypeByTag(tag uint16) vuv1.TransferType {
+// TransferTypeForTag returns the transfer type of a transfer tag, as found at
+// the start of each transfer of a VU download.
+//
+// Tags are of the form 0x76XX, where XX is the TREP value of the transfer
+// response (Appendix 7, Section 2.2.6). It returns false for tags of unknown
+// transfers.
+func TransferTypeForTag(tag uint16) (vuv1.TransferType, bool) {
 	values := vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED.Descriptor().Values()
 	for i := 0; i < values.Len(); i++ {
-		valueDesc := values.Get(i)
-		opts := valueDesc.Options()
-		if proto.HasExtension(opts, vuv1.E_TrepValue) {
-			trepValue := proto.GetExtension(opts, vuv1.E_TrepValue).(int32)
-			// VU tags are constructed as 0x76XX where XX is the TREP value
-			expectedTag := uint16(0x7600 | (uint16(trepValue) & 0xFF))
-			if expectedTag == tag {
-				return vuv1.TransferType(valueDesc.Number())
-			}
+		transferType := vuv1.TransferType(values.Get(i).Number())
+		if expectedTag, ok := TagForTransferType(transferType); ok && expectedTag == tag {
+			return transferType, true
 		}
 	}
-	return vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED
+	return vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED, false
 }
 
 // appendTransfer appends a transfer in TV format: [Tag: 2 bytes][Value: N bytes]
+//
+// An empty value appends the tag alone, which UnmarshalRawVehicleUnitFile
+// reports as an empty transfer for all types but CARD_DOWNLOAD.
 func appendTransfer(dst []byte, transferType vuv1.TransferType, data []byte) []byte {
-	tag := getTagForTransferType(transferType)
+	tag, _ := TagForTransferType(transferType)
 	dst = binary.BigEndian.AppendUint16(dst, tag)
 	dst = append(dst, data...)
 	return dst
 }
 
-// getTagForTransferType returns the TV format tag for a given transfer type
-func getTagForTransferType(transferType vuv1.TransferType) uint16 {
+// TagForTransferType returns the tag of a transfer type, see
+// TransferTypeForTag. It returns false for transfer types without a TREP
+// value, such as TRANSFER_TYPE_UNSPECIFIED.
+func TagForTransferType(transferType vuv1.TransferType) (uint16, bool) {
 	valueDesc := transferType.Descriptor().Values().ByNumber(protoreflect.EnumNumber(transferType))
 	if valueDesc == nil {
-		return 0
+		return 0, false
 	}
 
 	opts := valueDesc.Options()
 	if !proto.HasExtension(opts, vuv1.E_TrepValue) {
-		return 0
+		return 0, false
 	}
 
 	trepValue := proto.GetExtension(opts, vuv1.E_TrepValue).(int32)
 	// VU tags are constructed as 0x76XX where XX is the TREP value
-	return uint16(0x7600 | (uint16(trepValue) & 0xFF))
+	return uint16(0x7600 | (uint16(trepValue) & 0xFF)), true
 }
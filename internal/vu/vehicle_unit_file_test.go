@@ -2,15 +2,23 @@ package vu
 
 import (
 	"bytes"
+	"context"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
 // TestUnmarshalVehicleUnitFile tests the full semantic parsing of VU files.
@@ -216,3 +224,285 @@ func TestVehicleUnitFileGolden(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRawVehicleUnitFileContext_canceled(t *testing.T) {
+	_, file := readGen1TestDownload(t)
+	data, err := MarshalOptions{}.MarshalVehicleUnitFile(file)
+	if err != nil {
+		t.Fatalf("MarshalVehicleUnitFile failed: %v", err)
+	}
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := (ParseOptions{}).ParseRawVehicleUnitFileContext(ctx, rawFile); err != nil {
+		t.Fatalf("ParseRawVehicleUnitFileContext failed: %v", err)
+	}
+	cancel()
+	if _, err := (ParseOptions{}).ParseRawVehicleUnitFileContext(ctx, rawFile); !errors.Is(err, context.Canceled) {
+		t.Errorf("ParseRawVehicleUnitFileContext with canceled context: got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestTransferTypeForTag(t *testing.T) {
+	values := vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED.Descriptor().Values()
+	for i := 0; i < values.Len(); i++ {
+		transferType := vuv1.TransferType(values.Get(i).Number())
+		tag, ok := TagForTransferType(transferType)
+		if !ok {
+			if transferType != vuv1.TransferType_TRANSFER_TYPE_UNSPECIFIED {
+				t.Errorf("TagForTransferType(%v) = false, want a tag", transferType)
+			}
+			continue
+		}
+		if got, ok := TransferTypeForTag(tag); !ok || got != transferType {
+			t.Errorf("TransferTypeForTag(0x%04X) = %v, %v; want %v, true", tag, got, ok, transferType)
+		}
+	}
+	if got, ok := TransferTypeForTag(0x7601); !ok || got != vuv1.TransferType_OVERVIEW_GEN1 {
+		t.Errorf("TransferTypeForTag(0x7601) = %v, %v; want OVERVIEW_GEN1, true", got, ok)
+	}
+	for _, tag := range []uint16{0x0000, 0x7607, 0x76FF, 0x0501} {
+		if got, ok := TransferTypeForTag(tag); ok {
+			t.Errorf("TransferTypeForTag(0x%04X) = %v, true; want false", tag, got)
+		}
+	}
+}
+
+func TestParseRawVehicleUnitFile_gen2Version(t *testing.T) {
+	activities := &vuv1.ActivitiesGen2V2{}
+	activities.SetDateOfDay(timestamppb.New(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	activities.SetSignature([]byte{0x08, 0x00, 0x40, 0x00, 0x00}) // empty SignatureRecordArray
+	data, err := MarshalOptions{}.MarshalActivitiesGen2V2(activities)
+	if err != nil {
+		t.Fatalf("MarshalActivitiesGen2V2() failed: %v", err)
+	}
+	value := appendTransfer(nil, vuv1.TransferType_ACTIVITIES_GEN2_V2, data)
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(value)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+	}
+	file, err := ParseOptions{}.ParseRawVehicleUnitFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawVehicleUnitFile failed: %v", err)
+	}
+	if file.GetVersion() != ddv1.Version_VERSION_2 || len(file.GetGen2V2().GetActivities()) != 1 {
+		t.Errorf("file with only TREP 32: version %v, %d Gen2 V2 activities; want VERSION_2, 1", file.GetVersion(), len(file.GetGen2V2().GetActivities()))
+	}
+
+	newRawFile := func(records ...*vuv1.RawVehicleUnitFile_Record) *vuv1.RawVehicleUnitFile {
+		rawFile := &vuv1.RawVehicleUnitFile{}
+		rawFile.SetRecords(records)
+		return rawFile
+	}
+	newRecord := func(transferType vuv1.TransferType, value []byte) *vuv1.RawVehicleUnitFile_Record {
+		record := &vuv1.RawVehicleUnitFile_Record{}
+		record.SetType(transferType)
+		record.SetGeneration(ddv1.Generation_GENERATION_2)
+		record.SetValue(value)
+		return record
+	}
+	for _, tt := range []struct {
+		name    string
+		rawFile *vuv1.RawVehicleUnitFile
+		want    ddv1.Version
+	}{
+		{
+			name:    "shared transfers only",
+			rawFile: newRawFile(newRecord(vuv1.TransferType_DETAILED_SPEED_GEN2, nil)),
+			want:    ddv1.Version_VERSION_1,
+		},
+		{
+			name: "interface version with shared transfers",
+			rawFile: newRawFile(
+				newRecord(vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION, []byte{0x01, 0x01}),
+				newRecord(vuv1.TransferType_DETAILED_SPEED_GEN2, nil),
+			),
+			want: ddv1.Version_VERSION_2,
+		},
+		{
+			name:    "version 1 transfers",
+			rawFile: newRawFile(newRecord(vuv1.TransferType_ACTIVITIES_GEN2_V1, nil)),
+			want:    ddv1.Version_VERSION_1,
+		},
+	} {
+		if got := gen2Version(tt.rawFile); got != tt.want {
+			t.Errorf("%s: gen2Version() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEstimateSize(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/records/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			file := readRecordsAsVehicleUnitFile(t, dir)
+			// Anonymization clears raw_data, so that the transfers are encoded
+			// from their semantic fields.
+			anonymized, err := AnonymizeOptions{}.AnonymizeVehicleUnitFile(file)
+			if err != nil {
+				t.Fatalf("AnonymizeVehicleUnitFile failed: %v", err)
+			}
+			for name, file := range map[string]*vuv1.VehicleUnitFile{"parsed": file, "anonymized": anonymized} {
+				data, err := MarshalOptions{}.MarshalVehicleUnitFile(file)
+				if err != nil {
+					t.Fatalf("%s: MarshalVehicleUnitFile failed: %v", name, err)
+				}
+				size, err := MarshalOptions{}.EstimateSize(file)
+				if err != nil {
+					t.Fatalf("%s: EstimateSize failed: %v", name, err)
+				}
+				if size != len(data) {
+					t.Errorf("%s: EstimateSize = %d, want %d", name, size, len(data))
+				}
+			}
+		})
+	}
+}
+
+func TestEstimateSize_gen2(t *testing.T) {
+	activities := &vuv1.ActivitiesGen2V1{}
+	activities.SetDateOfDay(timestamppb.New(time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)))
+	activities.SetSignature([]byte{0x08, 0x00, 0x40, 0x00, 0x00}) // empty SignatureRecordArray
+	gen2v1 := &vuv1.VehicleUnitFileGen2V1{}
+	gen2v1.SetActivities([]*vuv1.ActivitiesGen2V1{activities})
+	file := &vuv1.VehicleUnitFile{}
+	file.SetGeneration(ddv1.Generation_GENERATION_2)
+	file.SetVersion(ddv1.Version_VERSION_1)
+	file.SetGen2V1(gen2v1)
+
+	data, err := MarshalOptions{}.MarshalVehicleUnitFile(file)
+	if err != nil {
+		t.Fatalf("MarshalVehicleUnitFile failed: %v", err)
+	}
+	size, err := MarshalOptions{}.EstimateSize(file)
+	if err != nil {
+		t.Fatalf("EstimateSize failed: %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("EstimateSize = %d, want %d", size, len(data))
+	}
+
+	// Overviews are only written from their raw data.
+	gen2v1.SetOverview(&vuv1.OverviewGen2V1{})
+	if _, err := (MarshalOptions{}).EstimateSize(file); err == nil {
+		t.Error("EstimateSize of an overview without raw_data: got no error")
+	}
+}
+
+// readRecordsAsVehicleUnitFile reassembles the transfers extracted from a VU
+// file into a parsed file, in their original order.
+func readRecordsAsVehicleUnitFile(t *testing.T, dir string) *vuv1.VehicleUnitFile {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.hexdump"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var data []byte
+	for _, path := range paths {
+		// Example: "001-ACTIVITIES_GEN1.hexdump"
+		_, name, _ := strings.Cut(strings.TrimSuffix(filepath.Base(path), ".hexdump"), "-")
+		tag, ok := TagForTransferType(vuv1.TransferType(vuv1.TransferType_value[name]))
+		if !ok {
+			t.Fatalf("no tag for transfer %s", name)
+		}
+		value, err := readHexdump(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = binary.BigEndian.AppendUint16(data, tag)
+		data = append(data, value...)
+	}
+	rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+	if err != nil {
+		t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+	}
+	file, err := ParseOptions{}.ParseRawVehicleUnitFile(rawFile)
+	if err != nil {
+		t.Fatalf("ParseRawVehicleUnitFile failed: %v", err)
+	}
+	return file
+}
+
+func TestParseRawVehicleUnitFile_MaxRecordsPerArrayTransfers(t *testing.T) {
+	// recordArray returns a RecordArray of n records of one byte.
+	recordArray := func(n int) []byte {
+		data := []byte{0x01, 0x00, 0x01, 0x00, byte(n)}
+		return append(data, make([]byte, n)...)
+	}
+	// transfer returns a transfer of arrays RecordArrays and a
+	// SignatureRecordArray, where the array at index large holds three
+	// records, and the other arrays none.
+	transfer := func(arrays, large int) []byte {
+		var value []byte
+		for i := 0; i <= arrays; i++ {
+			if i == large {
+				value = append(value, recordArray(3)...)
+			} else {
+				value = append(value, recordArray(0)...)
+			}
+		}
+		return value
+	}
+	for _, tt := range []struct {
+		name         string
+		transferType vuv1.TransferType
+		value        []byte
+	}{
+		{"overview", vuv1.TransferType_OVERVIEW_GEN2_V1, transfer(len(overviewGen2V1RecordArrays), 0)},
+		{"overview V2", vuv1.TransferType_OVERVIEW_GEN2_V2, transfer(len(overviewGen2V2RecordArrays), 6)},
+		{"events and faults", vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V1, transfer(len(eventsAndFaultsGen2V1RecordArrays), 4)},
+		{"events and faults V2", vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V2, transfer(len(eventsAndFaultsGen2V2RecordArrays), 5)},
+		{"detailed speed", vuv1.TransferType_DETAILED_SPEED_GEN2, transfer(len(detailedSpeedGen2RecordArrays), 0)},
+		{"technical data", vuv1.TransferType_TECHNICAL_DATA_GEN2_V1, transfer(len(technicalDataGen2RecordArrays), 6)},
+		{"technical data V2", vuv1.TransferType_TECHNICAL_DATA_GEN2_V2, transfer(len(technicalDataGen2RecordArrays), 0)},
+		{"signature", vuv1.TransferType_EVENTS_AND_FAULTS_GEN2_V1, transfer(len(eventsAndFaultsGen2V1RecordArrays), len(eventsAndFaultsGen2V1RecordArrays))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			record := &vuv1.RawVehicleUnitFile_Record{}
+			record.SetType(tt.transferType)
+			record.SetGeneration(ddv1.Generation_GENERATION_2)
+			record.SetValue(tt.value)
+			rawFile := &vuv1.RawVehicleUnitFile{}
+			rawFile.SetRecords([]*vuv1.RawVehicleUnitFile_Record{record})
+
+			if _, err := (ParseOptions{MaxRecordsPerArray: 3}).ParseRawVehicleUnitFile(rawFile); err != nil {
+				t.Errorf("ParseRawVehicleUnitFile(MaxRecordsPerArray: 3) failed: %v", err)
+			}
+			_, err := ParseOptions{MaxRecordsPerArray: 2}.ParseRawVehicleUnitFile(rawFile)
+			if err == nil || !strings.Contains(err.Error(), "more than the maximum of 2") {
+				t.Errorf("ParseRawVehicleUnitFile(MaxRecordsPerArray: 2): got error %v, want more than the maximum", err)
+			}
+		})
+	}
+}
+
+func TestParseRawVehicleUnitFile_MaxRecordsPerArrayParsedRecords(t *testing.T) {
+	// A Gen2 V1 overview whose VuCompanyLocksRecordArray declares three
+	// records, which are rejected before they are decoded.
+	companyLocks := slices.Index(overviewGen2V1RecordArrays, "VuCompanyLocksRecordArray")
+	var value []byte
+	for i := 0; i <= len(overviewGen2V1RecordArrays); i++ {
+		if i == companyLocks {
+			value = append(value, 0x01, 0x00, 0x01, 0x00, 0x03, 0x00, 0x00, 0x00)
+		} else {
+			value = append(value, 0x01, 0x00, 0x01, 0x00, 0x00)
+		}
+	}
+	record := &vuv1.RawVehicleUnitFile_Record{}
+	record.SetType(vuv1.TransferType_OVERVIEW_GEN2_V1)
+	record.SetGeneration(ddv1.Generation_GENERATION_2)
+	record.SetValue(value)
+	rawFile := &vuv1.RawVehicleUnitFile{}
+	rawFile.SetRecords([]*vuv1.RawVehicleUnitFile_Record{record})
+
+	_, err := ParseOptions{MaxRecordsPerArray: 2}.ParseRawVehicleUnitFile(rawFile)
+	if err == nil || !strings.Contains(err.Error(), "VuCompanyLocksRecord array declares 3 records") {
+		t.Errorf("ParseRawVehicleUnitFile(MaxRecordsPerArray: 2): got error %v, want VuCompanyLocksRecord array declares 3 records", err)
+	}
+}
@@ -0,0 +1,167 @@
+package vu
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/way-platform/tachograph-go/internal/cert"
+	"github.com/way-platform/tachograph-go/internal/dd"
+	"github.com/way-platform/tachograph-go/internal/security"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// VerifyOptions configures the verification of a vehicle unit file.
+type VerifyOptions struct {
+	// CertificateResolver is used to resolve the root certificates.
+	// If nil, this defaults to cert.DefaultResolver.
+	CertificateResolver cert.Resolver
+
+	// VerifyChain reports the verified links of the certificate chain of the
+	// VU certificate: VU certificate ← member state certificate ← European
+	// root certificate.
+	//
+	// The chain is always verified, since a data signature is only as
+	// trustworthy as the certificate it is verified against; VerifyChain only
+	// controls whether it is reported.
+	VerifyChain bool
+}
+
+// Verification is the result of verifying a vehicle unit file.
+type Verification struct {
+	// Chain lists the verified certificate links, starting from the VU
+	// certificate and ending at the root. It is empty unless VerifyChain is set.
+	Chain []*CertificateLink
+}
+
+// CertificateLink is a verified link in a certificate chain.
+type CertificateLink struct {
+	// Subject is the certificate holder reference (CHR) of the certificate.
+	Subject string
+
+	// Issuer is the certificate holder reference of the issuing certificate,
+	// or the key identifier of the root certificate.
+	Issuer string
+}
+
+// VerifyVehicleUnitFile verifies the data signatures of all transfers in a raw
+// vehicle unit file against the VU certificate of its overview transfer.
+//
+// Unlike AuthenticateRawVehicleUnitFile, this function does not mutate the
+// file; it returns an error for the first transfer or link that fails.
+// Transfers without a signature, such as the download interface version and
+// the empty transfers kept by non-strict unmarshalling, are skipped.
+//
+// See Appendix 11, Sections 6 and 13 for the complete specification.
+func (opts VerifyOptions) VerifyVehicleUnitFile(ctx context.Context, rawFile *vuv1.RawVehicleUnitFile) (*Verification, error) {
+	if rawFile == nil {
+		return nil, fmt.Errorf("rawFile cannot be nil")
+	}
+	if opts.CertificateResolver == nil {
+		opts.CertificateResolver = cert.DefaultResolver()
+	}
+	records := rawFile.GetRecords()
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no records to verify")
+	}
+
+	authOpts := AuthenticateOptions{CertificateResolver: opts.CertificateResolver}
+	verification := &Verification{}
+	switch generation := records[0].GetGeneration(); generation {
+	case ddv1.Generation_GENERATION_1:
+		overviewRecord := authOpts.findOverviewRecord(records)
+		if overviewRecord == nil {
+			return nil, fmt.Errorf("Overview record not found for verification")
+		}
+		vuCert, mscaCert, err := authOpts.extractGen1Certificates(overviewRecord)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract Gen1 certificates: %w", err)
+		}
+		chain, err := opts.verifyGen1Chain(ctx, vuCert, mscaCert)
+		if err != nil {
+			return nil, err
+		}
+		if opts.VerifyChain {
+			verification.Chain = chain
+		}
+		for _, record := range records {
+			if !isSignedTransfer(record) {
+				continue
+			}
+			if err := authOpts.verifyGen1DataSignature(record, vuCert, &securityv1.Authentication{}); err != nil {
+				return nil, fmt.Errorf("%v: %w", record.GetType(), err)
+			}
+		}
+	case ddv1.Generation_GENERATION_2:
+		overviewRecord := authOpts.findGen2OverviewRecord(records)
+		if overviewRecord == nil {
+			return nil, fmt.Errorf("Gen2 Overview record not found for verification")
+		}
+		vuCert, mscaCert, err := authOpts.extractGen2Certificates(overviewRecord)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract Gen2 certificates: %w", err)
+		}
+		chain, err := opts.verifyGen2Chain(ctx, vuCert, mscaCert)
+		if err != nil {
+			return nil, err
+		}
+		if opts.VerifyChain {
+			verification.Chain = chain
+		}
+		for _, record := range records {
+			if !isSignedTransfer(record) {
+				continue
+			}
+			if err := authOpts.verifyGen2DataSignature(record, vuCert, &securityv1.Authentication{}); err != nil {
+				return nil, fmt.Errorf("%v: %w", record.GetType(), err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: %v", dd.ErrUnsupportedGeneration, generation)
+	}
+	return verification, nil
+}
+
+// isSignedTransfer reports whether a transfer carries a data signature. The
+// download interface version and card downloads are not signed, and neither
+// are empty transfers.
+func isSignedTransfer(record *vuv1.RawVehicleUnitFile_Record) bool {
+	return record.GetSignatureSize() > 0
+}
+
+// verifyGen1Chain verifies the Gen1 certificate chain: EUR Root -> MSCA -> VU.
+func (opts VerifyOptions) verifyGen1Chain(ctx context.Context, vuCert, mscaCert *securityv1.RsaCertificate) ([]*CertificateLink, error) {
+	rootCert, err := opts.CertificateResolver.GetRootCertificate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root certificate: %w", err)
+	}
+	if err := security.VerifyRsaCertificateWithRoot(mscaCert, rootCert); err != nil {
+		return nil, fmt.Errorf("MSCA certificate verification failed: %w", err)
+	}
+	if err := security.VerifyRsaCertificateWithCA(vuCert, mscaCert); err != nil {
+		return nil, fmt.Errorf("VU certificate verification failed: %w", err)
+	}
+	return []*CertificateLink{
+		{Subject: vuCert.GetCertificateHolderReference(), Issuer: mscaCert.GetCertificateHolderReference()},
+		{Subject: mscaCert.GetCertificateHolderReference(), Issuer: rootCert.GetKeyId()},
+	}, nil
+}
+
+// verifyGen2Chain verifies the Gen2 certificate chain: EUR Root (ECC) -> MSCA (ECC) -> VU (ECC).
+func (opts VerifyOptions) verifyGen2Chain(ctx context.Context, vuCert, mscaCert *securityv1.EccCertificate) ([]*CertificateLink, error) {
+	rootCert, err := opts.CertificateResolver.GetEccRootCertificate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Gen2 root certificate: %w", err)
+	}
+	if err := security.VerifyEccCertificateWithEccRoot(mscaCert, rootCert); err != nil {
+		return nil, fmt.Errorf("MSCA certificate verification failed: %w", err)
+	}
+	if err := security.VerifyEccCertificateWithCA(vuCert, mscaCert); err != nil {
+		return nil, fmt.Errorf("VU certificate verification failed: %w", err)
+	}
+	return []*CertificateLink{
+		{Subject: vuCert.GetCertificateHolderReference(), Issuer: mscaCert.GetCertificateHolderReference()},
+		{Subject: mscaCert.GetCertificateHolderReference(), Issuer: rootCert.GetCertificateHolderReference()},
+	}, nil
+}
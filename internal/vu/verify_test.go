@@ -0,0 +1,322 @@
+package vu
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	securityv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestVerifyVehicleUnitFile_Gen1Chain(t *testing.T) {
+	const (
+		rootKeyID = 0x0101010101010101
+		mscaCHR   = 0x0202020202020202
+		vuCHR     = 0x0303030303030303
+	)
+	rootKey := generateRsaKey(t)
+	mscaKey := generateRsaKey(t)
+	vuKey := generateRsaKey(t)
+
+	root := &securityv1.RootCertificate{}
+	root.SetKeyId("72340172838076673")
+	root.SetRsaModulus(rootKey.N.Bytes())
+	root.SetRsaExponent(big.NewInt(int64(rootKey.E)).FillBytes(make([]byte, 8)))
+	resolver := &rootResolver{root: root}
+
+	mscaCert := signRsaCertificate(rootKey, rootKeyID, mscaCHR, &mscaKey.PublicKey)
+	brokenMscaCert := append([]byte{}, mscaCert...)
+	brokenMscaCert[100] ^= 0xFF // corrupt the signature
+	vuCert := signRsaCertificate(mscaKey, mscaCHR, vuCHR, &vuKey.PublicKey)
+
+	hexdumpFiles, err := findHexdumpFiles(vuv1.TransferType_OVERVIEW_GEN1)
+	if err != nil || len(hexdumpFiles) == 0 {
+		t.Fatalf("Failed to find OVERVIEW_GEN1 hexdump files: %v", err)
+	}
+	overview, err := readHexdump(hexdumpFiles[0])
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		mscaCert  []byte
+		wantChain []*CertificateLink
+		wantErr   bool
+	}{
+		{
+			name:     "valid chain",
+			mscaCert: mscaCert,
+			wantChain: []*CertificateLink{
+				{Subject: "217020518514230019", Issuer: "144680345676153346"},
+				{Subject: "144680345676153346", Issuer: "72340172838076673"},
+			},
+		},
+		{
+			name:     "broken member state link",
+			mscaCert: brokenMscaCert,
+			wantErr:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// Replace the anonymized certificates and re-sign the overview.
+			value := append([]byte{}, overview...)
+			copy(value[0:194], tt.mscaCert)
+			copy(value[194:388], vuCert)
+			dataEnd := len(value) - 128
+			hash := sha1.Sum(value[388:dataEnd])
+			signature, err := rsa.SignPKCS1v15(nil, vuKey, crypto.SHA1, hash[:])
+			if err != nil {
+				t.Fatalf("SignPKCS1v15 failed: %v", err)
+			}
+			copy(value[dataEnd:], signature)
+
+			data := appendTransfer(nil, vuv1.TransferType_OVERVIEW_GEN1, value)
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+			if err != nil {
+				t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+			}
+
+			opts := VerifyOptions{CertificateResolver: resolver, VerifyChain: true}
+			verification, err := opts.VerifyVehicleUnitFile(context.Background(), rawFile)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("VerifyVehicleUnitFile succeeded, want error")
+				}
+				if _, err := (VerifyOptions{CertificateResolver: resolver}).VerifyVehicleUnitFile(context.Background(), rawFile); err == nil {
+					t.Fatal("VerifyVehicleUnitFile without VerifyChain succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyVehicleUnitFile failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantChain, verification.Chain); diff != "" {
+				t.Errorf("Chain mismatch (-want +got):\n%s", diff)
+			}
+
+			verification, err = VerifyOptions{CertificateResolver: resolver}.VerifyVehicleUnitFile(context.Background(), rawFile)
+			if err != nil {
+				t.Fatalf("VerifyVehicleUnitFile without VerifyChain failed: %v", err)
+			}
+			if len(verification.Chain) != 0 {
+				t.Errorf("Chain = %v, want empty without VerifyChain", verification.Chain)
+			}
+		})
+	}
+}
+
+func TestVerifyVehicleUnitFile_Gen2Chain(t *testing.T) {
+	const (
+		rootCHR = 0x0101010101010101
+		mscaCHR = 0x0202020202020202
+		vuCHR   = 0x0303030303030303
+	)
+	rootKey := generateEccKey(t)
+	mscaKey := generateEccKey(t)
+	vuKey := generateEccKey(t)
+
+	rootPublicKey := &securityv1.EccCertificate_PublicKey{}
+	rootPublicKey.SetDomainParametersOid("1.2.840.10045.3.1.7")
+	rootPublicKey.SetPublicPointX(rootKey.X.FillBytes(make([]byte, 32)))
+	rootPublicKey.SetPublicPointY(rootKey.Y.FillBytes(make([]byte, 32)))
+	root := &securityv1.EccCertificate{}
+	root.SetCertificateHolderReference("72340172838076673")
+	root.SetPublicKey(rootPublicKey)
+	resolver := &rootResolver{eccRoot: root}
+
+	mscaCert := signEccCertificate(t, rootKey, rootCHR, mscaCHR, &mscaKey.PublicKey)
+	brokenMscaCert := append([]byte{}, mscaCert...)
+	brokenMscaCert[len(brokenMscaCert)-1] ^= 0xFF // corrupt the signature
+	vuCert := signEccCertificate(t, mscaKey, mscaCHR, vuCHR, &vuKey.PublicKey)
+
+	for _, tt := range []struct {
+		name      string
+		mscaCert  []byte
+		wantChain []*CertificateLink
+		wantErr   bool
+	}{
+		{
+			name:     "valid chain",
+			mscaCert: mscaCert,
+			wantChain: []*CertificateLink{
+				{Subject: "217020518514230019", Issuer: "144680345676153346"},
+				{Subject: "144680345676153346", Issuer: "72340172838076673"},
+			},
+		},
+		{
+			name:     "broken member state link",
+			mscaCert: brokenMscaCert,
+			wantErr:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// A Gen2 V2 overview holding the certificates, signed by the VU.
+			var value []byte
+			for _, name := range overviewGen2V2RecordArrays {
+				switch name {
+				case "MemberStateCertificateRecordArray":
+					value = appendRecordArrayHeader(value, 0x00, uint16(len(tt.mscaCert)), 1)
+					value = append(value, tt.mscaCert...)
+				case "VUCertificateRecordArray":
+					value = appendRecordArrayHeader(value, 0x00, uint16(len(vuCert)), 1)
+					value = append(value, vuCert...)
+				default:
+					value = appendRecordArrayHeader(value, 0x00, 0, 0)
+				}
+			}
+			signature := signEcc(t, vuKey, value)
+			value = appendRecordArrayHeader(value, 0x08, uint16(len(signature)), 1)
+			value = append(value, signature...)
+
+			// The download interface version and the trailing empty transfer
+			// are not signed.
+			data := appendTransfer(nil, vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION, []byte{0x01, 0x01})
+			data = appendTransfer(data, vuv1.TransferType_OVERVIEW_GEN2_V2, value)
+			data = appendTransfer(data, vuv1.TransferType_ACTIVITIES_GEN2_V2, nil)
+			rawFile, err := UnmarshalOptions{}.UnmarshalRawVehicleUnitFile(data)
+			if err != nil {
+				t.Fatalf("UnmarshalRawVehicleUnitFile failed: %v", err)
+			}
+			if got := len(rawFile.GetRecords()); got != 3 {
+				t.Fatalf("got %d records, want 3", got)
+			}
+
+			opts := VerifyOptions{CertificateResolver: resolver, VerifyChain: true}
+			verification, err := opts.VerifyVehicleUnitFile(context.Background(), rawFile)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("VerifyVehicleUnitFile succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyVehicleUnitFile failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantChain, verification.Chain); diff != "" {
+				t.Errorf("Chain mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// rootResolver resolves fixed Gen1 and Gen2 root certificates.
+type rootResolver struct {
+	root    *securityv1.RootCertificate
+	eccRoot *securityv1.EccCertificate
+}
+
+func (r *rootResolver) GetRootCertificate(context.Context) (*securityv1.RootCertificate, error) {
+	return r.root, nil
+}
+
+func (r *rootResolver) GetEccRootCertificate(context.Context) (*securityv1.EccCertificate, error) {
+	return r.eccRoot, nil
+}
+
+func (r *rootResolver) GetRsaCertificate(context.Context, string) (*securityv1.RsaCertificate, error) {
+	return nil, nil
+}
+
+func (r *rootResolver) GetEccCertificate(context.Context, string) (*securityv1.EccCertificate, error) {
+	return nil, nil
+}
+
+func generateRsaKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	return key
+}
+
+// signRsaCertificate issues a 194-byte Gen1 certificate with ISO/IEC 9796-2
+// message recovery, as specified in Appendix 11, Section 3.3.
+func signRsaCertificate(caKey *rsa.PrivateKey, car, chr uint64, pub *rsa.PublicKey) []byte {
+	// C = CPI || CAR || CHA || EOV || CHR || n || e
+	content := []byte{0x01}
+	content = binary.BigEndian.AppendUint64(content, car)
+	content = append(content, make([]byte, 7)...)     // CHA
+	content = append(content, 0xFF, 0xFF, 0xFF, 0xFF) // EOV
+	content = binary.BigEndian.AppendUint64(content, chr)
+	content = append(content, pub.N.FillBytes(make([]byte, 128))...)
+	content = append(content, big.NewInt(int64(pub.E)).FillBytes(make([]byte, 8))...)
+
+	hash := sha1.Sum(content)
+	message := []byte{0x6A}
+	message = append(message, content[:106]...)
+	message = append(message, hash[:]...)
+	message = append(message, 0xBC)
+	sr := new(big.Int).Exp(new(big.Int).SetBytes(message), caKey.D, caKey.N)
+
+	certificate := sr.FillBytes(make([]byte, 128))
+	certificate = append(certificate, content[106:]...)
+	return binary.BigEndian.AppendUint64(certificate, car)
+}
+
+func generateEccKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	return key
+}
+
+// signEcc returns the plain (r || s) ECDSA signature of data with SHA-256.
+func signEcc(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	t.Helper()
+	hash := sha256.Sum256(data)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+}
+
+// signEccCertificate issues a Gen2 certificate for a NIST P-256 key, as
+// specified in Appendix 11, Section 9.3.2.
+func signEccCertificate(t *testing.T, caKey *ecdsa.PrivateKey, car, chr uint64, pub *ecdsa.PublicKey) []byte {
+	t.Helper()
+	tlv := func(tag []byte, content ...[]byte) []byte {
+		var value []byte
+		for _, c := range content {
+			value = append(value, c...)
+		}
+		result := append([]byte{}, tag...)
+		if len(value) >= 0x80 {
+			result = append(result, 0x81)
+		}
+		result = append(result, byte(len(value)))
+		return append(result, value...)
+	}
+	oid, err := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	if err != nil {
+		t.Fatalf("Marshal OID failed: %v", err)
+	}
+	point := append([]byte{0x04}, pub.X.FillBytes(make([]byte, 32))...)
+	point = append(point, pub.Y.FillBytes(make([]byte, 32))...)
+	body := tlv([]byte{0x7F, 0x4E},
+		tlv([]byte{0x5F, 0x29}, []byte{0x00}),                            // CPI
+		tlv([]byte{0x42}, binary.BigEndian.AppendUint64(nil, car)),       // CAR
+		tlv([]byte{0x5F, 0x4C}, make([]byte, 7)),                         // CHA
+		tlv([]byte{0x7F, 0x49}, oid, tlv([]byte{0x86}, point)),           // PublicKey
+		tlv([]byte{0x5F, 0x20}, binary.BigEndian.AppendUint64(nil, chr)), // CHR
+		tlv([]byte{0x5F, 0x25}, []byte{0x60, 0x00, 0x00, 0x00}),          // CEfD
+		tlv([]byte{0x5F, 0x24}, []byte{0x70, 0x00, 0x00, 0x00}),          // CExD
+	)
+	return tlv([]byte{0x7F, 0x21}, body, tlv([]byte{0x5F, 0x37}, signEcc(t, caKey, body)))
+}
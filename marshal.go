@@ -3,10 +3,14 @@ package tachograph
 import (
 	"fmt"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/way-platform/tachograph-go/internal/card"
 	"github.com/way-platform/tachograph-go/internal/dd"
 	"github.com/way-platform/tachograph-go/internal/vu"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
 	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
 // Marshal serializes a parsed tachograph file into binary format with default options.
@@ -33,11 +37,19 @@ type MarshalOptions struct {
 	// If true (default), the marshaler will use the raw_data fields when
 	// available, applying the "raw data painting" strategy to ensure perfect
 	// binary round-tripping while validating semantic field correctness.
+	// Transfers that cannot be painted field by field, such as Gen2 VU
+	// activities, are emitted verbatim from raw_data.
 	//
-	// If false, the marshaler will always encode from semantic fields,
-	// ignoring any raw_data fields. This is useful when semantic fields
-	// have been modified and you want to generate new binary data.
+	// If false, the marshaler encodes from semantic fields, so that edits to
+	// them take effect, and raw_data is only used as a canvas for bytes that
+	// are not represented semantically. Re-encoded transfers no longer match
+	// their original signatures.
 	UseRawData bool
+
+	// VerifyPaint re-parses VU transfers that were painted over a raw_data
+	// canvas and returns an error if they do not decode to the semantic fields
+	// of the input message.
+	VerifyPaint bool
 }
 
 // Marshal serializes a parsed tachograph file into its binary representation.
@@ -47,20 +59,96 @@ type MarshalOptions struct {
 func (o MarshalOptions) Marshal(file *tachographv1.File) ([]byte, error) {
 	switch file.GetType() {
 	case tachographv1.File_DRIVER_CARD:
-		cardOpts := card.MarshalOptions{
-			MarshalOptions: dd.MarshalOptions{
-				UseRawData: o.UseRawData,
-			},
-		}
-		return cardOpts.MarshalDriverCardFile(file.GetDriverCard())
+		return o.card().MarshalDriverCardFile(file.GetDriverCard())
 	case tachographv1.File_VEHICLE_UNIT:
-		vuOpts := vu.MarshalOptions{
-			MarshalOptions: dd.MarshalOptions{
-				UseRawData: o.UseRawData,
-			},
-		}
-		return vuOpts.MarshalVehicleUnitFile(file.GetVehicleUnit())
+		return o.vu().MarshalVehicleUnitFile(file.GetVehicleUnit())
 	default:
 		return nil, fmt.Errorf("unsupported file type for marshaling: %v", file.GetType())
 	}
 }
+
+// EstimateSize returns the length of the output of Marshal for file, so that
+// callers can size their buffers before marshaling.
+//
+// The size is computed from the record counts and fixed record lengths of the
+// file, without encoding it. It is exact for files that Marshal can encode.
+func (o MarshalOptions) EstimateSize(file *tachographv1.File) (int, error) {
+	switch file.GetType() {
+	case tachographv1.File_DRIVER_CARD:
+		return o.card().EstimateSize(file.GetDriverCard())
+	case tachographv1.File_VEHICLE_UNIT:
+		return o.vu().EstimateSize(file.GetVehicleUnit())
+	default:
+		return 0, fmt.Errorf("unsupported file type for marshaling: %v", file.GetType())
+	}
+}
+
+// MarshalFile serializes a tachograph file message into binary format with
+// default options. See MarshalOptions.MarshalFile.
+func MarshalFile(file proto.Message) ([]byte, error) {
+	opts := MarshalOptions{
+		UseRawData: true,
+	}
+	return opts.MarshalFile(file)
+}
+
+// MarshalFile serializes a tachograph file message into its binary (.DDD)
+// representation.
+//
+// Parsed messages (File, DriverCardFile and VehicleUnitFile) are encoded from
+// their semantic fields, and raw messages (RawFile, RawCardFile and
+// RawVehicleUnitFile) from their TLV or TV records. This allows a file that
+// was edited in its protojson representation to be serialized again:
+//
+//	var file vuv1.VehicleUnitFile
+//	if err := protojson.Unmarshal(data, &file); err != nil {
+//		return err
+//	}
+//	ddd, err := tachograph.MarshalOptions{UseRawData: false}.MarshalFile(&file)
+//
+// When editing fields of a parsed message that also carries raw_data, leave
+// UseRawData unset, or remove the raw_data fields, so that the edits take effect.
+func (o MarshalOptions) MarshalFile(file proto.Message) ([]byte, error) {
+	switch file := file.(type) {
+	case *tachographv1.File:
+		return o.Marshal(file)
+	case *cardv1.DriverCardFile:
+		return o.card().MarshalDriverCardFile(file)
+	case *vuv1.VehicleUnitFile:
+		return o.vu().MarshalVehicleUnitFile(file)
+	case *tachographv1.RawFile:
+		switch file.GetType() {
+		case tachographv1.RawFile_CARD:
+			return o.card().MarshalRawCardFile(file.GetCard())
+		case tachographv1.RawFile_VEHICLE_UNIT:
+			return o.vu().MarshalRawVehicleUnitFile(file.GetVehicleUnit())
+		default:
+			return nil, fmt.Errorf("unsupported raw file type for marshaling: %v", file.GetType())
+		}
+	case *cardv1.RawCardFile:
+		return o.card().MarshalRawCardFile(file)
+	case *vuv1.RawVehicleUnitFile:
+		return o.vu().MarshalRawVehicleUnitFile(file)
+	default:
+		return nil, fmt.Errorf("unsupported message for marshaling: %T", file)
+	}
+}
+
+// card returns card.MarshalOptions configured from MarshalOptions.
+func (o MarshalOptions) card() card.MarshalOptions {
+	return card.MarshalOptions{
+		MarshalOptions: dd.MarshalOptions{
+			UseRawData: o.UseRawData,
+		},
+	}
+}
+
+// vu returns vu.MarshalOptions configured from MarshalOptions.
+func (o MarshalOptions) vu() vu.MarshalOptions {
+	return vu.MarshalOptions{
+		MarshalOptions: dd.MarshalOptions{
+			UseRawData: o.UseRawData,
+		},
+		VerifyPaint: o.VerifyPaint,
+	}
+}
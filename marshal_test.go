@@ -0,0 +1,97 @@
+package tachograph
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestMarshalFile_protojson(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	data := append(binary.BigEndian.AppendUint16(nil, 0x7601), value...)
+
+	rawFile, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	file, err := Parse(rawFile)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	// Round-trip the parsed vehicle unit file through protojson, as when
+	// editing a file by hand.
+	jsonData, err := protojson.Marshal(file.GetVehicleUnit())
+	if err != nil {
+		t.Fatalf("protojson.Marshal() error = %v", err)
+	}
+	var vehicleUnit vuv1.VehicleUnitFile
+	if err := protojson.Unmarshal(jsonData, &vehicleUnit); err != nil {
+		t.Fatalf("protojson.Unmarshal() error = %v", err)
+	}
+	got, err := MarshalFile(&vehicleUnit)
+	if err != nil {
+		t.Fatalf("MarshalFile(VehicleUnitFile) error = %v", err)
+	}
+	if diff := cmp.Diff(data, got); diff != "" {
+		t.Errorf("MarshalFile(VehicleUnitFile) mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = MarshalFile(rawFile)
+	if err != nil {
+		t.Fatalf("MarshalFile(RawFile) error = %v", err)
+	}
+	if diff := cmp.Diff(data, got); diff != "" {
+		t.Errorf("MarshalFile(RawFile) mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := MarshalFile(&vuv1.OverviewGen1{}); err == nil {
+		t.Error("MarshalFile(OverviewGen1) succeeded, want error")
+	}
+}
+
+func TestEstimateSize(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	data := append(binary.BigEndian.AppendUint16(nil, 0x7601), value...)
+	rawFile, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	file, err := Parse(rawFile)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	size, err := MarshalOptions{UseRawData: true}.EstimateSize(file)
+	if err != nil {
+		t.Fatalf("EstimateSize() error = %v", err)
+	}
+	if size != len(data) {
+		t.Errorf("EstimateSize() = %d, want %d", size, len(data))
+	}
+
+	if _, err := (MarshalOptions{}).EstimateSize(&tachographv1.File{}); err == nil {
+		t.Error("EstimateSize() of a file without type succeeded, want error")
+	}
+}
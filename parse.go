@@ -1,6 +1,7 @@
 package tachograph
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/way-platform/tachograph-go/internal/card"
@@ -21,10 +22,16 @@ import (
 //	opts := ParseOptions{PreserveRawData: false}
 //	file, err := opts.Parse(rawFile)
 func Parse(rawFile *tachographv1.RawFile) (*tachographv1.File, error) {
+	return ParseContext(context.Background(), rawFile)
+}
+
+// ParseContext is like Parse, but stops parsing with the context's error when
+// ctx is done.
+func ParseContext(ctx context.Context, rawFile *tachographv1.RawFile) (*tachographv1.File, error) {
 	opts := ParseOptions{
 		PreserveRawData: true,
 	}
-	return opts.Parse(rawFile)
+	return opts.ParseContext(ctx, rawFile)
 }
 
 // ParseOptions configures the parsing process for converting raw tachograph
@@ -40,19 +47,76 @@ type ParseOptions struct {
 	// If false, raw_data fields will be left empty, reducing memory usage
 	// but preventing exact binary reconstruction.
 	PreserveRawData bool
+
+	// LenientRecordSizes controls how the parser handles record arrays of
+	// vehicle unit files whose declared record size differs from the
+	// specification.
+	//
+	// If false (default), such arrays are rejected with an error.
+	// If true, the parser recovers what it can and reports the mismatches
+	// in the parse warnings of the parsed vehicle unit file.
+	LenientRecordSizes bool
+
+	// MaxRecordsPerArray limits the number of records a record array of a
+	// vehicle unit file may declare, guarding against large allocations when
+	// parsing untrusted input. Zero (default) means no limit beyond the size
+	// of the file; see vu.ParseOptions.MaxRecordsPerArray.
+	MaxRecordsPerArray int
+
+	// LenientSignatureOrder controls how the parser handles signature records
+	// of card files that do not directly follow the data record of their EF.
+	//
+	// If false (default), such signatures are rejected with an error naming
+	// the EF and generation. If true, they are attached to the data record of
+	// the same EF and generation regardless of their position, and dropped if
+	// there is none. Some card readers emit records in a nonstandard order.
+	LenientSignatureOrder bool
+
+	// KeepUnknownEFs controls how the parser handles records of card files
+	// whose EF is not recognized, such as manufacturer proprietary EFs.
+	//
+	// If false (default), such records are dropped. If true, they are kept
+	// in the unknown_records field of the parsed card file and written back
+	// on Unparse, preserving byte fidelity of the round trip.
+	KeepUnknownEFs bool
+
+	// OnUnrecognized, if set, is called for each enum value in the file that
+	// has no matching value in the Data Dictionary, such as a slot card type
+	// emitted by a newer vehicle unit.
+	//
+	// The context names the data element holding the value, such as
+	// "CardSlotsStatus.driver", and rawValue is the value as read. The callback
+	// does not affect parsing, so it is suitable for logging values that are
+	// parsed as UNRECOGNIZED.
+	OnUnrecognized func(context string, rawValue uint64)
+
+	// StrictEnums controls how the parser handles enum values outside the
+	// domain defined by the Data Dictionary.
+	//
+	// If false (default), such values are parsed as UNRECOGNIZED, keeping the
+	// raw value where the message has a field for it. If true, parsing fails
+	// with an error naming the first such value, which is intended for
+	// conformance testing. OnUnrecognized is called for each value either way.
+	StrictEnums bool
 }
 
 // card returns card.ParseOptions configured from ParseOptions.
 func (o ParseOptions) card() card.ParseOptions {
 	return card.ParseOptions{
-		PreserveRawData: o.PreserveRawData,
+		PreserveRawData:       o.PreserveRawData,
+		LenientSignatureOrder: o.LenientSignatureOrder,
+		KeepUnknownEFs:        o.KeepUnknownEFs,
+		OnUnrecognized:        o.OnUnrecognized,
 	}
 }
 
 // vu returns vu.ParseOptions configured from ParseOptions.
 func (o ParseOptions) vu() vu.ParseOptions {
 	return vu.ParseOptions{
-		PreserveRawData: o.PreserveRawData,
+		PreserveRawData:    o.PreserveRawData,
+		LenientRecordSizes: o.LenientRecordSizes,
+		MaxRecordsPerArray: o.MaxRecordsPerArray,
+		OnUnrecognized:     o.OnUnrecognized,
 	}
 }
 
@@ -61,25 +125,40 @@ func (o ParseOptions) vu() vu.ParseOptions {
 // AuthenticateOptions.Authenticate), the authentication results are propagated
 // to the parsed messages.
 func (o ParseOptions) Parse(rawFile *tachographv1.RawFile) (*tachographv1.File, error) {
+	return o.ParseContext(context.Background(), rawFile)
+}
+
+// ParseContext is like Parse, but stops parsing with the context's error when
+// ctx is done. Cancellation is checked between the transfers of a vehicle unit
+// file and between the EFs of a card file, so a long parse can be aborted, for
+// example when the client of a request goes away.
+func (o ParseOptions) ParseContext(ctx context.Context, rawFile *tachographv1.RawFile) (*tachographv1.File, error) {
+	if o.StrictEnums {
+		return o.parseStrictEnums(ctx, rawFile)
+	}
+
 	var file tachographv1.File
 
 	switch rawFile.GetType() {
 	case tachographv1.RawFile_CARD:
-		cardType := card.InferFileType(rawFile.GetCard())
+		cardType, details := card.InferFileType(rawFile.GetCard())
 		switch cardType {
 		case cardv1.CardType_DRIVER_CARD:
-			driverCard, err := o.card().ParseRawDriverCardFile(rawFile.GetCard())
+			driverCard, err := o.card().ParseRawDriverCardFileContext(ctx, rawFile.GetCard())
 			if err != nil {
 				return nil, fmt.Errorf("failed to parse driver card: %w", err)
 			}
 			file.SetType(tachographv1.File_DRIVER_CARD)
 			file.SetDriverCard(driverCard)
 		default:
-			return nil, fmt.Errorf("unsupported card type: %v", cardType)
+			if details.VehicleUnit {
+				return nil, fmt.Errorf("unsupported card type: %v (data looks like a vehicle unit download)", cardType)
+			}
+			return nil, fmt.Errorf("unsupported card type: %v (candidates %v, application card type %v)", cardType, details.Candidates, details.ApplicationCardType)
 		}
 
 	case tachographv1.RawFile_VEHICLE_UNIT:
-		vuFile, err := o.vu().ParseRawVehicleUnitFile(rawFile.GetVehicleUnit())
+		vuFile, err := o.vu().ParseRawVehicleUnitFileContext(ctx, rawFile.GetVehicleUnit())
 		if err != nil {
 			return nil, err
 		}
@@ -92,3 +171,27 @@ func (o ParseOptions) Parse(rawFile *tachographv1.RawFile) (*tachographv1.File,
 
 	return &file, nil
 }
+
+// parseStrictEnums parses a raw file, failing if it holds any enum value
+// reported to OnUnrecognized.
+func (o ParseOptions) parseStrictEnums(ctx context.Context, rawFile *tachographv1.RawFile) (*tachographv1.File, error) {
+	var unrecognized error
+	onUnrecognized := o.OnUnrecognized
+	o.OnUnrecognized = func(context string, rawValue uint64) {
+		if unrecognized == nil {
+			unrecognized = fmt.Errorf("value %d of %s is outside the domain of the Data Dictionary", rawValue, context)
+		}
+		if onUnrecognized != nil {
+			onUnrecognized(context, rawValue)
+		}
+	}
+	o.StrictEnums = false
+	file, err := o.ParseContext(ctx, rawFile)
+	if err != nil {
+		return nil, err
+	}
+	if unrecognized != nil {
+		return nil, fmt.Errorf("strict enums: %w", unrecognized)
+	}
+	return file, nil
+}
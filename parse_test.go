@@ -0,0 +1,49 @@
+package tachograph
+
+import (
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+)
+
+func TestParseOptions_strictEnums(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	// Set the driver slot of CardSlotsStatus, which follows the certificates,
+	// VIN, VRN and times, to a value not in SlotCardType.
+	const idxCardSlotsStatus = 2*194 + 17 + 15 + 4 + 8
+	value[idxCardSlotsStatus] = value[idxCardSlotsStatus]&0xF0 | 0x07
+	data := append(binary.BigEndian.AppendUint16(nil, 0x7601), value...)
+
+	rawFile, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if _, err := (ParseOptions{}).Parse(rawFile); err != nil {
+		t.Errorf("Parse() error = %v, want lenient parse", err)
+	}
+
+	var reported []string
+	opts := ParseOptions{
+		StrictEnums: true,
+		OnUnrecognized: func(context string, rawValue uint64) {
+			reported = append(reported, context)
+		},
+	}
+	_, err = opts.Parse(rawFile)
+	if err == nil || !strings.Contains(err.Error(), "CardSlotsStatus.driver") {
+		t.Errorf("Parse() with StrictEnums error = %v, want error naming CardSlotsStatus.driver", err)
+	}
+	if len(reported) != 1 {
+		t.Errorf("OnUnrecognized called for %v, want CardSlotsStatus.driver only", reported)
+	}
+}
@@ -0,0 +1,87 @@
+package tachograph
+
+import (
+	"context"
+
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+)
+
+// ParserOptions configures a Parser.
+type ParserOptions struct {
+	// UnmarshalOptions configures the unmarshaling of files.
+	UnmarshalOptions UnmarshalOptions
+
+	// Authenticate controls whether files are authenticated between
+	// unmarshaling and parsing, propagating the authentication results to the
+	// parsed messages. If true, files that fail authentication are rejected
+	// with the error of AuthenticateOptions.Authenticate.
+	Authenticate bool
+
+	// CertificateResolver is used to resolve CA certificates when
+	// Authenticate is set. If nil, DefaultCertificateResolver is used.
+	CertificateResolver CertificateResolver
+
+	// ParseOptions configures the parsing of files.
+	ParseOptions ParseOptions
+}
+
+// Parser unmarshals, optionally authenticates, and parses tachograph files
+// with a fixed configuration.
+//
+// A Parser is meant to be created once and reused across files by servers
+// parsing many of them: its options are resolved once, and the decompression
+// state of compressed input is pooled between files. The parsed files never
+// share memory with the pooled state.
+//
+// A Parser is safe for concurrent use by multiple goroutines.
+type Parser struct {
+	unmarshal    UnmarshalOptions
+	authenticate *AuthenticateOptions
+	parse        ParseOptions
+	decompressor decompressor
+}
+
+// NewParser creates a new [Parser].
+func NewParser(opts ParserOptions) *Parser {
+	p := &Parser{
+		unmarshal: opts.UnmarshalOptions,
+		parse:     opts.ParseOptions,
+	}
+	if opts.Authenticate {
+		resolver := opts.CertificateResolver
+		if resolver == nil {
+			resolver = DefaultCertificateResolver()
+		}
+		// The raw file is owned by the parser, so it is authenticated in place.
+		p.authenticate = &AuthenticateOptions{
+			CertificateResolver: resolver,
+			Mutate:              true,
+		}
+	}
+	return p
+}
+
+// Parse unmarshals, optionally authenticates, and parses a .DDD file.
+//
+// It is equivalent to calling UnmarshalOptions.Unmarshal,
+// AuthenticateOptions.Authenticate and ParseOptions.ParseContext in turn.
+func (p *Parser) Parse(ctx context.Context, data []byte) (*tachographv1.File, error) {
+	unmarshalOpts := p.unmarshal
+	if unmarshalOpts.Decompress {
+		var err error
+		if data, err = p.decompressor.decompress(data); err != nil {
+			return nil, err
+		}
+		unmarshalOpts.Decompress = false
+	}
+	rawFile, err := unmarshalOpts.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if p.authenticate != nil {
+		if rawFile, err = p.authenticate.Authenticate(ctx, rawFile); err != nil {
+			return nil, err
+		}
+	}
+	return p.parse.ParseContext(ctx, rawFile)
+}
@@ -0,0 +1,116 @@
+package tachograph
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+)
+
+// readOverviewFile returns a vehicle unit file holding the anonymized Gen1
+// overview test record.
+func readOverviewFile(t testing.TB) []byte {
+	t.Helper()
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	return append([]byte{0x76, 0x01}, value...)
+}
+
+func TestParser(t *testing.T) {
+	data := readOverviewFile(t)
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	if _, err := gw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	w, err := zw.Create("download.DDD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	rawFile, err := UnmarshalOptions{Strict: true}.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	want, err := ParseOptions{PreserveRawData: true}.Parse(rawFile)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	parser := NewParser(ParserOptions{
+		UnmarshalOptions: UnmarshalOptions{Strict: true, Decompress: true},
+		ParseOptions:     ParseOptions{PreserveRawData: true},
+	})
+	inputs := [][]byte{data, gzipped.Bytes(), zipped.Bytes()}
+	var wg sync.WaitGroup
+	for range 4 {
+		for _, input := range inputs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				got, err := parser.Parse(context.Background(), input)
+				if err != nil {
+					t.Errorf("Parse failed: %v", err)
+					return
+				}
+				if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("Parse mismatch (-want +got):\n%s", diff)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	if _, err := parser.Parse(context.Background(), []byte{0x00}); err == nil {
+		t.Error("Parse of truncated data succeeded, want error")
+	}
+}
+
+func BenchmarkParser(b *testing.B) {
+	data := readOverviewFile(b)
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	if _, err := gw.Write(data); err != nil {
+		b.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		b.Fatal(err)
+	}
+	parser := NewParser(ParserOptions{
+		UnmarshalOptions: UnmarshalOptions{Strict: true, Decompress: true},
+	})
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := parser.Parse(context.Background(), gzipped.Bytes()); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
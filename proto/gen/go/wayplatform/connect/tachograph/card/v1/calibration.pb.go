@@ -43,6 +43,7 @@ type Calibration struct {
 	xxx_hidden_CalibrationTotalCount int32                  `protobuf:"varint,1,opt,name=calibration_total_count,json=calibrationTotalCount"`
 	xxx_hidden_NewestRecordIndex     int32                  `protobuf:"varint,2,opt,name=newest_record_index,json=newestRecordIndex"`
 	xxx_hidden_Records               *[]*Calibration_Record `protobuf:"bytes,3,rep,name=records"`
+	xxx_hidden_RawData               []byte                 `protobuf:"bytes,99,opt,name=raw_data,json=rawData"`
 	XXX_raceDetectHookData           protoimpl.RaceDetectHookData
 	XXX_presence                     [1]uint32
 	unknownFields                    protoimpl.UnknownFields
@@ -97,20 +98,35 @@ func (x *Calibration) GetRecords() []*Calibration_Record {
 	return nil
 }
 
+func (x *Calibration) GetRawData() []byte {
+	if x != nil {
+		return x.xxx_hidden_RawData
+	}
+	return nil
+}
+
 func (x *Calibration) SetCalibrationTotalCount(v int32) {
 	x.xxx_hidden_CalibrationTotalCount = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
 func (x *Calibration) SetNewestRecordIndex(v int32) {
 	x.xxx_hidden_NewestRecordIndex = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
 func (x *Calibration) SetRecords(v []*Calibration_Record) {
 	x.xxx_hidden_Records = &v
 }
 
+func (x *Calibration) SetRawData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_RawData = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
 func (x *Calibration) HasCalibrationTotalCount() bool {
 	if x == nil {
 		return false
@@ -125,6 +141,13 @@ func (x *Calibration) HasNewestRecordIndex() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
+func (x *Calibration) HasRawData() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
 func (x *Calibration) ClearCalibrationTotalCount() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_CalibrationTotalCount = 0
@@ -135,6 +158,11 @@ func (x *Calibration) ClearNewestRecordIndex() {
 	x.xxx_hidden_NewestRecordIndex = 0
 }
 
+func (x *Calibration) ClearRawData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_RawData = nil
+}
+
 type Calibration_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -144,7 +172,7 @@ type Calibration_builder struct {
 	// See Data Dictionary, Section 2.235.
 	// ASN.1 Specification:
 	//
-	//	INTEGER(0..NoOfCalibrationRecords)
+	//     INTEGER(0..NoOfCalibrationRecords)
 	CalibrationTotalCount *int32
 	// Index of the last updated record.
 	// Corresponds to `calibrationPointerNewestRecord`.
@@ -152,11 +180,15 @@ type Calibration_builder struct {
 	// See Data Dictionary, Section 2.235.
 	// ASN.1 Specification:
 	//
-	//	INTEGER(0..NoOfCalibrationRecords-1)
+	//     INTEGER(0..NoOfCalibrationRecords-1)
 	NewestRecordIndex *int32
 	// The set of calibration records.
 	// Corresponds to `calibrationRecords`.
+	//
+	// Unused record slots are included as invalid records.
 	Records []*Calibration_Record
+	// The raw binary data of the EF, for round-trip fidelity.
+	RawData []byte
 }
 
 func (b0 Calibration_builder) Build() *Calibration {
@@ -164,14 +196,18 @@ func (b0 Calibration_builder) Build() *Calibration {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.CalibrationTotalCount != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_CalibrationTotalCount = *b.CalibrationTotalCount
 	}
 	if b.NewestRecordIndex != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_NewestRecordIndex = *b.NewestRecordIndex
 	}
 	x.xxx_hidden_Records = &b.Records
+	if b.RawData != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_RawData = b.RawData
+	}
 	return m0
 }
 
@@ -476,24 +512,27 @@ func (b0 Calibration_SealDataCard_builder) Build() *Calibration_SealDataCard {
 type Calibration_Record struct {
 	state                                     protoimpl.MessageState                `protogen:"opaque.v1"`
 	xxx_hidden_CalibrationPurpose             v1.CalibrationPurpose                 `protobuf:"varint,1,opt,name=calibration_purpose,json=calibrationPurpose,enum=wayplatform.connect.tachograph.dd.v1.CalibrationPurpose"`
-	xxx_hidden_VehicleIdentificationNumber    *v1.StringValue                       `protobuf:"bytes,3,opt,name=vehicle_identification_number,json=vehicleIdentificationNumber"`
+	xxx_hidden_UnrecognizedCalibrationPurpose int32                                 `protobuf:"varint,2,opt,name=unrecognized_calibration_purpose,json=unrecognizedCalibrationPurpose"`
+	xxx_hidden_VehicleIdentificationNumber    *v1.Ia5StringValue                    `protobuf:"bytes,23,opt,name=vehicle_identification_number,json=vehicleIdentificationNumber"`
 	xxx_hidden_VehicleRegistration            *v1.VehicleRegistrationIdentification `protobuf:"bytes,4,opt,name=vehicle_registration,json=vehicleRegistration"`
 	xxx_hidden_WVehicleCharacteristicConstant int32                                 `protobuf:"varint,5,opt,name=w_vehicle_characteristic_constant,json=wVehicleCharacteristicConstant"`
 	xxx_hidden_KConstantOfRecordingEquipment  int32                                 `protobuf:"varint,6,opt,name=k_constant_of_recording_equipment,json=kConstantOfRecordingEquipment"`
 	xxx_hidden_LTyreCircumferenceEighthsMm    int32                                 `protobuf:"varint,7,opt,name=l_tyre_circumference_eighths_mm,json=lTyreCircumferenceEighthsMm"`
-	xxx_hidden_TyreSize                       *v1.StringValue                       `protobuf:"bytes,8,opt,name=tyre_size,json=tyreSize"`
+	xxx_hidden_TyreSize                       *v1.Ia5StringValue                    `protobuf:"bytes,24,opt,name=tyre_size,json=tyreSize"`
 	xxx_hidden_AuthorisedSpeedKmh             int32                                 `protobuf:"varint,9,opt,name=authorised_speed_kmh,json=authorisedSpeedKmh"`
 	xxx_hidden_OldOdometerKm                  int32                                 `protobuf:"varint,10,opt,name=old_odometer_km,json=oldOdometerKm"`
 	xxx_hidden_NewOdometerKm                  int32                                 `protobuf:"varint,11,opt,name=new_odometer_km,json=newOdometerKm"`
 	xxx_hidden_OldTime                        *timestamppb.Timestamp                `protobuf:"bytes,12,opt,name=old_time,json=oldTime"`
 	xxx_hidden_NewTime                        *timestamppb.Timestamp                `protobuf:"bytes,13,opt,name=new_time,json=newTime"`
 	xxx_hidden_NextCalibrationDate            *timestamppb.Timestamp                `protobuf:"bytes,14,opt,name=next_calibration_date,json=nextCalibrationDate"`
-	xxx_hidden_VuPartNumber                   *v1.StringValue                       `protobuf:"bytes,15,opt,name=vu_part_number,json=vuPartNumber"`
+	xxx_hidden_VuPartNumber                   *v1.Ia5StringValue                    `protobuf:"bytes,25,opt,name=vu_part_number,json=vuPartNumber"`
 	xxx_hidden_VuSerialNumber                 *v1.ExtendedSerialNumber              `protobuf:"bytes,16,opt,name=vu_serial_number,json=vuSerialNumber"`
 	xxx_hidden_SensorSerialNumber             *v1.ExtendedSerialNumber              `protobuf:"bytes,17,opt,name=sensor_serial_number,json=sensorSerialNumber"`
 	xxx_hidden_SensorGnssSerialNumber         *v1.ExtendedSerialNumber              `protobuf:"bytes,18,opt,name=sensor_gnss_serial_number,json=sensorGnssSerialNumber"`
 	xxx_hidden_RcmSerialNumber                *v1.ExtendedSerialNumber              `protobuf:"bytes,19,opt,name=rcm_serial_number,json=rcmSerialNumber"`
 	xxx_hidden_SealDataCard                   *Calibration_SealDataCard             `protobuf:"bytes,20,opt,name=seal_data_card,json=sealDataCard"`
+	xxx_hidden_RawData                        []byte                                `protobuf:"bytes,21,opt,name=raw_data,json=rawData"`
+	xxx_hidden_Valid                          bool                                  `protobuf:"varint,22,opt,name=valid"`
 	XXX_raceDetectHookData                    protoimpl.RaceDetectHookData
 	XXX_presence                              [1]uint32
 	unknownFields                             protoimpl.UnknownFields
@@ -534,7 +573,14 @@ func (x *Calibration_Record) GetCalibrationPurpose() v1.CalibrationPurpose {
 	return v1.CalibrationPurpose(0)
 }
 
-func (x *Calibration_Record) GetVehicleIdentificationNumber() *v1.StringValue {
+func (x *Calibration_Record) GetUnrecognizedCalibrationPurpose() int32 {
+	if x != nil {
+		return x.xxx_hidden_UnrecognizedCalibrationPurpose
+	}
+	return 0
+}
+
+func (x *Calibration_Record) GetVehicleIdentificationNumber() *v1.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_VehicleIdentificationNumber
 	}
@@ -569,7 +615,7 @@ func (x *Calibration_Record) GetLTyreCircumferenceEighthsMm() int32 {
 	return 0
 }
 
-func (x *Calibration_Record) GetTyreSize() *v1.StringValue {
+func (x *Calibration_Record) GetTyreSize() *v1.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_TyreSize
 	}
@@ -618,7 +664,7 @@ func (x *Calibration_Record) GetNextCalibrationDate() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *Calibration_Record) GetVuPartNumber() *v1.StringValue {
+func (x *Calibration_Record) GetVuPartNumber() *v1.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_VuPartNumber
 	}
@@ -660,12 +706,31 @@ func (x *Calibration_Record) GetSealDataCard() *Calibration_SealDataCard {
 	return nil
 }
 
+func (x *Calibration_Record) GetRawData() []byte {
+	if x != nil {
+		return x.xxx_hidden_RawData
+	}
+	return nil
+}
+
+func (x *Calibration_Record) GetValid() bool {
+	if x != nil {
+		return x.xxx_hidden_Valid
+	}
+	return false
+}
+
 func (x *Calibration_Record) SetCalibrationPurpose(v v1.CalibrationPurpose) {
 	x.xxx_hidden_CalibrationPurpose = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 22)
 }
 
-func (x *Calibration_Record) SetVehicleIdentificationNumber(v *v1.StringValue) {
+func (x *Calibration_Record) SetUnrecognizedCalibrationPurpose(v int32) {
+	x.xxx_hidden_UnrecognizedCalibrationPurpose = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 22)
+}
+
+func (x *Calibration_Record) SetVehicleIdentificationNumber(v *v1.Ia5StringValue) {
 	x.xxx_hidden_VehicleIdentificationNumber = v
 }
 
@@ -675,36 +740,36 @@ func (x *Calibration_Record) SetVehicleRegistration(v *v1.VehicleRegistrationIde
 
 func (x *Calibration_Record) SetWVehicleCharacteristicConstant(v int32) {
 	x.xxx_hidden_WVehicleCharacteristicConstant = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 22)
 }
 
 func (x *Calibration_Record) SetKConstantOfRecordingEquipment(v int32) {
 	x.xxx_hidden_KConstantOfRecordingEquipment = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 22)
 }
 
 func (x *Calibration_Record) SetLTyreCircumferenceEighthsMm(v int32) {
 	x.xxx_hidden_LTyreCircumferenceEighthsMm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 22)
 }
 
-func (x *Calibration_Record) SetTyreSize(v *v1.StringValue) {
+func (x *Calibration_Record) SetTyreSize(v *v1.Ia5StringValue) {
 	x.xxx_hidden_TyreSize = v
 }
 
 func (x *Calibration_Record) SetAuthorisedSpeedKmh(v int32) {
 	x.xxx_hidden_AuthorisedSpeedKmh = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 22)
 }
 
 func (x *Calibration_Record) SetOldOdometerKm(v int32) {
 	x.xxx_hidden_OldOdometerKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 22)
 }
 
 func (x *Calibration_Record) SetNewOdometerKm(v int32) {
 	x.xxx_hidden_NewOdometerKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 19)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 22)
 }
 
 func (x *Calibration_Record) SetOldTime(v *timestamppb.Timestamp) {
@@ -719,7 +784,7 @@ func (x *Calibration_Record) SetNextCalibrationDate(v *timestamppb.Timestamp) {
 	x.xxx_hidden_NextCalibrationDate = v
 }
 
-func (x *Calibration_Record) SetVuPartNumber(v *v1.StringValue) {
+func (x *Calibration_Record) SetVuPartNumber(v *v1.Ia5StringValue) {
 	x.xxx_hidden_VuPartNumber = v
 }
 
@@ -743,6 +808,19 @@ func (x *Calibration_Record) SetSealDataCard(v *Calibration_SealDataCard) {
 	x.xxx_hidden_SealDataCard = v
 }
 
+func (x *Calibration_Record) SetRawData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_RawData = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 20, 22)
+}
+
+func (x *Calibration_Record) SetValid(v bool) {
+	x.xxx_hidden_Valid = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 21, 22)
+}
+
 func (x *Calibration_Record) HasCalibrationPurpose() bool {
 	if x == nil {
 		return false
@@ -750,6 +828,13 @@ func (x *Calibration_Record) HasCalibrationPurpose() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
+func (x *Calibration_Record) HasUnrecognizedCalibrationPurpose() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
 func (x *Calibration_Record) HasVehicleIdentificationNumber() bool {
 	if x == nil {
 		return false
@@ -768,21 +853,21 @@ func (x *Calibration_Record) HasWVehicleCharacteristicConstant() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
 func (x *Calibration_Record) HasKConstantOfRecordingEquipment() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
 }
 
 func (x *Calibration_Record) HasLTyreCircumferenceEighthsMm() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
 func (x *Calibration_Record) HasTyreSize() bool {
@@ -796,21 +881,21 @@ func (x *Calibration_Record) HasAuthorisedSpeedKmh() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
 }
 
 func (x *Calibration_Record) HasOldOdometerKm() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
 }
 
 func (x *Calibration_Record) HasNewOdometerKm() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
 }
 
 func (x *Calibration_Record) HasOldTime() bool {
@@ -876,11 +961,30 @@ func (x *Calibration_Record) HasSealDataCard() bool {
 	return x.xxx_hidden_SealDataCard != nil
 }
 
+func (x *Calibration_Record) HasRawData() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 20)
+}
+
+func (x *Calibration_Record) HasValid() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 21)
+}
+
 func (x *Calibration_Record) ClearCalibrationPurpose() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_CalibrationPurpose = v1.CalibrationPurpose_CALIBRATION_PURPOSE_UNSPECIFIED
 }
 
+func (x *Calibration_Record) ClearUnrecognizedCalibrationPurpose() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UnrecognizedCalibrationPurpose = 0
+}
+
 func (x *Calibration_Record) ClearVehicleIdentificationNumber() {
 	x.xxx_hidden_VehicleIdentificationNumber = nil
 }
@@ -890,17 +994,17 @@ func (x *Calibration_Record) ClearVehicleRegistration() {
 }
 
 func (x *Calibration_Record) ClearWVehicleCharacteristicConstant() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
 	x.xxx_hidden_WVehicleCharacteristicConstant = 0
 }
 
 func (x *Calibration_Record) ClearKConstantOfRecordingEquipment() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
 	x.xxx_hidden_KConstantOfRecordingEquipment = 0
 }
 
 func (x *Calibration_Record) ClearLTyreCircumferenceEighthsMm() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
 	x.xxx_hidden_LTyreCircumferenceEighthsMm = 0
 }
 
@@ -909,17 +1013,17 @@ func (x *Calibration_Record) ClearTyreSize() {
 }
 
 func (x *Calibration_Record) ClearAuthorisedSpeedKmh() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
 	x.xxx_hidden_AuthorisedSpeedKmh = 0
 }
 
 func (x *Calibration_Record) ClearOldOdometerKm() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
 	x.xxx_hidden_OldOdometerKm = 0
 }
 
 func (x *Calibration_Record) ClearNewOdometerKm() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
 	x.xxx_hidden_NewOdometerKm = 0
 }
 
@@ -959,6 +1063,16 @@ func (x *Calibration_Record) ClearSealDataCard() {
 	x.xxx_hidden_SealDataCard = nil
 }
 
+func (x *Calibration_Record) ClearRawData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 20)
+	x.xxx_hidden_RawData = nil
+}
+
+func (x *Calibration_Record) ClearValid() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 21)
+	x.xxx_hidden_Valid = false
+}
+
 type Calibration_Record_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -967,112 +1081,114 @@ type Calibration_Record_builder struct {
 	// See Data Dictionary, Section 2.8, `CalibrationPurpose`.
 	// ASN.1 Specification:
 	//
-	//	CalibrationPurpose ::= OCTET STRING (SIZE(1))
+	//     CalibrationPurpose ::= OCTET STRING (SIZE(1))
 	CalibrationPurpose *v1.CalibrationPurpose
+	// Stores the raw protocol value if it's not recognized in the CalibrationPurpose enum.
+	UnrecognizedCalibrationPurpose *int32
 	// The Vehicle Identification Number.
 	//
 	// See Data Dictionary, Section 2.164, `VehicleIdentificationNumber`.
 	// ASN.1 Definition:
 	//
-	//	VehicleIdentificationNumber ::= IA5String(SIZE(17))
-	VehicleIdentificationNumber *v1.StringValue
+	//     VehicleIdentificationNumber ::= IA5String(SIZE(17))
+	VehicleIdentificationNumber *v1.Ia5StringValue
 	// The vehicle registration identifier.
 	//
 	// See Data Dictionary, Section 2.166, `VehicleRegistrationIdentification`.
 	// ASN.1 Definition:
 	//
-	//	VehicleRegistrationIdentification ::= SEQUENCE { ... }
+	//     VehicleRegistrationIdentification ::= SEQUENCE { ... }
 	VehicleRegistration *v1.VehicleRegistrationIdentification
 	// The vehicle characteristic constant.
 	//
 	// See Data Dictionary, Section 2.239, `W-VehicleCharacteristicConstant`.
 	// ASN.1 Definition:
 	//
-	//	W-VehicleCharacteristicConstant ::= INTEGER(0..65535)
+	//     W-VehicleCharacteristicConstant ::= INTEGER(0..65535)
 	WVehicleCharacteristicConstant *int32
 	// The constant of the recording equipment.
 	//
 	// See Data Dictionary, Section 2.85, `K-ConstantOfRecordingEquipment`.
 	// ASN.1 Definition:
 	//
-	//	K-ConstantOfRecordingEquipment ::= INTEGER(0..65535)
+	//     K-ConstantOfRecordingEquipment ::= INTEGER(0..65535)
 	KConstantOfRecordingEquipment *int32
 	// The tyre circumference in 1/8ths of a mm.
 	//
 	// See Data Dictionary, Section 2.91, `L-TyreCircumference`.
 	// ASN.1 Definition:
 	//
-	//	L-TyreCircumference ::= INTEGER(0..65535)
+	//     L-TyreCircumference ::= INTEGER(0..65535)
 	LTyreCircumferenceEighthsMm *int32
 	// The tyre size designation.
 	//
 	// See Data Dictionary, Section 2.163, `TyreSize`.
 	// ASN.1 Definition:
 	//
-	//	TyreSize ::= IA5String(SIZE(15))
-	TyreSize *v1.StringValue
+	//     TyreSize ::= IA5String(SIZE(15))
+	TyreSize *v1.Ia5StringValue
 	// The authorised speed in km/h.
 	//
 	// See Data Dictionary, Section 2.156, `SpeedAuthorised`.
 	// ASN.1 Definition:
 	//
-	//	SpeedAuthorised ::= INTEGER(0..255)
+	//     SpeedAuthorised ::= INTEGER(0..255)
 	AuthorisedSpeedKmh *int32
 	// The odometer value before calibration in km.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	OldOdometerKm *int32
 	// The odometer value after calibration in km.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	NewOdometerKm *int32
 	// The time value before calibration.
 	//
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	OldTime *timestamppb.Timestamp
 	// The time value after calibration.
 	//
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	NewTime *timestamppb.Timestamp
 	// The date of the next calibration.
 	//
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	NextCalibrationDate *timestamppb.Timestamp
 	// The part number of the Vehicle Unit.
 	//
 	// See Data Dictionary, Section 2.217, `VuPartNumber`.
 	// ASN.1 Definition:
 	//
-	//	VuPartNumber ::= IA5String(SIZE(16))
-	VuPartNumber *v1.StringValue
+	//     VuPartNumber ::= IA5String(SIZE(16))
+	VuPartNumber *v1.Ia5StringValue
 	// The serial number of the Vehicle Unit.
 	//
 	// See Data Dictionary, Section 2.72, `ExtendedSerialNumber`.
 	// ASN.1 Specification:
 	//
-	//	ExtendedSerialNumber ::= SEQUENCE { ... }
+	//     ExtendedSerialNumber ::= SEQUENCE { ... }
 	VuSerialNumber *v1.ExtendedSerialNumber
 	// The serial number of the motion sensor.
 	//
 	// See Data Dictionary, Section 2.148, `SensorSerialNumber`.
 	// ASN.1 Specification:
 	//
-	//	SensorSerialNumber ::= ExtendedSerialNumber
+	//     SensorSerialNumber ::= ExtendedSerialNumber
 	SensorSerialNumber *v1.ExtendedSerialNumber
 	// The serial number of the external GNSS facility.
 	// See Data Dictionary, Section 2.139, `SensorGNSSSerialNumber`.
@@ -1083,6 +1199,13 @@ type Calibration_Record_builder struct {
 	// Information about seals attached to vehicle components.
 	// See Data Dictionary, Section 2.128, `SealDataCard`.
 	SealDataCard *Calibration_SealDataCard
+	// The raw bytes of the record, set for unused slots.
+	RawData []byte
+	// Indicates whether this record holds a calibration.
+	//
+	// When false: The record is an unused slot of the cyclic buffer of
+	// EF_Calibration, filled with '00'H or 'FF'H, and only raw_data is set.
+	Valid *bool
 }
 
 func (b0 Calibration_Record_builder) Build() *Calibration_Record {
@@ -1090,34 +1213,38 @@ func (b0 Calibration_Record_builder) Build() *Calibration_Record {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.CalibrationPurpose != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 22)
 		x.xxx_hidden_CalibrationPurpose = *b.CalibrationPurpose
 	}
+	if b.UnrecognizedCalibrationPurpose != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 22)
+		x.xxx_hidden_UnrecognizedCalibrationPurpose = *b.UnrecognizedCalibrationPurpose
+	}
 	x.xxx_hidden_VehicleIdentificationNumber = b.VehicleIdentificationNumber
 	x.xxx_hidden_VehicleRegistration = b.VehicleRegistration
 	if b.WVehicleCharacteristicConstant != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 22)
 		x.xxx_hidden_WVehicleCharacteristicConstant = *b.WVehicleCharacteristicConstant
 	}
 	if b.KConstantOfRecordingEquipment != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 22)
 		x.xxx_hidden_KConstantOfRecordingEquipment = *b.KConstantOfRecordingEquipment
 	}
 	if b.LTyreCircumferenceEighthsMm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 22)
 		x.xxx_hidden_LTyreCircumferenceEighthsMm = *b.LTyreCircumferenceEighthsMm
 	}
 	x.xxx_hidden_TyreSize = b.TyreSize
 	if b.AuthorisedSpeedKmh != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 22)
 		x.xxx_hidden_AuthorisedSpeedKmh = *b.AuthorisedSpeedKmh
 	}
 	if b.OldOdometerKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 22)
 		x.xxx_hidden_OldOdometerKm = *b.OldOdometerKm
 	}
 	if b.NewOdometerKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 19)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 22)
 		x.xxx_hidden_NewOdometerKm = *b.NewOdometerKm
 	}
 	x.xxx_hidden_OldTime = b.OldTime
@@ -1129,6 +1256,14 @@ func (b0 Calibration_Record_builder) Build() *Calibration_Record {
 	x.xxx_hidden_SensorGnssSerialNumber = b.SensorGnssSerialNumber
 	x.xxx_hidden_RcmSerialNumber = b.RcmSerialNumber
 	x.xxx_hidden_SealDataCard = b.SealDataCard
+	if b.RawData != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 20, 22)
+		x.xxx_hidden_RawData = b.RawData
+	}
+	if b.Valid != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 21, 22)
+		x.xxx_hidden_Valid = *b.Valid
+	}
 	return m0
 }
 
@@ -1136,11 +1271,12 @@ var File_wayplatform_connect_tachograph_card_v1_calibration_proto protoreflect.F
 
 const file_wayplatform_connect_tachograph_card_v1_calibration_proto_rawDesc = "" +
 	"\n" +
-	"8wayplatform/connect/tachograph/card/v1/calibration.proto\x12&wayplatform.connect.tachograph.card.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a>wayplatform/connect/tachograph/dd/v1/calibration_purpose.proto\x1a9wayplatform/connect/tachograph/dd/v1/equipment_type.proto\x1aAwayplatform/connect/tachograph/dd/v1/extended_serial_number.proto\x1a7wayplatform/connect/tachograph/dd/v1/string_value.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\"\xcf\x12\n" +
+	"8wayplatform/connect/tachograph/card/v1/calibration.proto\x12&wayplatform.connect.tachograph.card.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a>wayplatform/connect/tachograph/dd/v1/calibration_purpose.proto\x1a9wayplatform/connect/tachograph/dd/v1/equipment_type.proto\x1aAwayplatform/connect/tachograph/dd/v1/extended_serial_number.proto\x1a;wayplatform/connect/tachograph/dd/v1/ia5_string_value.proto\x1a7wayplatform/connect/tachograph/dd/v1/string_value.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\"\x80\x14\n" +
 	"\vCalibration\x126\n" +
 	"\x17calibration_total_count\x18\x01 \x01(\x05R\x15calibrationTotalCount\x12.\n" +
 	"\x13newest_record_index\x18\x02 \x01(\x05R\x11newestRecordIndex\x12T\n" +
-	"\arecords\x18\x03 \x03(\v2:.wayplatform.connect.tachograph.card.v1.Calibration.RecordR\arecords\x1a\xd4\x01\n" +
+	"\arecords\x18\x03 \x03(\v2:.wayplatform.connect.tachograph.card.v1.Calibration.RecordR\arecords\x12\x19\n" +
+	"\braw_data\x18c \x01(\fR\arawData\x1a\xd4\x01\n" +
 	"\x16ExtendedSealIdentifier\x12^\n" +
 	"\x11manufacturer_code\x18\x01 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x10manufacturerCode\x12Z\n" +
 	"\x0fseal_identifier\x18\x02 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0esealIdentifier\x1a\xef\x01\n" +
@@ -1149,28 +1285,31 @@ const file_wayplatform_connect_tachograph_card_v1_calibration_proto_rawDesc = ""
 	"\x0eequipment_type\x18\x01 \x01(\x0e23.wayplatform.connect.tachograph.dd.v1.EquipmentTypeR\requipmentType\x12\x84\x01\n" +
 	"\x18extended_seal_identifier\x18\x02 \x01(\v2J.wayplatform.connect.tachograph.card.v1.Calibration.ExtendedSealIdentifierR\x16extendedSealIdentifier\x1aq\n" +
 	"\fSealDataCard\x12a\n" +
-	"\fseal_records\x18\x01 \x03(\v2>.wayplatform.connect.tachograph.card.v1.Calibration.SealRecordR\vsealRecords\x1a\xc5\f\n" +
+	"\fseal_records\x18\x01 \x03(\v2>.wayplatform.connect.tachograph.card.v1.Calibration.SealRecordR\vsealRecords\x1a\xdb\r\n" +
 	"\x06Record\x12i\n" +
-	"\x13calibration_purpose\x18\x01 \x01(\x0e28.wayplatform.connect.tachograph.dd.v1.CalibrationPurposeR\x12calibrationPurpose\x12u\n" +
-	"\x1dvehicle_identification_number\x18\x03 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x1bvehicleIdentificationNumber\x12z\n" +
+	"\x13calibration_purpose\x18\x01 \x01(\x0e28.wayplatform.connect.tachograph.dd.v1.CalibrationPurposeR\x12calibrationPurpose\x12H\n" +
+	" unrecognized_calibration_purpose\x18\x02 \x01(\x05R\x1eunrecognizedCalibrationPurpose\x12x\n" +
+	"\x1dvehicle_identification_number\x18\x17 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\x1bvehicleIdentificationNumber\x12z\n" +
 	"\x14vehicle_registration\x18\x04 \x01(\v2G.wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentificationR\x13vehicleRegistration\x12I\n" +
 	"!w_vehicle_characteristic_constant\x18\x05 \x01(\x05R\x1ewVehicleCharacteristicConstant\x12H\n" +
 	"!k_constant_of_recording_equipment\x18\x06 \x01(\x05R\x1dkConstantOfRecordingEquipment\x12D\n" +
-	"\x1fl_tyre_circumference_eighths_mm\x18\a \x01(\x05R\x1blTyreCircumferenceEighthsMm\x12N\n" +
-	"\ttyre_size\x18\b \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\btyreSize\x120\n" +
+	"\x1fl_tyre_circumference_eighths_mm\x18\a \x01(\x05R\x1blTyreCircumferenceEighthsMm\x12Q\n" +
+	"\ttyre_size\x18\x18 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\btyreSize\x120\n" +
 	"\x14authorised_speed_kmh\x18\t \x01(\x05R\x12authorisedSpeedKmh\x12&\n" +
 	"\x0fold_odometer_km\x18\n" +
 	" \x01(\x05R\roldOdometerKm\x12&\n" +
 	"\x0fnew_odometer_km\x18\v \x01(\x05R\rnewOdometerKm\x125\n" +
 	"\bold_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\aoldTime\x125\n" +
 	"\bnew_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\anewTime\x12N\n" +
-	"\x15next_calibration_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x13nextCalibrationDate\x12W\n" +
-	"\x0evu_part_number\x18\x0f \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\fvuPartNumber\x12d\n" +
+	"\x15next_calibration_date\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x13nextCalibrationDate\x12Z\n" +
+	"\x0evu_part_number\x18\x19 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\fvuPartNumber\x12d\n" +
 	"\x10vu_serial_number\x18\x10 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\x0evuSerialNumber\x12l\n" +
 	"\x14sensor_serial_number\x18\x11 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\x12sensorSerialNumber\x12u\n" +
 	"\x19sensor_gnss_serial_number\x18\x12 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\x16sensorGnssSerialNumber\x12f\n" +
 	"\x11rcm_serial_number\x18\x13 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\x0frcmSerialNumber\x12f\n" +
-	"\x0eseal_data_card\x18\x14 \x01(\v2@.wayplatform.connect.tachograph.card.v1.Calibration.SealDataCardR\fsealDataCardB\xdd\x02\n" +
+	"\x0eseal_data_card\x18\x14 \x01(\v2@.wayplatform.connect.tachograph.card.v1.Calibration.SealDataCardR\fsealDataCard\x12\x19\n" +
+	"\braw_data\x18\x15 \x01(\fR\arawData\x12\x14\n" +
+	"\x05valid\x18\x16 \x01(\bR\x05validJ\x04\b\x03\x10\x04J\x04\b\b\x10\tJ\x04\b\x0f\x10\x10B\xdd\x02\n" +
 	"*com.wayplatform.connect.tachograph.card.v1B\x10CalibrationProtoP\x01Z`github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1;cardv1\xa2\x02\x04WCTC\xaa\x02&Wayplatform.Connect.Tachograph.Card.V1\xca\x02&Wayplatform\\Connect\\Tachograph\\Card\\V1\xe2\x022Wayplatform\\Connect\\Tachograph\\Card\\V1\\GPBMetadata\xea\x02*Wayplatform::Connect::Tachograph::Card::V1b\beditionsp\xe8\a"
 
 var file_wayplatform_connect_tachograph_card_v1_calibration_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
@@ -1183,9 +1322,10 @@ var file_wayplatform_connect_tachograph_card_v1_calibration_proto_goTypes = []an
 	(*v1.StringValue)(nil),                       // 5: wayplatform.connect.tachograph.dd.v1.StringValue
 	(v1.EquipmentType)(0),                        // 6: wayplatform.connect.tachograph.dd.v1.EquipmentType
 	(v1.CalibrationPurpose)(0),                   // 7: wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
-	(*v1.VehicleRegistrationIdentification)(nil), // 8: wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
-	(*timestamppb.Timestamp)(nil),                // 9: google.protobuf.Timestamp
-	(*v1.ExtendedSerialNumber)(nil),              // 10: wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
+	(*v1.Ia5StringValue)(nil),                    // 8: wayplatform.connect.tachograph.dd.v1.Ia5StringValue
+	(*v1.VehicleRegistrationIdentification)(nil), // 9: wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
+	(*timestamppb.Timestamp)(nil),                // 10: google.protobuf.Timestamp
+	(*v1.ExtendedSerialNumber)(nil),              // 11: wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
 }
 var file_wayplatform_connect_tachograph_card_v1_calibration_proto_depIdxs = []int32{
 	4,  // 0: wayplatform.connect.tachograph.card.v1.Calibration.records:type_name -> wayplatform.connect.tachograph.card.v1.Calibration.Record
@@ -1195,17 +1335,17 @@ var file_wayplatform_connect_tachograph_card_v1_calibration_proto_depIdxs = []in
 	1,  // 4: wayplatform.connect.tachograph.card.v1.Calibration.SealRecord.extended_seal_identifier:type_name -> wayplatform.connect.tachograph.card.v1.Calibration.ExtendedSealIdentifier
 	2,  // 5: wayplatform.connect.tachograph.card.v1.Calibration.SealDataCard.seal_records:type_name -> wayplatform.connect.tachograph.card.v1.Calibration.SealRecord
 	7,  // 6: wayplatform.connect.tachograph.card.v1.Calibration.Record.calibration_purpose:type_name -> wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
-	5,  // 7: wayplatform.connect.tachograph.card.v1.Calibration.Record.vehicle_identification_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	8,  // 8: wayplatform.connect.tachograph.card.v1.Calibration.Record.vehicle_registration:type_name -> wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
-	5,  // 9: wayplatform.connect.tachograph.card.v1.Calibration.Record.tyre_size:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	9,  // 10: wayplatform.connect.tachograph.card.v1.Calibration.Record.old_time:type_name -> google.protobuf.Timestamp
-	9,  // 11: wayplatform.connect.tachograph.card.v1.Calibration.Record.new_time:type_name -> google.protobuf.Timestamp
-	9,  // 12: wayplatform.connect.tachograph.card.v1.Calibration.Record.next_calibration_date:type_name -> google.protobuf.Timestamp
-	5,  // 13: wayplatform.connect.tachograph.card.v1.Calibration.Record.vu_part_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	10, // 14: wayplatform.connect.tachograph.card.v1.Calibration.Record.vu_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	10, // 15: wayplatform.connect.tachograph.card.v1.Calibration.Record.sensor_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	10, // 16: wayplatform.connect.tachograph.card.v1.Calibration.Record.sensor_gnss_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	10, // 17: wayplatform.connect.tachograph.card.v1.Calibration.Record.rcm_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
+	8,  // 7: wayplatform.connect.tachograph.card.v1.Calibration.Record.vehicle_identification_number:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
+	9,  // 8: wayplatform.connect.tachograph.card.v1.Calibration.Record.vehicle_registration:type_name -> wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
+	8,  // 9: wayplatform.connect.tachograph.card.v1.Calibration.Record.tyre_size:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
+	10, // 10: wayplatform.connect.tachograph.card.v1.Calibration.Record.old_time:type_name -> google.protobuf.Timestamp
+	10, // 11: wayplatform.connect.tachograph.card.v1.Calibration.Record.new_time:type_name -> google.protobuf.Timestamp
+	10, // 12: wayplatform.connect.tachograph.card.v1.Calibration.Record.next_calibration_date:type_name -> google.protobuf.Timestamp
+	8,  // 13: wayplatform.connect.tachograph.card.v1.Calibration.Record.vu_part_number:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
+	11, // 14: wayplatform.connect.tachograph.card.v1.Calibration.Record.vu_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
+	11, // 15: wayplatform.connect.tachograph.card.v1.Calibration.Record.sensor_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
+	11, // 16: wayplatform.connect.tachograph.card.v1.Calibration.Record.sensor_gnss_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
+	11, // 17: wayplatform.connect.tachograph.card.v1.Calibration.Record.rcm_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
 	3,  // 18: wayplatform.connect.tachograph.card.v1.Calibration.Record.seal_data_card:type_name -> wayplatform.connect.tachograph.card.v1.Calibration.SealDataCard
 	19, // [19:19] is the sub-list for method output_type
 	19, // [19:19] is the sub-list for method input_type
@@ -33,13 +33,14 @@ const (
 //
 // See regulation document Appendix 2, Section 4.2 (driver card applications).
 type DriverCardFile struct {
-	state                   protoimpl.MessageState       `protogen:"opaque.v1"`
-	xxx_hidden_Icc          *Icc                         `protobuf:"bytes,1,opt,name=icc"`
-	xxx_hidden_Ic           *Ic                          `protobuf:"bytes,2,opt,name=ic"`
-	xxx_hidden_Tachograph   *DriverCardFile_Tachograph   `protobuf:"bytes,3,opt,name=tachograph"`
-	xxx_hidden_TachographG2 *DriverCardFile_TachographG2 `protobuf:"bytes,4,opt,name=tachograph_g2,json=tachographG2"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	state                     protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_Icc            *Icc                         `protobuf:"bytes,1,opt,name=icc"`
+	xxx_hidden_Ic             *Ic                          `protobuf:"bytes,2,opt,name=ic"`
+	xxx_hidden_Tachograph     *DriverCardFile_Tachograph   `protobuf:"bytes,3,opt,name=tachograph"`
+	xxx_hidden_TachographG2   *DriverCardFile_TachographG2 `protobuf:"bytes,4,opt,name=tachograph_g2,json=tachographG2"`
+	xxx_hidden_UnknownRecords *[]*RawCardFile_Record       `protobuf:"bytes,5,rep,name=unknown_records,json=unknownRecords"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *DriverCardFile) Reset() {
@@ -95,6 +96,15 @@ func (x *DriverCardFile) GetTachographG2() *DriverCardFile_TachographG2 {
 	return nil
 }
 
+func (x *DriverCardFile) GetUnknownRecords() []*RawCardFile_Record {
+	if x != nil {
+		if x.xxx_hidden_UnknownRecords != nil {
+			return *x.xxx_hidden_UnknownRecords
+		}
+	}
+	return nil
+}
+
 func (x *DriverCardFile) SetIcc(v *Icc) {
 	x.xxx_hidden_Icc = v
 }
@@ -111,6 +121,10 @@ func (x *DriverCardFile) SetTachographG2(v *DriverCardFile_TachographG2) {
 	x.xxx_hidden_TachographG2 = v
 }
 
+func (x *DriverCardFile) SetUnknownRecords(v []*RawCardFile_Record) {
+	x.xxx_hidden_UnknownRecords = &v
+}
+
 func (x *DriverCardFile) HasIcc() bool {
 	if x == nil {
 		return false
@@ -172,6 +186,11 @@ type DriverCardFile_builder struct {
 	// Only present on Gen2 cards.
 	// In the TLV format, EFs from this DF use tag appendix '02' (data) and '03' (signature).
 	TachographG2 *DriverCardFile_TachographG2
+	// TLV records of EFs that are not recognized, such as manufacturer
+	// proprietary EFs, including their signature records.
+	// Only populated when the parser is configured to keep unknown EFs. The
+	// records are written back unchanged, at their original file offset.
+	UnknownRecords []*RawCardFile_Record
 }
 
 func (b0 DriverCardFile_builder) Build() *DriverCardFile {
@@ -182,6 +201,7 @@ func (b0 DriverCardFile_builder) Build() *DriverCardFile {
 	x.xxx_hidden_Ic = b.Ic
 	x.xxx_hidden_Tachograph = b.Tachograph
 	x.xxx_hidden_TachographG2 = b.TachographG2
+	x.xxx_hidden_UnknownRecords = &b.UnknownRecords
 	return m0
 }
 
@@ -1441,14 +1461,15 @@ var File_wayplatform_connect_tachograph_card_v1_driver_card_file_proto protorefl
 
 const file_wayplatform_connect_tachograph_card_v1_driver_card_file_proto_rawDesc = "" +
 	"\n" +
-	"=wayplatform/connect/tachograph/card/v1/driver_card_file.proto\x12&wayplatform.connect.tachograph.card.v1\x1aGwayplatform/connect/tachograph/card/v1/application_identification.proto\x1aJwayplatform/connect/tachograph/card/v1/application_identification_g2.proto\x1aJwayplatform/connect/tachograph/card/v1/application_identification_v2.proto\x1a=wayplatform/connect/tachograph/card/v1/border_crossings.proto\x1a;wayplatform/connect/tachograph/card/v1/ca_certificate.proto\x1a>wayplatform/connect/tachograph/card/v1/ca_certificate_g2.proto\x1a=wayplatform/connect/tachograph/card/v1/card_certificate.proto\x1aAwayplatform/connect/tachograph/card/v1/card_download_driver.proto\x1a@wayplatform/connect/tachograph/card/v1/card_ma_certificate.proto\x1aBwayplatform/connect/tachograph/card/v1/card_sign_certificate.proto\x1aBwayplatform/connect/tachograph/card/v1/company_activity_data.proto\x1aBwayplatform/connect/tachograph/card/v1/control_activity_data.proto\x1a:wayplatform/connect/tachograph/card/v1/current_usage.proto\x1aAwayplatform/connect/tachograph/card/v1/driver_activity_data.proto\x1aGwayplatform/connect/tachograph/card/v1/driver_card_identification.proto\x1aAwayplatform/connect/tachograph/card/v1/driving_licence_info.proto\x1a8wayplatform/connect/tachograph/card/v1/events_data.proto\x1a8wayplatform/connect/tachograph/card/v1/faults_data.proto\x1a8wayplatform/connect/tachograph/card/v1/gnss_places.proto\x1aGwayplatform/connect/tachograph/card/v1/gnss_places_authentication.proto\x1a/wayplatform/connect/tachograph/card/v1/ic.proto\x1a0wayplatform/connect/tachograph/card/v1/icc.proto\x1a=wayplatform/connect/tachograph/card/v1/link_certificate.proto\x1a>wayplatform/connect/tachograph/card/v1/load_type_entries.proto\x1aCwayplatform/connect/tachograph/card/v1/load_unload_operations.proto\x1a3wayplatform/connect/tachograph/card/v1/places.proto\x1aBwayplatform/connect/tachograph/card/v1/places_authentication.proto\x1a6wayplatform/connect/tachograph/card/v1/places_g2.proto\x1a@wayplatform/connect/tachograph/card/v1/specific_conditions.proto\x1aCwayplatform/connect/tachograph/card/v1/specific_conditions_g2.proto\x1a?wayplatform/connect/tachograph/card/v1/vehicle_units_used.proto\x1a:wayplatform/connect/tachograph/card/v1/vehicles_used.proto\x1a=wayplatform/connect/tachograph/card/v1/vehicles_used_g2.proto\x1a=wayplatform/connect/tachograph/card/v1/vu_configuration.proto\"\x8e#\n" +
+	"=wayplatform/connect/tachograph/card/v1/driver_card_file.proto\x12&wayplatform.connect.tachograph.card.v1\x1aGwayplatform/connect/tachograph/card/v1/application_identification.proto\x1aJwayplatform/connect/tachograph/card/v1/application_identification_g2.proto\x1aJwayplatform/connect/tachograph/card/v1/application_identification_v2.proto\x1a=wayplatform/connect/tachograph/card/v1/border_crossings.proto\x1a;wayplatform/connect/tachograph/card/v1/ca_certificate.proto\x1a>wayplatform/connect/tachograph/card/v1/ca_certificate_g2.proto\x1a=wayplatform/connect/tachograph/card/v1/card_certificate.proto\x1aAwayplatform/connect/tachograph/card/v1/card_download_driver.proto\x1a@wayplatform/connect/tachograph/card/v1/card_ma_certificate.proto\x1aBwayplatform/connect/tachograph/card/v1/card_sign_certificate.proto\x1aBwayplatform/connect/tachograph/card/v1/company_activity_data.proto\x1aBwayplatform/connect/tachograph/card/v1/control_activity_data.proto\x1a:wayplatform/connect/tachograph/card/v1/current_usage.proto\x1aAwayplatform/connect/tachograph/card/v1/driver_activity_data.proto\x1aGwayplatform/connect/tachograph/card/v1/driver_card_identification.proto\x1aAwayplatform/connect/tachograph/card/v1/driving_licence_info.proto\x1a8wayplatform/connect/tachograph/card/v1/events_data.proto\x1a8wayplatform/connect/tachograph/card/v1/faults_data.proto\x1a8wayplatform/connect/tachograph/card/v1/gnss_places.proto\x1aGwayplatform/connect/tachograph/card/v1/gnss_places_authentication.proto\x1a/wayplatform/connect/tachograph/card/v1/ic.proto\x1a0wayplatform/connect/tachograph/card/v1/icc.proto\x1a=wayplatform/connect/tachograph/card/v1/link_certificate.proto\x1a>wayplatform/connect/tachograph/card/v1/load_type_entries.proto\x1aCwayplatform/connect/tachograph/card/v1/load_unload_operations.proto\x1a3wayplatform/connect/tachograph/card/v1/places.proto\x1aBwayplatform/connect/tachograph/card/v1/places_authentication.proto\x1a6wayplatform/connect/tachograph/card/v1/places_g2.proto\x1a:wayplatform/connect/tachograph/card/v1/raw_card_file.proto\x1a@wayplatform/connect/tachograph/card/v1/specific_conditions.proto\x1aCwayplatform/connect/tachograph/card/v1/specific_conditions_g2.proto\x1a?wayplatform/connect/tachograph/card/v1/vehicle_units_used.proto\x1a:wayplatform/connect/tachograph/card/v1/vehicles_used.proto\x1a=wayplatform/connect/tachograph/card/v1/vehicles_used_g2.proto\x1a=wayplatform/connect/tachograph/card/v1/vu_configuration.proto\"\xf3#\n" +
 	"\x0eDriverCardFile\x12=\n" +
 	"\x03icc\x18\x01 \x01(\v2+.wayplatform.connect.tachograph.card.v1.IccR\x03icc\x12:\n" +
 	"\x02ic\x18\x02 \x01(\v2*.wayplatform.connect.tachograph.card.v1.IcR\x02ic\x12a\n" +
 	"\n" +
 	"tachograph\x18\x03 \x01(\v2A.wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographR\n" +
 	"tachograph\x12h\n" +
-	"\rtachograph_g2\x18\x04 \x01(\v2C.wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2R\ftachographG2\x1a\xfe\n" +
+	"\rtachograph_g2\x18\x04 \x01(\v2C.wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2R\ftachographG2\x12c\n" +
+	"\x0funknown_records\x18\x05 \x03(\v2:.wayplatform.connect.tachograph.card.v1.RawCardFile.RecordR\x0eunknownRecords\x1a\xfe\n" +
 	"\n" +
 	"\n" +
 	"Tachograph\x12\x80\x01\n" +
@@ -1509,89 +1530,91 @@ var file_wayplatform_connect_tachograph_card_v1_driver_card_file_proto_goTypes =
 	(*DriverCardFile_TachographG2)(nil), // 2: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2
 	(*Icc)(nil),                         // 3: wayplatform.connect.tachograph.card.v1.Icc
 	(*Ic)(nil),                          // 4: wayplatform.connect.tachograph.card.v1.Ic
-	(*ApplicationIdentification)(nil),   // 5: wayplatform.connect.tachograph.card.v1.ApplicationIdentification
-	(*DriverCardIdentification)(nil),    // 6: wayplatform.connect.tachograph.card.v1.DriverCardIdentification
-	(*CardDownloadDriver)(nil),          // 7: wayplatform.connect.tachograph.card.v1.CardDownloadDriver
-	(*DrivingLicenceInfo)(nil),          // 8: wayplatform.connect.tachograph.card.v1.DrivingLicenceInfo
-	(*EventsData)(nil),                  // 9: wayplatform.connect.tachograph.card.v1.EventsData
-	(*FaultsData)(nil),                  // 10: wayplatform.connect.tachograph.card.v1.FaultsData
-	(*DriverActivityData)(nil),          // 11: wayplatform.connect.tachograph.card.v1.DriverActivityData
-	(*VehiclesUsed)(nil),                // 12: wayplatform.connect.tachograph.card.v1.VehiclesUsed
-	(*Places)(nil),                      // 13: wayplatform.connect.tachograph.card.v1.Places
-	(*CurrentUsage)(nil),                // 14: wayplatform.connect.tachograph.card.v1.CurrentUsage
-	(*ControlActivityData)(nil),         // 15: wayplatform.connect.tachograph.card.v1.ControlActivityData
-	(*SpecificConditions)(nil),          // 16: wayplatform.connect.tachograph.card.v1.SpecificConditions
-	(*CardCertificate)(nil),             // 17: wayplatform.connect.tachograph.card.v1.CardCertificate
-	(*CaCertificate)(nil),               // 18: wayplatform.connect.tachograph.card.v1.CaCertificate
-	(*ApplicationIdentificationG2)(nil), // 19: wayplatform.connect.tachograph.card.v1.ApplicationIdentificationG2
-	(*VehiclesUsedG2)(nil),              // 20: wayplatform.connect.tachograph.card.v1.VehiclesUsedG2
-	(*PlacesG2)(nil),                    // 21: wayplatform.connect.tachograph.card.v1.PlacesG2
-	(*SpecificConditionsG2)(nil),        // 22: wayplatform.connect.tachograph.card.v1.SpecificConditionsG2
-	(*VehicleUnitsUsed)(nil),            // 23: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed
-	(*GnssPlaces)(nil),                  // 24: wayplatform.connect.tachograph.card.v1.GnssPlaces
-	(*ApplicationIdentificationV2)(nil), // 25: wayplatform.connect.tachograph.card.v1.ApplicationIdentificationV2
-	(*PlacesAuthentication)(nil),        // 26: wayplatform.connect.tachograph.card.v1.PlacesAuthentication
-	(*GnssPlacesAuthentication)(nil),    // 27: wayplatform.connect.tachograph.card.v1.GnssPlacesAuthentication
-	(*BorderCrossings)(nil),             // 28: wayplatform.connect.tachograph.card.v1.BorderCrossings
-	(*LoadUnloadOperations)(nil),        // 29: wayplatform.connect.tachograph.card.v1.LoadUnloadOperations
-	(*LoadTypeEntries)(nil),             // 30: wayplatform.connect.tachograph.card.v1.LoadTypeEntries
-	(*CompanyActivityData)(nil),         // 31: wayplatform.connect.tachograph.card.v1.CompanyActivityData
-	(*VuConfiguration)(nil),             // 32: wayplatform.connect.tachograph.card.v1.VuConfiguration
-	(*CardMaCertificate)(nil),           // 33: wayplatform.connect.tachograph.card.v1.CardMaCertificate
-	(*CardSignCertificate)(nil),         // 34: wayplatform.connect.tachograph.card.v1.CardSignCertificate
-	(*CaCertificateG2)(nil),             // 35: wayplatform.connect.tachograph.card.v1.CaCertificateG2
-	(*LinkCertificate)(nil),             // 36: wayplatform.connect.tachograph.card.v1.LinkCertificate
+	(*RawCardFile_Record)(nil),          // 5: wayplatform.connect.tachograph.card.v1.RawCardFile.Record
+	(*ApplicationIdentification)(nil),   // 6: wayplatform.connect.tachograph.card.v1.ApplicationIdentification
+	(*DriverCardIdentification)(nil),    // 7: wayplatform.connect.tachograph.card.v1.DriverCardIdentification
+	(*CardDownloadDriver)(nil),          // 8: wayplatform.connect.tachograph.card.v1.CardDownloadDriver
+	(*DrivingLicenceInfo)(nil),          // 9: wayplatform.connect.tachograph.card.v1.DrivingLicenceInfo
+	(*EventsData)(nil),                  // 10: wayplatform.connect.tachograph.card.v1.EventsData
+	(*FaultsData)(nil),                  // 11: wayplatform.connect.tachograph.card.v1.FaultsData
+	(*DriverActivityData)(nil),          // 12: wayplatform.connect.tachograph.card.v1.DriverActivityData
+	(*VehiclesUsed)(nil),                // 13: wayplatform.connect.tachograph.card.v1.VehiclesUsed
+	(*Places)(nil),                      // 14: wayplatform.connect.tachograph.card.v1.Places
+	(*CurrentUsage)(nil),                // 15: wayplatform.connect.tachograph.card.v1.CurrentUsage
+	(*ControlActivityData)(nil),         // 16: wayplatform.connect.tachograph.card.v1.ControlActivityData
+	(*SpecificConditions)(nil),          // 17: wayplatform.connect.tachograph.card.v1.SpecificConditions
+	(*CardCertificate)(nil),             // 18: wayplatform.connect.tachograph.card.v1.CardCertificate
+	(*CaCertificate)(nil),               // 19: wayplatform.connect.tachograph.card.v1.CaCertificate
+	(*ApplicationIdentificationG2)(nil), // 20: wayplatform.connect.tachograph.card.v1.ApplicationIdentificationG2
+	(*VehiclesUsedG2)(nil),              // 21: wayplatform.connect.tachograph.card.v1.VehiclesUsedG2
+	(*PlacesG2)(nil),                    // 22: wayplatform.connect.tachograph.card.v1.PlacesG2
+	(*SpecificConditionsG2)(nil),        // 23: wayplatform.connect.tachograph.card.v1.SpecificConditionsG2
+	(*VehicleUnitsUsed)(nil),            // 24: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed
+	(*GnssPlaces)(nil),                  // 25: wayplatform.connect.tachograph.card.v1.GnssPlaces
+	(*ApplicationIdentificationV2)(nil), // 26: wayplatform.connect.tachograph.card.v1.ApplicationIdentificationV2
+	(*PlacesAuthentication)(nil),        // 27: wayplatform.connect.tachograph.card.v1.PlacesAuthentication
+	(*GnssPlacesAuthentication)(nil),    // 28: wayplatform.connect.tachograph.card.v1.GnssPlacesAuthentication
+	(*BorderCrossings)(nil),             // 29: wayplatform.connect.tachograph.card.v1.BorderCrossings
+	(*LoadUnloadOperations)(nil),        // 30: wayplatform.connect.tachograph.card.v1.LoadUnloadOperations
+	(*LoadTypeEntries)(nil),             // 31: wayplatform.connect.tachograph.card.v1.LoadTypeEntries
+	(*CompanyActivityData)(nil),         // 32: wayplatform.connect.tachograph.card.v1.CompanyActivityData
+	(*VuConfiguration)(nil),             // 33: wayplatform.connect.tachograph.card.v1.VuConfiguration
+	(*CardMaCertificate)(nil),           // 34: wayplatform.connect.tachograph.card.v1.CardMaCertificate
+	(*CardSignCertificate)(nil),         // 35: wayplatform.connect.tachograph.card.v1.CardSignCertificate
+	(*CaCertificateG2)(nil),             // 36: wayplatform.connect.tachograph.card.v1.CaCertificateG2
+	(*LinkCertificate)(nil),             // 37: wayplatform.connect.tachograph.card.v1.LinkCertificate
 }
 var file_wayplatform_connect_tachograph_card_v1_driver_card_file_proto_depIdxs = []int32{
 	3,  // 0: wayplatform.connect.tachograph.card.v1.DriverCardFile.icc:type_name -> wayplatform.connect.tachograph.card.v1.Icc
 	4,  // 1: wayplatform.connect.tachograph.card.v1.DriverCardFile.ic:type_name -> wayplatform.connect.tachograph.card.v1.Ic
 	1,  // 2: wayplatform.connect.tachograph.card.v1.DriverCardFile.tachograph:type_name -> wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph
 	2,  // 3: wayplatform.connect.tachograph.card.v1.DriverCardFile.tachograph_g2:type_name -> wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2
-	5,  // 4: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.application_identification:type_name -> wayplatform.connect.tachograph.card.v1.ApplicationIdentification
-	6,  // 5: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.identification:type_name -> wayplatform.connect.tachograph.card.v1.DriverCardIdentification
-	7,  // 6: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.card_download:type_name -> wayplatform.connect.tachograph.card.v1.CardDownloadDriver
-	8,  // 7: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.driving_licence_info:type_name -> wayplatform.connect.tachograph.card.v1.DrivingLicenceInfo
-	9,  // 8: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.events_data:type_name -> wayplatform.connect.tachograph.card.v1.EventsData
-	10, // 9: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.faults_data:type_name -> wayplatform.connect.tachograph.card.v1.FaultsData
-	11, // 10: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.driver_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.DriverActivityData
-	12, // 11: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.vehicles_used:type_name -> wayplatform.connect.tachograph.card.v1.VehiclesUsed
-	13, // 12: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.places:type_name -> wayplatform.connect.tachograph.card.v1.Places
-	14, // 13: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.current_usage:type_name -> wayplatform.connect.tachograph.card.v1.CurrentUsage
-	15, // 14: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.control_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.ControlActivityData
-	16, // 15: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.specific_conditions:type_name -> wayplatform.connect.tachograph.card.v1.SpecificConditions
-	17, // 16: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.card_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CardCertificate
-	18, // 17: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.ca_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CaCertificate
-	19, // 18: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.application_identification:type_name -> wayplatform.connect.tachograph.card.v1.ApplicationIdentificationG2
-	6,  // 19: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.identification:type_name -> wayplatform.connect.tachograph.card.v1.DriverCardIdentification
-	7,  // 20: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.card_download:type_name -> wayplatform.connect.tachograph.card.v1.CardDownloadDriver
-	8,  // 21: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.driving_licence_info:type_name -> wayplatform.connect.tachograph.card.v1.DrivingLicenceInfo
-	9,  // 22: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.events_data:type_name -> wayplatform.connect.tachograph.card.v1.EventsData
-	10, // 23: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.faults_data:type_name -> wayplatform.connect.tachograph.card.v1.FaultsData
-	11, // 24: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.driver_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.DriverActivityData
-	20, // 25: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.vehicles_used:type_name -> wayplatform.connect.tachograph.card.v1.VehiclesUsedG2
-	21, // 26: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.places:type_name -> wayplatform.connect.tachograph.card.v1.PlacesG2
-	14, // 27: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.current_usage:type_name -> wayplatform.connect.tachograph.card.v1.CurrentUsage
-	15, // 28: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.control_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.ControlActivityData
-	22, // 29: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.specific_conditions:type_name -> wayplatform.connect.tachograph.card.v1.SpecificConditionsG2
-	23, // 30: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.vehicle_units_used:type_name -> wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed
-	24, // 31: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.gnss_places:type_name -> wayplatform.connect.tachograph.card.v1.GnssPlaces
-	25, // 32: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.application_identification_v2:type_name -> wayplatform.connect.tachograph.card.v1.ApplicationIdentificationV2
-	26, // 33: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.places_authentication:type_name -> wayplatform.connect.tachograph.card.v1.PlacesAuthentication
-	27, // 34: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.gnss_places_authentication:type_name -> wayplatform.connect.tachograph.card.v1.GnssPlacesAuthentication
-	28, // 35: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.border_crossings:type_name -> wayplatform.connect.tachograph.card.v1.BorderCrossings
-	29, // 36: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.load_unload_operations:type_name -> wayplatform.connect.tachograph.card.v1.LoadUnloadOperations
-	30, // 37: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.load_type_entries:type_name -> wayplatform.connect.tachograph.card.v1.LoadTypeEntries
-	31, // 38: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.company_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.CompanyActivityData
-	32, // 39: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.vu_configuration:type_name -> wayplatform.connect.tachograph.card.v1.VuConfiguration
-	33, // 40: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.card_ma_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CardMaCertificate
-	34, // 41: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.card_sign_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CardSignCertificate
-	35, // 42: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.ca_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CaCertificateG2
-	36, // 43: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.link_certificate:type_name -> wayplatform.connect.tachograph.card.v1.LinkCertificate
-	44, // [44:44] is the sub-list for method output_type
-	44, // [44:44] is the sub-list for method input_type
-	44, // [44:44] is the sub-list for extension type_name
-	44, // [44:44] is the sub-list for extension extendee
-	0,  // [0:44] is the sub-list for field type_name
+	5,  // 4: wayplatform.connect.tachograph.card.v1.DriverCardFile.unknown_records:type_name -> wayplatform.connect.tachograph.card.v1.RawCardFile.Record
+	6,  // 5: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.application_identification:type_name -> wayplatform.connect.tachograph.card.v1.ApplicationIdentification
+	7,  // 6: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.identification:type_name -> wayplatform.connect.tachograph.card.v1.DriverCardIdentification
+	8,  // 7: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.card_download:type_name -> wayplatform.connect.tachograph.card.v1.CardDownloadDriver
+	9,  // 8: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.driving_licence_info:type_name -> wayplatform.connect.tachograph.card.v1.DrivingLicenceInfo
+	10, // 9: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.events_data:type_name -> wayplatform.connect.tachograph.card.v1.EventsData
+	11, // 10: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.faults_data:type_name -> wayplatform.connect.tachograph.card.v1.FaultsData
+	12, // 11: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.driver_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.DriverActivityData
+	13, // 12: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.vehicles_used:type_name -> wayplatform.connect.tachograph.card.v1.VehiclesUsed
+	14, // 13: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.places:type_name -> wayplatform.connect.tachograph.card.v1.Places
+	15, // 14: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.current_usage:type_name -> wayplatform.connect.tachograph.card.v1.CurrentUsage
+	16, // 15: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.control_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.ControlActivityData
+	17, // 16: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.specific_conditions:type_name -> wayplatform.connect.tachograph.card.v1.SpecificConditions
+	18, // 17: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.card_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CardCertificate
+	19, // 18: wayplatform.connect.tachograph.card.v1.DriverCardFile.Tachograph.ca_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CaCertificate
+	20, // 19: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.application_identification:type_name -> wayplatform.connect.tachograph.card.v1.ApplicationIdentificationG2
+	7,  // 20: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.identification:type_name -> wayplatform.connect.tachograph.card.v1.DriverCardIdentification
+	8,  // 21: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.card_download:type_name -> wayplatform.connect.tachograph.card.v1.CardDownloadDriver
+	9,  // 22: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.driving_licence_info:type_name -> wayplatform.connect.tachograph.card.v1.DrivingLicenceInfo
+	10, // 23: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.events_data:type_name -> wayplatform.connect.tachograph.card.v1.EventsData
+	11, // 24: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.faults_data:type_name -> wayplatform.connect.tachograph.card.v1.FaultsData
+	12, // 25: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.driver_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.DriverActivityData
+	21, // 26: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.vehicles_used:type_name -> wayplatform.connect.tachograph.card.v1.VehiclesUsedG2
+	22, // 27: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.places:type_name -> wayplatform.connect.tachograph.card.v1.PlacesG2
+	15, // 28: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.current_usage:type_name -> wayplatform.connect.tachograph.card.v1.CurrentUsage
+	16, // 29: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.control_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.ControlActivityData
+	23, // 30: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.specific_conditions:type_name -> wayplatform.connect.tachograph.card.v1.SpecificConditionsG2
+	24, // 31: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.vehicle_units_used:type_name -> wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed
+	25, // 32: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.gnss_places:type_name -> wayplatform.connect.tachograph.card.v1.GnssPlaces
+	26, // 33: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.application_identification_v2:type_name -> wayplatform.connect.tachograph.card.v1.ApplicationIdentificationV2
+	27, // 34: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.places_authentication:type_name -> wayplatform.connect.tachograph.card.v1.PlacesAuthentication
+	28, // 35: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.gnss_places_authentication:type_name -> wayplatform.connect.tachograph.card.v1.GnssPlacesAuthentication
+	29, // 36: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.border_crossings:type_name -> wayplatform.connect.tachograph.card.v1.BorderCrossings
+	30, // 37: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.load_unload_operations:type_name -> wayplatform.connect.tachograph.card.v1.LoadUnloadOperations
+	31, // 38: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.load_type_entries:type_name -> wayplatform.connect.tachograph.card.v1.LoadTypeEntries
+	32, // 39: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.company_activity_data:type_name -> wayplatform.connect.tachograph.card.v1.CompanyActivityData
+	33, // 40: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.vu_configuration:type_name -> wayplatform.connect.tachograph.card.v1.VuConfiguration
+	34, // 41: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.card_ma_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CardMaCertificate
+	35, // 42: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.card_sign_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CardSignCertificate
+	36, // 43: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.ca_certificate:type_name -> wayplatform.connect.tachograph.card.v1.CaCertificateG2
+	37, // 44: wayplatform.connect.tachograph.card.v1.DriverCardFile.TachographG2.link_certificate:type_name -> wayplatform.connect.tachograph.card.v1.LinkCertificate
+	45, // [45:45] is the sub-list for method output_type
+	45, // [45:45] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_wayplatform_connect_tachograph_card_v1_driver_card_file_proto_init() }
@@ -1627,6 +1650,7 @@ func file_wayplatform_connect_tachograph_card_v1_driver_card_file_proto_init() {
 	file_wayplatform_connect_tachograph_card_v1_places_proto_init()
 	file_wayplatform_connect_tachograph_card_v1_places_authentication_proto_init()
 	file_wayplatform_connect_tachograph_card_v1_places_g2_proto_init()
+	file_wayplatform_connect_tachograph_card_v1_raw_card_file_proto_init()
 	file_wayplatform_connect_tachograph_card_v1_specific_conditions_proto_init()
 	file_wayplatform_connect_tachograph_card_v1_specific_conditions_g2_proto_init()
 	file_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto_init()
@@ -98,6 +98,7 @@ type RawCardFile_Record struct {
 	xxx_hidden_ContentType    ContentType            `protobuf:"varint,4,opt,name=content_type,json=contentType,enum=wayplatform.connect.tachograph.card.v1.ContentType"`
 	xxx_hidden_Length         int32                  `protobuf:"varint,5,opt,name=length"`
 	xxx_hidden_Value          []byte                 `protobuf:"bytes,6,opt,name=value"`
+	xxx_hidden_FileOffset     int32                  `protobuf:"varint,7,opt,name=file_offset,json=fileOffset"`
 	xxx_hidden_Authentication *v11.Authentication    `protobuf:"bytes,99,opt,name=authentication"`
 	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
 	XXX_presence              [1]uint32
@@ -178,6 +179,13 @@ func (x *RawCardFile_Record) GetValue() []byte {
 	return nil
 }
 
+func (x *RawCardFile_Record) GetFileOffset() int32 {
+	if x != nil {
+		return x.xxx_hidden_FileOffset
+	}
+	return 0
+}
+
 func (x *RawCardFile_Record) GetAuthentication() *v11.Authentication {
 	if x != nil {
 		return x.xxx_hidden_Authentication
@@ -187,27 +195,27 @@ func (x *RawCardFile_Record) GetAuthentication() *v11.Authentication {
 
 func (x *RawCardFile_Record) SetTag(v int32) {
 	x.xxx_hidden_Tag = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
 }
 
 func (x *RawCardFile_Record) SetFile(v ElementaryFileType) {
 	x.xxx_hidden_File = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
 }
 
 func (x *RawCardFile_Record) SetGeneration(v v1.Generation) {
 	x.xxx_hidden_Generation = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
 }
 
 func (x *RawCardFile_Record) SetContentType(v ContentType) {
 	x.xxx_hidden_ContentType = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
 }
 
 func (x *RawCardFile_Record) SetLength(v int32) {
 	x.xxx_hidden_Length = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
 }
 
 func (x *RawCardFile_Record) SetValue(v []byte) {
@@ -215,7 +223,12 @@ func (x *RawCardFile_Record) SetValue(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+}
+
+func (x *RawCardFile_Record) SetFileOffset(v int32) {
+	x.xxx_hidden_FileOffset = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
 }
 
 func (x *RawCardFile_Record) SetAuthentication(v *v11.Authentication) {
@@ -264,6 +277,13 @@ func (x *RawCardFile_Record) HasValue() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
 }
 
+func (x *RawCardFile_Record) HasFileOffset() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
 func (x *RawCardFile_Record) HasAuthentication() bool {
 	if x == nil {
 		return false
@@ -301,6 +321,11 @@ func (x *RawCardFile_Record) ClearValue() {
 	x.xxx_hidden_Value = nil
 }
 
+func (x *RawCardFile_Record) ClearFileOffset() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_FileOffset = 0
+}
+
 func (x *RawCardFile_Record) ClearAuthentication() {
 	x.xxx_hidden_Authentication = nil
 }
@@ -322,6 +347,11 @@ type RawCardFile_Record_builder struct {
 	Length *int32
 	// The raw byte value of the record.
 	Value []byte
+	// The byte offset of the record's tag within the original card file.
+	//
+	// The record spans `length` + 5 bytes from this offset (3-byte tag and
+	// 2-byte length, followed by the value).
+	FileOffset *int32
 	// Result of cryptographic signature authentication for this record.
 	// Present when signature verification has been performed.
 	Authentication *v11.Authentication
@@ -332,29 +362,33 @@ func (b0 RawCardFile_Record_builder) Build() *RawCardFile_Record {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Tag != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
 		x.xxx_hidden_Tag = *b.Tag
 	}
 	if b.File != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
 		x.xxx_hidden_File = *b.File
 	}
 	if b.Generation != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
 		x.xxx_hidden_Generation = *b.Generation
 	}
 	if b.ContentType != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
 		x.xxx_hidden_ContentType = *b.ContentType
 	}
 	if b.Length != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
 		x.xxx_hidden_Length = *b.Length
 	}
 	if b.Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
 		x.xxx_hidden_Value = b.Value
 	}
+	if b.FileOffset != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		x.xxx_hidden_FileOffset = *b.FileOffset
+	}
 	x.xxx_hidden_Authentication = b.Authentication
 	return m0
 }
@@ -363,9 +397,9 @@ var File_wayplatform_connect_tachograph_card_v1_raw_card_file_proto protoreflect
 
 const file_wayplatform_connect_tachograph_card_v1_raw_card_file_proto_rawDesc = "" +
 	"\n" +
-	":wayplatform/connect/tachograph/card/v1/raw_card_file.proto\x12&wayplatform.connect.tachograph.card.v1\x1a9wayplatform/connect/tachograph/card/v1/content_type.proto\x1aAwayplatform/connect/tachograph/card/v1/elementary_file_type.proto\x1a5wayplatform/connect/tachograph/dd/v1/generation.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\x8c\x04\n" +
+	":wayplatform/connect/tachograph/card/v1/raw_card_file.proto\x12&wayplatform.connect.tachograph.card.v1\x1a9wayplatform/connect/tachograph/card/v1/content_type.proto\x1aAwayplatform/connect/tachograph/card/v1/elementary_file_type.proto\x1a5wayplatform/connect/tachograph/dd/v1/generation.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\xad\x04\n" +
 	"\vRawCardFile\x12T\n" +
-	"\arecords\x18\x01 \x03(\v2:.wayplatform.connect.tachograph.card.v1.RawCardFile.RecordR\arecords\x1a\xa6\x03\n" +
+	"\arecords\x18\x01 \x03(\v2:.wayplatform.connect.tachograph.card.v1.RawCardFile.RecordR\arecords\x1a\xc7\x03\n" +
 	"\x06Record\x12\x10\n" +
 	"\x03tag\x18\x01 \x01(\x05R\x03tag\x12N\n" +
 	"\x04file\x18\x02 \x01(\x0e2:.wayplatform.connect.tachograph.card.v1.ElementaryFileTypeR\x04file\x12P\n" +
@@ -374,7 +408,9 @@ const file_wayplatform_connect_tachograph_card_v1_raw_card_file_proto_rawDesc =
 	"generation\x12V\n" +
 	"\fcontent_type\x18\x04 \x01(\x0e23.wayplatform.connect.tachograph.card.v1.ContentTypeR\vcontentType\x12\x16\n" +
 	"\x06length\x18\x05 \x01(\x05R\x06length\x12\x14\n" +
-	"\x05value\x18\x06 \x01(\fR\x05value\x12b\n" +
+	"\x05value\x18\x06 \x01(\fR\x05value\x12\x1f\n" +
+	"\vfile_offset\x18\a \x01(\x05R\n" +
+	"fileOffset\x12b\n" +
 	"\x0eauthentication\x18c \x01(\v2:.wayplatform.connect.tachograph.security.v1.AuthenticationR\x0eauthenticationB\xdd\x02\n" +
 	"*com.wayplatform.connect.tachograph.card.v1B\x10RawCardFileProtoP\x01Z`github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1;cardv1\xa2\x02\x04WCTC\xaa\x02&Wayplatform.Connect.Tachograph.Card.V1\xca\x02&Wayplatform\\Connect\\Tachograph\\Card\\V1\xe2\x022Wayplatform\\Connect\\Tachograph\\Card\\V1\\GPBMetadata\xea\x02*Wayplatform::Connect::Tachograph::Card::V1b\beditionsp\xe8\a"
 
@@ -7,6 +7,7 @@
 package cardv1
 
 import (
+	v11 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
 	v1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/security/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -173,7 +174,7 @@ type VehicleUnitsUsed_builder struct {
 	// See Data Dictionary, Section 2.40.
 	// ASN.1 Definition:
 	//
-	//	INTEGER(0..NoOfCardVehicleUnitRecords-1)
+	//     INTEGER(0..NoOfCardVehicleUnitRecords-1)
 	VehicleUnitPointerNewestRecord *int32
 	// The set of records for vehicle units used.
 	// Corresponds to `cardVehicleUnitRecords`.
@@ -185,11 +186,11 @@ type VehicleUnitsUsed_builder struct {
 	//
 	// ASN.1 Definition (Gen1):
 	//
-	//	Signature ::= OCTET STRING (SIZE(128))
+	//     Signature ::= OCTET STRING (SIZE(128))
 	//
 	// ASN.1 Definition (Gen2):
 	//
-	//	Signature ::= OCTET STRING (variable size, depends on elliptic curve)
+	//     Signature ::= OCTET STRING (variable size, depends on elliptic curve)
 	//
 	// Gen2 uses ECDSA signatures with variable lengths based on the curve:
 	// - 256-bit curves: ~64 bytes
@@ -236,6 +237,7 @@ type VehicleUnitsUsed_Record struct {
 	xxx_hidden_ManufacturerCode  int32                  `protobuf:"varint,2,opt,name=manufacturer_code,json=manufacturerCode"`
 	xxx_hidden_DeviceId          []byte                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId"`
 	xxx_hidden_VuSoftwareVersion []byte                 `protobuf:"bytes,4,opt,name=vu_software_version,json=vuSoftwareVersion"`
+	xxx_hidden_VuGeneration      v11.Generation         `protobuf:"varint,5,opt,name=vu_generation,json=vuGeneration,enum=wayplatform.connect.tachograph.dd.v1.Generation"`
 	XXX_raceDetectHookData       protoimpl.RaceDetectHookData
 	XXX_presence                 [1]uint32
 	unknownFields                protoimpl.UnknownFields
@@ -295,13 +297,22 @@ func (x *VehicleUnitsUsed_Record) GetVuSoftwareVersion() []byte {
 	return nil
 }
 
+func (x *VehicleUnitsUsed_Record) GetVuGeneration() v11.Generation {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 4) {
+			return x.xxx_hidden_VuGeneration
+		}
+	}
+	return v11.Generation(0)
+}
+
 func (x *VehicleUnitsUsed_Record) SetTimestamp(v *timestamppb.Timestamp) {
 	x.xxx_hidden_Timestamp = v
 }
 
 func (x *VehicleUnitsUsed_Record) SetManufacturerCode(v int32) {
 	x.xxx_hidden_ManufacturerCode = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
 }
 
 func (x *VehicleUnitsUsed_Record) SetDeviceId(v []byte) {
@@ -309,7 +320,7 @@ func (x *VehicleUnitsUsed_Record) SetDeviceId(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_DeviceId = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
 }
 
 func (x *VehicleUnitsUsed_Record) SetVuSoftwareVersion(v []byte) {
@@ -317,7 +328,12 @@ func (x *VehicleUnitsUsed_Record) SetVuSoftwareVersion(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_VuSoftwareVersion = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *VehicleUnitsUsed_Record) SetVuGeneration(v v11.Generation) {
+	x.xxx_hidden_VuGeneration = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
 }
 
 func (x *VehicleUnitsUsed_Record) HasTimestamp() bool {
@@ -348,6 +364,13 @@ func (x *VehicleUnitsUsed_Record) HasVuSoftwareVersion() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
+func (x *VehicleUnitsUsed_Record) HasVuGeneration() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
 func (x *VehicleUnitsUsed_Record) ClearTimestamp() {
 	x.xxx_hidden_Timestamp = nil
 }
@@ -367,6 +390,11 @@ func (x *VehicleUnitsUsed_Record) ClearVuSoftwareVersion() {
 	x.xxx_hidden_VuSoftwareVersion = nil
 }
 
+func (x *VehicleUnitsUsed_Record) ClearVuGeneration() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_VuGeneration = v11.Generation_GENERATION_UNSPECIFIED
+}
+
 type VehicleUnitsUsed_Record_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -375,29 +403,37 @@ type VehicleUnitsUsed_Record_builder struct {
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	Timestamp *timestamppb.Timestamp
 	// The code of the manufacturer of the Vehicle Unit.
 	//
 	// See Data Dictionary, Section 2.94, `ManufacturerCode`.
 	// ASN.1 Definition:
 	//
-	//	ManufacturerCode ::= INTEGER(0..255)
+	//     ManufacturerCode ::= INTEGER(0..255)
 	ManufacturerCode *int32
 	// The manufacturer-specific identifier for the Vehicle Unit type.
 	//
 	// See Data Dictionary, Section 2.39, `deviceID`.
 	// ASN.1 Definition:
 	//
-	//	OCTET STRING(SIZE(1))
+	//     OCTET STRING(SIZE(1))
 	DeviceId []byte
 	// The software version of the Vehicle Unit.
 	//
 	// See Data Dictionary, Section 2.226, `VuSoftwareVersion`.
 	// ASN.1 Definition:
 	//
-	//	VuSoftwareVersion ::= OCTET STRING (SIZE(4))
+	//     VuSoftwareVersion ::= OCTET STRING (SIZE(4))
 	VuSoftwareVersion []byte
+	// The generation of the vehicle unit.
+	//
+	// This is not stored in the record: EF_VehicleUnits_Used only exists in the
+	// Tachograph_G2 application, which Generation 1 vehicle units cannot
+	// access, so every used record was written by a Generation 2 vehicle unit.
+	// It is GENERATION_2 for records with a timestamp, and unset for unused
+	// records.
+	VuGeneration *v11.Generation
 }
 
 func (b0 VehicleUnitsUsed_Record_builder) Build() *VehicleUnitsUsed_Record {
@@ -406,17 +442,21 @@ func (b0 VehicleUnitsUsed_Record_builder) Build() *VehicleUnitsUsed_Record {
 	_, _ = b, x
 	x.xxx_hidden_Timestamp = b.Timestamp
 	if b.ManufacturerCode != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
 		x.xxx_hidden_ManufacturerCode = *b.ManufacturerCode
 	}
 	if b.DeviceId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
 		x.xxx_hidden_DeviceId = b.DeviceId
 	}
 	if b.VuSoftwareVersion != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
 		x.xxx_hidden_VuSoftwareVersion = b.VuSoftwareVersion
 	}
+	if b.VuGeneration != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_VuGeneration = *b.VuGeneration
+	}
 	return m0
 }
 
@@ -424,17 +464,18 @@ var File_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto protore
 
 const file_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto_rawDesc = "" +
 	"\n" +
-	"?wayplatform/connect/tachograph/card/v1/vehicle_units_used.proto\x12&wayplatform.connect.tachograph.card.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\xfa\x03\n" +
+	"?wayplatform/connect/tachograph/card/v1/vehicle_units_used.proto\x12&wayplatform.connect.tachograph.card.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a5wayplatform/connect/tachograph/dd/v1/generation.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\xd1\x04\n" +
 	"\x10VehicleUnitsUsed\x12J\n" +
 	"\"vehicle_unit_pointer_newest_record\x18\x01 \x01(\x05R\x1evehicleUnitPointerNewestRecord\x12Y\n" +
 	"\arecords\x18\x02 \x03(\v2?.wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.RecordR\arecords\x12\x1c\n" +
 	"\tsignature\x18\x03 \x01(\fR\tsignature\x12b\n" +
-	"\x0eauthentication\x18c \x01(\v2:.wayplatform.connect.tachograph.security.v1.AuthenticationR\x0eauthentication\x1a\xbc\x01\n" +
+	"\x0eauthentication\x18c \x01(\v2:.wayplatform.connect.tachograph.security.v1.AuthenticationR\x0eauthentication\x1a\x93\x02\n" +
 	"\x06Record\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12+\n" +
 	"\x11manufacturer_code\x18\x02 \x01(\x05R\x10manufacturerCode\x12\x1b\n" +
 	"\tdevice_id\x18\x03 \x01(\fR\bdeviceId\x12.\n" +
-	"\x13vu_software_version\x18\x04 \x01(\fR\x11vuSoftwareVersionB\xe2\x02\n" +
+	"\x13vu_software_version\x18\x04 \x01(\fR\x11vuSoftwareVersion\x12U\n" +
+	"\rvu_generation\x18\x05 \x01(\x0e20.wayplatform.connect.tachograph.dd.v1.GenerationR\fvuGenerationB\xe2\x02\n" +
 	"*com.wayplatform.connect.tachograph.card.v1B\x15VehicleUnitsUsedProtoP\x01Z`github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1;cardv1\xa2\x02\x04WCTC\xaa\x02&Wayplatform.Connect.Tachograph.Card.V1\xca\x02&Wayplatform\\Connect\\Tachograph\\Card\\V1\xe2\x022Wayplatform\\Connect\\Tachograph\\Card\\V1\\GPBMetadata\xea\x02*Wayplatform::Connect::Tachograph::Card::V1b\beditionsp\xe8\a"
 
 var file_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
@@ -443,16 +484,18 @@ var file_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto_goTypes
 	(*VehicleUnitsUsed_Record)(nil), // 1: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.Record
 	(*v1.Authentication)(nil),       // 2: wayplatform.connect.tachograph.security.v1.Authentication
 	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+	(v11.Generation)(0),             // 4: wayplatform.connect.tachograph.dd.v1.Generation
 }
 var file_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto_depIdxs = []int32{
 	1, // 0: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.records:type_name -> wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.Record
 	2, // 1: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.authentication:type_name -> wayplatform.connect.tachograph.security.v1.Authentication
 	3, // 2: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.Record.timestamp:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: wayplatform.connect.tachograph.card.v1.VehicleUnitsUsed.Record.vu_generation:type_name -> wayplatform.connect.tachograph.dd.v1.Generation
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_wayplatform_connect_tachograph_card_v1_vehicle_units_used_proto_init() }
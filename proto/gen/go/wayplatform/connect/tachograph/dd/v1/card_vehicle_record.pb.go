@@ -58,6 +58,7 @@ type CardVehicleRecord struct {
 	xxx_hidden_VehicleRegistration    *VehicleRegistrationIdentification `protobuf:"bytes,5,opt,name=vehicle_registration,json=vehicleRegistration"`
 	xxx_hidden_VuDataBlockCounter     *BcdString                         `protobuf:"bytes,6,opt,name=vu_data_block_counter,json=vuDataBlockCounter"`
 	xxx_hidden_RawData                []byte                             `protobuf:"bytes,7,opt,name=raw_data,json=rawData"`
+	xxx_hidden_Valid                  bool                               `protobuf:"varint,8,opt,name=valid"`
 	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
 	XXX_presence                      [1]uint32
 	unknownFields                     protoimpl.UnknownFields
@@ -138,14 +139,21 @@ func (x *CardVehicleRecord) GetRawData() []byte {
 	return nil
 }
 
+func (x *CardVehicleRecord) GetValid() bool {
+	if x != nil {
+		return x.xxx_hidden_Valid
+	}
+	return false
+}
+
 func (x *CardVehicleRecord) SetVehicleOdometerBeginKm(v int32) {
 	x.xxx_hidden_VehicleOdometerBeginKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
 }
 
 func (x *CardVehicleRecord) SetVehicleOdometerEndKm(v int32) {
 	x.xxx_hidden_VehicleOdometerEndKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
 }
 
 func (x *CardVehicleRecord) SetVehicleFirstUse(v *timestamppb.Timestamp) {
@@ -169,7 +177,12 @@ func (x *CardVehicleRecord) SetRawData(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_RawData = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+}
+
+func (x *CardVehicleRecord) SetValid(v bool) {
+	x.xxx_hidden_Valid = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
 }
 
 func (x *CardVehicleRecord) HasVehicleOdometerBeginKm() bool {
@@ -221,6 +234,13 @@ func (x *CardVehicleRecord) HasRawData() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
+func (x *CardVehicleRecord) HasValid() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
 func (x *CardVehicleRecord) ClearVehicleOdometerBeginKm() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_VehicleOdometerBeginKm = 0
@@ -252,6 +272,11 @@ func (x *CardVehicleRecord) ClearRawData() {
 	x.xxx_hidden_RawData = nil
 }
 
+func (x *CardVehicleRecord) ClearValid() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Valid = false
+}
+
 type CardVehicleRecord_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -288,6 +313,11 @@ type CardVehicleRecord_builder struct {
 	//
 	// Size: 31 bytes (fixed for Gen1).
 	RawData []byte
+	// Indicates whether this record holds a period of use of a vehicle.
+	//
+	// When false: The record is an unused slot of the cyclic buffer of
+	// EF_Vehicles_Used, filled with '00'H or 'FF'H, and only raw_data is set.
+	Valid *bool
 }
 
 func (b0 CardVehicleRecord_builder) Build() *CardVehicleRecord {
@@ -295,11 +325,11 @@ func (b0 CardVehicleRecord_builder) Build() *CardVehicleRecord {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.VehicleOdometerBeginKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
 		x.xxx_hidden_VehicleOdometerBeginKm = *b.VehicleOdometerBeginKm
 	}
 	if b.VehicleOdometerEndKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
 		x.xxx_hidden_VehicleOdometerEndKm = *b.VehicleOdometerEndKm
 	}
 	x.xxx_hidden_VehicleFirstUse = b.VehicleFirstUse
@@ -307,9 +337,13 @@ func (b0 CardVehicleRecord_builder) Build() *CardVehicleRecord {
 	x.xxx_hidden_VehicleRegistration = b.VehicleRegistration
 	x.xxx_hidden_VuDataBlockCounter = b.VuDataBlockCounter
 	if b.RawData != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
 		x.xxx_hidden_RawData = b.RawData
 	}
+	if b.Valid != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		x.xxx_hidden_Valid = *b.Valid
+	}
 	return m0
 }
 
@@ -317,7 +351,7 @@ var File_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_proto protoref
 
 const file_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_proto_rawDesc = "" +
 	"\n" +
-	">wayplatform/connect/tachograph/dd/v1/card_vehicle_record.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a5wayplatform/connect/tachograph/dd/v1/bcd_string.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\"\x8e\x04\n" +
+	">wayplatform/connect/tachograph/dd/v1/card_vehicle_record.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a5wayplatform/connect/tachograph/dd/v1/bcd_string.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\"\xa4\x04\n" +
 	"\x11CardVehicleRecord\x129\n" +
 	"\x19vehicle_odometer_begin_km\x18\x01 \x01(\x05R\x16vehicleOdometerBeginKm\x125\n" +
 	"\x17vehicle_odometer_end_km\x18\x02 \x01(\x05R\x14vehicleOdometerEndKm\x12F\n" +
@@ -325,7 +359,8 @@ const file_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_proto_rawDes
 	"\x10vehicle_last_use\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0evehicleLastUse\x12z\n" +
 	"\x14vehicle_registration\x18\x05 \x01(\v2G.wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentificationR\x13vehicleRegistration\x12b\n" +
 	"\x15vu_data_block_counter\x18\x06 \x01(\v2/.wayplatform.connect.tachograph.dd.v1.BcdStringR\x12vuDataBlockCounter\x12\x19\n" +
-	"\braw_data\x18\a \x01(\fR\arawDataB\xd5\x02\n" +
+	"\braw_data\x18\a \x01(\fR\arawData\x12\x14\n" +
+	"\x05valid\x18\b \x01(\bR\x05validB\xd5\x02\n" +
 	"(com.wayplatform.connect.tachograph.dd.v1B\x16CardVehicleRecordProtoP\x01Z\\github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1;ddv1\xa2\x02\x04WCTD\xaa\x02$Wayplatform.Connect.Tachograph.Dd.V1\xca\x02$Wayplatform\\Connect\\Tachograph\\Dd\\V1\xe2\x020Wayplatform\\Connect\\Tachograph\\Dd\\V1\\GPBMetadata\xea\x02(Wayplatform::Connect::Tachograph::Dd::V1b\beditionsp\xe8\a"
 
 var file_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
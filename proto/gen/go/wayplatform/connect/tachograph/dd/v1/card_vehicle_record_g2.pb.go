@@ -61,6 +61,7 @@ type CardVehicleRecordG2 struct {
 	xxx_hidden_VuDataBlockCounter          *BcdString                         `protobuf:"bytes,6,opt,name=vu_data_block_counter,json=vuDataBlockCounter"`
 	xxx_hidden_VehicleIdentificationNumber *string                            `protobuf:"bytes,7,opt,name=vehicle_identification_number,json=vehicleIdentificationNumber"`
 	xxx_hidden_RawData                     []byte                             `protobuf:"bytes,8,opt,name=raw_data,json=rawData"`
+	xxx_hidden_Valid                       bool                               `protobuf:"varint,9,opt,name=valid"`
 	XXX_raceDetectHookData                 protoimpl.RaceDetectHookData
 	XXX_presence                           [1]uint32
 	unknownFields                          protoimpl.UnknownFields
@@ -151,14 +152,21 @@ func (x *CardVehicleRecordG2) GetRawData() []byte {
 	return nil
 }
 
+func (x *CardVehicleRecordG2) GetValid() bool {
+	if x != nil {
+		return x.xxx_hidden_Valid
+	}
+	return false
+}
+
 func (x *CardVehicleRecordG2) SetVehicleOdometerBeginKm(v int32) {
 	x.xxx_hidden_VehicleOdometerBeginKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
 }
 
 func (x *CardVehicleRecordG2) SetVehicleOdometerEndKm(v int32) {
 	x.xxx_hidden_VehicleOdometerEndKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
 }
 
 func (x *CardVehicleRecordG2) SetVehicleFirstUse(v *timestamppb.Timestamp) {
@@ -179,7 +187,7 @@ func (x *CardVehicleRecordG2) SetVuDataBlockCounter(v *BcdString) {
 
 func (x *CardVehicleRecordG2) SetVehicleIdentificationNumber(v string) {
 	x.xxx_hidden_VehicleIdentificationNumber = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
 }
 
 func (x *CardVehicleRecordG2) SetRawData(v []byte) {
@@ -187,7 +195,12 @@ func (x *CardVehicleRecordG2) SetRawData(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_RawData = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 8)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *CardVehicleRecordG2) SetValid(v bool) {
+	x.xxx_hidden_Valid = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
 }
 
 func (x *CardVehicleRecordG2) HasVehicleOdometerBeginKm() bool {
@@ -246,6 +259,13 @@ func (x *CardVehicleRecordG2) HasRawData() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
 }
 
+func (x *CardVehicleRecordG2) HasValid() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
 func (x *CardVehicleRecordG2) ClearVehicleOdometerBeginKm() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_VehicleOdometerBeginKm = 0
@@ -282,6 +302,11 @@ func (x *CardVehicleRecordG2) ClearRawData() {
 	x.xxx_hidden_RawData = nil
 }
 
+func (x *CardVehicleRecordG2) ClearValid() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Valid = false
+}
+
 type CardVehicleRecordG2_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -328,6 +353,11 @@ type CardVehicleRecordG2_builder struct {
 	//
 	// Size: 48 bytes (fixed for Gen2).
 	RawData []byte
+	// Indicates whether this record holds a period of use of a vehicle.
+	//
+	// When false: The record is an unused slot of the cyclic buffer of
+	// EF_Vehicles_Used, filled with '00'H or 'FF'H, and only raw_data is set.
+	Valid *bool
 }
 
 func (b0 CardVehicleRecordG2_builder) Build() *CardVehicleRecordG2 {
@@ -335,11 +365,11 @@ func (b0 CardVehicleRecordG2_builder) Build() *CardVehicleRecordG2 {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.VehicleOdometerBeginKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
 		x.xxx_hidden_VehicleOdometerBeginKm = *b.VehicleOdometerBeginKm
 	}
 	if b.VehicleOdometerEndKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
 		x.xxx_hidden_VehicleOdometerEndKm = *b.VehicleOdometerEndKm
 	}
 	x.xxx_hidden_VehicleFirstUse = b.VehicleFirstUse
@@ -347,13 +377,17 @@ func (b0 CardVehicleRecordG2_builder) Build() *CardVehicleRecordG2 {
 	x.xxx_hidden_VehicleRegistration = b.VehicleRegistration
 	x.xxx_hidden_VuDataBlockCounter = b.VuDataBlockCounter
 	if b.VehicleIdentificationNumber != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
 		x.xxx_hidden_VehicleIdentificationNumber = b.VehicleIdentificationNumber
 	}
 	if b.RawData != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 8)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
 		x.xxx_hidden_RawData = b.RawData
 	}
+	if b.Valid != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_Valid = *b.Valid
+	}
 	return m0
 }
 
@@ -361,7 +395,7 @@ var File_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_g2_proto proto
 
 const file_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_g2_proto_rawDesc = "" +
 	"\n" +
-	"Awayplatform/connect/tachograph/dd/v1/card_vehicle_record_g2.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a5wayplatform/connect/tachograph/dd/v1/bcd_string.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\"\xd4\x04\n" +
+	"Awayplatform/connect/tachograph/dd/v1/card_vehicle_record_g2.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a5wayplatform/connect/tachograph/dd/v1/bcd_string.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\"\xea\x04\n" +
 	"\x13CardVehicleRecordG2\x129\n" +
 	"\x19vehicle_odometer_begin_km\x18\x01 \x01(\x05R\x16vehicleOdometerBeginKm\x125\n" +
 	"\x17vehicle_odometer_end_km\x18\x02 \x01(\x05R\x14vehicleOdometerEndKm\x12F\n" +
@@ -370,7 +404,8 @@ const file_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_g2_proto_raw
 	"\x14vehicle_registration\x18\x05 \x01(\v2G.wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentificationR\x13vehicleRegistration\x12b\n" +
 	"\x15vu_data_block_counter\x18\x06 \x01(\v2/.wayplatform.connect.tachograph.dd.v1.BcdStringR\x12vuDataBlockCounter\x12B\n" +
 	"\x1dvehicle_identification_number\x18\a \x01(\tR\x1bvehicleIdentificationNumber\x12\x19\n" +
-	"\braw_data\x18\b \x01(\fR\arawDataB\xd7\x02\n" +
+	"\braw_data\x18\b \x01(\fR\arawData\x12\x14\n" +
+	"\x05valid\x18\t \x01(\bR\x05validB\xd7\x02\n" +
 	"(com.wayplatform.connect.tachograph.dd.v1B\x18CardVehicleRecordG2ProtoP\x01Z\\github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1;ddv1\xa2\x02\x04WCTD\xaa\x02$Wayplatform.Connect.Tachograph.Dd.V1\xca\x02$Wayplatform\\Connect\\Tachograph\\Dd\\V1\xe2\x020Wayplatform\\Connect\\Tachograph\\Dd\\V1\\GPBMetadata\xea\x02(Wayplatform::Connect::Tachograph::Dd::V1b\beditionsp\xe8\a"
 
 var file_wayplatform_connect_tachograph_dd_v1_card_vehicle_record_g2_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
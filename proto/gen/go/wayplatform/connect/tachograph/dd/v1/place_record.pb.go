@@ -7,6 +7,7 @@
 package ddv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -56,7 +57,7 @@ type PlaceRecord struct {
 	xxx_hidden_UnrecognizedEntryTypeDailyWorkPeriod int32                    `protobuf:"varint,3,opt,name=unrecognized_entry_type_daily_work_period,json=unrecognizedEntryTypeDailyWorkPeriod"`
 	xxx_hidden_DailyWorkPeriodCountry               NationNumeric            `protobuf:"varint,4,opt,name=daily_work_period_country,json=dailyWorkPeriodCountry,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
 	xxx_hidden_UnrecognizedDailyWorkPeriodCountry   int32                    `protobuf:"varint,5,opt,name=unrecognized_daily_work_period_country,json=unrecognizedDailyWorkPeriodCountry"`
-	xxx_hidden_DailyWorkPeriodRegion                []byte                   `protobuf:"bytes,6,opt,name=daily_work_period_region,json=dailyWorkPeriodRegion"`
+	xxx_hidden_DailyWorkPeriodRegion                int32                    `protobuf:"varint,6,opt,name=daily_work_period_region,json=dailyWorkPeriodRegion"`
 	xxx_hidden_VehicleOdometerKm                    int32                    `protobuf:"varint,7,opt,name=vehicle_odometer_km,json=vehicleOdometerKm"`
 	xxx_hidden_RawData                              []byte                   `protobuf:"bytes,8,opt,name=raw_data,json=rawData"`
 	xxx_hidden_Valid                                bool                     `protobuf:"varint,9,opt,name=valid"`
@@ -130,11 +131,11 @@ func (x *PlaceRecord) GetUnrecognizedDailyWorkPeriodCountry() int32 {
 	return 0
 }
 
-func (x *PlaceRecord) GetDailyWorkPeriodRegion() []byte {
+func (x *PlaceRecord) GetDailyWorkPeriodRegion() int32 {
 	if x != nil {
 		return x.xxx_hidden_DailyWorkPeriodRegion
 	}
-	return nil
+	return 0
 }
 
 func (x *PlaceRecord) GetVehicleOdometerKm() int32 {
@@ -182,10 +183,7 @@ func (x *PlaceRecord) SetUnrecognizedDailyWorkPeriodCountry(v int32) {
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
 }
 
-func (x *PlaceRecord) SetDailyWorkPeriodRegion(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
+func (x *PlaceRecord) SetDailyWorkPeriodRegion(v int32) {
 	x.xxx_hidden_DailyWorkPeriodRegion = v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
 }
@@ -297,7 +295,7 @@ func (x *PlaceRecord) ClearUnrecognizedDailyWorkPeriodCountry() {
 
 func (x *PlaceRecord) ClearDailyWorkPeriodRegion() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_DailyWorkPeriodRegion = nil
+	x.xxx_hidden_DailyWorkPeriodRegion = 0
 }
 
 func (x *PlaceRecord) ClearVehicleOdometerKm() {
@@ -349,14 +347,17 @@ type PlaceRecord_builder struct {
 	// See Data Dictionary, Section 2.122, `RegionNumeric`.
 	// ASN.1 Definition:
 	//
-	//	RegionNumeric ::= OCTET STRING (SIZE (1))
-	DailyWorkPeriodRegion []byte
+	//     RegionNumeric ::= OCTET STRING (SIZE (1))
+	//
+	// The byte is held as its unsigned value, where 0 means no information
+	// available.
+	DailyWorkPeriodRegion *int32
 	// The odometer value at the time of place entry in kilometers.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	VehicleOdometerKm *int32
 	// Original encoded bytes from the binary format.
 	//
@@ -400,7 +401,7 @@ func (b0 PlaceRecord_builder) Build() *PlaceRecord {
 	}
 	if b.DailyWorkPeriodRegion != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
-		x.xxx_hidden_DailyWorkPeriodRegion = b.DailyWorkPeriodRegion
+		x.xxx_hidden_DailyWorkPeriodRegion = *b.DailyWorkPeriodRegion
 	}
 	if b.VehicleOdometerKm != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
@@ -421,15 +422,16 @@ var File_wayplatform_connect_tachograph_dd_v1_place_record_proto protoreflect.Fi
 
 const file_wayplatform_connect_tachograph_dd_v1_place_record_proto_rawDesc = "" +
 	"\n" +
-	"7wayplatform/connect/tachograph/dd/v1/place_record.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1aGwayplatform/connect/tachograph/dd/v1/entry_type_daily_work_period.proto\x1a9wayplatform/connect/tachograph/dd/v1/nation_numeric.proto\"\xff\x04\n" +
+	"7wayplatform/connect/tachograph/dd/v1/place_record.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1aGwayplatform/connect/tachograph/dd/v1/entry_type_daily_work_period.proto\x1a9wayplatform/connect/tachograph/dd/v1/nation_numeric.proto\"\x8b\x05\n" +
 	"\vPlaceRecord\x129\n" +
 	"\n" +
 	"entry_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tentryTime\x12~\n" +
 	"\x1centry_type_daily_work_period\x18\x02 \x01(\x0e2>.wayplatform.connect.tachograph.dd.v1.EntryTypeDailyWorkPeriodR\x18entryTypeDailyWorkPeriod\x12W\n" +
 	")unrecognized_entry_type_daily_work_period\x18\x03 \x01(\x05R$unrecognizedEntryTypeDailyWorkPeriod\x12n\n" +
 	"\x19daily_work_period_country\x18\x04 \x01(\x0e23.wayplatform.connect.tachograph.dd.v1.NationNumericR\x16dailyWorkPeriodCountry\x12R\n" +
-	"&unrecognized_daily_work_period_country\x18\x05 \x01(\x05R\"unrecognizedDailyWorkPeriodCountry\x127\n" +
-	"\x18daily_work_period_region\x18\x06 \x01(\fR\x15dailyWorkPeriodRegion\x12.\n" +
+	"&unrecognized_daily_work_period_country\x18\x05 \x01(\x05R\"unrecognizedDailyWorkPeriodCountry\x12C\n" +
+	"\x18daily_work_period_region\x18\x06 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xff\x01(\x00R\x15dailyWorkPeriodRegion\x12.\n" +
 	"\x13vehicle_odometer_km\x18\a \x01(\x05R\x11vehicleOdometerKm\x12\x19\n" +
 	"\braw_data\x18\b \x01(\fR\arawData\x12\x14\n" +
 	"\x05valid\x18\t \x01(\bR\x05validB\xcf\x02\n" +
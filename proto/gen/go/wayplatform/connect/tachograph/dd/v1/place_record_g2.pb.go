@@ -7,6 +7,7 @@
 package ddv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -58,7 +59,7 @@ type PlaceRecordG2 struct {
 	xxx_hidden_UnrecognizedEntryTypeDailyWorkPeriod int32                    `protobuf:"varint,3,opt,name=unrecognized_entry_type_daily_work_period,json=unrecognizedEntryTypeDailyWorkPeriod"`
 	xxx_hidden_DailyWorkPeriodCountry               NationNumeric            `protobuf:"varint,4,opt,name=daily_work_period_country,json=dailyWorkPeriodCountry,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
 	xxx_hidden_UnrecognizedDailyWorkPeriodCountry   int32                    `protobuf:"varint,5,opt,name=unrecognized_daily_work_period_country,json=unrecognizedDailyWorkPeriodCountry"`
-	xxx_hidden_DailyWorkPeriodRegion                []byte                   `protobuf:"bytes,6,opt,name=daily_work_period_region,json=dailyWorkPeriodRegion"`
+	xxx_hidden_DailyWorkPeriodRegion                int32                    `protobuf:"varint,6,opt,name=daily_work_period_region,json=dailyWorkPeriodRegion"`
 	xxx_hidden_VehicleOdometerKm                    int32                    `protobuf:"varint,7,opt,name=vehicle_odometer_km,json=vehicleOdometerKm"`
 	xxx_hidden_EntryGnssPlaceRecord                 *GNSSPlaceRecord         `protobuf:"bytes,8,opt,name=entry_gnss_place_record,json=entryGnssPlaceRecord"`
 	xxx_hidden_RawData                              []byte                   `protobuf:"bytes,9,opt,name=raw_data,json=rawData"`
@@ -133,11 +134,11 @@ func (x *PlaceRecordG2) GetUnrecognizedDailyWorkPeriodCountry() int32 {
 	return 0
 }
 
-func (x *PlaceRecordG2) GetDailyWorkPeriodRegion() []byte {
+func (x *PlaceRecordG2) GetDailyWorkPeriodRegion() int32 {
 	if x != nil {
 		return x.xxx_hidden_DailyWorkPeriodRegion
 	}
-	return nil
+	return 0
 }
 
 func (x *PlaceRecordG2) GetVehicleOdometerKm() int32 {
@@ -192,10 +193,7 @@ func (x *PlaceRecordG2) SetUnrecognizedDailyWorkPeriodCountry(v int32) {
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 10)
 }
 
-func (x *PlaceRecordG2) SetDailyWorkPeriodRegion(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
+func (x *PlaceRecordG2) SetDailyWorkPeriodRegion(v int32) {
 	x.xxx_hidden_DailyWorkPeriodRegion = v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
 }
@@ -318,7 +316,7 @@ func (x *PlaceRecordG2) ClearUnrecognizedDailyWorkPeriodCountry() {
 
 func (x *PlaceRecordG2) ClearDailyWorkPeriodRegion() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_DailyWorkPeriodRegion = nil
+	x.xxx_hidden_DailyWorkPeriodRegion = 0
 }
 
 func (x *PlaceRecordG2) ClearVehicleOdometerKm() {
@@ -374,14 +372,17 @@ type PlaceRecordG2_builder struct {
 	// See Data Dictionary, Section 2.122, `RegionNumeric`.
 	// ASN.1 Definition:
 	//
-	//	RegionNumeric ::= OCTET STRING (SIZE (1))
-	DailyWorkPeriodRegion []byte
+	//     RegionNumeric ::= OCTET STRING (SIZE (1))
+	//
+	// The byte is held as its unsigned value, where 0 means no information
+	// available.
+	DailyWorkPeriodRegion *int32
 	// The odometer value at the time of place entry in kilometers.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	VehicleOdometerKm *int32
 	// The recorded GNSS location and time.
 	//
@@ -432,7 +433,7 @@ func (b0 PlaceRecordG2_builder) Build() *PlaceRecordG2 {
 	}
 	if b.DailyWorkPeriodRegion != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
-		x.xxx_hidden_DailyWorkPeriodRegion = b.DailyWorkPeriodRegion
+		x.xxx_hidden_DailyWorkPeriodRegion = *b.DailyWorkPeriodRegion
 	}
 	if b.VehicleOdometerKm != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 10)
@@ -454,15 +455,16 @@ var File_wayplatform_connect_tachograph_dd_v1_place_record_g2_proto protoreflect
 
 const file_wayplatform_connect_tachograph_dd_v1_place_record_g2_proto_rawDesc = "" +
 	"\n" +
-	":wayplatform/connect/tachograph/dd/v1/place_record_g2.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1aGwayplatform/connect/tachograph/dd/v1/entry_type_daily_work_period.proto\x1a<wayplatform/connect/tachograph/dd/v1/gnss_place_record.proto\x1a9wayplatform/connect/tachograph/dd/v1/nation_numeric.proto\"\xef\x05\n" +
+	":wayplatform/connect/tachograph/dd/v1/place_record_g2.proto\x12$wayplatform.connect.tachograph.dd.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1aGwayplatform/connect/tachograph/dd/v1/entry_type_daily_work_period.proto\x1a<wayplatform/connect/tachograph/dd/v1/gnss_place_record.proto\x1a9wayplatform/connect/tachograph/dd/v1/nation_numeric.proto\"\xfb\x05\n" +
 	"\rPlaceRecordG2\x129\n" +
 	"\n" +
 	"entry_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tentryTime\x12~\n" +
 	"\x1centry_type_daily_work_period\x18\x02 \x01(\x0e2>.wayplatform.connect.tachograph.dd.v1.EntryTypeDailyWorkPeriodR\x18entryTypeDailyWorkPeriod\x12W\n" +
 	")unrecognized_entry_type_daily_work_period\x18\x03 \x01(\x05R$unrecognizedEntryTypeDailyWorkPeriod\x12n\n" +
 	"\x19daily_work_period_country\x18\x04 \x01(\x0e23.wayplatform.connect.tachograph.dd.v1.NationNumericR\x16dailyWorkPeriodCountry\x12R\n" +
-	"&unrecognized_daily_work_period_country\x18\x05 \x01(\x05R\"unrecognizedDailyWorkPeriodCountry\x127\n" +
-	"\x18daily_work_period_region\x18\x06 \x01(\fR\x15dailyWorkPeriodRegion\x12.\n" +
+	"&unrecognized_daily_work_period_country\x18\x05 \x01(\x05R\"unrecognizedDailyWorkPeriodCountry\x12C\n" +
+	"\x18daily_work_period_region\x18\x06 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xff\x01(\x00R\x15dailyWorkPeriodRegion\x12.\n" +
 	"\x13vehicle_odometer_km\x18\a \x01(\x05R\x11vehicleOdometerKm\x12l\n" +
 	"\x17entry_gnss_place_record\x18\b \x01(\v25.wayplatform.connect.tachograph.dd.v1.GNSSPlaceRecordR\x14entryGnssPlaceRecord\x12\x19\n" +
 	"\braw_data\x18\t \x01(\fR\arawData\x12\x14\n" +
@@ -27,31 +27,33 @@ const (
 //
 // Data Dictionary Reference: Section 2.203a (Generation 2, version 2)
 //
-// Binary Size: 57 bytes
+// Binary Size: 55 bytes
 //
 // ASN.1 Definition:
 //
 //	VuBorderCrossingRecord ::= SEQUENCE {
-//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 20 bytes
-//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 20 bytes
+//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 19 bytes
+//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 19 bytes
 //	    countryLeft                     NationNumeric,                  -- 1 byte
 //	    countryEntered                  NationNumeric,                  -- 1 byte
 //	    gnssPlaceAuthRecord             GNSSPlaceAuthRecord,            -- 12 bytes
 //	    vehicleOdometerValue            OdometerShort                   -- 3 bytes
 //	}
 type VuBorderCrossingRecord struct {
-	state                             protoimpl.MessageState       `protogen:"opaque.v1"`
-	xxx_hidden_CardNumberDriverSlot   *FullCardNumberAndGeneration `protobuf:"bytes,1,opt,name=card_number_driver_slot,json=cardNumberDriverSlot"`
-	xxx_hidden_CardNumberCodriverSlot *FullCardNumberAndGeneration `protobuf:"bytes,2,opt,name=card_number_codriver_slot,json=cardNumberCodriverSlot"`
-	xxx_hidden_CountryLeft            NationNumeric                `protobuf:"varint,3,opt,name=country_left,json=countryLeft,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
-	xxx_hidden_CountryEntered         NationNumeric                `protobuf:"varint,4,opt,name=country_entered,json=countryEntered,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
-	xxx_hidden_GnssPlaceAuthRecord    *GNSSPlaceAuthRecord         `protobuf:"bytes,5,opt,name=gnss_place_auth_record,json=gnssPlaceAuthRecord"`
-	xxx_hidden_VehicleOdometerKm      int32                        `protobuf:"varint,6,opt,name=vehicle_odometer_km,json=vehicleOdometerKm"`
-	xxx_hidden_RawData                []byte                       `protobuf:"bytes,7,opt,name=raw_data,json=rawData"`
-	XXX_raceDetectHookData            protoimpl.RaceDetectHookData
-	XXX_presence                      [1]uint32
-	unknownFields                     protoimpl.UnknownFields
-	sizeCache                         protoimpl.SizeCache
+	state                                 protoimpl.MessageState       `protogen:"opaque.v1"`
+	xxx_hidden_CardNumberDriverSlot       *FullCardNumberAndGeneration `protobuf:"bytes,1,opt,name=card_number_driver_slot,json=cardNumberDriverSlot"`
+	xxx_hidden_CardNumberCodriverSlot     *FullCardNumberAndGeneration `protobuf:"bytes,2,opt,name=card_number_codriver_slot,json=cardNumberCodriverSlot"`
+	xxx_hidden_CountryLeft                NationNumeric                `protobuf:"varint,3,opt,name=country_left,json=countryLeft,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
+	xxx_hidden_CountryEntered             NationNumeric                `protobuf:"varint,4,opt,name=country_entered,json=countryEntered,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
+	xxx_hidden_GnssPlaceAuthRecord        *GNSSPlaceAuthRecord         `protobuf:"bytes,5,opt,name=gnss_place_auth_record,json=gnssPlaceAuthRecord"`
+	xxx_hidden_VehicleOdometerKm          int32                        `protobuf:"varint,6,opt,name=vehicle_odometer_km,json=vehicleOdometerKm"`
+	xxx_hidden_RawData                    []byte                       `protobuf:"bytes,7,opt,name=raw_data,json=rawData"`
+	xxx_hidden_UnrecognizedCountryLeft    int32                        `protobuf:"varint,8,opt,name=unrecognized_country_left,json=unrecognizedCountryLeft"`
+	xxx_hidden_UnrecognizedCountryEntered int32                        `protobuf:"varint,9,opt,name=unrecognized_country_entered,json=unrecognizedCountryEntered"`
+	XXX_raceDetectHookData                protoimpl.RaceDetectHookData
+	XXX_presence                          [1]uint32
+	unknownFields                         protoimpl.UnknownFields
+	sizeCache                             protoimpl.SizeCache
 }
 
 func (x *VuBorderCrossingRecord) Reset() {
@@ -132,6 +134,20 @@ func (x *VuBorderCrossingRecord) GetRawData() []byte {
 	return nil
 }
 
+func (x *VuBorderCrossingRecord) GetUnrecognizedCountryLeft() int32 {
+	if x != nil {
+		return x.xxx_hidden_UnrecognizedCountryLeft
+	}
+	return 0
+}
+
+func (x *VuBorderCrossingRecord) GetUnrecognizedCountryEntered() int32 {
+	if x != nil {
+		return x.xxx_hidden_UnrecognizedCountryEntered
+	}
+	return 0
+}
+
 func (x *VuBorderCrossingRecord) SetCardNumberDriverSlot(v *FullCardNumberAndGeneration) {
 	x.xxx_hidden_CardNumberDriverSlot = v
 }
@@ -142,12 +158,12 @@ func (x *VuBorderCrossingRecord) SetCardNumberCodriverSlot(v *FullCardNumberAndG
 
 func (x *VuBorderCrossingRecord) SetCountryLeft(v NationNumeric) {
 	x.xxx_hidden_CountryLeft = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
 }
 
 func (x *VuBorderCrossingRecord) SetCountryEntered(v NationNumeric) {
 	x.xxx_hidden_CountryEntered = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
 }
 
 func (x *VuBorderCrossingRecord) SetGnssPlaceAuthRecord(v *GNSSPlaceAuthRecord) {
@@ -156,7 +172,7 @@ func (x *VuBorderCrossingRecord) SetGnssPlaceAuthRecord(v *GNSSPlaceAuthRecord)
 
 func (x *VuBorderCrossingRecord) SetVehicleOdometerKm(v int32) {
 	x.xxx_hidden_VehicleOdometerKm = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
 }
 
 func (x *VuBorderCrossingRecord) SetRawData(v []byte) {
@@ -164,7 +180,17 @@ func (x *VuBorderCrossingRecord) SetRawData(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_RawData = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
+}
+
+func (x *VuBorderCrossingRecord) SetUnrecognizedCountryLeft(v int32) {
+	x.xxx_hidden_UnrecognizedCountryLeft = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 9)
+}
+
+func (x *VuBorderCrossingRecord) SetUnrecognizedCountryEntered(v int32) {
+	x.xxx_hidden_UnrecognizedCountryEntered = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 9)
 }
 
 func (x *VuBorderCrossingRecord) HasCardNumberDriverSlot() bool {
@@ -216,6 +242,20 @@ func (x *VuBorderCrossingRecord) HasRawData() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
+func (x *VuBorderCrossingRecord) HasUnrecognizedCountryLeft() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *VuBorderCrossingRecord) HasUnrecognizedCountryEntered() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
 func (x *VuBorderCrossingRecord) ClearCardNumberDriverSlot() {
 	x.xxx_hidden_CardNumberDriverSlot = nil
 }
@@ -248,6 +288,16 @@ func (x *VuBorderCrossingRecord) ClearRawData() {
 	x.xxx_hidden_RawData = nil
 }
 
+func (x *VuBorderCrossingRecord) ClearUnrecognizedCountryLeft() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_UnrecognizedCountryLeft = 0
+}
+
+func (x *VuBorderCrossingRecord) ClearUnrecognizedCountryEntered() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_UnrecognizedCountryEntered = 0
+}
+
 type VuBorderCrossingRecord_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -256,17 +306,23 @@ type VuBorderCrossingRecord_builder struct {
 	// Card in co-driver slot (including generation)
 	CardNumberCodriverSlot *FullCardNumberAndGeneration
 	// Country which was left by the vehicle
-	// 'Rest of the World' (0xFF) shall be used when the VU cannot determine the country
+	// REST_OF_WORLD shall be used when the VU cannot determine the country
 	CountryLeft *NationNumeric
 	// Country into which the vehicle has entered
-	// 'Rest of the World' (0xFF) shall be used when the VU cannot determine the country
+	// REST_OF_WORLD shall be used when the VU cannot determine the country
 	CountryEntered *NationNumeric
 	// GNSS position and authentication status when border crossing was detected
 	GnssPlaceAuthRecord *GNSSPlaceAuthRecord
 	// Vehicle odometer value (in km) when border crossing was detected
 	VehicleOdometerKm *int32
-	// Raw binary data for round-trip fidelity (57 bytes)
+	// Raw binary data for round-trip fidelity (55 bytes)
 	RawData []byte
+	// Stores the raw protocol value when an unrecognized country left is
+	// encountered during parsing.
+	UnrecognizedCountryLeft *int32
+	// Stores the raw protocol value when an unrecognized country entered is
+	// encountered during parsing.
+	UnrecognizedCountryEntered *int32
 }
 
 func (b0 VuBorderCrossingRecord_builder) Build() *VuBorderCrossingRecord {
@@ -276,22 +332,30 @@ func (b0 VuBorderCrossingRecord_builder) Build() *VuBorderCrossingRecord {
 	x.xxx_hidden_CardNumberDriverSlot = b.CardNumberDriverSlot
 	x.xxx_hidden_CardNumberCodriverSlot = b.CardNumberCodriverSlot
 	if b.CountryLeft != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
 		x.xxx_hidden_CountryLeft = *b.CountryLeft
 	}
 	if b.CountryEntered != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
 		x.xxx_hidden_CountryEntered = *b.CountryEntered
 	}
 	x.xxx_hidden_GnssPlaceAuthRecord = b.GnssPlaceAuthRecord
 	if b.VehicleOdometerKm != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
 		x.xxx_hidden_VehicleOdometerKm = *b.VehicleOdometerKm
 	}
 	if b.RawData != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
 		x.xxx_hidden_RawData = b.RawData
 	}
+	if b.UnrecognizedCountryLeft != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 9)
+		x.xxx_hidden_UnrecognizedCountryLeft = *b.UnrecognizedCountryLeft
+	}
+	if b.UnrecognizedCountryEntered != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 9)
+		x.xxx_hidden_UnrecognizedCountryEntered = *b.UnrecognizedCountryEntered
+	}
 	return m0
 }
 
@@ -299,7 +363,7 @@ var File_wayplatform_connect_tachograph_dd_v1_vu_border_crossing_record_proto pr
 
 const file_wayplatform_connect_tachograph_dd_v1_vu_border_crossing_record_proto_rawDesc = "" +
 	"\n" +
-	"Dwayplatform/connect/tachograph/dd/v1/vu_border_crossing_record.proto\x12$wayplatform.connect.tachograph.dd.v1\x1aJwayplatform/connect/tachograph/dd/v1/full_card_number_and_generation.proto\x1aAwayplatform/connect/tachograph/dd/v1/gnss_place_auth_record.proto\x1a9wayplatform/connect/tachograph/dd/v1/nation_numeric.proto\"\x81\x05\n" +
+	"Dwayplatform/connect/tachograph/dd/v1/vu_border_crossing_record.proto\x12$wayplatform.connect.tachograph.dd.v1\x1aJwayplatform/connect/tachograph/dd/v1/full_card_number_and_generation.proto\x1aAwayplatform/connect/tachograph/dd/v1/gnss_place_auth_record.proto\x1a9wayplatform/connect/tachograph/dd/v1/nation_numeric.proto\"\xff\x05\n" +
 	"\x16VuBorderCrossingRecord\x12x\n" +
 	"\x17card_number_driver_slot\x18\x01 \x01(\v2A.wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGenerationR\x14cardNumberDriverSlot\x12|\n" +
 	"\x19card_number_codriver_slot\x18\x02 \x01(\v2A.wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGenerationR\x16cardNumberCodriverSlot\x12V\n" +
@@ -307,7 +371,9 @@ const file_wayplatform_connect_tachograph_dd_v1_vu_border_crossing_record_proto_
 	"\x0fcountry_entered\x18\x04 \x01(\x0e23.wayplatform.connect.tachograph.dd.v1.NationNumericR\x0ecountryEntered\x12n\n" +
 	"\x16gnss_place_auth_record\x18\x05 \x01(\v29.wayplatform.connect.tachograph.dd.v1.GNSSPlaceAuthRecordR\x13gnssPlaceAuthRecord\x12.\n" +
 	"\x13vehicle_odometer_km\x18\x06 \x01(\x05R\x11vehicleOdometerKm\x12\x19\n" +
-	"\braw_data\x18\a \x01(\fR\arawDataB\xda\x02\n" +
+	"\braw_data\x18\a \x01(\fR\arawData\x12:\n" +
+	"\x19unrecognized_country_left\x18\b \x01(\x05R\x17unrecognizedCountryLeft\x12@\n" +
+	"\x1cunrecognized_country_entered\x18\t \x01(\x05R\x1aunrecognizedCountryEnteredB\xda\x02\n" +
 	"(com.wayplatform.connect.tachograph.dd.v1B\x1bVuBorderCrossingRecordProtoP\x01Z\\github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1;ddv1\xa2\x02\x04WCTD\xaa\x02$Wayplatform.Connect.Tachograph.Dd.V1\xca\x02$Wayplatform\\Connect\\Tachograph\\Dd\\V1\xe2\x020Wayplatform\\Connect\\Tachograph\\Dd\\V1\\GPBMetadata\xea\x02(Wayplatform::Connect::Tachograph::Dd::V1b\beditionsp\xe8\a"
 
 var file_wayplatform_connect_tachograph_dd_v1_vu_border_crossing_record_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
@@ -28,13 +28,13 @@ const (
 //
 // Data Dictionary Reference: Section 2.177 (Generation 2)
 //
-// Binary Size: 132 bytes
+// Binary Size: 131 bytes
 //
 // ASN.1 Definition (Gen2):
 //
 //	VuCardIWRecord ::= SEQUENCE {
 //	    cardHolderName                     HolderName,                         -- 72 bytes
-//	    fullCardNumberAndGeneration        FullCardNumberAndGeneration,        -- 20 bytes
+//	    fullCardNumberAndGeneration        FullCardNumberAndGeneration,        -- 19 bytes
 //	    cardExpiryDate                     Datef,                              -- 4 bytes
 //	    cardInsertionTime                  TimeReal,                           -- 4 bytes
 //	    vehicleOdometerValueAtInsertion    OdometerShort,                      -- 3 bytes
@@ -47,7 +47,7 @@ const (
 //
 // Generation Differences:
 // - Gen1: Uses FullCardNumber (18 bytes) and PreviousVehicleInfo (19 bytes) = 129 bytes total
-// - Gen2: Uses FullCardNumberAndGeneration (20 bytes) and PreviousVehicleInfoGen2 (20 bytes) = 132 bytes total
+// - Gen2: Uses FullCardNumberAndGeneration (19 bytes) and PreviousVehicleInfoGen2 (20 bytes) = 131 bytes total
 type VuCardIWRecordG2 struct {
 	state                             protoimpl.MessageState       `protogen:"opaque.v1"`
 	xxx_hidden_CardHolderName         *HolderName                  `protobuf:"bytes,1,opt,name=card_holder_name,json=cardHolderName"`
@@ -372,7 +372,7 @@ type VuCardIWRecordG2_builder struct {
 	PreviousVehicleInfo *PreviousVehicleInfoG2
 	// Flag indicating if driver manually entered activities at card insertion
 	ManualInputFlag *bool
-	// Raw binary data for round-trip fidelity (132 bytes)
+	// Raw binary data for round-trip fidelity (131 bytes)
 	RawData []byte
 }
 
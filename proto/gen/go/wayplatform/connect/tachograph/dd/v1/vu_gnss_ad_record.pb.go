@@ -28,21 +28,21 @@ const (
 //
 // Data Dictionary Reference: Section 2.203 (Generation 2, version 1)
 //
-// Binary Size: 58 bytes
+// Binary Size: 56 bytes
 //
 // ASN.1 Definition:
 //
 //	VuGNSSADRecord ::= SEQUENCE {
 //	    timeStamp                       TimeReal,                       -- 4 bytes
-//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 20 bytes
-//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 20 bytes
+//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 19 bytes
+//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 19 bytes
 //	    gnssPlaceRecord                 GNSSPlaceRecord,                -- 11 bytes
 //	    vehicleOdometerValue            OdometerShort                   -- 3 bytes
 //	}
 //
 // Generation Differences:
-// - Gen2v1: Uses GNSSPlaceRecord (11 bytes) = 58 bytes total
-// - Gen2v2: Uses GNSSPlaceAuthRecord (12 bytes) = 59 bytes total (see VuGNSSADRecordG2)
+// - Gen2v1: Uses GNSSPlaceRecord (11 bytes) = 56 bytes total
+// - Gen2v2: Uses GNSSPlaceAuthRecord (12 bytes) = 57 bytes total (see VuGNSSADRecordG2)
 type VuGNSSADRecord struct {
 	state                             protoimpl.MessageState       `protogen:"opaque.v1"`
 	xxx_hidden_TimeStamp              *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=time_stamp,json=timeStamp"`
@@ -234,7 +234,7 @@ type VuGNSSADRecord_builder struct {
 	GnssPlaceRecord *GNSSPlaceRecord
 	// Vehicle odometer value (in km) when accumulated driving reaches multiple of three hours
 	VehicleOdometerKm *int32
-	// Raw binary data for round-trip fidelity (58 bytes)
+	// Raw binary data for round-trip fidelity (56 bytes)
 	RawData []byte
 }
 
@@ -28,21 +28,21 @@ const (
 //
 // Data Dictionary Reference: Section 2.203 (Generation 2, version 2)
 //
-// Binary Size: 59 bytes
+// Binary Size: 57 bytes
 //
 // ASN.1 Definition:
 //
 //	VuGNSSADRecord ::= SEQUENCE {
 //	    timeStamp                       TimeReal,                       -- 4 bytes
-//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 20 bytes
-//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 20 bytes
+//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 19 bytes
+//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 19 bytes
 //	    gnssPlaceAuthRecord             GNSSPlaceAuthRecord,            -- 12 bytes
 //	    vehicleOdometerValue            OdometerShort                   -- 3 bytes
 //	}
 //
 // Generation Differences:
-// - Gen2v1: Uses GNSSPlaceRecord (11 bytes) = 58 bytes total (see VuGNSSADRecord)
-// - Gen2v2: Uses GNSSPlaceAuthRecord (12 bytes) = 59 bytes total
+// - Gen2v1: Uses GNSSPlaceRecord (11 bytes) = 56 bytes total (see VuGNSSADRecord)
+// - Gen2v2: Uses GNSSPlaceAuthRecord (12 bytes) = 57 bytes total
 type VuGNSSADRecordG2 struct {
 	state                             protoimpl.MessageState       `protogen:"opaque.v1"`
 	xxx_hidden_TimeStamp              *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=time_stamp,json=timeStamp"`
@@ -234,7 +234,7 @@ type VuGNSSADRecordG2_builder struct {
 	GnssPlaceAuthRecord *GNSSPlaceAuthRecord
 	// Vehicle odometer value (in km) when accumulated driving reaches multiple of three hours
 	VehicleOdometerKm *int32
-	// Raw binary data for round-trip fidelity (59 bytes)
+	// Raw binary data for round-trip fidelity (57 bytes)
 	RawData []byte
 }
 
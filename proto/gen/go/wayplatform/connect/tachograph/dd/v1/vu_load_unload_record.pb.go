@@ -28,15 +28,15 @@ const (
 //
 // Data Dictionary Reference: Section 2.208a (Generation 2, version 2)
 //
-// Binary Size: 60 bytes
+// Binary Size: 58 bytes
 //
 // ASN.1 Definition:
 //
 //	VuLoadUnloadRecord ::= SEQUENCE {
 //	    timeStamp                       TimeReal,                       -- 4 bytes
 //	    operationType                   OperationType,                  -- 1 byte
-//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 20 bytes
-//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 20 bytes
+//	    cardNumberAndGenDriverSlot      FullCardNumberAndGeneration,    -- 19 bytes
+//	    cardNumberAndGenCodriverSlot    FullCardNumberAndGeneration,    -- 19 bytes
 //	    gnssPlaceAuthRecord             GNSSPlaceAuthRecord,            -- 12 bytes
 //	    vehicleOdometerValue            OdometerShort                   -- 3 bytes
 //	}
@@ -260,7 +260,7 @@ type VuLoadUnloadRecord_builder struct {
 	GnssPlaceAuthRecord *GNSSPlaceAuthRecord
 	// Vehicle odometer value (in km) at load/unload operation
 	VehicleOdometerKm *int32
-	// Raw binary data for round-trip fidelity (60 bytes)
+	// Raw binary data for round-trip fidelity (58 bytes)
 	RawData []byte
 }
 
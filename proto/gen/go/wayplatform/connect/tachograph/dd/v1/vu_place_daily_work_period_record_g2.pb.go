@@ -27,19 +27,19 @@ const (
 //
 // Data Dictionary Reference: Section 2.219 (Generation 2, version 1)
 //
-// Binary Size: 41 bytes
+// Binary Size: 40 bytes
 //
 // ASN.1 Definition (Gen2 V1):
 //
 //	VuPlaceDailyWorkPeriodRecord ::= SEQUENCE {
-//	    fullCardNumberAndGeneration FullCardNumberAndGeneration,    -- 20 bytes
+//	    fullCardNumberAndGeneration FullCardNumberAndGeneration,    -- 19 bytes
 //	    placeRecord                 PlaceRecord                     -- 21 bytes (Gen2)
 //	}
 //
 // Generation Differences:
 // - Gen1: Uses FullCardNumber (18 bytes) and PlaceRecord (10 bytes) = 28 bytes
-// - Gen2v1: Uses FullCardNumberAndGeneration (20 bytes) and PlaceRecordG2 (21 bytes) = 41 bytes
-// - Gen2v2: Uses FullCardNumberAndGeneration (20 bytes) and PlaceAuthRecord (22 bytes) = 42 bytes
+// - Gen2v1: Uses FullCardNumberAndGeneration (19 bytes) and PlaceRecordG2 (21 bytes) = 40 bytes
+// - Gen2v2: Uses FullCardNumberAndGeneration (19 bytes) and PlaceAuthRecord (22 bytes) = 41 bytes
 type VuPlaceDailyWorkPeriodRecordG2 struct {
 	state                     protoimpl.MessageState       `protogen:"opaque.v1"`
 	xxx_hidden_FullCardNumber *FullCardNumberAndGeneration `protobuf:"bytes,1,opt,name=full_card_number,json=fullCardNumber"`
@@ -154,7 +154,7 @@ type VuPlaceDailyWorkPeriodRecordG2_builder struct {
 	FullCardNumber *FullCardNumberAndGeneration
 	// Information related to the place entered (Gen2 version with GNSS)
 	PlaceRecord *PlaceRecordG2
-	// Raw binary data for round-trip fidelity (41 bytes)
+	// Raw binary data for round-trip fidelity (40 bytes)
 	RawData []byte
 }
 
@@ -27,19 +27,19 @@ const (
 //
 // Data Dictionary Reference: Section 2.219 (Generation 2, version 2)
 //
-// Binary Size: 42 bytes
+// Binary Size: 41 bytes
 //
 // ASN.1 Definition (Gen2 V2):
 //
 //	VuPlaceDailyWorkPeriodRecord ::= SEQUENCE {
-//	    fullCardNumberAndGeneration FullCardNumberAndGeneration,    -- 20 bytes
+//	    fullCardNumberAndGeneration FullCardNumberAndGeneration,    -- 19 bytes
 //	    placeAuthRecord             PlaceAuthRecord                 -- 22 bytes
 //	}
 //
 // Generation Differences:
 // - Gen1: Uses FullCardNumber (18 bytes) and PlaceRecord (10 bytes) = 28 bytes
-// - Gen2v1: Uses FullCardNumberAndGeneration (20 bytes) and PlaceRecordG2 (21 bytes) = 41 bytes
-// - Gen2v2: Uses FullCardNumberAndGeneration (20 bytes) and PlaceAuthRecord (22 bytes) = 42 bytes
+// - Gen2v1: Uses FullCardNumberAndGeneration (19 bytes) and PlaceRecordG2 (21 bytes) = 40 bytes
+// - Gen2v2: Uses FullCardNumberAndGeneration (19 bytes) and PlaceAuthRecord (22 bytes) = 41 bytes
 type VuPlaceDailyWorkPeriodRecordG2V2 struct {
 	state                      protoimpl.MessageState       `protogen:"opaque.v1"`
 	xxx_hidden_FullCardNumber  *FullCardNumberAndGeneration `protobuf:"bytes,1,opt,name=full_card_number,json=fullCardNumber"`
@@ -154,7 +154,7 @@ type VuPlaceDailyWorkPeriodRecordG2V2_builder struct {
 	FullCardNumber *FullCardNumberAndGeneration
 	// Information related to the place entered with GNSS authentication (Gen2v2)
 	PlaceAuthRecord *PlaceAuthRecord
-	// Raw binary data for round-trip fidelity (42 bytes)
+	// Raw binary data for round-trip fidelity (41 bytes)
 	RawData []byte
 }
 
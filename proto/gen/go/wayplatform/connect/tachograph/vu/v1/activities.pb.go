@@ -357,14 +357,14 @@ type Activities_builder struct {
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	DateOfDay *timestamppb.Timestamp
 	// Odometer value at midnight in kilometers.
 	//
 	// See Data Dictionary, Section 2.114, `OdometerValueMidnight`.
 	// ASN.1 Definition:
 	//
-	//	OdometerValueMidnight ::= OdometerShort ::= INTEGER(0..999999)
+	//     OdometerValueMidnight ::= OdometerShort ::= INTEGER(0..999999)
 	OdometerMidnightKm *int32
 	// Card insertion and withdrawal data.
 	// Corresponds to `VuCardIWData` (DD 2.176) or `VuCardIWRecordArray` (DD 2.178).
@@ -458,9 +458,9 @@ func (b0 Activities_builder) Build() *Activities {
 //	  - previousVehicleInfo: 19 bytes (15 vehicle reg + 4 cardWithdrawalTime)
 //	  - manualInputFlag: 1 byte
 //
-//	Gen2: 132 bytes total
+//	Gen2: 131 bytes total
 //	  - cardHolderName: 72 bytes
-//	  - fullCardNumberAndGeneration: 20 bytes
+//	  - fullCardNumberAndGeneration: 19 bytes
 //	  - cardExpiryDate: 4 bytes
 //	  - cardInsertionTime: 4 bytes
 //	  - vehicleOdometerValueAtInsertion: 3 bytes
@@ -779,21 +779,21 @@ type Activities_CardIWRecord_builder struct {
 	// See Data Dictionary, Section 2.57, `Datef`.
 	// ASN.1 Definition:
 	//
-	//	Datef ::= OCTET STRING(SIZE(4))
+	//     Datef ::= OCTET STRING(SIZE(4))
 	CardExpiryDate *v1.Date
 	// The time the card was inserted.
 	//
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	CardInsertionTime *timestamppb.Timestamp
 	// The odometer value at the time of card insertion in kilometers.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	OdometerAtInsertionKm *int32
 	// The slot the card was inserted into.
 	//
@@ -804,14 +804,14 @@ type Activities_CardIWRecord_builder struct {
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	CardWithdrawalTime *timestamppb.Timestamp
 	// The odometer value at the time of card withdrawal in kilometers.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	OdometerAtWithdrawalKm *int32
 	// Information about the previous vehicle used.
 	//
@@ -860,7 +860,7 @@ type Activities_PlaceRecord struct {
 	xxx_hidden_EntryTime   *timestamppb.Timestamp      `protobuf:"bytes,1,opt,name=entry_time,json=entryTime"`
 	xxx_hidden_EntryType   v1.EntryTypeDailyWorkPeriod `protobuf:"varint,2,opt,name=entry_type,json=entryType,enum=wayplatform.connect.tachograph.dd.v1.EntryTypeDailyWorkPeriod"`
 	xxx_hidden_Country     v1.NationNumeric            `protobuf:"varint,4,opt,name=country,enum=wayplatform.connect.tachograph.dd.v1.NationNumeric"`
-	xxx_hidden_Region      []byte                      `protobuf:"bytes,6,opt,name=region"`
+	xxx_hidden_Region      int32                       `protobuf:"varint,6,opt,name=region"`
 	xxx_hidden_OdometerKm  int32                       `protobuf:"varint,7,opt,name=odometer_km,json=odometerKm"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
@@ -918,11 +918,11 @@ func (x *Activities_PlaceRecord) GetCountry() v1.NationNumeric {
 	return v1.NationNumeric(0)
 }
 
-func (x *Activities_PlaceRecord) GetRegion() []byte {
+func (x *Activities_PlaceRecord) GetRegion() int32 {
 	if x != nil {
 		return x.xxx_hidden_Region
 	}
-	return nil
+	return 0
 }
 
 func (x *Activities_PlaceRecord) GetOdometerKm() int32 {
@@ -946,10 +946,7 @@ func (x *Activities_PlaceRecord) SetCountry(v v1.NationNumeric) {
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
 }
 
-func (x *Activities_PlaceRecord) SetRegion(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
+func (x *Activities_PlaceRecord) SetRegion(v int32) {
 	x.xxx_hidden_Region = v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
 }
@@ -1010,7 +1007,7 @@ func (x *Activities_PlaceRecord) ClearCountry() {
 
 func (x *Activities_PlaceRecord) ClearRegion() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_Region = nil
+	x.xxx_hidden_Region = 0
 }
 
 func (x *Activities_PlaceRecord) ClearOdometerKm() {
@@ -1026,7 +1023,7 @@ type Activities_PlaceRecord_builder struct {
 	// See Data Dictionary, Section 2.162, `TimeReal`.
 	// ASN.1 Definition:
 	//
-	//	TimeReal ::= INTEGER (0..2^32-1)
+	//     TimeReal ::= INTEGER (0..2^32-1)
 	EntryTime *timestamppb.Timestamp
 	// Type of entry (begin or end).
 	//
@@ -1037,7 +1034,7 @@ type Activities_PlaceRecord_builder struct {
 	// See Data Dictionary, Section 2.101, `NationNumeric`.
 	// ASN.1 Definition:
 	//
-	//	NationNumeric ::= INTEGER (0..255)
+	//     NationNumeric ::= INTEGER (0..255)
 	Country *v1.NationNumeric
 	// Region code. This is not a number, but a single-byte identifier.
 	//
@@ -1052,14 +1049,17 @@ type Activities_PlaceRecord_builder struct {
 	// See Data Dictionary, Section 2.122, `RegionNumeric`.
 	// ASN.1 Definition:
 	//
-	//	RegionNumeric ::= OCTET STRING (SIZE (1))
-	Region []byte
+	//     RegionNumeric ::= OCTET STRING (SIZE (1))
+	//
+	// The byte is held as its unsigned value, where 0 means no information
+	// available.
+	Region *int32
 	// Odometer value at the time of entry in kilometers.
 	//
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	OdometerKm *int32
 }
 
@@ -1078,7 +1078,7 @@ func (b0 Activities_PlaceRecord_builder) Build() *Activities_PlaceRecord {
 	}
 	if b.Region != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
-		x.xxx_hidden_Region = b.Region
+		x.xxx_hidden_Region = *b.Region
 	}
 	if b.OdometerKm != nil {
 		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
@@ -1310,9 +1310,9 @@ func (b0 Activities_GnssRecord_builder) Build() *Activities_GnssRecord {
 
 // Represents a border crossing record (Gen2v2+).
 //
-// Binary Layout: 57 bytes total
-//   - cardNumberAndGenDriverSlot: 20 bytes
-//   - cardNumberAndGenCodriverSlot: 20 bytes
+// Binary Layout: 55 bytes total
+//   - cardNumberAndGenDriverSlot: 19 bytes
+//   - cardNumberAndGenCodriverSlot: 19 bytes
 //   - countryLeft: 1 byte
 //   - countryEntered: 1 byte
 //   - gnssPlaceAuthRecord: 12 bytes (4 + 1 + 6)
@@ -1535,7 +1535,7 @@ type Activities_BorderCrossingRecord_builder struct {
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	OdometerKm *int32
 }
 
@@ -1563,11 +1563,11 @@ func (b0 Activities_BorderCrossingRecord_builder) Build() *Activities_BorderCros
 
 // Represents a load/unload operation record (Gen2v2+).
 //
-// Binary Layout: 60 bytes total
+// Binary Layout: 58 bytes total
 //   - timeStamp: 4 bytes
 //   - operationType: 1 byte
-//   - cardNumberAndGenDriverSlot: 20 bytes
-//   - cardNumberAndGenCodriverSlot: 20 bytes
+//   - cardNumberAndGenDriverSlot: 19 bytes
+//   - cardNumberAndGenCodriverSlot: 19 bytes
 //   - gnssPlaceAuthRecord: 12 bytes (4 + 1 + 6)
 //   - vehicleOdometerValue: 3 bytes
 //
@@ -1780,7 +1780,7 @@ type Activities_LoadUnloadRecord_builder struct {
 	// See Data Dictionary, Section 2.113, `OdometerShort`.
 	// ASN.1 Definition:
 	//
-	//	OdometerShort ::= INTEGER(0..999999)
+	//     OdometerShort ::= INTEGER(0..999999)
 	OdometerKm *int32
 }
 
@@ -1846,7 +1846,7 @@ const file_wayplatform_connect_tachograph_vu_v1_activities_proto_rawDesc = "" +
 	"\n" +
 	"entry_type\x18\x02 \x01(\x0e2>.wayplatform.connect.tachograph.dd.v1.EntryTypeDailyWorkPeriodR\tentryType\x12M\n" +
 	"\acountry\x18\x04 \x01(\x0e23.wayplatform.connect.tachograph.dd.v1.NationNumericR\acountry\x12\x16\n" +
-	"\x06region\x18\x06 \x01(\fR\x06region\x12\x1f\n" +
+	"\x06region\x18\x06 \x01(\x05R\x06region\x12\x1f\n" +
 	"\vodometer_km\x18\a \x01(\x05R\n" +
 	"odometerKm\x1a\x91\x03\n" +
 	"\n" +
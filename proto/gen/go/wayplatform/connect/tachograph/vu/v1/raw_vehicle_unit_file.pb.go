@@ -96,6 +96,7 @@ type RawVehicleUnitFile_Record struct {
 	xxx_hidden_Generation     v1.Generation          `protobuf:"varint,3,opt,name=generation,enum=wayplatform.connect.tachograph.dd.v1.Generation"`
 	xxx_hidden_Value          []byte                 `protobuf:"bytes,4,opt,name=value"`
 	xxx_hidden_SignatureSize  int32                  `protobuf:"varint,5,opt,name=signature_size,json=signatureSize"`
+	xxx_hidden_FileOffset     int32                  `protobuf:"varint,6,opt,name=file_offset,json=fileOffset"`
 	xxx_hidden_Authentication *v11.Authentication    `protobuf:"bytes,99,opt,name=authentication"`
 	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
 	XXX_presence              [1]uint32
@@ -167,6 +168,13 @@ func (x *RawVehicleUnitFile_Record) GetSignatureSize() int32 {
 	return 0
 }
 
+func (x *RawVehicleUnitFile_Record) GetFileOffset() int32 {
+	if x != nil {
+		return x.xxx_hidden_FileOffset
+	}
+	return 0
+}
+
 func (x *RawVehicleUnitFile_Record) GetAuthentication() *v11.Authentication {
 	if x != nil {
 		return x.xxx_hidden_Authentication
@@ -176,17 +184,17 @@ func (x *RawVehicleUnitFile_Record) GetAuthentication() *v11.Authentication {
 
 func (x *RawVehicleUnitFile_Record) SetTag(v uint32) {
 	x.xxx_hidden_Tag = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
 }
 
 func (x *RawVehicleUnitFile_Record) SetType(v TransferType) {
 	x.xxx_hidden_Type = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
 }
 
 func (x *RawVehicleUnitFile_Record) SetGeneration(v v1.Generation) {
 	x.xxx_hidden_Generation = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
 }
 
 func (x *RawVehicleUnitFile_Record) SetValue(v []byte) {
@@ -194,12 +202,17 @@ func (x *RawVehicleUnitFile_Record) SetValue(v []byte) {
 		v = []byte{}
 	}
 	x.xxx_hidden_Value = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
 }
 
 func (x *RawVehicleUnitFile_Record) SetSignatureSize(v int32) {
 	x.xxx_hidden_SignatureSize = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 6)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+}
+
+func (x *RawVehicleUnitFile_Record) SetFileOffset(v int32) {
+	x.xxx_hidden_FileOffset = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 7)
 }
 
 func (x *RawVehicleUnitFile_Record) SetAuthentication(v *v11.Authentication) {
@@ -241,6 +254,13 @@ func (x *RawVehicleUnitFile_Record) HasSignatureSize() bool {
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
+func (x *RawVehicleUnitFile_Record) HasFileOffset() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
 func (x *RawVehicleUnitFile_Record) HasAuthentication() bool {
 	if x == nil {
 		return false
@@ -273,6 +293,11 @@ func (x *RawVehicleUnitFile_Record) ClearSignatureSize() {
 	x.xxx_hidden_SignatureSize = 0
 }
 
+func (x *RawVehicleUnitFile_Record) ClearFileOffset() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_FileOffset = 0
+}
+
 func (x *RawVehicleUnitFile_Record) ClearAuthentication() {
 	x.xxx_hidden_Authentication = nil
 }
@@ -299,6 +324,11 @@ type RawVehicleUnitFile_Record_builder struct {
 	// Generation 1: Always 128 (RSA-1024 signature)
 	// Generation 2: Variable length (ECDSA signature, determined by sizeOf functions)
 	SignatureSize *int32
+	// The byte offset of the record's tag within the original VU file.
+	//
+	// The record spans the length of `value` + 2 bytes from this offset
+	// (2-byte tag, followed by the value).
+	FileOffset *int32
 	// Result of cryptographic signature authentication for this record.
 	// Present when signature verification has been performed.
 	Authentication *v11.Authentication
@@ -309,25 +339,29 @@ func (b0 RawVehicleUnitFile_Record_builder) Build() *RawVehicleUnitFile_Record {
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Tag != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
 		x.xxx_hidden_Tag = *b.Tag
 	}
 	if b.Type != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
 		x.xxx_hidden_Type = *b.Type
 	}
 	if b.Generation != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
 		x.xxx_hidden_Generation = *b.Generation
 	}
 	if b.Value != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
 		x.xxx_hidden_Value = b.Value
 	}
 	if b.SignatureSize != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 6)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
 		x.xxx_hidden_SignatureSize = *b.SignatureSize
 	}
+	if b.FileOffset != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 7)
+		x.xxx_hidden_FileOffset = *b.FileOffset
+	}
 	x.xxx_hidden_Authentication = b.Authentication
 	return m0
 }
@@ -336,9 +370,9 @@ var File_wayplatform_connect_tachograph_vu_v1_raw_vehicle_unit_file_proto protor
 
 const file_wayplatform_connect_tachograph_vu_v1_raw_vehicle_unit_file_proto_rawDesc = "" +
 	"\n" +
-	"@wayplatform/connect/tachograph/vu/v1/raw_vehicle_unit_file.proto\x12$wayplatform.connect.tachograph.vu.v1\x1a5wayplatform/connect/tachograph/dd/v1/generation.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\x1a8wayplatform/connect/tachograph/vu/v1/transfer_type.proto\"\xc7\x03\n" +
+	"@wayplatform/connect/tachograph/vu/v1/raw_vehicle_unit_file.proto\x12$wayplatform.connect.tachograph.vu.v1\x1a5wayplatform/connect/tachograph/dd/v1/generation.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\x1a8wayplatform/connect/tachograph/vu/v1/transfer_type.proto\"\xe8\x03\n" +
 	"\x12RawVehicleUnitFile\x12Y\n" +
-	"\arecords\x18\x01 \x03(\v2?.wayplatform.connect.tachograph.vu.v1.RawVehicleUnitFile.RecordR\arecords\x1a\xd5\x02\n" +
+	"\arecords\x18\x01 \x03(\v2?.wayplatform.connect.tachograph.vu.v1.RawVehicleUnitFile.RecordR\arecords\x1a\xf6\x02\n" +
 	"\x06Record\x12\x10\n" +
 	"\x03tag\x18\x01 \x01(\rR\x03tag\x12F\n" +
 	"\x04type\x18\x02 \x01(\x0e22.wayplatform.connect.tachograph.vu.v1.TransferTypeR\x04type\x12P\n" +
@@ -346,7 +380,9 @@ const file_wayplatform_connect_tachograph_vu_v1_raw_vehicle_unit_file_proto_rawD
 	"generation\x18\x03 \x01(\x0e20.wayplatform.connect.tachograph.dd.v1.GenerationR\n" +
 	"generation\x12\x14\n" +
 	"\x05value\x18\x04 \x01(\fR\x05value\x12%\n" +
-	"\x0esignature_size\x18\x05 \x01(\x05R\rsignatureSize\x12b\n" +
+	"\x0esignature_size\x18\x05 \x01(\x05R\rsignatureSize\x12\x1f\n" +
+	"\vfile_offset\x18\x06 \x01(\x05R\n" +
+	"fileOffset\x12b\n" +
 	"\x0eauthentication\x18c \x01(\v2:.wayplatform.connect.tachograph.security.v1.AuthenticationR\x0eauthenticationB\xd6\x02\n" +
 	"(com.wayplatform.connect.tachograph.vu.v1B\x17RawVehicleUnitFileProtoP\x01Z\\github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1;vuv1\xa2\x02\x04WCTV\xaa\x02$Wayplatform.Connect.Tachograph.Vu.V1\xca\x02$Wayplatform\\Connect\\Tachograph\\Vu\\V1\xe2\x020Wayplatform\\Connect\\Tachograph\\Vu\\V1\\GPBMetadata\xea\x02(Wayplatform::Connect::Tachograph::Vu::V1b\beditionsp\xe8\a"
 
@@ -32,12 +32,13 @@ const (
 // ASN.1 Definition:
 //
 //	VuTechnicalDataSecondGen ::= SEQUENCE {
-//	    vuIdentification VuIdentification,
-//	    vuCalibrationRecordArray VuCalibrationRecordArray,
+//	    vuIdentificationRecordArray VuIdentificationRecordArray,
 //	    vuSensorPairedRecordArray VuSensorPairedRecordArray,
 //	    vuSensorExternalGNSSCoupledRecordArray VuSensorExternalGNSSCoupledRecordArray,
+//	    vuCalibrationRecordArray VuCalibrationRecordArray,
 //	    vuCardRecordArray VuCardRecordArray,
 //	    vuITSConsentRecordArray VuITSConsentRecordArray,
+//	    vuPowerSupplyInterruptionRecordArray VuPowerSupplyInterruptionRecordArray,
 //	    signatureRecordArray SignatureRecordArray
 //	}
 type TechnicalDataGen2V1 struct {
@@ -553,7 +554,7 @@ func (b0 TechnicalDataGen2V1_VuIdentification_builder) Build() *TechnicalDataGen
 type TechnicalDataGen2V1_PairedSensor struct {
 	state                     protoimpl.MessageState    `protogen:"opaque.v1"`
 	xxx_hidden_SerialNumber   *v11.ExtendedSerialNumber `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber"`
-	xxx_hidden_ApprovalNumber *v11.StringValue          `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
+	xxx_hidden_ApprovalNumber *v11.Ia5StringValue       `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
 	xxx_hidden_PairingDate    *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=pairing_date,json=pairingDate"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
@@ -591,7 +592,7 @@ func (x *TechnicalDataGen2V1_PairedSensor) GetSerialNumber() *v11.ExtendedSerial
 	return nil
 }
 
-func (x *TechnicalDataGen2V1_PairedSensor) GetApprovalNumber() *v11.StringValue {
+func (x *TechnicalDataGen2V1_PairedSensor) GetApprovalNumber() *v11.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_ApprovalNumber
 	}
@@ -609,7 +610,7 @@ func (x *TechnicalDataGen2V1_PairedSensor) SetSerialNumber(v *v11.ExtendedSerial
 	x.xxx_hidden_SerialNumber = v
 }
 
-func (x *TechnicalDataGen2V1_PairedSensor) SetApprovalNumber(v *v11.StringValue) {
+func (x *TechnicalDataGen2V1_PairedSensor) SetApprovalNumber(v *v11.Ia5StringValue) {
 	x.xxx_hidden_ApprovalNumber = v
 }
 
@@ -660,7 +661,7 @@ type TechnicalDataGen2V1_PairedSensor_builder struct {
 	// The approval number of the motion sensor (Gen2: 16 bytes).
 	//
 	// See Data Dictionary, Section 2.131, `SensorApprovalNumber`.
-	ApprovalNumber *v11.StringValue
+	ApprovalNumber *v11.Ia5StringValue
 	// The date the sensor was paired.
 	//
 	// See Data Dictionary, Section 2.146, `SensorPairingDate`.
@@ -683,7 +684,7 @@ func (b0 TechnicalDataGen2V1_PairedSensor_builder) Build() *TechnicalDataGen2V1_
 type TechnicalDataGen2V1_CoupledGnss struct {
 	state                     protoimpl.MessageState    `protogen:"opaque.v1"`
 	xxx_hidden_SerialNumber   *v11.ExtendedSerialNumber `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber"`
-	xxx_hidden_ApprovalNumber *v11.StringValue          `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
+	xxx_hidden_ApprovalNumber *v11.Ia5StringValue       `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
 	xxx_hidden_CouplingDate   *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=coupling_date,json=couplingDate"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
@@ -721,7 +722,7 @@ func (x *TechnicalDataGen2V1_CoupledGnss) GetSerialNumber() *v11.ExtendedSerialN
 	return nil
 }
 
-func (x *TechnicalDataGen2V1_CoupledGnss) GetApprovalNumber() *v11.StringValue {
+func (x *TechnicalDataGen2V1_CoupledGnss) GetApprovalNumber() *v11.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_ApprovalNumber
 	}
@@ -739,7 +740,7 @@ func (x *TechnicalDataGen2V1_CoupledGnss) SetSerialNumber(v *v11.ExtendedSerialN
 	x.xxx_hidden_SerialNumber = v
 }
 
-func (x *TechnicalDataGen2V1_CoupledGnss) SetApprovalNumber(v *v11.StringValue) {
+func (x *TechnicalDataGen2V1_CoupledGnss) SetApprovalNumber(v *v11.Ia5StringValue) {
 	x.xxx_hidden_ApprovalNumber = v
 }
 
@@ -790,7 +791,7 @@ type TechnicalDataGen2V1_CoupledGnss_builder struct {
 	// The approval number of the external GNSS.
 	//
 	// See Data Dictionary, Section 2.132, `SensorExternalGNSSApprovalNumber`.
-	ApprovalNumber *v11.StringValue
+	ApprovalNumber *v11.Ia5StringValue
 	// The date the GNSS was coupled.
 	//
 	// See Data Dictionary, Section 2.138, `SensorGNSSCouplingDate`.
@@ -1690,7 +1691,7 @@ var File_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v1_proto proto
 
 const file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v1_proto_rawDesc = "" +
 	"\n" +
-	"Awayplatform/connect/tachograph/vu/v1/technical_data_gen2_v1.proto\x12$wayplatform.connect.tachograph.vu.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a>wayplatform/connect/tachograph/dd/v1/calibration_purpose.proto\x1aAwayplatform/connect/tachograph/dd/v1/card_structure_version.proto\x1a/wayplatform/connect/tachograph/dd/v1/date.proto\x1a@wayplatform/connect/tachograph/dd/v1/driver_identification.proto\x1aAwayplatform/connect/tachograph/dd/v1/extended_serial_number.proto\x1aJwayplatform/connect/tachograph/dd/v1/full_card_number_and_generation.proto\x1a?wayplatform/connect/tachograph/dd/v1/owner_identification.proto\x1aBwayplatform/connect/tachograph/dd/v1/software_identification.proto\x1a7wayplatform/connect/tachograph/dd/v1/string_value.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\x8a\"\n" +
+	"Awayplatform/connect/tachograph/vu/v1/technical_data_gen2_v1.proto\x12$wayplatform.connect.tachograph.vu.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a>wayplatform/connect/tachograph/dd/v1/calibration_purpose.proto\x1aAwayplatform/connect/tachograph/dd/v1/card_structure_version.proto\x1a/wayplatform/connect/tachograph/dd/v1/date.proto\x1a@wayplatform/connect/tachograph/dd/v1/driver_identification.proto\x1aAwayplatform/connect/tachograph/dd/v1/extended_serial_number.proto\x1aJwayplatform/connect/tachograph/dd/v1/full_card_number_and_generation.proto\x1a;wayplatform/connect/tachograph/dd/v1/ia5_string_value.proto\x1a?wayplatform/connect/tachograph/dd/v1/owner_identification.proto\x1aBwayplatform/connect/tachograph/dd/v1/software_identification.proto\x1a7wayplatform/connect/tachograph/dd/v1/string_value.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\x90\"\n" +
 	"\x13TechnicalDataGen2V1\x12w\n" +
 	"\x11vu_identification\x18\x01 \x01(\v2J.wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.VuIdentificationR\x10vuIdentification\x12|\n" +
 	"\x13calibration_records\x18\x02 \x03(\v2K.wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecordR\x12calibrationRecords\x12m\n" +
@@ -1709,14 +1710,14 @@ const file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v1_proto_raw
 	"\rserial_number\x18\x04 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12u\n" +
 	"\x17software_identification\x18\x05 \x01(\v2<.wayplatform.connect.tachograph.dd.v1.SoftwareIdentificationR\x16softwareIdentification\x12I\n" +
 	"\x12manufacturing_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11manufacturingDate\x12Z\n" +
-	"\x0fapproval_number\x18\a \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x1a\x8a\x02\n" +
+	"\x0fapproval_number\x18\a \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x1a\x8d\x02\n" +
 	"\fPairedSensor\x12_\n" +
-	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12Z\n" +
-	"\x0fapproval_number\x18\x02 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x12=\n" +
-	"\fpairing_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpairingDate\x1a\x8b\x02\n" +
+	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12]\n" +
+	"\x0fapproval_number\x18\x02 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\x0eapprovalNumber\x12=\n" +
+	"\fpairing_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpairingDate\x1a\x8e\x02\n" +
 	"\vCoupledGnss\x12_\n" +
-	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12Z\n" +
-	"\x0fapproval_number\x18\x02 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x12?\n" +
+	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12]\n" +
+	"\x0fapproval_number\x18\x02 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\x0eapprovalNumber\x12?\n" +
 	"\rcoupling_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcouplingDate\x1a\xa1\v\n" +
 	"\x11CalibrationRecord\x12R\n" +
 	"\apurpose\x18\x01 \x01(\x0e28.wayplatform.connect.tachograph.dd.v1.CalibrationPurposeR\apurpose\x121\n" +
@@ -1764,13 +1765,14 @@ var file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v1_proto_goTyp
 	(*v11.ExtendedSerialNumber)(nil),              // 9: wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
 	(*v11.SoftwareIdentification)(nil),            // 10: wayplatform.connect.tachograph.dd.v1.SoftwareIdentification
 	(*timestamppb.Timestamp)(nil),                 // 11: google.protobuf.Timestamp
-	(v11.CalibrationPurpose)(0),                   // 12: wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
-	(*v11.FullCardNumberAndGeneration)(nil),       // 13: wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
-	(*v11.Date)(nil),                              // 14: wayplatform.connect.tachograph.dd.v1.Date
-	(*v11.VehicleRegistrationIdentification)(nil), // 15: wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
-	(*v11.CardStructureVersion)(nil),              // 16: wayplatform.connect.tachograph.dd.v1.CardStructureVersion
-	(*v11.DriverIdentification)(nil),              // 17: wayplatform.connect.tachograph.dd.v1.DriverIdentification
-	(*v11.OwnerIdentification)(nil),               // 18: wayplatform.connect.tachograph.dd.v1.OwnerIdentification
+	(*v11.Ia5StringValue)(nil),                    // 12: wayplatform.connect.tachograph.dd.v1.Ia5StringValue
+	(v11.CalibrationPurpose)(0),                   // 13: wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
+	(*v11.FullCardNumberAndGeneration)(nil),       // 14: wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	(*v11.Date)(nil),                              // 15: wayplatform.connect.tachograph.dd.v1.Date
+	(*v11.VehicleRegistrationIdentification)(nil), // 16: wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
+	(*v11.CardStructureVersion)(nil),              // 17: wayplatform.connect.tachograph.dd.v1.CardStructureVersion
+	(*v11.DriverIdentification)(nil),              // 18: wayplatform.connect.tachograph.dd.v1.DriverIdentification
+	(*v11.OwnerIdentification)(nil),               // 19: wayplatform.connect.tachograph.dd.v1.OwnerIdentification
 }
 var file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v1_proto_depIdxs = []int32{
 	1,  // 0: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.vu_identification:type_name -> wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.VuIdentification
@@ -1788,28 +1790,28 @@ var file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v1_proto_depId
 	11, // 12: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.VuIdentification.manufacturing_date:type_name -> google.protobuf.Timestamp
 	8,  // 13: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.VuIdentification.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
 	9,  // 14: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.PairedSensor.serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	8,  // 15: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.PairedSensor.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
+	12, // 15: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.PairedSensor.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
 	11, // 16: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.PairedSensor.pairing_date:type_name -> google.protobuf.Timestamp
 	9,  // 17: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CoupledGnss.serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	8,  // 18: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CoupledGnss.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
+	12, // 18: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CoupledGnss.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
 	11, // 19: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CoupledGnss.coupling_date:type_name -> google.protobuf.Timestamp
-	12, // 20: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.purpose:type_name -> wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
+	13, // 20: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.purpose:type_name -> wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
 	8,  // 21: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.workshop_name:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
 	8,  // 22: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.workshop_address:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	13, // 23: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.workshop_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
-	14, // 24: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.workshop_card_expiry_date:type_name -> wayplatform.connect.tachograph.dd.v1.Date
+	14, // 23: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.workshop_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	15, // 24: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.workshop_card_expiry_date:type_name -> wayplatform.connect.tachograph.dd.v1.Date
 	8,  // 25: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.vin:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	15, // 26: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.vehicle_registration:type_name -> wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
+	16, // 26: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.vehicle_registration:type_name -> wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
 	8,  // 27: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.tyre_size:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
 	11, // 28: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.old_time_value:type_name -> google.protobuf.Timestamp
 	11, // 29: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.new_time_value:type_name -> google.protobuf.Timestamp
 	11, // 30: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CalibrationRecord.next_calibration_date:type_name -> google.protobuf.Timestamp
-	13, // 31: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	14, // 31: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
 	9,  // 32: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.card_extended_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	16, // 33: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.card_structure_version:type_name -> wayplatform.connect.tachograph.dd.v1.CardStructureVersion
-	17, // 34: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.driver_identification:type_name -> wayplatform.connect.tachograph.dd.v1.DriverIdentification
-	18, // 35: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.owner_identification:type_name -> wayplatform.connect.tachograph.dd.v1.OwnerIdentification
-	13, // 36: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.ItsConsentRecord.full_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	17, // 33: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.card_structure_version:type_name -> wayplatform.connect.tachograph.dd.v1.CardStructureVersion
+	18, // 34: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.driver_identification:type_name -> wayplatform.connect.tachograph.dd.v1.DriverIdentification
+	19, // 35: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.CardRecord.owner_identification:type_name -> wayplatform.connect.tachograph.dd.v1.OwnerIdentification
+	14, // 36: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V1.ItsConsentRecord.full_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
 	37, // [37:37] is the sub-list for method output_type
 	37, // [37:37] is the sub-list for method input_type
 	37, // [37:37] is the sub-list for extension type_name
@@ -27,15 +27,15 @@ const (
 //
 // See Appendix 7, Section 2.2.6.6 (TREP 35 Hex).
 //
-// Gen2 V2 extends Gen2 V1 with power supply interruption records.
+// Gen2 V2 uses the same record arrays as Gen2 V1.
 //
 // ASN.1 Definition:
 //
 //	VuTechnicalDataSecondGenV2 ::= SEQUENCE {
-//	    vuIdentification VuIdentification,
-//	    vuCalibrationRecordArray VuCalibrationRecordArray,
+//	    vuIdentificationRecordArray VuIdentificationRecordArray,
 //	    vuSensorPairedRecordArray VuSensorPairedRecordArray,
 //	    vuSensorExternalGNSSCoupledRecordArray VuSensorExternalGNSSCoupledRecordArray,
+//	    vuCalibrationRecordArray VuCalibrationRecordArray,
 //	    vuCardRecordArray VuCardRecordArray,
 //	    vuITSConsentRecordArray VuITSConsentRecordArray,
 //	    vuPowerSupplyInterruptionRecordArray VuPowerSupplyInterruptionRecordArray,
@@ -573,7 +573,7 @@ func (b0 TechnicalDataGen2V2_VuIdentification_builder) Build() *TechnicalDataGen
 type TechnicalDataGen2V2_PairedSensor struct {
 	state                     protoimpl.MessageState    `protogen:"opaque.v1"`
 	xxx_hidden_SerialNumber   *v11.ExtendedSerialNumber `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber"`
-	xxx_hidden_ApprovalNumber *v11.StringValue          `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
+	xxx_hidden_ApprovalNumber *v11.Ia5StringValue       `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
 	xxx_hidden_PairingDate    *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=pairing_date,json=pairingDate"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
@@ -611,7 +611,7 @@ func (x *TechnicalDataGen2V2_PairedSensor) GetSerialNumber() *v11.ExtendedSerial
 	return nil
 }
 
-func (x *TechnicalDataGen2V2_PairedSensor) GetApprovalNumber() *v11.StringValue {
+func (x *TechnicalDataGen2V2_PairedSensor) GetApprovalNumber() *v11.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_ApprovalNumber
 	}
@@ -629,7 +629,7 @@ func (x *TechnicalDataGen2V2_PairedSensor) SetSerialNumber(v *v11.ExtendedSerial
 	x.xxx_hidden_SerialNumber = v
 }
 
-func (x *TechnicalDataGen2V2_PairedSensor) SetApprovalNumber(v *v11.StringValue) {
+func (x *TechnicalDataGen2V2_PairedSensor) SetApprovalNumber(v *v11.Ia5StringValue) {
 	x.xxx_hidden_ApprovalNumber = v
 }
 
@@ -680,7 +680,7 @@ type TechnicalDataGen2V2_PairedSensor_builder struct {
 	// The approval number of the motion sensor (Gen2: 16 bytes).
 	//
 	// See Data Dictionary, Section 2.131, `SensorApprovalNumber`.
-	ApprovalNumber *v11.StringValue
+	ApprovalNumber *v11.Ia5StringValue
 	// The date the sensor was paired.
 	//
 	// See Data Dictionary, Section 2.146, `SensorPairingDate`.
@@ -703,7 +703,7 @@ func (b0 TechnicalDataGen2V2_PairedSensor_builder) Build() *TechnicalDataGen2V2_
 type TechnicalDataGen2V2_CoupledGnss struct {
 	state                     protoimpl.MessageState    `protogen:"opaque.v1"`
 	xxx_hidden_SerialNumber   *v11.ExtendedSerialNumber `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber"`
-	xxx_hidden_ApprovalNumber *v11.StringValue          `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
+	xxx_hidden_ApprovalNumber *v11.Ia5StringValue       `protobuf:"bytes,2,opt,name=approval_number,json=approvalNumber"`
 	xxx_hidden_CouplingDate   *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=coupling_date,json=couplingDate"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
@@ -741,7 +741,7 @@ func (x *TechnicalDataGen2V2_CoupledGnss) GetSerialNumber() *v11.ExtendedSerialN
 	return nil
 }
 
-func (x *TechnicalDataGen2V2_CoupledGnss) GetApprovalNumber() *v11.StringValue {
+func (x *TechnicalDataGen2V2_CoupledGnss) GetApprovalNumber() *v11.Ia5StringValue {
 	if x != nil {
 		return x.xxx_hidden_ApprovalNumber
 	}
@@ -759,7 +759,7 @@ func (x *TechnicalDataGen2V2_CoupledGnss) SetSerialNumber(v *v11.ExtendedSerialN
 	x.xxx_hidden_SerialNumber = v
 }
 
-func (x *TechnicalDataGen2V2_CoupledGnss) SetApprovalNumber(v *v11.StringValue) {
+func (x *TechnicalDataGen2V2_CoupledGnss) SetApprovalNumber(v *v11.Ia5StringValue) {
 	x.xxx_hidden_ApprovalNumber = v
 }
 
@@ -810,7 +810,7 @@ type TechnicalDataGen2V2_CoupledGnss_builder struct {
 	// The approval number of the external GNSS.
 	//
 	// See Data Dictionary, Section 2.132, `SensorExternalGNSSApprovalNumber`.
-	ApprovalNumber *v11.StringValue
+	ApprovalNumber *v11.Ia5StringValue
 	// The date the GNSS was coupled.
 	//
 	// See Data Dictionary, Section 2.138, `SensorGNSSCouplingDate`.
@@ -1851,7 +1851,7 @@ var File_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v2_proto proto
 
 const file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v2_proto_rawDesc = "" +
 	"\n" +
-	"Awayplatform/connect/tachograph/vu/v1/technical_data_gen2_v2.proto\x12$wayplatform.connect.tachograph.vu.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a>wayplatform/connect/tachograph/dd/v1/calibration_purpose.proto\x1a;wayplatform/connect/tachograph/dd/v1/card_slot_number.proto\x1aAwayplatform/connect/tachograph/dd/v1/card_structure_version.proto\x1a/wayplatform/connect/tachograph/dd/v1/date.proto\x1a@wayplatform/connect/tachograph/dd/v1/driver_identification.proto\x1aAwayplatform/connect/tachograph/dd/v1/extended_serial_number.proto\x1aJwayplatform/connect/tachograph/dd/v1/full_card_number_and_generation.proto\x1a?wayplatform/connect/tachograph/dd/v1/owner_identification.proto\x1aBwayplatform/connect/tachograph/dd/v1/software_identification.proto\x1a7wayplatform/connect/tachograph/dd/v1/string_value.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\xa1%\n" +
+	"Awayplatform/connect/tachograph/vu/v1/technical_data_gen2_v2.proto\x12$wayplatform.connect.tachograph.vu.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a>wayplatform/connect/tachograph/dd/v1/calibration_purpose.proto\x1a;wayplatform/connect/tachograph/dd/v1/card_slot_number.proto\x1aAwayplatform/connect/tachograph/dd/v1/card_structure_version.proto\x1a/wayplatform/connect/tachograph/dd/v1/date.proto\x1a@wayplatform/connect/tachograph/dd/v1/driver_identification.proto\x1aAwayplatform/connect/tachograph/dd/v1/extended_serial_number.proto\x1aJwayplatform/connect/tachograph/dd/v1/full_card_number_and_generation.proto\x1a;wayplatform/connect/tachograph/dd/v1/ia5_string_value.proto\x1a?wayplatform/connect/tachograph/dd/v1/owner_identification.proto\x1aBwayplatform/connect/tachograph/dd/v1/software_identification.proto\x1a7wayplatform/connect/tachograph/dd/v1/string_value.proto\x1aNwayplatform/connect/tachograph/dd/v1/vehicle_registration_identification.proto\x1a?wayplatform/connect/tachograph/security/v1/authentication.proto\"\xa7%\n" +
 	"\x13TechnicalDataGen2V2\x12w\n" +
 	"\x11vu_identification\x18\x01 \x01(\v2J.wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.VuIdentificationR\x10vuIdentification\x12|\n" +
 	"\x13calibration_records\x18\x02 \x03(\v2K.wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecordR\x12calibrationRecords\x12m\n" +
@@ -1871,14 +1871,14 @@ const file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v2_proto_raw
 	"\rserial_number\x18\x04 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12u\n" +
 	"\x17software_identification\x18\x05 \x01(\v2<.wayplatform.connect.tachograph.dd.v1.SoftwareIdentificationR\x16softwareIdentification\x12I\n" +
 	"\x12manufacturing_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11manufacturingDate\x12Z\n" +
-	"\x0fapproval_number\x18\a \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x1a\x8a\x02\n" +
+	"\x0fapproval_number\x18\a \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x1a\x8d\x02\n" +
 	"\fPairedSensor\x12_\n" +
-	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12Z\n" +
-	"\x0fapproval_number\x18\x02 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x12=\n" +
-	"\fpairing_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpairingDate\x1a\x8b\x02\n" +
+	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12]\n" +
+	"\x0fapproval_number\x18\x02 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\x0eapprovalNumber\x12=\n" +
+	"\fpairing_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpairingDate\x1a\x8e\x02\n" +
 	"\vCoupledGnss\x12_\n" +
-	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12Z\n" +
-	"\x0fapproval_number\x18\x02 \x01(\v21.wayplatform.connect.tachograph.dd.v1.StringValueR\x0eapprovalNumber\x12?\n" +
+	"\rserial_number\x18\x01 \x01(\v2:.wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumberR\fserialNumber\x12]\n" +
+	"\x0fapproval_number\x18\x02 \x01(\v24.wayplatform.connect.tachograph.dd.v1.Ia5StringValueR\x0eapprovalNumber\x12?\n" +
 	"\rcoupling_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcouplingDate\x1a\xa1\v\n" +
 	"\x11CalibrationRecord\x12R\n" +
 	"\apurpose\x18\x01 \x01(\x0e28.wayplatform.connect.tachograph.dd.v1.CalibrationPurposeR\apurpose\x121\n" +
@@ -1931,14 +1931,15 @@ var file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v2_proto_goTyp
 	(*v11.ExtendedSerialNumber)(nil),                          // 10: wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
 	(*v11.SoftwareIdentification)(nil),                        // 11: wayplatform.connect.tachograph.dd.v1.SoftwareIdentification
 	(*timestamppb.Timestamp)(nil),                             // 12: google.protobuf.Timestamp
-	(v11.CalibrationPurpose)(0),                               // 13: wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
-	(*v11.FullCardNumberAndGeneration)(nil),                   // 14: wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
-	(*v11.Date)(nil),                                          // 15: wayplatform.connect.tachograph.dd.v1.Date
-	(*v11.VehicleRegistrationIdentification)(nil),             // 16: wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
-	(*v11.CardStructureVersion)(nil),                          // 17: wayplatform.connect.tachograph.dd.v1.CardStructureVersion
-	(*v11.DriverIdentification)(nil),                          // 18: wayplatform.connect.tachograph.dd.v1.DriverIdentification
-	(*v11.OwnerIdentification)(nil),                           // 19: wayplatform.connect.tachograph.dd.v1.OwnerIdentification
-	(v11.CardSlotNumber)(0),                                   // 20: wayplatform.connect.tachograph.dd.v1.CardSlotNumber
+	(*v11.Ia5StringValue)(nil),                                // 13: wayplatform.connect.tachograph.dd.v1.Ia5StringValue
+	(v11.CalibrationPurpose)(0),                               // 14: wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
+	(*v11.FullCardNumberAndGeneration)(nil),                   // 15: wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	(*v11.Date)(nil),                                          // 16: wayplatform.connect.tachograph.dd.v1.Date
+	(*v11.VehicleRegistrationIdentification)(nil),             // 17: wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
+	(*v11.CardStructureVersion)(nil),                          // 18: wayplatform.connect.tachograph.dd.v1.CardStructureVersion
+	(*v11.DriverIdentification)(nil),                          // 19: wayplatform.connect.tachograph.dd.v1.DriverIdentification
+	(*v11.OwnerIdentification)(nil),                           // 20: wayplatform.connect.tachograph.dd.v1.OwnerIdentification
+	(v11.CardSlotNumber)(0),                                   // 21: wayplatform.connect.tachograph.dd.v1.CardSlotNumber
 }
 var file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v2_proto_depIdxs = []int32{
 	1,  // 0: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.vu_identification:type_name -> wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.VuIdentification
@@ -1957,30 +1958,30 @@ var file_wayplatform_connect_tachograph_vu_v1_technical_data_gen2_v2_proto_depId
 	12, // 13: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.VuIdentification.manufacturing_date:type_name -> google.protobuf.Timestamp
 	9,  // 14: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.VuIdentification.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
 	10, // 15: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PairedSensor.serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	9,  // 16: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PairedSensor.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
+	13, // 16: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PairedSensor.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
 	12, // 17: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PairedSensor.pairing_date:type_name -> google.protobuf.Timestamp
 	10, // 18: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CoupledGnss.serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	9,  // 19: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CoupledGnss.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
+	13, // 19: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CoupledGnss.approval_number:type_name -> wayplatform.connect.tachograph.dd.v1.Ia5StringValue
 	12, // 20: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CoupledGnss.coupling_date:type_name -> google.protobuf.Timestamp
-	13, // 21: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.purpose:type_name -> wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
+	14, // 21: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.purpose:type_name -> wayplatform.connect.tachograph.dd.v1.CalibrationPurpose
 	9,  // 22: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.workshop_name:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
 	9,  // 23: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.workshop_address:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	14, // 24: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.workshop_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
-	15, // 25: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.workshop_card_expiry_date:type_name -> wayplatform.connect.tachograph.dd.v1.Date
+	15, // 24: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.workshop_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	16, // 25: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.workshop_card_expiry_date:type_name -> wayplatform.connect.tachograph.dd.v1.Date
 	9,  // 26: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.vin:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
-	16, // 27: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.vehicle_registration:type_name -> wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
+	17, // 27: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.vehicle_registration:type_name -> wayplatform.connect.tachograph.dd.v1.VehicleRegistrationIdentification
 	9,  // 28: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.tyre_size:type_name -> wayplatform.connect.tachograph.dd.v1.StringValue
 	12, // 29: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.old_time_value:type_name -> google.protobuf.Timestamp
 	12, // 30: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.new_time_value:type_name -> google.protobuf.Timestamp
 	12, // 31: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CalibrationRecord.next_calibration_date:type_name -> google.protobuf.Timestamp
-	14, // 32: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	15, // 32: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
 	10, // 33: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.card_extended_serial_number:type_name -> wayplatform.connect.tachograph.dd.v1.ExtendedSerialNumber
-	17, // 34: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.card_structure_version:type_name -> wayplatform.connect.tachograph.dd.v1.CardStructureVersion
-	18, // 35: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.driver_identification:type_name -> wayplatform.connect.tachograph.dd.v1.DriverIdentification
-	19, // 36: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.owner_identification:type_name -> wayplatform.connect.tachograph.dd.v1.OwnerIdentification
-	14, // 37: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.ItsConsentRecord.full_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
+	18, // 34: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.card_structure_version:type_name -> wayplatform.connect.tachograph.dd.v1.CardStructureVersion
+	19, // 35: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.driver_identification:type_name -> wayplatform.connect.tachograph.dd.v1.DriverIdentification
+	20, // 36: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.CardRecord.owner_identification:type_name -> wayplatform.connect.tachograph.dd.v1.OwnerIdentification
+	15, // 37: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.ItsConsentRecord.full_card_number_and_generation:type_name -> wayplatform.connect.tachograph.dd.v1.FullCardNumberAndGeneration
 	12, // 38: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PowerSupplyInterruptionRecord.timestamp:type_name -> google.protobuf.Timestamp
-	20, // 39: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PowerSupplyInterruptionRecord.card_slot_number:type_name -> wayplatform.connect.tachograph.dd.v1.CardSlotNumber
+	21, // 39: wayplatform.connect.tachograph.vu.v1.TechnicalDataGen2V2.PowerSupplyInterruptionRecord.card_slot_number:type_name -> wayplatform.connect.tachograph.dd.v1.CardSlotNumber
 	40, // [40:40] is the sub-list for method output_type
 	40, // [40:40] is the sub-list for method input_type
 	40, // [40:40] is the sub-list for extension type_name
@@ -0,0 +1,73 @@
+package tachograph
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// roundTripContext is the number of bytes from the first divergent offset
+// reported in a RoundTripResult.
+const roundTripContext = 16
+
+// RoundTripResult is the outcome of a binary round trip of a tachograph file
+// through its parsed representation.
+type RoundTripResult struct {
+	// Output holds the bytes produced by the round trip.
+	Output []byte
+
+	// Equal reports whether Output is identical to the input.
+	Equal bool
+
+	// Offset is the offset of the first byte at which Output diverges from
+	// the input, or -1 if they are equal. If one is a prefix of the other,
+	// Offset is the length of the shorter one.
+	Offset int
+
+	// Expected and Actual hold up to 16 bytes of the input and of Output,
+	// starting at Offset. They are empty if the data are equal.
+	Expected, Actual []byte
+}
+
+// RoundTrip checks that a tachograph file survives a round trip through its
+// parsed representation: it unmarshals, parses, unparses and marshals data
+// with default options, and compares the result with data.
+//
+// The input is unmarshaled in strict mode and is not decompressed. An error
+// is returned if a step fails; a round trip that completes but does not
+// reproduce data is reported in the result, not as an error.
+func RoundTrip(data []byte) (RoundTripResult, error) {
+	rawFile, err := UnmarshalOptions{Strict: true}.Unmarshal(data)
+	if err != nil {
+		return RoundTripResult{}, fmt.Errorf("unmarshal: %w", err)
+	}
+	file, err := Parse(rawFile)
+	if err != nil {
+		return RoundTripResult{}, fmt.Errorf("parse: %w", err)
+	}
+	unparsed, err := Unparse(file)
+	if err != nil {
+		return RoundTripResult{}, fmt.Errorf("unparse: %w", err)
+	}
+	output, err := MarshalFile(unparsed)
+	if err != nil {
+		return RoundTripResult{}, fmt.Errorf("marshal: %w", err)
+	}
+	return newRoundTripResult(data, output), nil
+}
+
+// newRoundTripResult compares the input and output of a round trip.
+func newRoundTripResult(input, output []byte) RoundTripResult {
+	result := RoundTripResult{Output: output, Offset: -1}
+	if bytes.Equal(input, output) {
+		result.Equal = true
+		return result
+	}
+	offset := 0
+	for offset < len(input) && offset < len(output) && input[offset] == output[offset] {
+		offset++
+	}
+	result.Offset = offset
+	result.Expected = input[offset:min(offset+roundTripContext, len(input))]
+	result.Actual = output[offset:min(offset+roundTripContext, len(output))]
+	return result
+}
@@ -0,0 +1,68 @@
+package tachograph
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+)
+
+func TestRoundTrip(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	data := append(binary.BigEndian.AppendUint16(nil, 0x7601), value...)
+
+	result, err := RoundTrip(data)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	if !result.Equal || result.Offset != -1 {
+		t.Errorf("RoundTrip() = {Equal: %v, Offset: %d}, want an equal round trip", result.Equal, result.Offset)
+	}
+
+	if _, err := RoundTrip([]byte{0x00}); err == nil {
+		t.Error("RoundTrip() of invalid data succeeded, want error")
+	}
+}
+
+func TestNewRoundTripResult(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		input, output []byte
+		want          RoundTripResult
+	}{
+		{
+			name:   "equal",
+			input:  []byte{1, 2, 3},
+			output: []byte{1, 2, 3},
+			want:   RoundTripResult{Output: []byte{1, 2, 3}, Equal: true, Offset: -1},
+		},
+		{
+			name:   "divergent byte",
+			input:  []byte{1, 2, 3, 4},
+			output: []byte{1, 2, 9, 4},
+			want:   RoundTripResult{Output: []byte{1, 2, 9, 4}, Offset: 2, Expected: []byte{3, 4}, Actual: []byte{9, 4}},
+		},
+		{
+			name:   "truncated output",
+			input:  []byte{1, 2, 3},
+			output: []byte{1, 2},
+			want:   RoundTripResult{Output: []byte{1, 2}, Offset: 2, Expected: []byte{3}, Actual: []byte{}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, newRoundTripResult(tt.input, tt.output)); diff != "" {
+				t.Errorf("newRoundTripResult() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
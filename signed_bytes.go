@@ -0,0 +1,33 @@
+package tachograph
+
+import (
+	"github.com/way-platform/tachograph-go/internal/card"
+	"github.com/way-platform/tachograph-go/internal/vu"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// CardSignedBytes returns the bytes of an EF of a raw card file over which
+// its signature is computed, as checked by Authenticate.
+//
+// The signature of an EF is computed over its whole data content, and stored
+// in the signature record that follows the data record (Appendix 7,
+// Section 3). The returned slice aliases the value of the record. An error is
+// returned for signature records and for EFs that are not signed, such as the
+// certificates and EF_ICC.
+func CardSignedBytes(record *cardv1.RawCardFile_Record) ([]byte, error) {
+	return card.SignedBytes(record)
+}
+
+// VehicleUnitSignedBytes returns the bytes of a transfer of a raw vehicle unit
+// file over which its signature is computed, as checked by Authenticate.
+//
+// Gen1 transfers are signed over all data preceding the signature, except for
+// the Overview, whose signature starts after the certificates (Appendix 7,
+// Section 2.2.6.2). Gen2 transfers are signed over all record arrays preceding
+// the SignatureRecordArray. The returned slice aliases the value of the
+// record. An error is returned for transfers without a signature, such as the
+// download interface version.
+func VehicleUnitSignedBytes(record *vuv1.RawVehicleUnitFile_Record) ([]byte, error) {
+	return vu.SignedBytes(record)
+}
@@ -0,0 +1,56 @@
+package tachograph
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// rawDataField is the name of the fields holding the raw bytes of parsed
+// elements, populated when ParseOptions.PreserveRawData is set.
+const rawDataField protoreflect.Name = "raw_data"
+
+// StripRawData clears the raw_data fields of a message and all messages
+// nested in it, in place, such as a parsed File.
+//
+// This shrinks the serialized message, for example protojson output served
+// by an API, once the raw bytes are no longer needed. Stripping gives up the
+// binary round-trip: without raw data to paint over, Marshal no longer
+// reproduces reserved bits and unused record slots, and fails for messages
+// it can only marshal from their raw data, such as Gen2 VU overviews.
+func StripRawData(m proto.Message) {
+	if m == nil {
+		return
+	}
+	stripRawData(m.ProtoReflect())
+}
+
+func stripRawData(m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+	if fd := m.Descriptor().Fields().ByName(rawDataField); fd != nil && fd.Kind() == protoreflect.BytesKind && !fd.IsList() {
+		m.Clear(fd)
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				stripRawData(list.Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					stripRawData(v.Message())
+					return true
+				})
+			}
+		default:
+			stripRawData(v.Message())
+		}
+		return true
+	})
+}
@@ -0,0 +1,73 @@
+package tachograph
+
+import (
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+)
+
+func TestStripRawData(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	rawFile, err := Unmarshal(append(binary.BigEndian.AppendUint16(nil, 0x7601), value...))
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	file, err := Parse(rawFile)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	before, err := protojson.Marshal(file)
+	if err != nil {
+		t.Fatalf("protojson.Marshal() error = %v", err)
+	}
+	if countRawData(file.ProtoReflect()) == 0 {
+		t.Fatal("parsed file has no raw_data fields to strip")
+	}
+
+	StripRawData(file)
+
+	if n := countRawData(file.ProtoReflect()); n != 0 {
+		t.Errorf("%d raw_data fields left after StripRawData", n)
+	}
+	after, err := protojson.Marshal(file)
+	if err != nil {
+		t.Fatalf("protojson.Marshal() error = %v", err)
+	}
+	if len(after) >= len(before) {
+		t.Errorf("protojson size after StripRawData = %d, want less than %d", len(after), len(before))
+	}
+	if got := file.GetVehicleUnit().GetGen1().GetOverview().GetVehicleIdentificationNumber().GetValue(); got == "" {
+		t.Error("StripRawData cleared the vehicle identification number")
+	}
+}
+
+// countRawData counts the populated raw_data fields of m and its nested messages.
+func countRawData(m protoreflect.Message) int {
+	var n int
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Name() == rawDataField:
+			n++
+		case fd.IsList() && fd.Message() != nil:
+			for i := 0; i < v.List().Len(); i++ {
+				n += countRawData(v.List().Get(i).Message())
+			}
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			n += countRawData(v.Message())
+		}
+		return true
+	})
+	return n
+}
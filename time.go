@@ -0,0 +1,30 @@
+package tachograph
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/way-platform/tachograph-go/internal/dd"
+)
+
+// DecodeDatef decodes a BCD-encoded date (Datef, Data Dictionary,
+// Section 2.57) to midnight UTC at the start of the day.
+//
+// Datef holds a calendar date without a time of day or time zone. It is
+// decoded in UTC, like the TimeReal timestamps of parsed files, so that it
+// compares with timestamps of the same day. The date '00000000'H is not set,
+// and decodes to the zero time.
+func DecodeDatef(input []byte) (time.Time, error) {
+	return dd.DecodeDatef(input)
+}
+
+// IsUnsetTime reports whether a timestamp of a parsed file denotes a TimeReal
+// that is not set: nil, or one of the sentinel values '00000000'H and
+// 'FFFFFFFF'H, which would otherwise read as 1970-01-01 and 2106-02-07.
+//
+// Timestamps should be tested with IsUnsetTime rather than against nil, since
+// 'FFFFFFFF'H is kept as a timestamp to reproduce it when marshalling.
+func IsUnsetTime(ts *timestamppb.Timestamp) bool {
+	return dd.IsUnsetTime(ts)
+}
@@ -31,13 +31,13 @@ type TimeReal struct {
 	Timedata [4]byte
 }
 
-// Decode converts TimeReal bytes to time.Time.
+// Decode converts TimeReal bytes to time.Time in UTC.
 func (tr TimeReal) Decode() time.Time {
 	timeVal := binary.BigEndian.Uint32(tr.Timedata[:])
 	if timeVal == 0 {
 		return time.Time{} // Zero time
 	}
-	return time.Unix(int64(timeVal), 0)
+	return time.Unix(int64(timeVal), 0).UTC()
 }
 
 // CertificateIndex contains metadata about all available certificates.
@@ -0,0 +1,35 @@
+package tachograph
+
+import (
+	"github.com/way-platform/tachograph-go/internal/vu"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// TransferTypeForTag returns the transfer type of a transfer tag, as found at
+// the start of each transfer of a VU download.
+//
+// Tags are of the form 0x76XX, where XX is the TREP value of the transfer
+// response (Appendix 7, Section 2.2.6). It returns false for tags of unknown
+// transfers.
+func TransferTypeForTag(tag uint16) (vuv1.TransferType, bool) {
+	return vu.TransferTypeForTag(tag)
+}
+
+// TagForTransferType returns the tag of a transfer type, see
+// TransferTypeForTag. It returns false for transfer types without a TREP
+// value, such as TRANSFER_TYPE_UNSPECIFIED.
+func TagForTransferType(transferType vuv1.TransferType) (uint16, bool) {
+	return vu.TagForTransferType(transferType)
+}
+
+// TransferSize returns the size of the transfer value at the start of data,
+// which follows the 2-byte tag of a transfer response (TREP) of the given
+// type, and the size of the signature at its end.
+//
+// Transfer values are not length-prefixed, so the size is computed from the
+// count fields in the value itself. If data is too short to reach all of them,
+// an error is returned, and download clients can read more bytes and retry.
+// CARD_DOWNLOAD transfers have no count fields and span all of data.
+func TransferSize(data []byte, transferType vuv1.TransferType) (totalSize, signatureSize int, err error) {
+	return vu.TransferSize(data, transferType)
+}
@@ -1,15 +1,19 @@
 package tachograph
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/way-platform/tachograph-go/internal/card"
 	"github.com/way-platform/tachograph-go/internal/dd"
 	"github.com/way-platform/tachograph-go/internal/vu"
 	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
 // Unmarshal parses a tachograph file from its binary representation into a raw,
@@ -18,6 +22,7 @@ import (
 //
 // This is a convenience function that uses default options:
 // - Strict: true (error on unrecognized tags)
+// - Decompress: true (accept gzip and single-file zip input)
 //
 // For custom options, use UnmarshalOptions directly:
 //
@@ -25,11 +30,24 @@ import (
 //	rawFile, err := opts.Unmarshal(data)
 func Unmarshal(data []byte) (*tachographv1.RawFile, error) {
 	opts := UnmarshalOptions{
-		Strict: true,
+		Strict:     true,
+		Decompress: true,
 	}
 	return opts.Unmarshal(data)
 }
 
+// UnmarshalFiles parses data that may hold several concatenated card files
+// into one RawFile per card, with default options.
+//
+// See UnmarshalOptions.UnmarshalFiles for details.
+func UnmarshalFiles(data []byte) ([]*tachographv1.RawFile, error) {
+	opts := UnmarshalOptions{
+		Strict:     true,
+		Decompress: true,
+	}
+	return opts.UnmarshalFiles(data)
+}
+
 // UnmarshalOptions configures the unmarshaling process for tachograph files.
 type UnmarshalOptions struct {
 	// Strict controls how the unmarshaler handles unrecognized tags or
@@ -41,12 +59,36 @@ type UnmarshalOptions struct {
 	// If false, the unmarshaler will attempt to skip over unrecognized
 	// parts of the file and continue parsing.
 	Strict bool
+
+	// Decompress controls whether compressed input is decompressed before
+	// parsing.
+	//
+	// If true, gzip streams (such as .DDD.gz files) and zip archives holding
+	// a single file are detected from their leading bytes and decompressed.
+	// Uncompressed input is parsed as is.
+	Decompress bool
+
+	// RepairByteOrder enables a best-effort repair of card files written by
+	// faulty card readers that byte-swap the 2-byte length of TLV records.
+	//
+	// If true, a record whose length is implausible, because it overruns the
+	// file or is not followed by another record, is read with the bytes of
+	// its length swapped, if that yields a plausible record. Records are
+	// otherwise read as is, and vehicle unit files are not affected. Repaired
+	// files marshal with the lengths in the correct byte order.
+	RepairByteOrder bool
 }
 
 // Unmarshal parses a tachograph file from its binary representation into a raw,
 // unparsed format. The returned RawFile is suitable for authentication via
 // AuthenticateOptions.Authenticate.
 func (o UnmarshalOptions) Unmarshal(data []byte) (*tachographv1.RawFile, error) {
+	if o.Decompress {
+		var err error
+		if data, err = decompress(data); err != nil {
+			return nil, err
+		}
+	}
 	if len(data) < 2 {
 		return nil, fmt.Errorf("insufficient data for tachograph file: %w", io.ErrUnexpectedEOF)
 	}
@@ -79,13 +121,191 @@ func (o UnmarshalOptions) Unmarshal(data []byte) (*tachographv1.RawFile, error)
 	return &rawFile, nil
 }
 
+// UnmarshalFrom is like Unmarshal, but reads the file from r, for example an
+// HTTP request body or a decompressing reader.
+//
+// The file is read incrementally, one record at a time: TLV records of card
+// files are length-prefixed, and the size of each transfer of a vehicle unit
+// file is computed from its count fields as they arrive. Reading stops at the
+// end of the stream, and an unrecognized file type is reported as soon as the
+// first bytes are read. Transfers whose size cannot be determined, such as
+// unknown tags or card downloads, are read until the end of the stream.
+//
+// The file is parsed once it has been read, so it is held in memory in full.
+// Reading fails once the stream exceeds 64 MiB, far more than any tachograph
+// file, so that an endless stream cannot exhaust memory.
+//
+// With Decompress, gzip streams are decompressed as they are read, while zip
+// archives, which are indexed from their end, are read in full first.
+func (o UnmarshalOptions) UnmarshalFrom(r io.Reader) (*tachographv1.RawFile, error) {
+	s := &streamReader{r: io.LimitReader(r, maxFileSize+1)}
+	if !s.fill(2) {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return o.Unmarshal(s.data)
+	}
+	if o.Decompress {
+		switch {
+		case bytes.HasPrefix(s.data, gzipMagic):
+			r, err := gzip.NewReader(io.MultiReader(bytes.NewReader(s.data), s.r))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read gzip header: %w", err)
+			}
+			return UnmarshalOptions{Strict: o.Strict, RepairByteOrder: o.RepairByteOrder}.UnmarshalFrom(r)
+		case bytes.HasPrefix(s.data, zipMagic[:2]):
+			s.readAll()
+			if s.err != nil {
+				return nil, s.err
+			}
+			return o.Unmarshal(s.data)
+		}
+	}
+	switch {
+	case s.data[0] == 0x76:
+		s.readTransfers()
+	case binary.BigEndian.Uint16(s.data[0:2]) == 0x0002 && o.RepairByteOrder:
+		// Byte-swapped lengths are only detected once the following record
+		// is available, so the file is read in full.
+		s.readAll()
+	case binary.BigEndian.Uint16(s.data[0:2]) == 0x0002:
+		s.readTLVRecords()
+	default:
+		return nil, errors.New("unknown or unsupported file type")
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return o.Unmarshal(s.data)
+}
+
+// streamReader reads a tachograph file from an io.Reader into memory, record
+// by record.
+type streamReader struct {
+	r    io.Reader
+	data []byte
+	eof  bool
+	err  error
+}
+
+// minStreamRead is the minimum number of bytes read from a stream at once.
+const minStreamRead = 4096
+
+// fill reads from the stream until at least n bytes have been read, and
+// reports whether it succeeded. It fails at the end of the stream or on a
+// read error, which is recorded in err, as is a stream exceeding maxFileSize.
+func (s *streamReader) fill(n int) bool {
+	for len(s.data) < n {
+		if s.eof || s.err != nil {
+			return false
+		}
+		s.data = slices.Grow(s.data, max(n-len(s.data), minStreamRead))
+		m, err := s.r.Read(s.data[len(s.data):cap(s.data)])
+		s.data = s.data[:len(s.data)+m]
+		switch {
+		case len(s.data) > maxFileSize:
+			s.err = fmt.Errorf("file exceeds %d bytes", maxFileSize)
+		case errors.Is(err, io.EOF):
+			s.eof = true
+		case err != nil:
+			s.err = err
+		}
+	}
+	return true
+}
+
+// readAll reads the remainder of the stream.
+func (s *streamReader) readAll() {
+	for s.fill(len(s.data) + 1) {
+	}
+}
+
+// readTLVRecords reads the TLV records of a card file: a 3-byte tag and a
+// 2-byte length followed by the value.
+func (s *streamReader) readTLVRecords() {
+	const lenHeader = 5
+	offset := 0
+	for s.fill(offset+1) && s.fill(offset+lenHeader) {
+		length := int(binary.BigEndian.Uint16(s.data[offset+3:]))
+		offset += lenHeader + length
+		if !s.fill(offset) {
+			return
+		}
+	}
+}
+
+// readTransfers reads the transfers of a vehicle unit file: a 2-byte tag
+// followed by a value whose size is determined by its content.
+func (s *streamReader) readTransfers() {
+	const lenTag = 2
+	offset := 0
+	for s.fill(offset+1) && s.fill(offset+lenTag) {
+		transferType, ok := vu.TransferTypeForTag(binary.BigEndian.Uint16(s.data[offset:]))
+		if !ok || transferType == vuv1.TransferType_CARD_DOWNLOAD {
+			s.readAll()
+			return
+		}
+		offset += lenTag
+		for {
+			size, _, err := vu.TransferSize(s.data[offset:], transferType)
+			if err == nil {
+				offset += size
+				break
+			}
+			// The transfer is incomplete, or malformed: read more and retry,
+			// leaving the error to Unmarshal at the end of the stream. Reading
+			// as much again as is pending keeps the number of retries
+			// logarithmic in the size of the transfer.
+			if !s.fill(len(s.data) + max(len(s.data)-offset, minStreamRead)) {
+				return
+			}
+		}
+		if !s.fill(offset) {
+			return
+		}
+	}
+}
+
+// UnmarshalFiles parses data that may hold several concatenated card files,
+// as bundled by some fleet management systems, into one RawFile per card.
+//
+// Vehicle unit files are returned as a single RawFile, as with Unmarshal.
+func (o UnmarshalOptions) UnmarshalFiles(data []byte) ([]*tachographv1.RawFile, error) {
+	if o.Decompress {
+		var err error
+		if data, err = decompress(data); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) < 2 || binary.BigEndian.Uint16(data[0:2]) != 0x0002 {
+		rawFile, err := o.Unmarshal(data)
+		if err != nil {
+			return nil, err
+		}
+		return []*tachographv1.RawFile{rawFile}, nil
+	}
+	cardRaws, err := o.card().UnmarshalRawCardFiles(data)
+	if err != nil {
+		return nil, err
+	}
+	rawFiles := make([]*tachographv1.RawFile, 0, len(cardRaws))
+	for _, cardRaw := range cardRaws {
+		var rawFile tachographv1.RawFile
+		rawFile.SetType(tachographv1.RawFile_CARD)
+		rawFile.SetCard(cardRaw)
+		rawFiles = append(rawFiles, &rawFile)
+	}
+	return rawFiles, nil
+}
+
 // card returns card.UnmarshalOptions configured from UnmarshalOptions.
 func (o UnmarshalOptions) card() card.UnmarshalOptions {
 	return card.UnmarshalOptions{
 		UnmarshalOptions: dd.UnmarshalOptions{
 			// PreserveRawData NOT set - unmarshal produces RawFile, not semantic messages
 		},
-		Strict: o.Strict,
+		Strict:          o.Strict,
+		RepairByteOrder: o.RepairByteOrder,
 	}
 }
 
@@ -2,15 +2,24 @@ package tachograph
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"buf.build/go/protovalidate"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+	"github.com/way-platform/tachograph-go/internal/vu"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
 )
 
 func TestUnmarshalFile_golden(t *testing.T) {
@@ -88,3 +97,83 @@ func TestUnmarshalFile_golden(t *testing.T) {
 		t.Fatalf("Failed to walk testdata directory: %v", err)
 	}
 }
+
+func TestUnmarshalOptions_UnmarshalFrom(t *testing.T) {
+	readHexdump := func(path string) []byte {
+		t.Helper()
+		dump, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("Failed to read hexdump: %v", err)
+		}
+		value, err := hexdump.Unmarshal(dump)
+		if err != nil {
+			t.Fatalf("Failed to decode hexdump: %v", err)
+		}
+		return value
+	}
+
+	// A vehicle unit file of Gen1 transfers, tagged from their file names.
+	vuPaths, err := filepath.Glob("internal/vu/testdata/records/000-anonymized/*.hexdump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vuData []byte
+	for _, path := range vuPaths {
+		// Example: "001-ACTIVITIES_GEN1.hexdump"
+		name := strings.TrimSuffix(filepath.Base(path), ".hexdump")
+		transferType := vuv1.TransferType(vuv1.TransferType_value[name[strings.Index(name, "-")+1:]])
+		tag, ok := vu.TagForTransferType(transferType)
+		if !ok {
+			t.Fatalf("no tag for %s", path)
+		}
+		vuData = binary.BigEndian.AppendUint16(vuData, tag)
+		vuData = append(vuData, readHexdump(path)...)
+	}
+
+	// A card file with the EF_ICC and EF_IC records.
+	var cardData []byte
+	for _, record := range []struct {
+		fid  uint16
+		path string
+	}{
+		{fid: 0x0002, path: "internal/card/testdata/records/000-anonymized/000-EF_ICC-GENERATION_1-DATA.hexdump"},
+		{fid: 0x0005, path: "internal/card/testdata/records/000-anonymized/001-EF_IC-GENERATION_1-DATA.hexdump"},
+	} {
+		value := readHexdump(record.path)
+		cardData = binary.BigEndian.AppendUint16(cardData, record.fid)
+		cardData = append(cardData, 0x00)
+		cardData = binary.BigEndian.AppendUint16(cardData, uint16(len(value)))
+		cardData = append(cardData, value...)
+	}
+
+	opts := UnmarshalOptions{Strict: true}
+	for name, data := range map[string][]byte{
+		"vehicle unit":           vuData,
+		"card":                   cardData,
+		"truncated vehicle unit": vuData[:len(vuData)-10],
+		"truncated card":         cardData[:len(cardData)-10],
+	} {
+		t.Run(name, func(t *testing.T) {
+			want, wantErr := opts.Unmarshal(data)
+			got, err := opts.UnmarshalFrom(iotest.OneByteReader(bytes.NewReader(data)))
+			if (err != nil) != (wantErr != nil) {
+				t.Fatalf("UnmarshalFrom error = %v, Unmarshal error = %v", err, wantErr)
+			}
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("UnmarshalFrom mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := opts.UnmarshalFrom(strings.NewReader("not a tachograph file")); err == nil {
+		t.Error("UnmarshalFrom of an unknown file type succeeded, want error")
+	}
+	readErr := errors.New("connection reset")
+	if _, err := opts.UnmarshalFrom(io.MultiReader(bytes.NewReader(vuData[:100]), iotest.ErrReader(readErr))); !errors.Is(err, readErr) {
+		t.Errorf("UnmarshalFrom with a failing reader: got error %v, want %v", err, readErr)
+	}
+	tooLarge := io.MultiReader(bytes.NewReader(vuData), bytes.NewReader(make([]byte, maxFileSize)))
+	if _, err := opts.UnmarshalFrom(tooLarge); err == nil || !strings.Contains(err.Error(), "exceeds") {
+		t.Errorf("UnmarshalFrom of a stream exceeding the maximum size: got error %v, want size error", err)
+	}
+}
@@ -0,0 +1,220 @@
+package tachograph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/way-platform/tachograph-go/internal/vu"
+	cardv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/card/v1"
+	tachographv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// IssueSeverity is the severity of a validation issue.
+type IssueSeverity int
+
+const (
+	// IssueWarning is an issue that does not prevent the file from being
+	// parsed, such as a missing signature or an inconsistent timestamp.
+	IssueWarning IssueSeverity = iota + 1
+
+	// IssueError is an issue that makes the file invalid, such as a
+	// truncated record or a signature that does not match its data.
+	IssueError
+)
+
+// String returns the name of the issue severity.
+func (s IssueSeverity) String() string {
+	switch s {
+	case IssueWarning:
+		return "WARNING"
+	case IssueError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ValidationIssue is a single issue found by Validate.
+type ValidationIssue struct {
+	// Severity is the severity of the issue.
+	Severity IssueSeverity
+
+	// Message describes the issue.
+	Message string
+}
+
+// String returns the issue as "SEVERITY: message".
+func (i ValidationIssue) String() string {
+	return i.Severity.String() + ": " + i.Message
+}
+
+// ValidationReport lists the issues found by Validate, in the order they
+// were found.
+type ValidationReport struct {
+	Issues []ValidationIssue
+}
+
+// HasErrors reports whether any issue of the report has error severity.
+func (r *ValidationReport) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == IssueError {
+			return true
+		}
+	}
+	return false
+}
+
+// add appends an issue to the report.
+func (r *ValidationReport) add(severity IssueSeverity, format string, args ...any) {
+	r.Issues = append(r.Issues, ValidationIssue{
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Validate checks a .DDD file with default options.
+// See ValidateOptions.Validate.
+func Validate(ctx context.Context, data []byte) *ValidationReport {
+	return ValidateOptions{}.Validate(ctx, data)
+}
+
+// ValidateOptions configures the validation of tachograph files.
+type ValidateOptions struct {
+	// Authenticate controls whether signatures are verified.
+	//
+	// If false (default), only the presence of signatures is checked. If
+	// true, signatures are also verified, resolving certificates with
+	// CertificateResolver: invalid signatures are errors, and signatures that
+	// cannot be verified are warnings.
+	Authenticate bool
+
+	// CertificateResolver is used to resolve CA certificates when
+	// Authenticate is set. If nil, DefaultCertificateResolver is used.
+	CertificateResolver CertificateResolver
+}
+
+// Validate checks a .DDD file and reports the issues found.
+//
+// The file is checked for:
+//   - structural errors: truncated TLV records and transfers, unrecognized
+//     tags, and transfers whose sizes do not match their content;
+//   - missing signatures of the records that are signed by the regulation;
+//   - errors parsing the records, and record arrays whose record sizes
+//     differ from the specification (as warnings);
+//   - violations of the protovalidate constraints of the parsed file, such
+//     as string values without an encoding;
+//   - inconsistent timestamps of vehicle unit files, see
+//     vu.CheckTimeConsistency.
+//
+// Validation stops at the first structural error, since the records of the
+// file cannot be checked further.
+func (o ValidateOptions) Validate(ctx context.Context, data []byte) *ValidationReport {
+	report := &ValidationReport{}
+	rawFile, err := UnmarshalOptions{Strict: true, Decompress: true}.Unmarshal(data)
+	if err != nil {
+		report.add(IssueError, "%v", err)
+		return report
+	}
+
+	if o.Authenticate {
+		authOpts := AuthenticateOptions{
+			CertificateResolver: o.CertificateResolver,
+			Mutate:              true,
+		}
+		_, authReport, err := authOpts.AuthenticateWithReport(ctx, rawFile)
+		if authReport == nil {
+			report.add(IssueError, "%v", err)
+			return report
+		}
+		o.validateSignatures(report, authReport)
+	} else {
+		o.validateSignatures(report, newAuthenticationReport(rawFile))
+	}
+
+	parseOpts := ParseOptions{
+		PreserveRawData:    true,
+		LenientRecordSizes: true,
+	}
+	file, err := parseOpts.ParseContext(ctx, rawFile)
+	if err != nil {
+		report.add(IssueError, "%v", err)
+		return report
+	}
+	validateConstraints(report, file)
+	if file.GetType() == tachographv1.File_VEHICLE_UNIT {
+		vuFile := file.GetVehicleUnit()
+		for _, warning := range vuFile.GetParseWarnings() {
+			report.add(IssueWarning, "%s", warning)
+		}
+		for _, anomaly := range vu.CheckTimeConsistency(vuFile) {
+			report.add(IssueWarning, "time anomaly %v at %s (reference %s)",
+				anomaly.Kind, anomaly.Time.Format(time.RFC3339), anomaly.Reference.Format(time.RFC3339))
+		}
+	}
+	return report
+}
+
+// validateSignatures reports the records of an authentication report whose
+// signature is missing, invalid, or could not be verified.
+func (o ValidateOptions) validateSignatures(report *ValidationReport, authReport *AuthenticationReport) {
+	for _, record := range authReport.Records {
+		switch record.Status {
+		case AuthenticationUnsigned:
+			if unsignedRecords[record.Name] {
+				continue
+			}
+			report.add(IssueWarning, "%s (%v) at offset %d has no signature", record.Name, record.Generation, record.FileOffset)
+		case AuthenticationInvalid:
+			report.add(IssueError, "%s (%v) at offset %d has an invalid signature", record.Name, record.Generation, record.FileOffset)
+		case AuthenticationUnverifiable:
+			if o.Authenticate {
+				report.add(IssueWarning, "%s (%v) at offset %d has a signature that could not be verified", record.Name, record.Generation, record.FileOffset)
+			}
+		}
+	}
+}
+
+// validateConstraints reports the violations of the protovalidate constraints
+// of a parsed message.
+func validateConstraints(report *ValidationReport, msg proto.Message) {
+	err := protovalidate.Validate(msg)
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		if err != nil {
+			report.add(IssueError, "%v", err)
+		}
+		return
+	}
+	for _, violation := range validationErr.Violations {
+		report.add(IssueError, "%s: %s [%s]",
+			protovalidate.FieldPathString(violation.Proto.GetField()), violation.Proto.GetMessage(), violation.Proto.GetRuleId())
+	}
+}
+
+// unsignedRecords are the card EFs that are not signed, see Appendix 2,
+// Section 3.3 (DDP_035, DDP_037), EFs that are not recognized, and the VU
+// transfers that carry no signature: the download interface version and card
+// downloads.
+var unsignedRecords = map[string]bool{
+	cardv1.ElementaryFileType_ELEMENTARY_FILE_UNSPECIFIED.String(): true,
+	cardv1.ElementaryFileType_EF_ICC.String():                      true,
+	cardv1.ElementaryFileType_EF_IC.String():                       true,
+	cardv1.ElementaryFileType_EF_ATR_INFO.String():                 true,
+	cardv1.ElementaryFileType_EF_EXTENDED_LENGTH.String():          true,
+	cardv1.ElementaryFileType_EF_DIR.String():                      true,
+	cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_DRIVER.String():     true,
+	cardv1.ElementaryFileType_EF_CARD_DOWNLOAD_WORKSHOP.String():   true,
+	cardv1.ElementaryFileType_EF_CARD_CERTIFICATE.String():         true,
+	cardv1.ElementaryFileType_EF_CARD_MA_CERTIFICATE.String():      true,
+	cardv1.ElementaryFileType_EF_CARD_SIGN_CERTIFICATE.String():    true,
+	cardv1.ElementaryFileType_EF_CA_CERTIFICATE.String():           true,
+	cardv1.ElementaryFileType_EF_LINK_CERTIFICATE.String():         true,
+	vuv1.TransferType_DOWNLOAD_INTERFACE_VERSION.String():          true,
+	vuv1.TransferType_CARD_DOWNLOAD.String():                       true,
+}
@@ -0,0 +1,71 @@
+package tachograph
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/way-platform/tachograph-go/internal/hexdump"
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+)
+
+func TestValidate(t *testing.T) {
+	dump, err := os.ReadFile("internal/vu/testdata/records/002-anonymized/000-OVERVIEW_GEN1.hexdump")
+	if err != nil {
+		t.Fatalf("Failed to read hexdump: %v", err)
+	}
+	value, err := hexdump.Unmarshal(dump)
+	if err != nil {
+		t.Fatalf("Failed to decode hexdump: %v", err)
+	}
+	data := append(binary.BigEndian.AppendUint16(nil, 0x7601), value...)
+
+	report := Validate(context.Background(), data)
+	if report.HasErrors() {
+		t.Errorf("Validate() reported errors: %v", report.Issues)
+	}
+
+	// A transfer truncated within its signature.
+	report = Validate(context.Background(), data[:len(data)-10])
+	if !report.HasErrors() {
+		t.Errorf("Validate() of a truncated file reported no errors: %v", report.Issues)
+	}
+}
+
+func TestValidationIssue_String(t *testing.T) {
+	issue := ValidationIssue{Severity: IssueError, Message: "truncated"}
+	if got, want := issue.String(), "ERROR: truncated"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestValidate_unsignedTransfers(t *testing.T) {
+	// A Gen2 V2 download, which starts with the unsigned download interface
+	// version.
+	data := []byte{0x76, 0x00, 0x01, 0x01}
+	report := Validate(context.Background(), data)
+	for _, issue := range report.Issues {
+		if strings.Contains(issue.Message, "has no signature") {
+			t.Errorf("Validate() reported %v", issue)
+		}
+	}
+}
+
+func TestValidateConstraints(t *testing.T) {
+	record := &ddv1.PlaceRecord{}
+	record.SetDailyWorkPeriodRegion(0x0E)
+	report := &ValidationReport{}
+	validateConstraints(report, record)
+	if len(report.Issues) != 0 {
+		t.Errorf("validateConstraints() of a valid record reported %v", report.Issues)
+	}
+
+	record.SetDailyWorkPeriodRegion(0x100)
+	validateConstraints(report, record)
+	if len(report.Issues) != 1 || report.Issues[0].Severity != IssueError ||
+		!strings.Contains(report.Issues[0].Message, "daily_work_period_region") {
+		t.Errorf("validateConstraints() of an out of range region reported %v, want one error", report.Issues)
+	}
+}
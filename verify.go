@@ -0,0 +1,39 @@
+package tachograph
+
+import (
+	"context"
+
+	"github.com/way-platform/tachograph-go/internal/vu"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+// VerifyOptions configures the verification of a vehicle unit file.
+type VerifyOptions struct {
+	// CertificateResolver is used to resolve the root certificates.
+	// If nil, this defaults to using DefaultCertificateResolver.
+	CertificateResolver CertificateResolver
+
+	// VerifyChain reports the verified links of the certificate chain of the
+	// VU certificate. The chain is always verified; VerifyChain only controls
+	// whether it is reported.
+	VerifyChain bool
+}
+
+// Verification is the result of verifying a vehicle unit file.
+type Verification = vu.Verification
+
+// CertificateLink is a verified link in a certificate chain.
+type CertificateLink = vu.CertificateLink
+
+// VerifyVehicleUnitFile verifies the data signatures of all signed transfers
+// in a raw vehicle unit file against the VU certificate of its overview, and
+// the certificate chain of the VU certificate.
+//
+// Unlike Authenticate, it does not populate the Authentication fields of the
+// records; it returns an error for the first transfer or link that fails.
+func (o VerifyOptions) VerifyVehicleUnitFile(ctx context.Context, rawFile *vuv1.RawVehicleUnitFile) (*Verification, error) {
+	return vu.VerifyOptions{
+		CertificateResolver: o.CertificateResolver,
+		VerifyChain:         o.VerifyChain,
+	}.VerifyVehicleUnitFile(ctx, rawFile)
+}
@@ -0,0 +1,68 @@
+package tachograph
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Walk calls fn for every populated field of a message and all messages
+// nested in it, such as a parsed File, in depth-first order.
+//
+// The path of a field is the dot-separated sequence of field names leading
+// to it from msg, such as "vehicle_unit.gen1.overview.vehicle_identification_number".
+// Elements of repeated fields are visited one by one with the index appended
+// to the path, as in "driver_card.activity_days[3]", and map entries with the
+// key appended, as in "labels[key]". Message fields are visited before their
+// own fields, so fn can select messages by type, such as
+// google.protobuf.Timestamp, through value.Message().Descriptor().
+//
+// Fields are visited in declaration order, so apart from map entries the
+// order of visits is stable for a given message.
+func Walk(msg proto.Message, fn func(path string, value protoreflect.Value)) {
+	if msg == nil {
+		return
+	}
+	walk(msg.ProtoReflect(), "", fn)
+}
+
+func walk(m protoreflect.Message, prefix string, fn func(path string, value protoreflect.Value)) {
+	if !m.IsValid() {
+		return
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for j := 0; j < list.Len(); j++ {
+				walkValue(fd, path+"["+strconv.Itoa(j)+"]", list.Get(j), fn)
+			}
+		case fd.IsMap():
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				walkValue(fd.MapValue(), path+"["+k.String()+"]", v, fn)
+				return true
+			})
+		default:
+			walkValue(fd, path, v, fn)
+		}
+	}
+}
+
+// walkValue visits a single value of the field fd, descending into messages.
+func walkValue(fd protoreflect.FieldDescriptor, path string, v protoreflect.Value, fn func(path string, value protoreflect.Value)) {
+	fn(path, v)
+	if fd.Message() != nil {
+		walk(v.Message(), path, fn)
+	}
+}
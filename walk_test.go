@@ -0,0 +1,80 @@
+package tachograph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ddv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/dd/v1"
+	vuv1 "github.com/way-platform/tachograph-go/proto/gen/go/wayplatform/connect/tachograph/vu/v1"
+)
+
+func TestWalk(t *testing.T) {
+	rawFile, err := Unmarshal(readOverviewFile(t))
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	file, err := Parse(rawFile)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	values := map[string]protoreflect.Value{}
+	var paths, timestamps []string
+	Walk(file, func(path string, value protoreflect.Value) {
+		if _, ok := values[path]; ok {
+			t.Errorf("path %q visited twice", path)
+		}
+		values[path] = value
+		paths = append(paths, path)
+		if m, ok := value.Interface().(protoreflect.Message); ok && m.Descriptor().FullName() == "google.protobuf.Timestamp" {
+			timestamps = append(timestamps, path)
+		}
+	})
+
+	const vinPath = "vehicle_unit.gen1.overview.vehicle_identification_number.value"
+	want := file.GetVehicleUnit().GetGen1().GetOverview().GetVehicleIdentificationNumber().GetValue()
+	if got := values[vinPath].String(); got != want {
+		t.Errorf("value at %q = %q, want %q", vinPath, got, want)
+	}
+	if diff := cmp.Diff([]string{"type", "vehicle_unit"}, paths[:2]); diff != "" {
+		t.Errorf("first paths mismatch (-want +got):\n%s", diff)
+	}
+	if len(timestamps) == 0 {
+		t.Fatal("Walk() visited no timestamps")
+	}
+	for _, path := range timestamps {
+		if !strings.HasPrefix(path, "vehicle_unit.gen1.overview.") {
+			t.Errorf("unexpected timestamp path %q", path)
+		}
+		if _, ok := values[path+".seconds"]; !ok && values[path].Message().Interface().(*timestamppb.Timestamp).GetSeconds() != 0 {
+			t.Errorf("Walk() did not descend into timestamp %q", path)
+		}
+	}
+}
+
+func TestWalk_repeated(t *testing.T) {
+	serialNumber := &ddv1.ExtendedSerialNumber{}
+	serialNumber.SetSerialNumber(42)
+	sensor := &vuv1.TechnicalDataGen2V1_PairedSensor{}
+	sensor.SetSerialNumber(serialNumber)
+	technicalData := &vuv1.TechnicalDataGen2V1{}
+	technicalData.SetPairedSensors([]*vuv1.TechnicalDataGen2V1_PairedSensor{{}, sensor})
+
+	var paths []string
+	Walk(technicalData, func(path string, value protoreflect.Value) {
+		paths = append(paths, path)
+	})
+	want := []string{
+		"paired_sensors[0]",
+		"paired_sensors[1]",
+		"paired_sensors[1].serial_number",
+		"paired_sensors[1].serial_number.serial_number",
+	}
+	if diff := cmp.Diff(want, paths); diff != "" {
+		t.Errorf("Walk() paths mismatch (-want +got):\n%s", diff)
+	}
+}